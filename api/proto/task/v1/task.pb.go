@@ -22,17 +22,663 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TimeReportGrouping int32
+
+const (
+	TimeReportGrouping_TIME_REPORT_GROUPING_UNSPECIFIED TimeReportGrouping = 0
+	TimeReportGrouping_TIME_REPORT_GROUPING_DAY         TimeReportGrouping = 1
+	TimeReportGrouping_TIME_REPORT_GROUPING_WEEK        TimeReportGrouping = 2
+	TimeReportGrouping_TIME_REPORT_GROUPING_MONTH       TimeReportGrouping = 3
+)
+
+// Enum value maps for TimeReportGrouping.
+var (
+	TimeReportGrouping_name = map[int32]string{
+		0: "TIME_REPORT_GROUPING_UNSPECIFIED",
+		1: "TIME_REPORT_GROUPING_DAY",
+		2: "TIME_REPORT_GROUPING_WEEK",
+		3: "TIME_REPORT_GROUPING_MONTH",
+	}
+	TimeReportGrouping_value = map[string]int32{
+		"TIME_REPORT_GROUPING_UNSPECIFIED": 0,
+		"TIME_REPORT_GROUPING_DAY":         1,
+		"TIME_REPORT_GROUPING_WEEK":        2,
+		"TIME_REPORT_GROUPING_MONTH":       3,
+	}
+)
+
+func (x TimeReportGrouping) Enum() *TimeReportGrouping {
+	p := new(TimeReportGrouping)
+	*p = x
+	return p
+}
+
+func (x TimeReportGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeReportGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeReportGrouping) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[0]
+}
+
+func (x TimeReportGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeReportGrouping.Descriptor instead.
+func (TimeReportGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+type TemplateKind int32
+
+const (
+	TemplateKind_TEMPLATE_KIND_UNSPECIFIED TemplateKind = 0
+	TemplateKind_TEMPLATE_KIND_BOARD       TemplateKind = 1
+	TemplateKind_TEMPLATE_KIND_TASK        TemplateKind = 2
+)
+
+// Enum value maps for TemplateKind.
+var (
+	TemplateKind_name = map[int32]string{
+		0: "TEMPLATE_KIND_UNSPECIFIED",
+		1: "TEMPLATE_KIND_BOARD",
+		2: "TEMPLATE_KIND_TASK",
+	}
+	TemplateKind_value = map[string]int32{
+		"TEMPLATE_KIND_UNSPECIFIED": 0,
+		"TEMPLATE_KIND_BOARD":       1,
+		"TEMPLATE_KIND_TASK":        2,
+	}
+)
+
+func (x TemplateKind) Enum() *TemplateKind {
+	p := new(TemplateKind)
+	*p = x
+	return p
+}
+
+func (x TemplateKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemplateKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TemplateKind) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[1]
+}
+
+func (x TemplateKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemplateKind.Descriptor instead.
+func (TemplateKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+type CustomFieldType int32
+
+const (
+	CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED   CustomFieldType = 0
+	CustomFieldType_CUSTOM_FIELD_TYPE_TEXT          CustomFieldType = 1
+	CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER        CustomFieldType = 2
+	CustomFieldType_CUSTOM_FIELD_TYPE_SINGLE_SELECT CustomFieldType = 3
+	CustomFieldType_CUSTOM_FIELD_TYPE_MULTI_SELECT  CustomFieldType = 4
+	CustomFieldType_CUSTOM_FIELD_TYPE_DATE          CustomFieldType = 5
+	CustomFieldType_CUSTOM_FIELD_TYPE_USER          CustomFieldType = 6
+	CustomFieldType_CUSTOM_FIELD_TYPE_URL           CustomFieldType = 7
+)
+
+// Enum value maps for CustomFieldType.
+var (
+	CustomFieldType_name = map[int32]string{
+		0: "CUSTOM_FIELD_TYPE_UNSPECIFIED",
+		1: "CUSTOM_FIELD_TYPE_TEXT",
+		2: "CUSTOM_FIELD_TYPE_NUMBER",
+		3: "CUSTOM_FIELD_TYPE_SINGLE_SELECT",
+		4: "CUSTOM_FIELD_TYPE_MULTI_SELECT",
+		5: "CUSTOM_FIELD_TYPE_DATE",
+		6: "CUSTOM_FIELD_TYPE_USER",
+		7: "CUSTOM_FIELD_TYPE_URL",
+	}
+	CustomFieldType_value = map[string]int32{
+		"CUSTOM_FIELD_TYPE_UNSPECIFIED":   0,
+		"CUSTOM_FIELD_TYPE_TEXT":          1,
+		"CUSTOM_FIELD_TYPE_NUMBER":        2,
+		"CUSTOM_FIELD_TYPE_SINGLE_SELECT": 3,
+		"CUSTOM_FIELD_TYPE_MULTI_SELECT":  4,
+		"CUSTOM_FIELD_TYPE_DATE":          5,
+		"CUSTOM_FIELD_TYPE_USER":          6,
+		"CUSTOM_FIELD_TYPE_URL":           7,
+	}
+)
+
+func (x CustomFieldType) Enum() *CustomFieldType {
+	p := new(CustomFieldType)
+	*p = x
+	return p
+}
+
+func (x CustomFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (CustomFieldType) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[2]
+}
+
+func (x CustomFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomFieldType.Descriptor instead.
+func (CustomFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+// CalendarComponent is what a calendar feed's tasks appear as.
+type CalendarComponent int32
+
+const (
+	CalendarComponent_CALENDAR_COMPONENT_UNSPECIFIED CalendarComponent = 0
+	// Events at the due time, shown by every calendar app. The default.
+	CalendarComponent_CALENDAR_COMPONENT_EVENT CalendarComponent = 1
+	// To-dos due at the due time, shown by task-aware apps.
+	CalendarComponent_CALENDAR_COMPONENT_TODO CalendarComponent = 2
+)
+
+// Enum value maps for CalendarComponent.
+var (
+	CalendarComponent_name = map[int32]string{
+		0: "CALENDAR_COMPONENT_UNSPECIFIED",
+		1: "CALENDAR_COMPONENT_EVENT",
+		2: "CALENDAR_COMPONENT_TODO",
+	}
+	CalendarComponent_value = map[string]int32{
+		"CALENDAR_COMPONENT_UNSPECIFIED": 0,
+		"CALENDAR_COMPONENT_EVENT":       1,
+		"CALENDAR_COMPONENT_TODO":        2,
+	}
+)
+
+func (x CalendarComponent) Enum() *CalendarComponent {
+	p := new(CalendarComponent)
+	*p = x
+	return p
+}
+
+func (x CalendarComponent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarComponent) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (CalendarComponent) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[3]
+}
+
+func (x CalendarComponent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarComponent.Descriptor instead.
+func (CalendarComponent) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	// Waiting for its first attempt or a retry.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED WebhookDeliveryStatus = 2
+	// Failed every attempt; only a redelivery sends it again.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[4].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[4]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+// EmailDelivery is how often a user's notifications are emailed. Anything
+// other than immediate batches them into a digest.
+type EmailDelivery int32
+
+const (
+	EmailDelivery_EMAIL_DELIVERY_UNSPECIFIED EmailDelivery = 0
+	EmailDelivery_EMAIL_DELIVERY_IMMEDIATE   EmailDelivery = 1
+	EmailDelivery_EMAIL_DELIVERY_HOURLY      EmailDelivery = 2
+	EmailDelivery_EMAIL_DELIVERY_DAILY       EmailDelivery = 3
+	EmailDelivery_EMAIL_DELIVERY_OFF         EmailDelivery = 4
+)
+
+// Enum value maps for EmailDelivery.
+var (
+	EmailDelivery_name = map[int32]string{
+		0: "EMAIL_DELIVERY_UNSPECIFIED",
+		1: "EMAIL_DELIVERY_IMMEDIATE",
+		2: "EMAIL_DELIVERY_HOURLY",
+		3: "EMAIL_DELIVERY_DAILY",
+		4: "EMAIL_DELIVERY_OFF",
+	}
+	EmailDelivery_value = map[string]int32{
+		"EMAIL_DELIVERY_UNSPECIFIED": 0,
+		"EMAIL_DELIVERY_IMMEDIATE":   1,
+		"EMAIL_DELIVERY_HOURLY":      2,
+		"EMAIL_DELIVERY_DAILY":       3,
+		"EMAIL_DELIVERY_OFF":         4,
+	}
+)
+
+func (x EmailDelivery) Enum() *EmailDelivery {
+	p := new(EmailDelivery)
+	*p = x
+	return p
+}
+
+func (x EmailDelivery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmailDelivery) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[5].Descriptor()
+}
+
+func (EmailDelivery) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[5]
+}
+
+func (x EmailDelivery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmailDelivery.Descriptor instead.
+func (EmailDelivery) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+// InboxNotificationKind is why a user got an inbox notification.
+type InboxNotificationKind int32
+
+const (
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_UNSPECIFIED InboxNotificationKind = 0
+	// Newly @mentioned in the task's description.
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_MENTIONED InboxNotificationKind = 1
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_ASSIGNED  InboxNotificationKind = 2
+	// A watched task changed, was completed or was deleted.
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_UPDATED   InboxNotificationKind = 3
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_COMPLETED InboxNotificationKind = 4
+	InboxNotificationKind_INBOX_NOTIFICATION_KIND_DELETED   InboxNotificationKind = 5
+)
+
+// Enum value maps for InboxNotificationKind.
+var (
+	InboxNotificationKind_name = map[int32]string{
+		0: "INBOX_NOTIFICATION_KIND_UNSPECIFIED",
+		1: "INBOX_NOTIFICATION_KIND_MENTIONED",
+		2: "INBOX_NOTIFICATION_KIND_ASSIGNED",
+		3: "INBOX_NOTIFICATION_KIND_UPDATED",
+		4: "INBOX_NOTIFICATION_KIND_COMPLETED",
+		5: "INBOX_NOTIFICATION_KIND_DELETED",
+	}
+	InboxNotificationKind_value = map[string]int32{
+		"INBOX_NOTIFICATION_KIND_UNSPECIFIED": 0,
+		"INBOX_NOTIFICATION_KIND_MENTIONED":   1,
+		"INBOX_NOTIFICATION_KIND_ASSIGNED":    2,
+		"INBOX_NOTIFICATION_KIND_UPDATED":     3,
+		"INBOX_NOTIFICATION_KIND_COMPLETED":   4,
+		"INBOX_NOTIFICATION_KIND_DELETED":     5,
+	}
+)
+
+func (x InboxNotificationKind) Enum() *InboxNotificationKind {
+	p := new(InboxNotificationKind)
+	*p = x
+	return p
+}
+
+func (x InboxNotificationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InboxNotificationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[6].Descriptor()
+}
+
+func (InboxNotificationKind) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[6]
+}
+
+func (x InboxNotificationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InboxNotificationKind.Descriptor instead.
+func (InboxNotificationKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+// BoardRole is what a board member may do. Each role can do everything
+// the ones below it can.
+type BoardRole int32
+
+const (
+	BoardRole_BOARD_ROLE_UNSPECIFIED BoardRole = 0
+	// Reads the board and its tasks.
+	BoardRole_BOARD_ROLE_VIEWER BoardRole = 1
+	// Creates, edits and deletes tasks, and tracks time.
+	BoardRole_BOARD_ROLE_MEMBER BoardRole = 2
+	// Manages the board's settings, custom fields, imports and members.
+	BoardRole_BOARD_ROLE_ADMIN BoardRole = 3
+	// Manages owners. A board always keeps at least one.
+	BoardRole_BOARD_ROLE_OWNER BoardRole = 4
+)
+
+// Enum value maps for BoardRole.
+var (
+	BoardRole_name = map[int32]string{
+		0: "BOARD_ROLE_UNSPECIFIED",
+		1: "BOARD_ROLE_VIEWER",
+		2: "BOARD_ROLE_MEMBER",
+		3: "BOARD_ROLE_ADMIN",
+		4: "BOARD_ROLE_OWNER",
+	}
+	BoardRole_value = map[string]int32{
+		"BOARD_ROLE_UNSPECIFIED": 0,
+		"BOARD_ROLE_VIEWER":      1,
+		"BOARD_ROLE_MEMBER":      2,
+		"BOARD_ROLE_ADMIN":       3,
+		"BOARD_ROLE_OWNER":       4,
+	}
+)
+
+func (x BoardRole) Enum() *BoardRole {
+	p := new(BoardRole)
+	*p = x
+	return p
+}
+
+func (x BoardRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[7].Descriptor()
+}
+
+func (BoardRole) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[7]
+}
+
+func (x BoardRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardRole.Descriptor instead.
+func (BoardRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+// WorkspaceRole is what a workspace member may do. Workspaces without
+// members are open to everyone.
+type WorkspaceRole int32
+
+const (
+	WorkspaceRole_WORKSPACE_ROLE_UNSPECIFIED WorkspaceRole = 0
+	// Uses the workspace's boards, subject to their own membership.
+	WorkspaceRole_WORKSPACE_ROLE_MEMBER WorkspaceRole = 1
+	// Also manages the workspace's members. A workspace always keeps at
+	// least one.
+	WorkspaceRole_WORKSPACE_ROLE_OWNER WorkspaceRole = 2
+)
+
+// Enum value maps for WorkspaceRole.
+var (
+	WorkspaceRole_name = map[int32]string{
+		0: "WORKSPACE_ROLE_UNSPECIFIED",
+		1: "WORKSPACE_ROLE_MEMBER",
+		2: "WORKSPACE_ROLE_OWNER",
+	}
+	WorkspaceRole_value = map[string]int32{
+		"WORKSPACE_ROLE_UNSPECIFIED": 0,
+		"WORKSPACE_ROLE_MEMBER":      1,
+		"WORKSPACE_ROLE_OWNER":       2,
+	}
+)
+
+func (x WorkspaceRole) Enum() *WorkspaceRole {
+	p := new(WorkspaceRole)
+	*p = x
+	return p
+}
+
+func (x WorkspaceRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[8].Descriptor()
+}
+
+func (WorkspaceRole) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[8]
+}
+
+func (x WorkspaceRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceRole.Descriptor instead.
+func (WorkspaceRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+type DataFormat int32
+
+const (
+	DataFormat_DATA_FORMAT_UNSPECIFIED DataFormat = 0
+	DataFormat_DATA_FORMAT_CSV         DataFormat = 1
+	// Lossless: the board, its custom field definitions and every task.
+	DataFormat_DATA_FORMAT_JSON DataFormat = 2
+	// Import only: a Trello board export.
+	DataFormat_DATA_FORMAT_TRELLO DataFormat = 3
+	// Import only: a JSON array of GitHub issues, from the REST API or gh
+	// issue list --json.
+	DataFormat_DATA_FORMAT_GITHUB DataFormat = 4
+)
+
+// Enum value maps for DataFormat.
+var (
+	DataFormat_name = map[int32]string{
+		0: "DATA_FORMAT_UNSPECIFIED",
+		1: "DATA_FORMAT_CSV",
+		2: "DATA_FORMAT_JSON",
+		3: "DATA_FORMAT_TRELLO",
+		4: "DATA_FORMAT_GITHUB",
+	}
+	DataFormat_value = map[string]int32{
+		"DATA_FORMAT_UNSPECIFIED": 0,
+		"DATA_FORMAT_CSV":         1,
+		"DATA_FORMAT_JSON":        2,
+		"DATA_FORMAT_TRELLO":      3,
+		"DATA_FORMAT_GITHUB":      4,
+	}
+)
+
+func (x DataFormat) Enum() *DataFormat {
+	p := new(DataFormat)
+	*p = x
+	return p
+}
+
+func (x DataFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[9].Descriptor()
+}
+
+func (DataFormat) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[9]
+}
+
+func (x DataFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataFormat.Descriptor instead.
+func (DataFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+type CustomFieldFilter_Op int32
+
+const (
+	CustomFieldFilter_OP_UNSPECIFIED CustomFieldFilter_Op = 0
+	CustomFieldFilter_OP_EQ          CustomFieldFilter_Op = 1
+	CustomFieldFilter_OP_NE          CustomFieldFilter_Op = 2
+	CustomFieldFilter_OP_LT          CustomFieldFilter_Op = 3
+	CustomFieldFilter_OP_LTE         CustomFieldFilter_Op = 4
+	CustomFieldFilter_OP_GT          CustomFieldFilter_Op = 5
+	CustomFieldFilter_OP_GTE         CustomFieldFilter_Op = 6
+	// Substring for text, membership for multi select.
+	CustomFieldFilter_OP_CONTAINS CustomFieldFilter_Op = 7
+	CustomFieldFilter_OP_SET      CustomFieldFilter_Op = 8
+	CustomFieldFilter_OP_UNSET    CustomFieldFilter_Op = 9
+)
+
+// Enum value maps for CustomFieldFilter_Op.
+var (
+	CustomFieldFilter_Op_name = map[int32]string{
+		0: "OP_UNSPECIFIED",
+		1: "OP_EQ",
+		2: "OP_NE",
+		3: "OP_LT",
+		4: "OP_LTE",
+		5: "OP_GT",
+		6: "OP_GTE",
+		7: "OP_CONTAINS",
+		8: "OP_SET",
+		9: "OP_UNSET",
+	}
+	CustomFieldFilter_Op_value = map[string]int32{
+		"OP_UNSPECIFIED": 0,
+		"OP_EQ":          1,
+		"OP_NE":          2,
+		"OP_LT":          3,
+		"OP_LTE":         4,
+		"OP_GT":          5,
+		"OP_GTE":         6,
+		"OP_CONTAINS":    7,
+		"OP_SET":         8,
+		"OP_UNSET":       9,
+	}
+)
+
+func (x CustomFieldFilter_Op) Enum() *CustomFieldFilter_Op {
+	p := new(CustomFieldFilter_Op)
+	*p = x
+	return p
+}
+
+func (x CustomFieldFilter_Op) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomFieldFilter_Op) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_task_v1_task_proto_enumTypes[10].Descriptor()
+}
+
+func (CustomFieldFilter_Op) Type() protoreflect.EnumType {
+	return &file_proto_task_v1_task_proto_enumTypes[10]
+}
+
+func (x CustomFieldFilter_Op) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomFieldFilter_Op.Descriptor instead.
+func (CustomFieldFilter_Op) EnumDescriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{66, 0}
+}
+
 // Task is a single task.
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BoardId       int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Completed     bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId     int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Completed   bool                   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	// The creating user's ID; empty if they aren't a user, such as for
+	// tasks from before accounts.
+	CreatedBy string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Sum of all finished time entries on the task, in seconds.
+	TotalTimeSeconds int64                  `protobuf:"varint,9,opt,name=total_time_seconds,json=totalTimeSeconds,proto3" json:"total_time_seconds,omitempty"`
+	AttachmentCount  int32                  `protobuf:"varint,10,opt,name=attachment_count,json=attachmentCount,proto3" json:"attachment_count,omitempty"`
+	CustomFields     []*CustomFieldValue    `protobuf:"bytes,11,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	DueAt            *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Labels           []string               `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty"`
+	AssigneeIds      []string               `protobuf:"bytes,14,rep,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	// The users created_by and assignee_ids name.
+	Creator       *UserSummary   `protobuf:"bytes,15,opt,name=creator,proto3" json:"creator,omitempty"`
+	Assignees     []*UserSummary `protobuf:"bytes,16,rep,name=assignees,proto3" json:"assignees,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -44,14 +690,8951 @@ func (x *Task) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Task) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *Task) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetTotalTimeSeconds() int64 {
+	if x != nil {
+		return x.TotalTimeSeconds
+	}
+	return 0
+}
+
+func (x *Task) GetAttachmentCount() int32 {
+	if x != nil {
+		return x.AttachmentCount
+	}
+	return 0
+}
+
+func (x *Task) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *Task) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Task) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Task) GetAssigneeIds() []string {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *Task) GetCreator() *UserSummary {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *Task) GetAssignees() []*UserSummary {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+// UserSummary names a user a task refers to.
+type UserSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UserSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserSummary) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CustomFields  []*CustomFieldValue    `protobuf:"bytes,5,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Labels        []string               `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty"`
+	AssigneeIds   []string               `protobuf:"bytes,8,rep,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTaskRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CreateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetAssigneeIds() []string {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filter by board.
+	BoardId int64 `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	// Filter based on if the task is completed. (optional)
+	// If not set, returns all tasks.
+	Completed  *bool `protobuf:"varint,2,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	PageSize   int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber int32 `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	// All filters must match.
+	CustomFieldFilters []*CustomFieldFilter `protobuf:"bytes,5,rep,name=custom_field_filters,json=customFieldFilters,proto3" json:"custom_field_filters,omitempty"`
+	// Sort by a custom field instead of creation time. Tasks without a
+	// value sort last.
+	SortCustomFieldId int64 `protobuf:"varint,6,opt,name=sort_custom_field_id,json=sortCustomFieldId,proto3" json:"sort_custom_field_id,omitempty"`
+	SortDescending    bool  `protobuf:"varint,7,opt,name=sort_descending,json=sortDescending,proto3" json:"sort_descending,omitempty"`
+	// Filter query, e.g. `status:open assignee:me label:bug due<7d "login"`.
+	// With a query or view, board_id may be left unset to search all boards.
+	Query string `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`
+	// The caller; "me" in queries refers to this user.
+	UserId string `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// created, updated, due, title or cf.<field id>; prefix with - to
+	// sort descending.
+	Sort string `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Applies a saved view's query and sort. Extra query terms narrow it.
+	ViewId        int64 `protobuf:"varint,11,opt,name=view_id,json=viewId,proto3" json:"view_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTasksRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetCompleted() bool {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetCustomFieldFilters() []*CustomFieldFilter {
+	if x != nil {
+		return x.CustomFieldFilters
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetSortCustomFieldId() int64 {
+	if x != nil {
+		return x.SortCustomFieldId
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetSortDescending() bool {
+	if x != nil {
+		return x.SortDescending
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetViewId() int64 {
+	if x != nil {
+		return x.ViewId
+	}
+	return 0
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateTaskRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Completed   *bool                  `protobuf:"varint,4,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	// Only the listed fields change. A value with nothing set clears the field.
+	CustomFields []*CustomFieldValue    `protobuf:"bytes,5,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	DueAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	ClearDueAt   bool                   `protobuf:"varint,7,opt,name=clear_due_at,json=clearDueAt,proto3" json:"clear_due_at,omitempty"`
+	// Replace the task's labels or assignees when set.
+	Labels        *StringList `protobuf:"bytes,8,opt,name=labels,proto3" json:"labels,omitempty"`
+	AssigneeIds   *StringList `protobuf:"bytes,9,opt,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetCompleted() bool {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetCustomFields() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetClearDueAt() bool {
+	if x != nil {
+		return x.ClearDueAt
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetLabels() *StringList {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetAssigneeIds() *StringList {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteTaskRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TimeEntry is a block of time a user spent on a task.
+type TimeEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId    int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note      string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Unset while the timer is still running.
+	EndedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,7,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TimeEntry) Reset() {
+	*x = TimeEntry{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeEntry) ProtoMessage() {}
+
+func (x *TimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeEntry.ProtoReflect.Descriptor instead.
+func (*TimeEntry) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TimeEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TimeEntry) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TimeEntry) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *TimeEntry) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *TimeEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TimeEntry) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type StartTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerRequest) Reset() {
+	*x = StartTimerRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerRequest) ProtoMessage() {}
+
+func (x *StartTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerRequest.ProtoReflect.Descriptor instead.
+func (*StartTimerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartTimerRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *StartTimerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartTimerRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type StartTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTimerResponse) Reset() {
+	*x = StartTimerResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTimerResponse) ProtoMessage() {}
+
+func (x *StartTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTimerResponse.ProtoReflect.Descriptor instead.
+func (*StartTimerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StartTimerResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type StopTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerRequest) Reset() {
+	*x = StopTimerRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerRequest) ProtoMessage() {}
+
+func (x *StopTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerRequest.ProtoReflect.Descriptor instead.
+func (*StopTimerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StopTimerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StopTimerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTimerResponse) Reset() {
+	*x = StopTimerResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTimerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTimerResponse) ProtoMessage() {}
+
+func (x *StopTimerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTimerResponse.ProtoReflect.Descriptor instead.
+func (*StopTimerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StopTimerResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+// CreateTimeEntryRequest adds a manual (already finished) time entry.
+type CreateTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeEntryRequest) Reset() {
+	*x = CreateTimeEntryRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeEntryRequest) ProtoMessage() {}
+
+func (x *CreateTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateTimeEntryRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *CreateTimeEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTimeEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateTimeEntryRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *CreateTimeEntryRequest) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+type CreateTimeEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTimeEntryResponse) Reset() {
+	*x = CreateTimeEntryResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTimeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeEntryResponse) ProtoMessage() {}
+
+func (x *CreateTimeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeEntryResponse.ProtoReflect.Descriptor instead.
+func (*CreateTimeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTimeEntryResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type UpdateTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          *string                `protobuf:"bytes,2,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimeEntryRequest) Reset() {
+	*x = UpdateTimeEntryRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimeEntryRequest) ProtoMessage() {}
+
+func (x *UpdateTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTimeEntryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTimeEntryRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateTimeEntryRequest) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *UpdateTimeEntryRequest) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+type UpdateTimeEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *TimeEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimeEntryResponse) Reset() {
+	*x = UpdateTimeEntryResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimeEntryResponse) ProtoMessage() {}
+
+func (x *UpdateTimeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimeEntryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTimeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateTimeEntryResponse) GetEntry() *TimeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type DeleteTimeEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeEntryRequest) Reset() {
+	*x = DeleteTimeEntryRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeEntryRequest) ProtoMessage() {}
+
+func (x *DeleteTimeEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTimeEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteTimeEntryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTimeEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTimeEntryResponse) Reset() {
+	*x = DeleteTimeEntryResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTimeEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTimeEntryResponse) ProtoMessage() {}
+
+func (x *DeleteTimeEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTimeEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTimeEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteTimeEntryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListTimeEntriesRequest filters time entries. At least one of task_id,
+// board_id or user_id is required.
+type ListTimeEntriesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TaskId  int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	BoardId int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only entries started in [from, to).
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeEntriesRequest) Reset() {
+	*x = ListTimeEntriesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeEntriesRequest) ProtoMessage() {}
+
+func (x *ListTimeEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTimeEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTimeEntriesRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ListTimeEntriesRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *ListTimeEntriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListTimeEntriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListTimeEntriesRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ListTimeEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TimeEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTimeEntriesResponse) Reset() {
+	*x = ListTimeEntriesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTimeEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTimeEntriesResponse) ProtoMessage() {}
+
+func (x *ListTimeEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTimeEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListTimeEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTimeEntriesResponse) GetEntries() []*TimeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetTimeReportRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BoardId int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId  string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Defaults to day.
+	GroupBy       TimeReportGrouping `protobuf:"varint,5,opt,name=group_by,json=groupBy,proto3,enum=task.v1.TimeReportGrouping" json:"group_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportRequest) Reset() {
+	*x = GetTimeReportRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportRequest) ProtoMessage() {}
+
+func (x *GetTimeReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTimeReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetTimeReportRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *GetTimeReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTimeReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetTimeReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetTimeReportRequest) GetGroupBy() TimeReportGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return TimeReportGrouping_TIME_REPORT_GROUPING_UNSPECIFIED
+}
+
+// TimeReportRow is the time one user logged on a board within one period.
+type TimeReportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	BoardId       int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,4,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,5,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeReportRow) Reset() {
+	*x = TimeReportRow{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeReportRow) ProtoMessage() {}
+
+func (x *TimeReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeReportRow.ProtoReflect.Descriptor instead.
+func (*TimeReportRow) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TimeReportRow) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *TimeReportRow) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *TimeReportRow) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TimeReportRow) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+func (x *TimeReportRow) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+type GetTimeReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*TimeReportRow       `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	TotalSeconds  int64                  `protobuf:"varint,2,opt,name=total_seconds,json=totalSeconds,proto3" json:"total_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimeReportResponse) Reset() {
+	*x = GetTimeReportResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimeReportResponse) ProtoMessage() {}
+
+func (x *GetTimeReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimeReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTimeReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTimeReportResponse) GetRows() []*TimeReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *GetTimeReportResponse) GetTotalSeconds() int64 {
+	if x != nil {
+		return x.TotalSeconds
+	}
+	return 0
+}
+
+// Attachment is a file attached to a task.
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId      int64                  `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Filename    string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Key of the contents in the blob store.
+	StorageKey    string                 `protobuf:"bytes,6,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	UploadedBy    string                 `protobuf:"bytes,7,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *Attachment) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateAttachmentRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TaskId      int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Filename    string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	StorageKey  string                 `protobuf:"bytes,5,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"`
+	UploadedBy  string                 `protobuf:"bytes,6,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	// Checks that the caller may attach files to the task without recording
+	// anything, so uploads are refused before they are stored. Only task_id
+	// is required.
+	DryRun        bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentRequest) Reset() {
+	*x = CreateAttachmentRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateAttachmentRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *CreateAttachmentRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CreateAttachmentRequest) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *CreateAttachmentRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CreateAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentResponse) Reset() {
+	*x = CreateAttachmentResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentResponse) ProtoMessage() {}
+
+func (x *CreateAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentResponse) Reset() {
+	*x = GetAttachmentResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListAttachmentsRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// Board groups tasks and defines their columns and labels.
+type Board struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Columns     []*BoardColumn         `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
+	Labels      []*BoardLabel          `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether only callers who signed in with a second factor may use the
+	// board.
+	RequireMfa    bool `protobuf:"varint,7,opt,name=require_mfa,json=requireMfa,proto3" json:"require_mfa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Board) Reset() {
+	*x = Board{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Board) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Board) ProtoMessage() {}
+
+func (x *Board) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Board.ProtoReflect.Descriptor instead.
+func (*Board) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Board) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Board) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Board) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Board) GetColumns() []*BoardColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Board) GetLabels() []*BoardLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Board) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Board) GetRequireMfa() bool {
+	if x != nil {
+		return x.RequireMfa
+	}
+	return false
+}
+
+type BoardColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardColumn) Reset() {
+	*x = BoardColumn{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardColumn) ProtoMessage() {}
+
+func (x *BoardColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardColumn.ProtoReflect.Descriptor instead.
+func (*BoardColumn) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BoardColumn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BoardColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardColumn) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type BoardLabel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardLabel) Reset() {
+	*x = BoardLabel{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardLabel) ProtoMessage() {}
+
+func (x *BoardLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardLabel.ProtoReflect.Descriptor instead.
+func (*BoardLabel) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BoardLabel) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BoardLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardLabel) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CreateBoardRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Column names, in display order.
+	Columns       []string      `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Labels        []*BoardLabel `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardRequest) Reset() {
+	*x = CreateBoardRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardRequest) ProtoMessage() {}
+
+func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateBoardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBoardRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *CreateBoardRequest) GetLabels() []*BoardLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardResponse) Reset() {
+	*x = CreateBoardResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardResponse) ProtoMessage() {}
+
+func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type GetBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardRequest) Reset() {
+	*x = GetBoardRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardRequest) ProtoMessage() {}
+
+func (x *GetBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetBoardRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBoardResponse) Reset() {
+	*x = GetBoardResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardResponse) ProtoMessage() {}
+
+func (x *GetBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+// UpdateBoardRequest changes a board's settings. Only admins and owners
+// update boards. Unset fields are left unchanged.
+type UpdateBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequireMfa    *bool                  `protobuf:"varint,2,opt,name=require_mfa,json=requireMfa,proto3,oneof" json:"require_mfa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardRequest) Reset() {
+	*x = UpdateBoardRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardRequest) ProtoMessage() {}
+
+func (x *UpdateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateBoardRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBoardRequest) GetRequireMfa() bool {
+	if x != nil && x.RequireMfa != nil {
+		return *x.RequireMfa
+	}
+	return false
+}
+
+type UpdateBoardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardResponse) Reset() {
+	*x = UpdateBoardResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardResponse) ProtoMessage() {}
+
+func (x *UpdateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+// TaskTemplate is the skeleton of a single task. Text fields may contain
+// variables such as {{date}} or {{project}}.
+type TaskTemplate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Rendered into the description as a markdown checklist.
+	Checklist     []string `protobuf:"bytes,3,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTemplate) Reset() {
+	*x = TaskTemplate{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTemplate) ProtoMessage() {}
+
+func (x *TaskTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTemplate.ProtoReflect.Descriptor instead.
+func (*TaskTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TaskTemplate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTemplate) GetChecklist() []string {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+// BoardTemplate is a board layout with seed tasks.
+type BoardTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Labels        []*BoardLabel          `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	Tasks         []*TaskTemplate        `protobuf:"bytes,5,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardTemplate) Reset() {
+	*x = BoardTemplate{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardTemplate) ProtoMessage() {}
+
+func (x *BoardTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardTemplate.ProtoReflect.Descriptor instead.
+func (*BoardTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BoardTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BoardTemplate) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *BoardTemplate) GetLabels() []*BoardLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *BoardTemplate) GetTasks() []*TaskTemplate {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type Template struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind        TemplateKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=task.v1.TemplateKind" json:"kind,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*Template_Board
+	//	*Template_Task
+	Content   isTemplate_Content     `protobuf_oneof:"content"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The user who created the template, empty for templates from before
+	// creators were recorded.
+	CreatedBy string `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// The board a template was saved from, if any. Such templates hold the
+	// board's layout and tasks, so only those who can see the board, and
+	// the template's creator, can read them.
+	SourceBoardId int64 `protobuf:"varint,9,opt,name=source_board_id,json=sourceBoardId,proto3" json:"source_board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Template) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Template) GetKind() TemplateKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TemplateKind_TEMPLATE_KIND_UNSPECIFIED
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetContent() isTemplate_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *Template) GetBoard() *BoardTemplate {
+	if x != nil {
+		if x, ok := x.Content.(*Template_Board); ok {
+			return x.Board
+		}
+	}
+	return nil
+}
+
+func (x *Template) GetTask() *TaskTemplate {
+	if x != nil {
+		if x, ok := x.Content.(*Template_Task); ok {
+			return x.Task
+		}
+	}
+	return nil
+}
+
+func (x *Template) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Template) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Template) GetSourceBoardId() int64 {
+	if x != nil {
+		return x.SourceBoardId
+	}
+	return 0
+}
+
+type isTemplate_Content interface {
+	isTemplate_Content()
+}
+
+type Template_Board struct {
+	Board *BoardTemplate `protobuf:"bytes,5,opt,name=board,proto3,oneof"`
+}
+
+type Template_Task struct {
+	Task *TaskTemplate `protobuf:"bytes,6,opt,name=task,proto3,oneof"`
+}
+
+func (*Template_Board) isTemplate_Content() {}
+
+func (*Template_Task) isTemplate_Content() {}
+
+type CreateTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Types that are valid to be assigned to Content:
+	//
+	//	*CreateTemplateRequest_Board
+	//	*CreateTemplateRequest_Task
+	Content       isCreateTemplateRequest_Content `protobuf_oneof:"content"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTemplateRequest) GetContent() isCreateTemplateRequest_Content {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetBoard() *BoardTemplate {
+	if x != nil {
+		if x, ok := x.Content.(*CreateTemplateRequest_Board); ok {
+			return x.Board
+		}
+	}
+	return nil
+}
+
+func (x *CreateTemplateRequest) GetTask() *TaskTemplate {
+	if x != nil {
+		if x, ok := x.Content.(*CreateTemplateRequest_Task); ok {
+			return x.Task
+		}
+	}
+	return nil
+}
+
+type isCreateTemplateRequest_Content interface {
+	isCreateTemplateRequest_Content()
+}
+
+type CreateTemplateRequest_Board struct {
+	Board *BoardTemplate `protobuf:"bytes,3,opt,name=board,proto3,oneof"`
+}
+
+type CreateTemplateRequest_Task struct {
+	Task *TaskTemplate `protobuf:"bytes,4,opt,name=task,proto3,oneof"`
+}
+
+func (*CreateTemplateRequest_Board) isCreateTemplateRequest_Content() {}
+
+func (*CreateTemplateRequest_Task) isCreateTemplateRequest_Content() {}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unspecified lists all kinds.
+	Kind          TemplateKind `protobuf:"varint,1,opt,name=kind,proto3,enum=task.v1.TemplateKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTemplatesRequest) GetKind() TemplateKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TemplateKind_TEMPLATE_KIND_UNSPECIFIED
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteTemplateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SaveBoardAsTemplateRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BoardId     int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Copy the board's current tasks as seed tasks.
+	IncludeTasks  bool `protobuf:"varint,4,opt,name=include_tasks,json=includeTasks,proto3" json:"include_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveBoardAsTemplateRequest) Reset() {
+	*x = SaveBoardAsTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveBoardAsTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBoardAsTemplateRequest) ProtoMessage() {}
+
+func (x *SaveBoardAsTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBoardAsTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveBoardAsTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SaveBoardAsTemplateRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *SaveBoardAsTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveBoardAsTemplateRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveBoardAsTemplateRequest) GetIncludeTasks() bool {
+	if x != nil {
+		return x.IncludeTasks
+	}
+	return false
+}
+
+type SaveBoardAsTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *Template              `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveBoardAsTemplateResponse) Reset() {
+	*x = SaveBoardAsTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveBoardAsTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBoardAsTemplateResponse) ProtoMessage() {}
+
+func (x *SaveBoardAsTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBoardAsTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveBoardAsTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SaveBoardAsTemplateResponse) GetTemplate() *Template {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateBoardFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// Overrides the template's board name. May use variables.
+	Name          string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variables     map[string]string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedBy     string            `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardFromTemplateRequest) Reset() {
+	*x = CreateBoardFromTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateBoardFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateBoardFromTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateBoardFromTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBoardFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateBoardFromTemplateRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateBoardFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Board         *Board                 `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	Tasks         []*Task                `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardFromTemplateResponse) Reset() {
+	*x = CreateBoardFromTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateBoardFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateBoardFromTemplateResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *CreateBoardFromTemplateResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type CreateTaskFromTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	BoardId       int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Variables     map[string]string      `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskFromTemplateRequest) Reset() {
+	*x = CreateTaskFromTemplateRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTaskFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateTaskFromTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *CreateTaskFromTemplateRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CreateTaskFromTemplateRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *CreateTaskFromTemplateRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type CreateTaskFromTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskFromTemplateResponse) Reset() {
+	*x = CreateTaskFromTemplateResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTaskFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateTaskFromTemplateResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// CustomField is a typed field a board defines for its tasks.
+type CustomField struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BoardId int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type    CustomFieldType        `protobuf:"varint,4,opt,name=type,proto3,enum=task.v1.CustomFieldType" json:"type,omitempty"`
+	// Allowed values of select fields.
+	Options       []string `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool     `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32    `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CustomField) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CustomField) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CustomField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CustomField) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CustomField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CustomField) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type StringList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StringList) Reset() {
+	*x = StringList{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StringList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringList) ProtoMessage() {}
+
+func (x *StringList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringList.ProtoReflect.Descriptor instead.
+func (*StringList) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StringList) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// CustomFieldValue is the value of one custom field on a task.
+type CustomFieldValue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FieldId int64                  `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	// Set on responses only.
+	FieldName string          `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	FieldType CustomFieldType `protobuf:"varint,3,opt,name=field_type,json=fieldType,proto3,enum=task.v1.CustomFieldType" json:"field_type,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*CustomFieldValue_Text
+	//	*CustomFieldValue_Number
+	//	*CustomFieldValue_Date
+	//	*CustomFieldValue_Options
+	Value         isCustomFieldValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldValue) ProtoMessage() {}
+
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CustomFieldValue) GetFieldId() int64 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CustomFieldValue) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetFieldType() CustomFieldType {
+	if x != nil {
+		return x.FieldType
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CustomFieldValue) GetValue() isCustomFieldValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CustomFieldValue) GetText() string {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetNumber() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *CustomFieldValue) GetDate() string {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Date); ok {
+			return x.Date
+		}
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetOptions() *StringList {
+	if x != nil {
+		if x, ok := x.Value.(*CustomFieldValue_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+type isCustomFieldValue_Value interface {
+	isCustomFieldValue_Value()
+}
+
+type CustomFieldValue_Text struct {
+	// Text, single select, user ID and URL fields.
+	Text string `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type CustomFieldValue_Number struct {
+	Number float64 `protobuf:"fixed64,5,opt,name=number,proto3,oneof"`
+}
+
+type CustomFieldValue_Date struct {
+	// YYYY-MM-DD.
+	Date string `protobuf:"bytes,6,opt,name=date,proto3,oneof"`
+}
+
+type CustomFieldValue_Options struct {
+	// Multi select fields.
+	Options *StringList `protobuf:"bytes,7,opt,name=options,proto3,oneof"`
+}
+
+func (*CustomFieldValue_Text) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_Number) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_Date) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_Options) isCustomFieldValue_Value() {}
+
+// CustomFieldFilter matches tasks by a custom field value.
+type CustomFieldFilter struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FieldId int64                  `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Op      CustomFieldFilter_Op   `protobuf:"varint,2,opt,name=op,proto3,enum=task.v1.CustomFieldFilter_Op" json:"op,omitempty"`
+	// Compared according to the field type. Unused by OP_SET and OP_UNSET.
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomFieldFilter) Reset() {
+	*x = CustomFieldFilter{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomFieldFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldFilter) ProtoMessage() {}
+
+func (x *CustomFieldFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldFilter.ProtoReflect.Descriptor instead.
+func (*CustomFieldFilter) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CustomFieldFilter) GetFieldId() int64 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CustomFieldFilter) GetOp() CustomFieldFilter_Op {
+	if x != nil {
+		return x.Op
+	}
+	return CustomFieldFilter_OP_UNSPECIFIED
+}
+
+func (x *CustomFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreateCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          CustomFieldType        `protobuf:"varint,3,opt,name=type,proto3,enum=task.v1.CustomFieldType" json:"type,omitempty"`
+	Options       []string               `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldRequest) Reset() {
+	*x = CreateCustomFieldRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldRequest) ProtoMessage() {}
+
+func (x *CreateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateCustomFieldRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CreateCustomFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCustomFieldRequest) GetType() CustomFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomFieldType_CUSTOM_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *CreateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateCustomFieldRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateCustomFieldRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CreateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomFieldResponse) Reset() {
+	*x = CreateCustomFieldResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomFieldResponse) ProtoMessage() {}
+
+func (x *CreateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateCustomFieldResponse) GetField() *CustomField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+// UpdateCustomFieldRequest changes a field definition. The type is fixed
+// once created.
+type UpdateCustomFieldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Replaces the options when replace_options is set.
+	Options        []string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	ReplaceOptions bool     `protobuf:"varint,4,opt,name=replace_options,json=replaceOptions,proto3" json:"replace_options,omitempty"`
+	Required       *bool    `protobuf:"varint,5,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	Position       *int32   `protobuf:"varint,6,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldRequest) Reset() {
+	*x = UpdateCustomFieldRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldRequest) ProtoMessage() {}
+
+func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateCustomFieldRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCustomFieldRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateCustomFieldRequest) GetReplaceOptions() bool {
+	if x != nil {
+		return x.ReplaceOptions
+	}
+	return false
+}
+
+func (x *UpdateCustomFieldRequest) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *UpdateCustomFieldRequest) GetPosition() int32 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+type UpdateCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *CustomField           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomFieldResponse) Reset() {
+	*x = UpdateCustomFieldResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldResponse) ProtoMessage() {}
+
+func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateCustomFieldResponse) GetField() *CustomField {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type DeleteCustomFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldRequest) Reset() {
+	*x = DeleteCustomFieldRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldRequest) ProtoMessage() {}
+
+func (x *DeleteCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeleteCustomFieldRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCustomFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomFieldResponse) Reset() {
+	*x = DeleteCustomFieldResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomFieldResponse) ProtoMessage() {}
+
+func (x *DeleteCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteCustomFieldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCustomFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsRequest) Reset() {
+	*x = ListCustomFieldsRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsRequest) ProtoMessage() {}
+
+func (x *ListCustomFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListCustomFieldsRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+type ListCustomFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fields        []*CustomField         `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCustomFieldsResponse) Reset() {
+	*x = ListCustomFieldsResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCustomFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCustomFieldsResponse) ProtoMessage() {}
+
+func (x *ListCustomFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCustomFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ListCustomFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListCustomFieldsResponse) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// SavedView is a named task query belonging to a user.
+type SavedView struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Filter query in the ListTasksRequest.query language.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Sort key, as in ListTasksRequest.sort.
+	Sort string `protobuf:"bytes,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	// Limits the view to one board when set.
+	BoardId int64 `protobuf:"varint,6,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	// Shared views are listed for, and usable by, every user.
+	Shared        bool                   `protobuf:"varint,7,opt,name=shared,proto3" json:"shared,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedView) Reset() {
+	*x = SavedView{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedView) ProtoMessage() {}
+
+func (x *SavedView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedView.ProtoReflect.Descriptor instead.
+func (*SavedView) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SavedView) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SavedView) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SavedView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedView) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedView) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SavedView) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *SavedView) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+func (x *SavedView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	BoardId       int64                  `protobuf:"varint,5,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Shared        bool                   `protobuf:"varint,6,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedViewRequest) Reset() {
+	*x = CreateSavedViewRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedViewRequest) ProtoMessage() {}
+
+func (x *CreateSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateSavedViewRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateSavedViewRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSavedViewRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CreateSavedViewRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *CreateSavedViewRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CreateSavedViewRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type CreateSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *SavedView             `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedViewResponse) Reset() {
+	*x = CreateSavedViewResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedViewResponse) ProtoMessage() {}
+
+func (x *CreateSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*CreateSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateSavedViewResponse) GetView() *SavedView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+// GetSavedViewRequest returns a view owned by user_id or shared.
+type GetSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedViewRequest) Reset() {
+	*x = GetSavedViewRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedViewRequest) ProtoMessage() {}
+
+func (x *GetSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSavedViewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSavedViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *SavedView             `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedViewResponse) Reset() {
+	*x = GetSavedViewResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedViewResponse) ProtoMessage() {}
+
+func (x *GetSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*GetSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetSavedViewResponse) GetView() *SavedView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+// ListSavedViewsRequest lists the user's own views and shared views. With
+// board_id set, only views for that board or for all boards are listed.
+type ListSavedViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BoardId       int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsRequest) Reset() {
+	*x = ListSavedViewsRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsRequest) ProtoMessage() {}
+
+func (x *ListSavedViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListSavedViewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSavedViewsRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+type ListSavedViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*SavedView           `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedViewsResponse) Reset() {
+	*x = ListSavedViewsResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedViewsResponse) ProtoMessage() {}
+
+func (x *ListSavedViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListSavedViewsResponse) GetViews() []*SavedView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
+// UpdateSavedViewRequest changes a view. Only its owner may update it.
+type UpdateSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Query         *string                `protobuf:"bytes,4,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	Sort          *string                `protobuf:"bytes,5,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	BoardId       *int64                 `protobuf:"varint,6,opt,name=board_id,json=boardId,proto3,oneof" json:"board_id,omitempty"`
+	Shared        *bool                  `protobuf:"varint,7,opt,name=shared,proto3,oneof" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedViewRequest) Reset() {
+	*x = UpdateSavedViewRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedViewRequest) ProtoMessage() {}
+
+func (x *UpdateSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateSavedViewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSavedViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *UpdateSavedViewRequest) GetBoardId() int64 {
+	if x != nil && x.BoardId != nil {
+		return *x.BoardId
+	}
+	return 0
+}
+
+func (x *UpdateSavedViewRequest) GetShared() bool {
+	if x != nil && x.Shared != nil {
+		return *x.Shared
+	}
+	return false
+}
+
+type UpdateSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *SavedView             `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedViewResponse) Reset() {
+	*x = UpdateSavedViewResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedViewResponse) ProtoMessage() {}
+
+func (x *UpdateSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateSavedViewResponse) GetView() *SavedView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+type DeleteSavedViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedViewRequest) Reset() {
+	*x = DeleteSavedViewRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedViewRequest) ProtoMessage() {}
+
+func (x *DeleteSavedViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedViewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedViewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteSavedViewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteSavedViewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteSavedViewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedViewResponse) Reset() {
+	*x = DeleteSavedViewResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedViewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedViewResponse) ProtoMessage() {}
+
+func (x *DeleteSavedViewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedViewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedViewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteSavedViewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// CalendarFeed is an iCalendar feed of tasks with due dates, read with a
+// secret token instead of a login so calendar apps can subscribe to it.
+type CalendarFeed struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	// The board whose tasks the feed lists; unset lists the tasks assigned
+	// to the owner on every board.
+	BoardId   int64             `protobuf:"varint,3,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Component CalendarComponent `protobuf:"varint,4,opt,name=component,proto3,enum=task.v1.CalendarComponent" json:"component,omitempty"`
+	// IANA time zone, such as Europe/Paris, that times are given in; empty
+	// means UTC.
+	TimeZone      string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeed) ProtoMessage() {}
+
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CalendarFeed) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalendarFeed) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CalendarFeed) GetComponent() CalendarComponent {
+	if x != nil {
+		return x.Component
+	}
+	return CalendarComponent_CALENDAR_COMPONENT_UNSPECIFIED
+}
+
+func (x *CalendarFeed) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CalendarFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	BoardId       int64                  `protobuf:"varint,2,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Component     CalendarComponent      `protobuf:"varint,3,opt,name=component,proto3,enum=task.v1.CalendarComponent" json:"component,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
+
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateCalendarFeedRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateCalendarFeedRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *CreateCalendarFeedRequest) GetComponent() CalendarComponent {
+	if x != nil {
+		return x.Component
+	}
+	return CalendarComponent_CALENDAR_COMPONENT_UNSPECIFIED
+}
+
+func (x *CreateCalendarFeedRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type CreateCalendarFeedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Feed  *CalendarFeed          `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	// The feed's token. Only a hash is stored, so it is returned only here.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalendarFeedResponse) Reset() {
+	*x = CreateCalendarFeedResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalendarFeedResponse) ProtoMessage() {}
+
+func (x *CreateCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateCalendarFeedResponse) GetFeed() *CalendarFeed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+func (x *CreateCalendarFeedResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ListCalendarFeedsRequest lists the user's feeds.
+type ListCalendarFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarFeedsRequest) Reset() {
+	*x = ListCalendarFeedsRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarFeedsRequest) ProtoMessage() {}
+
+func (x *ListCalendarFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarFeedsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListCalendarFeedsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCalendarFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*CalendarFeed        `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalendarFeedsResponse) Reset() {
+	*x = ListCalendarFeedsResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalendarFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarFeedsResponse) ProtoMessage() {}
+
+func (x *ListCalendarFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarFeedsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListCalendarFeedsResponse) GetFeeds() []*CalendarFeed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+// DeleteCalendarFeedRequest revokes a feed's token. Only its owner may
+// delete it.
+type DeleteCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarFeedRequest) Reset() {
+	*x = DeleteCalendarFeedRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarFeedRequest) ProtoMessage() {}
+
+func (x *DeleteCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DeleteCalendarFeedRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCalendarFeedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCalendarFeedResponse) Reset() {
+	*x = DeleteCalendarFeedResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCalendarFeedResponse) ProtoMessage() {}
+
+func (x *DeleteCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteCalendarFeedResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCalendarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// ETag from an earlier response. If the calendar is unchanged, the
+	// response has not_modified set and no data.
+	IfNoneMatch   string `protobuf:"bytes,2,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarRequest) Reset() {
+	*x = GetCalendarRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarRequest) ProtoMessage() {}
+
+func (x *GetCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetCalendarRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetCalendarRequest) GetIfNoneMatch() string {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return ""
+}
+
+type GetCalendarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The iCalendar file.
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	NotModified   bool   `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarResponse) Reset() {
+	*x = GetCalendarResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarResponse) ProtoMessage() {}
+
+func (x *GetCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetCalendarResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetCalendarResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetCalendarResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// Webhook is a subscription that POSTs task events to a URL, signed with
+// its secret.
+type Webhook struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId string                 `protobuf:"bytes,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Url     string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// Events to send: task.created, task.updated, task.deleted or
+	// task.overdue. Empty sends all of them.
+	EventTypes []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// Boards whose events are sent. Empty sends every board's.
+	BoardIds []int64 `protobuf:"varint,5,rep,packed,name=board_ids,json=boardIds,proto3" json:"board_ids,omitempty"`
+	// Inactive webhooks get no new events. Webhooks whose deliveries keep
+	// failing are deactivated, with the reason in disabled_reason.
+	Active         bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	DisabledReason string                 `protobuf:"bytes,7,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Webhook) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetBoardIds() []int64 {
+	if x != nil {
+		return x.BoardIds
+	}
+	return nil
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Url     string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Signing secret; a random one is generated if empty.
+	Secret        string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes    []string `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	BoardIds      []int64  `protobuf:"varint,5,rep,packed,name=board_ids,json=boardIds,proto3" json:"board_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateWebhookRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetBoardIds() []int64 {
+	if x != nil {
+		return x.BoardIds
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Webhook *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// The signing secret, which is returned only here and on rotation.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// GetWebhookRequest returns a webhook owned by user_id.
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListWebhooksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+// UpdateWebhookRequest changes a webhook. Only its owner may update it.
+// Reactivating a webhook also resumes its pending deliveries.
+type UpdateWebhookRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Url    *string                `protobuf:"bytes,3,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	Active *bool                  `protobuf:"varint,4,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	// Replace event_types or board_ids with the given lists, which may be
+	// empty.
+	ReplaceEventTypes bool     `protobuf:"varint,5,opt,name=replace_event_types,json=replaceEventTypes,proto3" json:"replace_event_types,omitempty"`
+	EventTypes        []string `protobuf:"bytes,6,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	ReplaceBoardIds   bool     `protobuf:"varint,7,opt,name=replace_board_ids,json=replaceBoardIds,proto3" json:"replace_board_ids,omitempty"`
+	BoardIds          []int64  `protobuf:"varint,8,rep,packed,name=board_ids,json=boardIds,proto3" json:"board_ids,omitempty"`
+	// Replaces the secret with a new random one.
+	RotateSecret  bool `protobuf:"varint,9,opt,name=rotate_secret,json=rotateSecret,proto3" json:"rotate_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetReplaceEventTypes() bool {
+	if x != nil {
+		return x.ReplaceEventTypes
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetReplaceBoardIds() bool {
+	if x != nil {
+		return x.ReplaceBoardIds
+	}
+	return false
+}
+
+func (x *UpdateWebhookRequest) GetBoardIds() []int64 {
+	if x != nil {
+		return x.BoardIds
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetRotateSecret() bool {
+	if x != nil {
+		return x.RotateSecret
+	}
+	return false
+}
+
+type UpdateWebhookResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Webhook *Webhook               `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// Set when the secret was rotated.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *UpdateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteWebhookRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteWebhookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// WebhookDelivery is one event sent, or to be sent, to a webhook.
+type WebhookDelivery struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId int64                  `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// Identifies the event; redeliveries keep it, so receivers can
+	// deduplicate.
+	EventId   string                `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType string                `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status    WebhookDeliveryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=task.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts  int32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// The JSON request body.
+	Payload string `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Outcome of the last attempt. response_status is 0 if there was no
+	// response.
+	ResponseStatus int32                  `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,9,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	Error          string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	// When a pending delivery is next attempted.
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// The delivery this one resends, if any.
+	RedeliveryOf  int64 `protobuf:"varint,14,opt,name=redelivery_of,json=redeliveryOf,proto3" json:"redelivery_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *WebhookDelivery) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetRedeliveryOf() int64 {
+	if x != nil {
+		return x.RedeliveryOf
+	}
+	return 0
+}
+
+// ListWebhookDeliveriesRequest lists a webhook's deliveries, newest first.
+type ListWebhookDeliveriesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only deliveries with this status, if set.
+	Status        WebhookDeliveryStatus `protobuf:"varint,3,opt,name=status,proto3,enum=task.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	PageSize      int32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber    int32                 `protobuf:"varint,5,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// RedeliverWebhookDeliveryRequest sends a delivery's event again, as a
+// new delivery.
+type RedeliverWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     int64                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	DeliveryId    int64                  `protobuf:"varint,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookDeliveryRequest) Reset() {
+	*x = RedeliverWebhookDeliveryRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetWebhookId() int64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetDeliveryId() int64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RedeliverWebhookDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookDeliveryResponse) Reset() {
+	*x = RedeliverWebhookDeliveryResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *RedeliverWebhookDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// WatchTaskRequest subscribes user_id to notifications of changes to a
+// task they aren't assigned to.
+type WatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *WatchTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *WatchTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type WatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskResponse) Reset() {
+	*x = WatchTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskResponse) ProtoMessage() {}
+
+func (x *WatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*WatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *WatchTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnwatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskRequest) Reset() {
+	*x = UnwatchTaskRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskRequest) ProtoMessage() {}
+
+func (x *UnwatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UnwatchTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *UnwatchTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnwatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskResponse) Reset() {
+	*x = UnwatchTaskResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskResponse) ProtoMessage() {}
+
+func (x *UnwatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UnwatchTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListTaskWatchersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        int64                  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersRequest) Reset() {
+	*x = ListTaskWatchersRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersRequest) ProtoMessage() {}
+
+func (x *ListTaskWatchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListTaskWatchersRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+type ListTaskWatchersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersResponse) Reset() {
+	*x = ListTaskWatchersResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersResponse) ProtoMessage() {}
+
+func (x *ListTaskWatchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListTaskWatchersResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type NotificationPreferences struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EmailDelivery EmailDelivery          `protobuf:"varint,2,opt,name=email_delivery,json=emailDelivery,proto3,enum=task.v1.EmailDelivery" json:"email_delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *NotificationPreferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetEmailDelivery() EmailDelivery {
+	if x != nil {
+		return x.EmailDelivery
+	}
+	return EmailDelivery_EMAIL_DELIVERY_UNSPECIFIED
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EmailDelivery EmailDelivery          `protobuf:"varint,2,opt,name=email_delivery,json=emailDelivery,proto3,enum=task.v1.EmailDelivery" json:"email_delivery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetEmailDelivery() EmailDelivery {
+	if x != nil {
+		return x.EmailDelivery
+	}
+	return EmailDelivery_EMAIL_DELIVERY_UNSPECIFIED
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// InboxNotification tells a user about a task. It keeps the task's title
+// at the time, as the task may since have been deleted.
+type InboxNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          InboxNotificationKind  `protobuf:"varint,3,opt,name=kind,proto3,enum=task.v1.InboxNotificationKind" json:"kind,omitempty"`
+	TaskId        int64                  `protobuf:"varint,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	BoardId       int64                  `protobuf:"varint,5,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Read          bool                   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=read_at,json=readAt,proto3" json:"read_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxNotification) Reset() {
+	*x = InboxNotification{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxNotification) ProtoMessage() {}
+
+func (x *InboxNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxNotification.ProtoReflect.Descriptor instead.
+func (*InboxNotification) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *InboxNotification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InboxNotification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InboxNotification) GetKind() InboxNotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return InboxNotificationKind_INBOX_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *InboxNotification) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *InboxNotification) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *InboxNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *InboxNotification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *InboxNotification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InboxNotification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+// ListInboxNotificationsRequest lists a user's notifications, newest
+// first.
+type ListInboxNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageNumber    int32                  `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxNotificationsRequest) Reset() {
+	*x = ListInboxNotificationsRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxNotificationsRequest) ProtoMessage() {}
+
+func (x *ListInboxNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInboxNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListInboxNotificationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListInboxNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListInboxNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInboxNotificationsRequest) GetPageNumber() int32 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type ListInboxNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*InboxNotification   `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInboxNotificationsResponse) Reset() {
+	*x = ListInboxNotificationsResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInboxNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInboxNotificationsResponse) ProtoMessage() {}
+
+func (x *ListInboxNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInboxNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInboxNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListInboxNotificationsResponse) GetNotifications() []*InboxNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListInboxNotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListInboxNotificationsResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type GetInboxUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboxUnreadCountRequest) Reset() {
+	*x = GetInboxUnreadCountRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboxUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboxUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetInboxUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboxUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetInboxUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetInboxUnreadCountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetInboxUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboxUnreadCountResponse) Reset() {
+	*x = GetInboxUnreadCountResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboxUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboxUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetInboxUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboxUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetInboxUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetInboxUnreadCountResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkInboxNotificationReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxNotificationReadRequest) Reset() {
+	*x = MarkInboxNotificationReadRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxNotificationReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxNotificationReadRequest) ProtoMessage() {}
+
+func (x *MarkInboxNotificationReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxNotificationReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkInboxNotificationReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *MarkInboxNotificationReadRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarkInboxNotificationReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarkInboxNotificationReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *InboxNotification     `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkInboxNotificationReadResponse) Reset() {
+	*x = MarkInboxNotificationReadResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkInboxNotificationReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkInboxNotificationReadResponse) ProtoMessage() {}
+
+func (x *MarkInboxNotificationReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkInboxNotificationReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkInboxNotificationReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *MarkInboxNotificationReadResponse) GetNotification() *InboxNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *MarkInboxNotificationReadResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkAllInboxNotificationsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllInboxNotificationsReadRequest) Reset() {
+	*x = MarkAllInboxNotificationsReadRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllInboxNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllInboxNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllInboxNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllInboxNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllInboxNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *MarkAllInboxNotificationsReadRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MarkAllInboxNotificationsReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many notifications were unread.
+	MarkedCount   int32 `protobuf:"varint,1,opt,name=marked_count,json=markedCount,proto3" json:"marked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllInboxNotificationsReadResponse) Reset() {
+	*x = MarkAllInboxNotificationsReadResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllInboxNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllInboxNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkAllInboxNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllInboxNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllInboxNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *MarkAllInboxNotificationsReadResponse) GetMarkedCount() int32 {
+	if x != nil {
+		return x.MarkedCount
+	}
+	return 0
+}
+
+type BoardMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          BoardRole              `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.BoardRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardMember) Reset() {
+	*x = BoardMember{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardMember) ProtoMessage() {}
+
+func (x *BoardMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardMember.ProtoReflect.Descriptor instead.
+func (*BoardMember) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *BoardMember) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *BoardMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BoardMember) GetRole() BoardRole {
+	if x != nil {
+		return x.Role
+	}
+	return BoardRole_BOARD_ROLE_UNSPECIFIED
+}
+
+func (x *BoardMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBoardMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardMembersRequest) Reset() {
+	*x = ListBoardMembersRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardMembersRequest) ProtoMessage() {}
+
+func (x *ListBoardMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListBoardMembersRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+type ListBoardMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*BoardMember         `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardMembersResponse) Reset() {
+	*x = ListBoardMembersResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardMembersResponse) ProtoMessage() {}
+
+func (x *ListBoardMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListBoardMembersResponse) GetMembers() []*BoardMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// AddBoardMemberRequest adds a member. Callers can grant roles up to their
+// own. On a board without members, anyone may add its first member, who
+// must be an owner.
+type AddBoardMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          BoardRole              `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.BoardRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBoardMemberRequest) Reset() {
+	*x = AddBoardMemberRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBoardMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBoardMemberRequest) ProtoMessage() {}
+
+func (x *AddBoardMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBoardMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddBoardMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AddBoardMemberRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *AddBoardMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddBoardMemberRequest) GetRole() BoardRole {
+	if x != nil {
+		return x.Role
+	}
+	return BoardRole_BOARD_ROLE_UNSPECIFIED
+}
+
+type AddBoardMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *BoardMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBoardMemberResponse) Reset() {
+	*x = AddBoardMemberResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBoardMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBoardMemberResponse) ProtoMessage() {}
+
+func (x *AddBoardMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBoardMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddBoardMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *AddBoardMemberResponse) GetMember() *BoardMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateBoardMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          BoardRole              `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.BoardRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardMemberRequest) Reset() {
+	*x = UpdateBoardMemberRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardMemberRequest) ProtoMessage() {}
+
+func (x *UpdateBoardMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UpdateBoardMemberRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *UpdateBoardMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateBoardMemberRequest) GetRole() BoardRole {
+	if x != nil {
+		return x.Role
+	}
+	return BoardRole_BOARD_ROLE_UNSPECIFIED
+}
+
+type UpdateBoardMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *BoardMember           `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBoardMemberResponse) Reset() {
+	*x = UpdateBoardMemberResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBoardMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBoardMemberResponse) ProtoMessage() {}
+
+func (x *UpdateBoardMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBoardMemberResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *UpdateBoardMemberResponse) GetMember() *BoardMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+// RemoveBoardMemberRequest removes a member. Members can always remove
+// themselves, unless they are the last owner.
+type RemoveBoardMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBoardMemberRequest) Reset() {
+	*x = RemoveBoardMemberRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBoardMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBoardMemberRequest) ProtoMessage() {}
+
+func (x *RemoveBoardMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBoardMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBoardMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *RemoveBoardMemberRequest) GetBoardId() int64 {
+	if x != nil {
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *RemoveBoardMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveBoardMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBoardMemberResponse) Reset() {
+	*x = RemoveBoardMemberResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBoardMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBoardMemberResponse) ProtoMessage() {}
+
+func (x *RemoveBoardMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBoardMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBoardMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *RemoveBoardMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Workspace owns boards, and everything on them. Calls are scoped to the
+// workspace in the x-workspace-id metadata, or the default workspace
+// (ID 1) without it.
+type Workspace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Unique, URL-safe name: lowercase letters, digits and hyphens.
+	Slug      string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Whether only callers who signed in with a second factor may use the
+	// workspace.
+	RequireMfa    bool `protobuf:"varint,5,opt,name=require_mfa,json=requireMfa,proto3" json:"require_mfa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workspace) Reset() {
+	*x = Workspace{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workspace) ProtoMessage() {}
+
+func (x *Workspace) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workspace.ProtoReflect.Descriptor instead.
+func (*Workspace) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *Workspace) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Workspace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Workspace) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Workspace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Workspace) GetRequireMfa() bool {
+	if x != nil {
+		return x.RequireMfa
+	}
+	return false
+}
+
+type WorkspaceMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          WorkspaceRole          `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.WorkspaceRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMember) Reset() {
+	*x = WorkspaceMember{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMember) ProtoMessage() {}
+
+func (x *WorkspaceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMember.ProtoReflect.Descriptor instead.
+func (*WorkspaceMember) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *WorkspaceMember) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *WorkspaceMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WorkspaceMember) GetRole() WorkspaceRole {
+	if x != nil {
+		return x.Role
+	}
+	return WorkspaceRole_WORKSPACE_ROLE_UNSPECIFIED
+}
+
+func (x *WorkspaceMember) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateWorkspaceRequest creates a workspace owned by the caller.
+type CreateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceRequest) Reset() {
+	*x = CreateWorkspaceRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceRequest) ProtoMessage() {}
+
+func (x *CreateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CreateWorkspaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWorkspaceRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWorkspaceResponse) Reset() {
+	*x = CreateWorkspaceResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkspaceResponse) ProtoMessage() {}
+
+func (x *CreateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+// UpdateWorkspaceRequest changes a workspace's settings. Only owners
+// update workspaces, and only callers who signed in with a second factor
+// may require one. Unset fields are left unchanged.
+type UpdateWorkspaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	RequireMfa    *bool                  `protobuf:"varint,2,opt,name=require_mfa,json=requireMfa,proto3,oneof" json:"require_mfa,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceRequest) Reset() {
+	*x = UpdateWorkspaceRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpdateWorkspaceRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceRequest) GetRequireMfa() bool {
+	if x != nil && x.RequireMfa != nil {
+		return *x.RequireMfa
+	}
+	return false
+}
+
+type UpdateWorkspaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspace     *Workspace             `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceResponse) Reset() {
+	*x = UpdateWorkspaceResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *UpdateWorkspaceResponse) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+// ListWorkspacesRequest lists the workspaces the caller can use.
+type ListWorkspacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesRequest) Reset() {
+	*x = ListWorkspacesRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesRequest) ProtoMessage() {}
+
+func (x *ListWorkspacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{146}
+}
+
+type ListWorkspacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workspaces    []*Workspace           `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspacesResponse) Reset() {
+	*x = ListWorkspacesResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspacesResponse) ProtoMessage() {}
+
+func (x *ListWorkspacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspacesResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspacesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ListWorkspacesResponse) GetWorkspaces() []*Workspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+type ListWorkspaceMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceMembersRequest) Reset() {
+	*x = ListWorkspaceMembersRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceMembersRequest) ProtoMessage() {}
+
+func (x *ListWorkspaceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ListWorkspaceMembersRequest) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+type ListWorkspaceMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*WorkspaceMember     `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkspaceMembersResponse) Reset() {
+	*x = ListWorkspaceMembersResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkspaceMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkspaceMembersResponse) ProtoMessage() {}
+
+func (x *ListWorkspaceMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkspaceMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkspaceMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListWorkspaceMembersResponse) GetMembers() []*WorkspaceMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// AddWorkspaceMemberRequest adds a member. Only owners add members.
+type AddWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          WorkspaceRole          `protobuf:"varint,3,opt,name=role,proto3,enum=task.v1.WorkspaceRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWorkspaceMemberRequest) Reset() {
+	*x = AddWorkspaceMemberRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWorkspaceMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*AddWorkspaceMemberRequest) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[0]
+func (x *AddWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,92 +9645,152 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AddWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *Task) GetId() int64 {
+func (x *AddWorkspaceMemberRequest) GetWorkspaceId() int64 {
 	if x != nil {
-		return x.Id
+		return x.WorkspaceId
 	}
 	return 0
 }
 
-func (x *Task) GetBoardId() int64 {
+func (x *AddWorkspaceMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.BoardId
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetTitle() string {
+func (x *AddWorkspaceMemberRequest) GetRole() WorkspaceRole {
 	if x != nil {
-		return x.Title
+		return x.Role
 	}
-	return ""
+	return WorkspaceRole_WORKSPACE_ROLE_UNSPECIFIED
 }
 
-func (x *Task) GetDescription() string {
+type AddWorkspaceMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *WorkspaceMember       `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWorkspaceMemberResponse) Reset() {
+	*x = AddWorkspaceMemberResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWorkspaceMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWorkspaceMemberResponse) ProtoMessage() {}
+
+func (x *AddWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[151]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetCompleted() bool {
+// Deprecated: Use AddWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *AddWorkspaceMemberResponse) GetMember() *WorkspaceMember {
 	if x != nil {
-		return x.Completed
+		return x.Member
 	}
-	return false
+	return nil
+}
+
+// RemoveWorkspaceMemberRequest removes a member. Members can always remove
+// themselves, unless they are the last owner.
+type RemoveWorkspaceMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId   int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveWorkspaceMemberRequest) Reset() {
+	*x = RemoveWorkspaceMemberRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveWorkspaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetCreatedBy() int64 {
+func (*RemoveWorkspaceMemberRequest) ProtoMessage() {}
+
+func (x *RemoveWorkspaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[152]
 	if x != nil {
-		return x.CreatedBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use RemoveWorkspaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWorkspaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *RemoveWorkspaceMemberRequest) GetWorkspaceId() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.WorkspaceId
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RemoveWorkspaceMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type CreateTaskRequest struct {
+type RemoveWorkspaceMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedBy     int64                  `protobuf:"varint,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[1]
+func (x *RemoveWorkspaceMemberResponse) Reset() {
+	*x = RemoveWorkspaceMemberResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *RemoveWorkspaceMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*RemoveWorkspaceMemberResponse) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[1]
+func (x *RemoveWorkspaceMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -158,61 +9801,151 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RemoveWorkspaceMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWorkspaceMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *CreateTaskRequest) GetBoardId() int64 {
+func (x *RemoveWorkspaceMemberResponse) GetSuccess() bool {
 	if x != nil {
-		return x.BoardId
+		return x.Success
+	}
+	return false
+}
+
+// WorkspaceUserData is what one workspace keeps about a user.
+type WorkspaceUserData struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WorkspaceId        int64                  `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	CreatedTasks       []*Task                `protobuf:"bytes,2,rep,name=created_tasks,json=createdTasks,proto3" json:"created_tasks,omitempty"`
+	AssignedTasks      []*Task                `protobuf:"bytes,3,rep,name=assigned_tasks,json=assignedTasks,proto3" json:"assigned_tasks,omitempty"`
+	TimeEntries        []*TimeEntry           `protobuf:"bytes,4,rep,name=time_entries,json=timeEntries,proto3" json:"time_entries,omitempty"`
+	WatchedTaskIds     []int64                `protobuf:"varint,5,rep,packed,name=watched_task_ids,json=watchedTaskIds,proto3" json:"watched_task_ids,omitempty"`
+	BoardMemberships   []*BoardMember         `protobuf:"bytes,6,rep,name=board_memberships,json=boardMemberships,proto3" json:"board_memberships,omitempty"`
+	SavedViews         []*SavedView           `protobuf:"bytes,7,rep,name=saved_views,json=savedViews,proto3" json:"saved_views,omitempty"`
+	CalendarFeeds      []*CalendarFeed        `protobuf:"bytes,8,rep,name=calendar_feeds,json=calendarFeeds,proto3" json:"calendar_feeds,omitempty"`
+	InboxNotifications []*InboxNotification   `protobuf:"bytes,9,rep,name=inbox_notifications,json=inboxNotifications,proto3" json:"inbox_notifications,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceUserData) Reset() {
+	*x = WorkspaceUserData{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceUserData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceUserData) ProtoMessage() {}
+
+func (x *WorkspaceUserData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceUserData.ProtoReflect.Descriptor instead.
+func (*WorkspaceUserData) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *WorkspaceUserData) GetWorkspaceId() int64 {
+	if x != nil {
+		return x.WorkspaceId
 	}
 	return 0
 }
 
-func (x *CreateTaskRequest) GetTitle() string {
+func (x *WorkspaceUserData) GetCreatedTasks() []*Task {
 	if x != nil {
-		return x.Title
+		return x.CreatedTasks
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTaskRequest) GetDescription() string {
+func (x *WorkspaceUserData) GetAssignedTasks() []*Task {
 	if x != nil {
-		return x.Description
+		return x.AssignedTasks
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateTaskRequest) GetCreatedBy() int64 {
+func (x *WorkspaceUserData) GetTimeEntries() []*TimeEntry {
 	if x != nil {
-		return x.CreatedBy
+		return x.TimeEntries
 	}
-	return 0
+	return nil
 }
 
-type CreateTaskResponse struct {
+func (x *WorkspaceUserData) GetWatchedTaskIds() []int64 {
+	if x != nil {
+		return x.WatchedTaskIds
+	}
+	return nil
+}
+
+func (x *WorkspaceUserData) GetBoardMemberships() []*BoardMember {
+	if x != nil {
+		return x.BoardMemberships
+	}
+	return nil
+}
+
+func (x *WorkspaceUserData) GetSavedViews() []*SavedView {
+	if x != nil {
+		return x.SavedViews
+	}
+	return nil
+}
+
+func (x *WorkspaceUserData) GetCalendarFeeds() []*CalendarFeed {
+	if x != nil {
+		return x.CalendarFeeds
+	}
+	return nil
+}
+
+func (x *WorkspaceUserData) GetInboxNotifications() []*InboxNotification {
+	if x != nil {
+		return x.InboxNotifications
+	}
+	return nil
+}
+
+// ExportUserDataRequest exports everything the task service keeps about
+// the caller, in every workspace.
+type ExportUserDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[2]
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *ExportUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*ExportUserDataRequest) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[2]
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,40 +9956,43 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *ExportUserDataRequest) GetUserId() string {
 	if x != nil {
-		return x.Task
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExportUserDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Workspaces without any of the user's data are left out.
+	Workspaces              []*WorkspaceUserData     `protobuf:"bytes,1,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	WorkspaceMemberships    []*WorkspaceMember       `protobuf:"bytes,2,rep,name=workspace_memberships,json=workspaceMemberships,proto3" json:"workspace_memberships,omitempty"`
+	NotificationPreferences *NotificationPreferences `protobuf:"bytes,3,opt,name=notification_preferences,json=notificationPreferences,proto3" json:"notification_preferences,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[3]
+func (x *ExportUserDataResponse) Reset() {
+	*x = ExportUserDataResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *ExportUserDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*ExportUserDataResponse) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[3]
+func (x *ExportUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,40 +10003,58 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ExportUserDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *GetTaskRequest) GetId() int64 {
+func (x *ExportUserDataResponse) GetWorkspaces() []*WorkspaceUserData {
 	if x != nil {
-		return x.Id
+		return x.Workspaces
 	}
-	return 0
+	return nil
 }
 
-type GetTaskResponse struct {
+func (x *ExportUserDataResponse) GetWorkspaceMemberships() []*WorkspaceMember {
+	if x != nil {
+		return x.WorkspaceMemberships
+	}
+	return nil
+}
+
+func (x *ExportUserDataResponse) GetNotificationPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.NotificationPreferences
+	}
+	return nil
+}
+
+// DeleteUserDataRequest removes the caller from every workspace, as their
+// account is deleted. Tasks and time entries they created stay, credited
+// to their anonymised account. Boards and workspaces they are the last
+// owner of pass to their most senior other member.
+type DeleteUserDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[4]
+func (x *DeleteUserDataRequest) Reset() {
+	*x = DeleteUserDataRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *DeleteUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*DeleteUserDataRequest) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[4]
+func (x *DeleteUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,46 +10065,40 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteUserDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *GetTaskResponse) GetTask() *Task {
+func (x *DeleteUserDataRequest) GetUserId() string {
 	if x != nil {
-		return x.Task
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type ListTasksRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Filter by board.
-	BoardId int64 `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
-	// Filter based on if the task is completed. (optional)
-	// If not set, returns all tasks.
-	Completed     *bool `protobuf:"varint,2,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
-	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageNumber    int32 `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+type DeleteUserDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[5]
+func (x *DeleteUserDataResponse) Reset() {
+	*x = DeleteUserDataResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *DeleteUserDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*DeleteUserDataResponse) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[5]
+func (x *DeleteUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,62 +10109,93 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeleteUserDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *DeleteUserDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ExportBoardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BoardId       int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=task.v1.DataFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) GetBoardId() int64 {
+func (x *ExportBoardRequest) Reset() {
+	*x = ExportBoardRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBoardRequest) ProtoMessage() {}
+
+func (x *ExportBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[159]
 	if x != nil {
-		return x.BoardId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListTasksRequest) GetCompleted() bool {
-	if x != nil && x.Completed != nil {
-		return *x.Completed
-	}
-	return false
+// Deprecated: Use ExportBoardRequest.ProtoReflect.Descriptor instead.
+func (*ExportBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *ListTasksRequest) GetPageSize() int32 {
+func (x *ExportBoardRequest) GetBoardId() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.BoardId
 	}
 	return 0
 }
 
-func (x *ListTasksRequest) GetPageNumber() int32 {
+func (x *ExportBoardRequest) GetFormat() DataFormat {
 	if x != nil {
-		return x.PageNumber
+		return x.Format
 	}
-	return 0
+	return DataFormat_DATA_FORMAT_UNSPECIFIED
 }
 
-type ListTasksResponse struct {
+// ExportBoardChunk is the next piece of the exported file.
+type ExportBoardChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[6]
+func (x *ExportBoardChunk) Reset() {
+	*x = ExportBoardChunk{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *ExportBoardChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*ExportBoardChunk) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[6]
+func (x *ExportBoardChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,50 +10206,61 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportBoardChunk.ProtoReflect.Descriptor instead.
+func (*ExportBoardChunk) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *ListTasksResponse) GetTasks() []*Task {
+func (x *ExportBoardChunk) GetData() []byte {
 	if x != nil {
-		return x.Tasks
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ListTasksResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	Completed     *bool                  `protobuf:"varint,4,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+// ImportBoardHeader is the first message of an import.
+type ImportBoardHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Board the tasks go into. JSON imports may leave it unset to create a
+	// new board from the file's board section. Trello and GitHub imports
+	// may leave it unset to reuse the board of an earlier import of the same
+	// board or repository, or else create one.
+	BoardId int64      `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	Format  DataFormat `protobuf:"varint,2,opt,name=format,proto3,enum=task.v1.DataFormat" json:"format,omitempty"`
+	// CSV only: maps source column names to task fields (title,
+	// description, completed, created_by, created_at, updated_at, due_at,
+	// labels, assignee_ids, or cf:<custom field name>). An empty target
+	// ignores the column. Unmapped columns must already be named after a
+	// task field.
+	ColumnMapping map[string]string `protobuf:"bytes,3,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Validates every row without writing anything.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Used for rows that don't name a creator, or name one who isn't a
+	// user.
+	CreatedBy string `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	// Trello and GitHub only: maps assignees, as "trello:<username>" or
+	// "github:<login>", to taskboard user IDs. Unmapped assignees are left
+	// out, as assignees must be users.
+	UserMap       map[string]string `protobuf:"bytes,6,rep,name=user_map,json=userMap,proto3" json:"user_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[7]
+func (x *ImportBoardHeader) Reset() {
+	*x = ImportBoardHeader{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *ImportBoardHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*ImportBoardHeader) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[7]
+func (x *ImportBoardHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,61 +10271,79 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportBoardHeader.ProtoReflect.Descriptor instead.
+func (*ImportBoardHeader) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *UpdateTaskRequest) GetId() int64 {
+func (x *ImportBoardHeader) GetBoardId() int64 {
 	if x != nil {
-		return x.Id
+		return x.BoardId
 	}
 	return 0
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *ImportBoardHeader) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
 	}
-	return ""
+	return DataFormat_DATA_FORMAT_UNSPECIFIED
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *ImportBoardHeader) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateTaskRequest) GetCompleted() bool {
-	if x != nil && x.Completed != nil {
-		return *x.Completed
+func (x *ImportBoardHeader) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
 	return false
 }
 
-type UpdateTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+func (x *ImportBoardHeader) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ImportBoardHeader) GetUserMap() map[string]string {
+	if x != nil {
+		return x.UserMap
+	}
+	return nil
+}
+
+type ImportBoardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportBoardRequest_Header
+	//	*ImportBoardRequest_Data
+	Payload       isImportBoardRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[8]
+func (x *ImportBoardRequest) Reset() {
+	*x = ImportBoardRequest{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *ImportBoardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*ImportBoardRequest) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[8]
+func (x *ImportBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,40 +10354,78 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ImportBoardRequest.ProtoReflect.Descriptor instead.
+func (*ImportBoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+func (x *ImportBoardRequest) GetPayload() isImportBoardRequest_Payload {
 	if x != nil {
-		return x.Task
+		return x.Payload
 	}
 	return nil
 }
 
-type DeleteTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ImportBoardRequest) GetHeader() *ImportBoardHeader {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportBoardRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *ImportBoardRequest) GetData() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportBoardRequest_Data); ok {
+			return x.Data
+		}
+	}
+	return nil
+}
+
+type isImportBoardRequest_Payload interface {
+	isImportBoardRequest_Payload()
+}
+
+type ImportBoardRequest_Header struct {
+	Header *ImportBoardHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ImportBoardRequest_Data struct {
+	// Next piece of the file.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*ImportBoardRequest_Header) isImportBoardRequest_Payload() {}
+
+func (*ImportBoardRequest_Data) isImportBoardRequest_Payload() {}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based data row; 0 for errors that concern the whole file.
+	Row           int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[9]
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *ImportRowError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*ImportRowError) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[9]
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,40 +10436,70 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *DeleteTaskRequest) GetId() int64 {
+func (x *ImportRowError) GetRow() int64 {
 	if x != nil {
-		return x.Id
+		return x.Row
 	}
 	return 0
 }
 
-type DeleteTaskResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportBoardResponse reports an import. CSV and JSON imports are all or
+// nothing: if any row has errors, no task is written. Trello and GitHub
+// imports write each task on its own and skip tasks an earlier import
+// already wrote, so they can be rerun after a failure.
+type ImportBoardResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BoardId   int64                  `protobuf:"varint,1,opt,name=board_id,json=boardId,proto3" json:"board_id,omitempty"`
+	RowsTotal int64                  `protobuf:"varint,2,opt,name=rows_total,json=rowsTotal,proto3" json:"rows_total,omitempty"`
+	// On dry runs, the rows that would have been imported.
+	RowsImported int64             `protobuf:"varint,3,opt,name=rows_imported,json=rowsImported,proto3" json:"rows_imported,omitempty"`
+	Errors       []*ImportRowError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Set when there were more errors than reported.
+	ErrorsTruncated bool `protobuf:"varint,5,opt,name=errors_truncated,json=errorsTruncated,proto3" json:"errors_truncated,omitempty"`
+	DryRun          bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Trello and GitHub only: tasks skipped because they were already
+	// imported.
+	RowsSkipped int64 `protobuf:"varint,7,opt,name=rows_skipped,json=rowsSkipped,proto3" json:"rows_skipped,omitempty"`
+	// Set when the import created the board.
+	BoardCreated  bool `protobuf:"varint,8,opt,name=board_created,json=boardCreated,proto3" json:"board_created,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_proto_task_v1_task_proto_msgTypes[10]
+func (x *ImportBoardResponse) Reset() {
+	*x = ImportBoardResponse{}
+	mi := &file_proto_task_v1_task_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *ImportBoardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*ImportBoardResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_task_v1_task_proto_msgTypes[10]
+func (x *ImportBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_v1_task_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,14 +10510,63 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportBoardResponse.ProtoReflect.Descriptor instead.
+func (*ImportBoardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_v1_task_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DeleteTaskResponse) GetSuccess() bool {
+func (x *ImportBoardResponse) GetBoardId() int64 {
 	if x != nil {
-		return x.Success
+		return x.BoardId
+	}
+	return 0
+}
+
+func (x *ImportBoardResponse) GetRowsTotal() int64 {
+	if x != nil {
+		return x.RowsTotal
+	}
+	return 0
+}
+
+func (x *ImportBoardResponse) GetRowsImported() int64 {
+	if x != nil {
+		return x.RowsImported
+	}
+	return 0
+}
+
+func (x *ImportBoardResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportBoardResponse) GetErrorsTruncated() bool {
+	if x != nil {
+		return x.ErrorsTruncated
+	}
+	return false
+}
+
+func (x *ImportBoardResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportBoardResponse) GetRowsSkipped() int64 {
+	if x != nil {
+		return x.RowsSkipped
+	}
+	return 0
+}
+
+func (x *ImportBoardResponse) GetBoardCreated() bool {
+	if x != nil {
+		return x.BoardCreated
 	}
 	return false
 }
@@ -650,7 +10575,7 @@ var File_proto_task_v1_task_proto protoreflect.FileDescriptor
 
 const file_proto_task_v1_task_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/task/v1/task.proto\x12\atask.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x02\n" +
+	"\x18proto/task/v1/task.proto\x12\atask.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x05\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x12\x14\n" +
@@ -658,40 +10583,70 @@ const file_proto_task_v1_task_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
 	"\tcompleted\x18\x05 \x01(\bR\tcompleted\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x06 \x01(\x03R\tcreatedBy\x129\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x129\n" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
+	"\x12total_time_seconds\x18\t \x01(\x03R\x10totalTimeSeconds\x12)\n" +
+	"\x10attachment_count\x18\n" +
+	" \x01(\x05R\x0fattachmentCount\x12>\n" +
+	"\rcustom_fields\x18\v \x03(\v2\x19.task.v1.CustomFieldValueR\fcustomFields\x121\n" +
+	"\x06due_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x16\n" +
+	"\x06labels\x18\r \x03(\tR\x06labels\x12!\n" +
+	"\fassignee_ids\x18\x0e \x03(\tR\vassigneeIds\x12.\n" +
+	"\acreator\x18\x0f \x01(\v2\x14.task.v1.UserSummaryR\acreator\x122\n" +
+	"\tassignees\x18\x10 \x03(\v2\x14.task.v1.UserSummaryR\tassignees\"9\n" +
+	"\vUserSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\xb3\x02\n" +
 	"\x11CreateTaskRequest\x12\x19\n" +
 	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
 	"\n" +
-	"created_by\x18\x04 \x01(\x03R\tcreatedBy\"7\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12>\n" +
+	"\rcustom_fields\x18\x05 \x03(\v2\x19.task.v1.CustomFieldValueR\fcustomFields\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x16\n" +
+	"\x06labels\x18\a \x03(\tR\x06labels\x12!\n" +
+	"\fassignee_ids\x18\b \x03(\tR\vassigneeIds\"7\n" +
 	"\x12CreateTaskResponse\x12!\n" +
 	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\" \n" +
 	"\x0eGetTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"4\n" +
 	"\x0fGetTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\x9c\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xa0\x03\n" +
 	"\x10ListTasksRequest\x12\x19\n" +
 	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12!\n" +
 	"\tcompleted\x18\x02 \x01(\bH\x00R\tcompleted\x88\x01\x01\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vpage_number\x18\x04 \x01(\x05R\n" +
-	"pageNumberB\f\n" +
+	"pageNumber\x12L\n" +
+	"\x14custom_field_filters\x18\x05 \x03(\v2\x1a.task.v1.CustomFieldFilterR\x12customFieldFilters\x12/\n" +
+	"\x14sort_custom_field_id\x18\x06 \x01(\x03R\x11sortCustomFieldId\x12'\n" +
+	"\x0fsort_descending\x18\a \x01(\bR\x0esortDescending\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12\x17\n" +
+	"\auser_id\x18\t \x01(\tR\x06userId\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\tR\x04sort\x12\x17\n" +
+	"\aview_id\x18\v \x01(\x03R\x06viewIdB\f\n" +
 	"\n" +
 	"_completed\"Y\n" +
 	"\x11ListTasksResponse\x12#\n" +
 	"\x05tasks\x18\x01 \x03(\v2\r.task.v1.TaskR\x05tasks\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xb0\x01\n" +
+	"totalCount\"\xaa\x03\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12!\n" +
-	"\tcompleted\x18\x04 \x01(\bH\x02R\tcompleted\x88\x01\x01B\b\n" +
+	"\tcompleted\x18\x04 \x01(\bH\x02R\tcompleted\x88\x01\x01\x12>\n" +
+	"\rcustom_fields\x18\x05 \x03(\v2\x19.task.v1.CustomFieldValueR\fcustomFields\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12 \n" +
+	"\fclear_due_at\x18\a \x01(\bR\n" +
+	"clearDueAt\x12+\n" +
+	"\x06labels\x18\b \x01(\v2\x13.task.v1.StringListR\x06labels\x126\n" +
+	"\fassignee_ids\x18\t \x01(\v2\x13.task.v1.StringListR\vassigneeIdsB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\f\n" +
 	"\n" +
@@ -701,7 +10656,732 @@ const file_proto_task_v1_task_proto_rawDesc = "" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xaa\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf4\x02\n" +
+	"\tTimeEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12)\n" +
+	"\x10duration_seconds\x18\a \x01(\x03R\x0fdurationSeconds\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Y\n" +
+	"\x11StartTimerRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\">\n" +
+	"\x12StartTimerResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.task.v1.TimeEntryR\x05entry\"+\n" +
+	"\x10StopTimerRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\x11StopTimerResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.task.v1.TimeEntryR\x05entry\"\xd0\x01\n" +
+	"\x16CreateTimeEntryRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\"C\n" +
+	"\x17CreateTimeEntryResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.task.v1.TimeEntryR\x05entry\"\xbc\x01\n" +
+	"\x16UpdateTimeEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04note\x18\x02 \x01(\tH\x00R\x04note\x88\x01\x01\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAtB\a\n" +
+	"\x05_note\"C\n" +
+	"\x17UpdateTimeEntryResponse\x12(\n" +
+	"\x05entry\x18\x01 \x01(\v2\x12.task.v1.TimeEntryR\x05entry\"(\n" +
+	"\x16DeleteTimeEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x17DeleteTimeEntryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc1\x01\n" +
+	"\x16ListTimeEntriesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"G\n" +
+	"\x17ListTimeEntriesResponse\x12,\n" +
+	"\aentries\x18\x01 \x03(\v2\x12.task.v1.TimeEntryR\aentries\"\xde\x01\n" +
+	"\x14GetTimeReportRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x126\n" +
+	"\bgroup_by\x18\x05 \x01(\x0e2\x1b.task.v1.TimeReportGroupingR\agroupBy\"\xc8\x01\n" +
+	"\rTimeReportRow\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12#\n" +
+	"\rtotal_seconds\x18\x04 \x01(\x03R\ftotalSeconds\x12\x1f\n" +
+	"\ventry_count\x18\x05 \x01(\x05R\n" +
+	"entryCount\"h\n" +
+	"\x15GetTimeReportResponse\x12*\n" +
+	"\x04rows\x18\x01 \x03(\v2\x16.task.v1.TimeReportRowR\x04rows\x12#\n" +
+	"\rtotal_seconds\x18\x02 \x01(\x03R\ftotalSeconds\"\x90\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x03R\x06taskId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x1f\n" +
+	"\vstorage_key\x18\x06 \x01(\tR\n" +
+	"storageKey\x12\x1f\n" +
+	"\vuploaded_by\x18\a \x01(\tR\n" +
+	"uploadedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xeb\x01\n" +
+	"\x17CreateAttachmentRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x1f\n" +
+	"\vstorage_key\x18\x05 \x01(\tR\n" +
+	"storageKey\x12\x1f\n" +
+	"\vuploaded_by\x18\x06 \x01(\tR\n" +
+	"uploadedBy\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\"O\n" +
+	"\x18CreateAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.task.v1.AttachmentR\n" +
+	"attachment\"&\n" +
+	"\x14GetAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"L\n" +
+	"\x15GetAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.task.v1.AttachmentR\n" +
+	"attachment\"1\n" +
+	"\x16ListAttachmentsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"P\n" +
+	"\x17ListAttachmentsResponse\x125\n" +
+	"\vattachments\x18\x01 \x03(\v2\x13.task.v1.AttachmentR\vattachments\")\n" +
+	"\x17DeleteAttachmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"O\n" +
+	"\x18DeleteAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.task.v1.AttachmentR\n" +
+	"attachment\"\x86\x02\n" +
+	"\x05Board\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\acolumns\x18\x04 \x03(\v2\x14.task.v1.BoardColumnR\acolumns\x12+\n" +
+	"\x06labels\x18\x05 \x03(\v2\x13.task.v1.BoardLabelR\x06labels\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vrequire_mfa\x18\a \x01(\bR\n" +
+	"requireMfa\"M\n" +
+	"\vBoardColumn\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"F\n" +
+	"\n" +
+	"BoardLabel\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"\x91\x01\n" +
+	"\x12CreateBoardRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12+\n" +
+	"\x06labels\x18\x04 \x03(\v2\x13.task.v1.BoardLabelR\x06labels\";\n" +
+	"\x13CreateBoardResponse\x12$\n" +
+	"\x05board\x18\x01 \x01(\v2\x0e.task.v1.BoardR\x05board\"!\n" +
+	"\x0fGetBoardRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x10GetBoardResponse\x12$\n" +
+	"\x05board\x18\x01 \x01(\v2\x0e.task.v1.BoardR\x05board\"Z\n" +
+	"\x12UpdateBoardRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\vrequire_mfa\x18\x02 \x01(\bH\x00R\n" +
+	"requireMfa\x88\x01\x01B\x0e\n" +
+	"\f_require_mfa\";\n" +
+	"\x13UpdateBoardResponse\x12$\n" +
+	"\x05board\x18\x01 \x01(\v2\x0e.task.v1.BoardR\x05board\"d\n" +
+	"\fTaskTemplate\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tchecklist\x18\x03 \x03(\tR\tchecklist\"\xb9\x01\n" +
+	"\rBoardTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12+\n" +
+	"\x06labels\x18\x04 \x03(\v2\x13.task.v1.BoardLabelR\x06labels\x12+\n" +
+	"\x05tasks\x18\x05 \x03(\v2\x15.task.v1.TaskTemplateR\x05tasks\"\xe5\x02\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12)\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x15.task.v1.TemplateKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12.\n" +
+	"\x05board\x18\x05 \x01(\v2\x16.task.v1.BoardTemplateH\x00R\x05board\x12+\n" +
+	"\x04task\x18\x06 \x01(\v2\x15.task.v1.TaskTemplateH\x00R\x04task\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12&\n" +
+	"\x0fsource_board_id\x18\t \x01(\x03R\rsourceBoardIdB\t\n" +
+	"\acontent\"\xb5\x01\n" +
+	"\x15CreateTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12.\n" +
+	"\x05board\x18\x03 \x01(\v2\x16.task.v1.BoardTemplateH\x00R\x05board\x12+\n" +
+	"\x04task\x18\x04 \x01(\v2\x15.task.v1.TaskTemplateH\x00R\x04taskB\t\n" +
+	"\acontent\"G\n" +
+	"\x16CreateTemplateResponse\x12-\n" +
+	"\btemplate\x18\x01 \x01(\v2\x11.task.v1.TemplateR\btemplate\"$\n" +
+	"\x12GetTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x13GetTemplateResponse\x12-\n" +
+	"\btemplate\x18\x01 \x01(\v2\x11.task.v1.TemplateR\btemplate\"A\n" +
+	"\x14ListTemplatesRequest\x12)\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x15.task.v1.TemplateKindR\x04kind\"H\n" +
+	"\x15ListTemplatesResponse\x12/\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x11.task.v1.TemplateR\ttemplates\"'\n" +
+	"\x15DeleteTemplateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16DeleteTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x01\n" +
+	"\x1aSaveBoardAsTemplateRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12#\n" +
+	"\rinclude_tasks\x18\x04 \x01(\bR\fincludeTasks\"L\n" +
+	"\x1bSaveBoardAsTemplateResponse\x12-\n" +
+	"\btemplate\x18\x01 \x01(\v2\x11.task.v1.TemplateR\btemplate\"\x88\x02\n" +
+	"\x1eCreateBoardFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12T\n" +
+	"\tvariables\x18\x03 \x03(\v26.task.v1.CreateBoardFromTemplateRequest.VariablesEntryR\tvariables\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\x1fCreateBoardFromTemplateResponse\x12$\n" +
+	"\x05board\x18\x01 \x01(\v2\x0e.task.v1.BoardR\x05board\x12#\n" +
+	"\x05tasks\x18\x02 \x03(\v2\r.task.v1.TaskR\x05tasks\"\x8d\x02\n" +
+	"\x1dCreateTaskFromTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x12S\n" +
+	"\tvariables\x18\x03 \x03(\v25.task.v1.CreateTaskFromTemplateRequest.VariablesEntryR\tvariables\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
+	"\x1eCreateTaskFromTemplateResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\"\xcc\x01\n" +
+	"\vCustomField\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12,\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x18.task.v1.CustomFieldTypeR\x04type\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\"$\n" +
+	"\n" +
+	"StringList\x12\x16\n" +
+	"\x06values\x18\x01 \x03(\tR\x06values\"\x85\x02\n" +
+	"\x10CustomFieldValue\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\x03R\afieldId\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x02 \x01(\tR\tfieldName\x127\n" +
+	"\n" +
+	"field_type\x18\x03 \x01(\x0e2\x18.task.v1.CustomFieldTypeR\tfieldType\x12\x14\n" +
+	"\x04text\x18\x04 \x01(\tH\x00R\x04text\x12\x18\n" +
+	"\x06number\x18\x05 \x01(\x01H\x00R\x06number\x12\x14\n" +
+	"\x04date\x18\x06 \x01(\tH\x00R\x04date\x12/\n" +
+	"\aoptions\x18\a \x01(\v2\x13.task.v1.StringListH\x00R\aoptionsB\a\n" +
+	"\x05value\"\xfd\x01\n" +
+	"\x11CustomFieldFilter\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\x03R\afieldId\x12-\n" +
+	"\x02op\x18\x02 \x01(\x0e2\x1d.task.v1.CustomFieldFilter.OpR\x02op\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\x87\x01\n" +
+	"\x02Op\x12\x12\n" +
+	"\x0eOP_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05OP_EQ\x10\x01\x12\t\n" +
+	"\x05OP_NE\x10\x02\x12\t\n" +
+	"\x05OP_LT\x10\x03\x12\n" +
+	"\n" +
+	"\x06OP_LTE\x10\x04\x12\t\n" +
+	"\x05OP_GT\x10\x05\x12\n" +
+	"\n" +
+	"\x06OP_GTE\x10\x06\x12\x0f\n" +
+	"\vOP_CONTAINS\x10\a\x12\n" +
+	"\n" +
+	"\x06OP_SET\x10\b\x12\f\n" +
+	"\bOP_UNSET\x10\t\"\xc9\x01\n" +
+	"\x18CreateCustomFieldRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12,\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x18.task.v1.CustomFieldTypeR\x04type\x12\x18\n" +
+	"\aoptions\x18\x04 \x03(\tR\aoptions\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\"G\n" +
+	"\x19CreateCustomFieldResponse\x12*\n" +
+	"\x05field\x18\x01 \x01(\v2\x14.task.v1.CustomFieldR\x05field\"\xeb\x01\n" +
+	"\x18UpdateCustomFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x18\n" +
+	"\aoptions\x18\x03 \x03(\tR\aoptions\x12'\n" +
+	"\x0freplace_options\x18\x04 \x01(\bR\x0ereplaceOptions\x12\x1f\n" +
+	"\brequired\x18\x05 \x01(\bH\x01R\brequired\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x06 \x01(\x05H\x02R\bposition\x88\x01\x01B\a\n" +
+	"\x05_nameB\v\n" +
+	"\t_requiredB\v\n" +
+	"\t_position\"G\n" +
+	"\x19UpdateCustomFieldResponse\x12*\n" +
+	"\x05field\x18\x01 \x01(\v2\x14.task.v1.CustomFieldR\x05field\"*\n" +
+	"\x18DeleteCustomFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"5\n" +
+	"\x19DeleteCustomFieldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	"\x17ListCustomFieldsRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\"H\n" +
+	"\x18ListCustomFieldsResponse\x12,\n" +
+	"\x06fields\x18\x01 \x03(\v2\x14.task.v1.CustomFieldR\x06fields\"\x9d\x02\n" +
+	"\tSavedView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\tR\x04sort\x12\x19\n" +
+	"\bboard_id\x18\x06 \x01(\x03R\aboardId\x12\x16\n" +
+	"\x06shared\x18\a \x01(\bR\x06shared\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa4\x01\n" +
+	"\x16CreateSavedViewRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x19\n" +
+	"\bboard_id\x18\x05 \x01(\x03R\aboardId\x12\x16\n" +
+	"\x06shared\x18\x06 \x01(\bR\x06shared\"A\n" +
+	"\x17CreateSavedViewResponse\x12&\n" +
+	"\x04view\x18\x01 \x01(\v2\x12.task.v1.SavedViewR\x04view\">\n" +
+	"\x13GetSavedViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\">\n" +
+	"\x14GetSavedViewResponse\x12&\n" +
+	"\x04view\x18\x01 \x01(\v2\x12.task.v1.SavedViewR\x04view\"K\n" +
+	"\x15ListSavedViewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\"B\n" +
+	"\x16ListSavedViewsResponse\x12(\n" +
+	"\x05views\x18\x01 \x03(\v2\x12.task.v1.SavedViewR\x05views\"\xff\x01\n" +
+	"\x16UpdateSavedViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05query\x18\x04 \x01(\tH\x01R\x05query\x88\x01\x01\x12\x17\n" +
+	"\x04sort\x18\x05 \x01(\tH\x02R\x04sort\x88\x01\x01\x12\x1e\n" +
+	"\bboard_id\x18\x06 \x01(\x03H\x03R\aboardId\x88\x01\x01\x12\x1b\n" +
+	"\x06shared\x18\a \x01(\bH\x04R\x06shared\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_queryB\a\n" +
+	"\x05_sortB\v\n" +
+	"\t_board_idB\t\n" +
+	"\a_shared\"A\n" +
+	"\x17UpdateSavedViewResponse\x12&\n" +
+	"\x04view\x18\x01 \x01(\v2\x12.task.v1.SavedViewR\x04view\"A\n" +
+	"\x16DeleteSavedViewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"3\n" +
+	"\x17DeleteSavedViewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xe6\x01\n" +
+	"\fCalendarFeed\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x19\n" +
+	"\bboard_id\x18\x03 \x01(\x03R\aboardId\x128\n" +
+	"\tcomponent\x18\x04 \x01(\x0e2\x1a.task.v1.CalendarComponentR\tcomponent\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x01\n" +
+	"\x19CreateCalendarFeedRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x19\n" +
+	"\bboard_id\x18\x02 \x01(\x03R\aboardId\x128\n" +
+	"\tcomponent\x18\x03 \x01(\x0e2\x1a.task.v1.CalendarComponentR\tcomponent\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\"]\n" +
+	"\x1aCreateCalendarFeedResponse\x12)\n" +
+	"\x04feed\x18\x01 \x01(\v2\x15.task.v1.CalendarFeedR\x04feed\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"3\n" +
+	"\x18ListCalendarFeedsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x19ListCalendarFeedsResponse\x12+\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x15.task.v1.CalendarFeedR\x05feeds\"D\n" +
+	"\x19DeleteCalendarFeedRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"6\n" +
+	"\x1aDeleteCalendarFeedResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x12GetCalendarRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
+	"\rif_none_match\x18\x02 \x01(\tR\vifNoneMatch\"`\n" +
+	"\x13GetCalendarResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\x12!\n" +
+	"\fnot_modified\x18\x03 \x01(\bR\vnotModified\"\xbb\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\tR\aownerId\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tboard_ids\x18\x05 \x03(\x03R\bboardIds\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12'\n" +
+	"\x0fdisabled_reason\x18\a \x01(\tR\x0edisabledReason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x01\n" +
+	"\x14CreateWebhookRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x1b\n" +
+	"\tboard_ids\x18\x05 \x03(\x03R\bboardIds\"[\n" +
+	"\x15CreateWebhookResponse\x12*\n" +
+	"\awebhook\x18\x01 \x01(\v2\x10.task.v1.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"<\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
+	"\x12GetWebhookResponse\x12*\n" +
+	"\awebhook\x18\x01 \x01(\v2\x10.task.v1.WebhookR\awebhook\".\n" +
+	"\x13ListWebhooksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"D\n" +
+	"\x14ListWebhooksResponse\x12,\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x10.task.v1.WebhookR\bwebhooks\"\xc5\x02\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tH\x00R\x03url\x88\x01\x01\x12\x1b\n" +
+	"\x06active\x18\x04 \x01(\bH\x01R\x06active\x88\x01\x01\x12.\n" +
+	"\x13replace_event_types\x18\x05 \x01(\bR\x11replaceEventTypes\x12\x1f\n" +
+	"\vevent_types\x18\x06 \x03(\tR\n" +
+	"eventTypes\x12*\n" +
+	"\x11replace_board_ids\x18\a \x01(\bR\x0freplaceBoardIds\x12\x1b\n" +
+	"\tboard_ids\x18\b \x03(\x03R\bboardIds\x12#\n" +
+	"\rrotate_secret\x18\t \x01(\bR\frotateSecretB\x06\n" +
+	"\x04_urlB\t\n" +
+	"\a_active\"[\n" +
+	"\x15UpdateWebhookResponse\x12*\n" +
+	"\awebhook\x18\x01 \x01(\v2\x10.task.v1.WebhookR\awebhook\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"?\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb4\x04\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\x03R\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.task.v1.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x18\n" +
+	"\apayload\x18\a \x01(\tR\apayload\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\t \x01(\tR\fresponseBody\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\x0flast_attempt_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12#\n" +
+	"\rredelivery_of\x18\x0e \x01(\x03R\fredeliveryOf\"\xcc\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.task.v1.WebhookDeliveryStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x05 \x01(\x05R\n" +
+	"pageNumber\"z\n" +
+	"\x1dListWebhookDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.task.v1.WebhookDeliveryR\n" +
+	"deliveries\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"z\n" +
+	"\x1fRedeliverWebhookDeliveryRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\x03R\twebhookId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\x03R\n" +
+	"deliveryId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"X\n" +
+	" RedeliverWebhookDeliveryResponse\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.task.v1.WebhookDeliveryR\bdelivery\"D\n" +
+	"\x10WatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"-\n" +
+	"\x11WatchTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"F\n" +
+	"\x12UnwatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"/\n" +
+	"\x13UnwatchTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\x17ListTaskWatchersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x03R\x06taskId\"5\n" +
+	"\x18ListTaskWatchersResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"q\n" +
+	"\x17NotificationPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\x0eemail_delivery\x18\x02 \x01(\x0e2\x16.task.v1.EmailDeliveryR\remailDelivery\"<\n" +
+	"!GetNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"h\n" +
+	"\"GetNotificationPreferencesResponse\x12B\n" +
+	"\vpreferences\x18\x01 \x01(\v2 .task.v1.NotificationPreferencesR\vpreferences\"~\n" +
+	"$UpdateNotificationPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12=\n" +
+	"\x0eemail_delivery\x18\x02 \x01(\x0e2\x16.task.v1.EmailDeliveryR\remailDelivery\"k\n" +
+	"%UpdateNotificationPreferencesResponse\x12B\n" +
+	"\vpreferences\x18\x01 \x01(\v2 .task.v1.NotificationPreferencesR\vpreferences\"\xbe\x02\n" +
+	"\x11InboxNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1e.task.v1.InboxNotificationKindR\x04kind\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\x03R\x06taskId\x12\x19\n" +
+	"\bboard_id\x18\x05 \x01(\x03R\aboardId\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x123\n" +
+	"\aread_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06readAt\"\x97\x01\n" +
+	"\x1dListInboxNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vpage_number\x18\x04 \x01(\x05R\n" +
+	"pageNumber\"\xa6\x01\n" +
+	"\x1eListInboxNotificationsResponse\x12@\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1a.task.v1.InboxNotificationR\rnotifications\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12!\n" +
+	"\funread_count\x18\x03 \x01(\x05R\vunreadCount\"5\n" +
+	"\x1aGetInboxUnreadCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\x1bGetInboxUnreadCountResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\"K\n" +
+	" MarkInboxNotificationReadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x86\x01\n" +
+	"!MarkInboxNotificationReadResponse\x12>\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1a.task.v1.InboxNotificationR\fnotification\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\"?\n" +
+	"$MarkAllInboxNotificationsReadRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"%MarkAllInboxNotificationsReadResponse\x12!\n" +
+	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"\xa4\x01\n" +
+	"\vBoardMember\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x12.task.v1.BoardRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x17ListBoardMembersRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\"J\n" +
+	"\x18ListBoardMembersResponse\x12.\n" +
+	"\amembers\x18\x01 \x03(\v2\x14.task.v1.BoardMemberR\amembers\"s\n" +
+	"\x15AddBoardMemberRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x12.task.v1.BoardRoleR\x04role\"F\n" +
+	"\x16AddBoardMemberResponse\x12,\n" +
+	"\x06member\x18\x01 \x01(\v2\x14.task.v1.BoardMemberR\x06member\"v\n" +
+	"\x18UpdateBoardMemberRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x12.task.v1.BoardRoleR\x04role\"I\n" +
+	"\x19UpdateBoardMemberResponse\x12,\n" +
+	"\x06member\x18\x01 \x01(\v2\x14.task.v1.BoardMemberR\x06member\"N\n" +
+	"\x18RemoveBoardMemberRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
+	"\x19RemoveBoardMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9f\x01\n" +
+	"\tWorkspace\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vrequire_mfa\x18\x05 \x01(\bR\n" +
+	"requireMfa\"\xb4\x01\n" +
+	"\x0fWorkspaceMember\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12*\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x16.task.v1.WorkspaceRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
+	"\x16CreateWorkspaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"K\n" +
+	"\x17CreateWorkspaceResponse\x120\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x12.task.v1.WorkspaceR\tworkspace\"q\n" +
+	"\x16UpdateWorkspaceRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12$\n" +
+	"\vrequire_mfa\x18\x02 \x01(\bH\x00R\n" +
+	"requireMfa\x88\x01\x01B\x0e\n" +
+	"\f_require_mfa\"K\n" +
+	"\x17UpdateWorkspaceResponse\x120\n" +
+	"\tworkspace\x18\x01 \x01(\v2\x12.task.v1.WorkspaceR\tworkspace\"\x17\n" +
+	"\x15ListWorkspacesRequest\"L\n" +
+	"\x16ListWorkspacesResponse\x122\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x12.task.v1.WorkspaceR\n" +
+	"workspaces\"@\n" +
+	"\x1bListWorkspaceMembersRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\"R\n" +
+	"\x1cListWorkspaceMembersResponse\x122\n" +
+	"\amembers\x18\x01 \x03(\v2\x18.task.v1.WorkspaceMemberR\amembers\"\x83\x01\n" +
+	"\x19AddWorkspaceMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12*\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x16.task.v1.WorkspaceRoleR\x04role\"N\n" +
+	"\x1aAddWorkspaceMemberResponse\x120\n" +
+	"\x06member\x18\x01 \x01(\v2\x18.task.v1.WorkspaceMemberR\x06member\"Z\n" +
+	"\x1cRemoveWorkspaceMemberRequest\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"9\n" +
+	"\x1dRemoveWorkspaceMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x84\x04\n" +
+	"\x11WorkspaceUserData\x12!\n" +
+	"\fworkspace_id\x18\x01 \x01(\x03R\vworkspaceId\x122\n" +
+	"\rcreated_tasks\x18\x02 \x03(\v2\r.task.v1.TaskR\fcreatedTasks\x124\n" +
+	"\x0eassigned_tasks\x18\x03 \x03(\v2\r.task.v1.TaskR\rassignedTasks\x125\n" +
+	"\ftime_entries\x18\x04 \x03(\v2\x12.task.v1.TimeEntryR\vtimeEntries\x12(\n" +
+	"\x10watched_task_ids\x18\x05 \x03(\x03R\x0ewatchedTaskIds\x12A\n" +
+	"\x11board_memberships\x18\x06 \x03(\v2\x14.task.v1.BoardMemberR\x10boardMemberships\x123\n" +
+	"\vsaved_views\x18\a \x03(\v2\x12.task.v1.SavedViewR\n" +
+	"savedViews\x12<\n" +
+	"\x0ecalendar_feeds\x18\b \x03(\v2\x15.task.v1.CalendarFeedR\rcalendarFeeds\x12K\n" +
+	"\x13inbox_notifications\x18\t \x03(\v2\x1a.task.v1.InboxNotificationR\x12inboxNotifications\"0\n" +
+	"\x15ExportUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x80\x02\n" +
+	"\x16ExportUserDataResponse\x12:\n" +
+	"\n" +
+	"workspaces\x18\x01 \x03(\v2\x1a.task.v1.WorkspaceUserDataR\n" +
+	"workspaces\x12M\n" +
+	"\x15workspace_memberships\x18\x02 \x03(\v2\x18.task.v1.WorkspaceMemberR\x14workspaceMemberships\x12[\n" +
+	"\x18notification_preferences\x18\x03 \x01(\v2 .task.v1.NotificationPreferencesR\x17notificationPreferences\"0\n" +
+	"\x15DeleteUserDataRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
+	"\x16DeleteUserDataResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\\\n" +
+	"\x12ExportBoardRequest\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12+\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x13.task.v1.DataFormatR\x06format\"&\n" +
+	"\x10ExportBoardChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xab\x03\n" +
+	"\x11ImportBoardHeader\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12+\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x13.task.v1.DataFormatR\x06format\x12T\n" +
+	"\x0ecolumn_mapping\x18\x03 \x03(\v2-.task.v1.ImportBoardHeader.ColumnMappingEntryR\rcolumnMapping\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12B\n" +
+	"\buser_map\x18\x06 \x03(\v2'.task.v1.ImportBoardHeader.UserMapEntryR\auserMap\x1a@\n" +
+	"\x12ColumnMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fUserMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"k\n" +
+	"\x12ImportBoardRequest\x124\n" +
+	"\x06header\x18\x01 \x01(\v2\x1a.task.v1.ImportBoardHeaderH\x00R\x06header\x12\x14\n" +
+	"\x04data\x18\x02 \x01(\fH\x00R\x04dataB\t\n" +
+	"\apayload\"T\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb1\x02\n" +
+	"\x13ImportBoardResponse\x12\x19\n" +
+	"\bboard_id\x18\x01 \x01(\x03R\aboardId\x12\x1d\n" +
+	"\n" +
+	"rows_total\x18\x02 \x01(\x03R\trowsTotal\x12#\n" +
+	"\rrows_imported\x18\x03 \x01(\x03R\frowsImported\x12/\n" +
+	"\x06errors\x18\x04 \x03(\v2\x17.task.v1.ImportRowErrorR\x06errors\x12)\n" +
+	"\x10errors_truncated\x18\x05 \x01(\bR\x0ferrorsTruncated\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12!\n" +
+	"\frows_skipped\x18\a \x01(\x03R\vrowsSkipped\x12#\n" +
+	"\rboard_created\x18\b \x01(\bR\fboardCreated*\x97\x01\n" +
+	"\x12TimeReportGrouping\x12$\n" +
+	" TIME_REPORT_GROUPING_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18TIME_REPORT_GROUPING_DAY\x10\x01\x12\x1d\n" +
+	"\x19TIME_REPORT_GROUPING_WEEK\x10\x02\x12\x1e\n" +
+	"\x1aTIME_REPORT_GROUPING_MONTH\x10\x03*^\n" +
+	"\fTemplateKind\x12\x1d\n" +
+	"\x19TEMPLATE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TEMPLATE_KIND_BOARD\x10\x01\x12\x16\n" +
+	"\x12TEMPLATE_KIND_TASK\x10\x02*\x8a\x02\n" +
+	"\x0fCustomFieldType\x12!\n" +
+	"\x1dCUSTOM_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_TEXT\x10\x01\x12\x1c\n" +
+	"\x18CUSTOM_FIELD_TYPE_NUMBER\x10\x02\x12#\n" +
+	"\x1fCUSTOM_FIELD_TYPE_SINGLE_SELECT\x10\x03\x12\"\n" +
+	"\x1eCUSTOM_FIELD_TYPE_MULTI_SELECT\x10\x04\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_DATE\x10\x05\x12\x1a\n" +
+	"\x16CUSTOM_FIELD_TYPE_USER\x10\x06\x12\x19\n" +
+	"\x15CUSTOM_FIELD_TYPE_URL\x10\a*r\n" +
+	"\x11CalendarComponent\x12\"\n" +
+	"\x1eCALENDAR_COMPONENT_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18CALENDAR_COMPONENT_EVENT\x10\x01\x12\x1b\n" +
+	"\x17CALENDAR_COMPONENT_TODO\x10\x02*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x03*\x9a\x01\n" +
+	"\rEmailDelivery\x12\x1e\n" +
+	"\x1aEMAIL_DELIVERY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18EMAIL_DELIVERY_IMMEDIATE\x10\x01\x12\x19\n" +
+	"\x15EMAIL_DELIVERY_HOURLY\x10\x02\x12\x18\n" +
+	"\x14EMAIL_DELIVERY_DAILY\x10\x03\x12\x16\n" +
+	"\x12EMAIL_DELIVERY_OFF\x10\x04*\xfe\x01\n" +
+	"\x15InboxNotificationKind\x12'\n" +
+	"#INBOX_NOTIFICATION_KIND_UNSPECIFIED\x10\x00\x12%\n" +
+	"!INBOX_NOTIFICATION_KIND_MENTIONED\x10\x01\x12$\n" +
+	" INBOX_NOTIFICATION_KIND_ASSIGNED\x10\x02\x12#\n" +
+	"\x1fINBOX_NOTIFICATION_KIND_UPDATED\x10\x03\x12%\n" +
+	"!INBOX_NOTIFICATION_KIND_COMPLETED\x10\x04\x12#\n" +
+	"\x1fINBOX_NOTIFICATION_KIND_DELETED\x10\x05*\x81\x01\n" +
+	"\tBoardRole\x12\x1a\n" +
+	"\x16BOARD_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11BOARD_ROLE_VIEWER\x10\x01\x12\x15\n" +
+	"\x11BOARD_ROLE_MEMBER\x10\x02\x12\x14\n" +
+	"\x10BOARD_ROLE_ADMIN\x10\x03\x12\x14\n" +
+	"\x10BOARD_ROLE_OWNER\x10\x04*d\n" +
+	"\rWorkspaceRole\x12\x1e\n" +
+	"\x1aWORKSPACE_ROLE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15WORKSPACE_ROLE_MEMBER\x10\x01\x12\x18\n" +
+	"\x14WORKSPACE_ROLE_OWNER\x10\x02*\x84\x01\n" +
+	"\n" +
+	"DataFormat\x12\x1b\n" +
+	"\x17DATA_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDATA_FORMAT_CSV\x10\x01\x12\x14\n" +
+	"\x10DATA_FORMAT_JSON\x10\x02\x12\x16\n" +
+	"\x12DATA_FORMAT_TRELLO\x10\x03\x12\x16\n" +
+	"\x12DATA_FORMAT_GITHUB\x10\x042\xc00\n" +
 	"\vTaskService\x12G\n" +
 	"\n" +
 	"CreateTask\x12\x1a.task.v1.CreateTaskRequest\x1a\x1b.task.v1.CreateTaskResponse\"\x00\x12>\n" +
@@ -712,7 +11392,73 @@ const file_proto_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x1b.task.v1.DeleteTaskResponse\"\x00\x12:\n" +
 	"\n" +
-	"WatchTasks\x12\x19.task.v1.ListTasksRequest\x1a\r.task.v1.Task\"\x000\x01B8Z6github.com/zaouldyeck/taskboard/pkg/api/task/v1;taskv1b\x06proto3"
+	"WatchTasks\x12\x19.task.v1.ListTasksRequest\x1a\r.task.v1.Task\"\x000\x01\x12G\n" +
+	"\n" +
+	"StartTimer\x12\x1a.task.v1.StartTimerRequest\x1a\x1b.task.v1.StartTimerResponse\"\x00\x12D\n" +
+	"\tStopTimer\x12\x19.task.v1.StopTimerRequest\x1a\x1a.task.v1.StopTimerResponse\"\x00\x12V\n" +
+	"\x0fCreateTimeEntry\x12\x1f.task.v1.CreateTimeEntryRequest\x1a .task.v1.CreateTimeEntryResponse\"\x00\x12V\n" +
+	"\x0fUpdateTimeEntry\x12\x1f.task.v1.UpdateTimeEntryRequest\x1a .task.v1.UpdateTimeEntryResponse\"\x00\x12V\n" +
+	"\x0fDeleteTimeEntry\x12\x1f.task.v1.DeleteTimeEntryRequest\x1a .task.v1.DeleteTimeEntryResponse\"\x00\x12V\n" +
+	"\x0fListTimeEntries\x12\x1f.task.v1.ListTimeEntriesRequest\x1a .task.v1.ListTimeEntriesResponse\"\x00\x12P\n" +
+	"\rGetTimeReport\x12\x1d.task.v1.GetTimeReportRequest\x1a\x1e.task.v1.GetTimeReportResponse\"\x00\x12Y\n" +
+	"\x10CreateAttachment\x12 .task.v1.CreateAttachmentRequest\x1a!.task.v1.CreateAttachmentResponse\"\x00\x12P\n" +
+	"\rGetAttachment\x12\x1d.task.v1.GetAttachmentRequest\x1a\x1e.task.v1.GetAttachmentResponse\"\x00\x12V\n" +
+	"\x0fListAttachments\x12\x1f.task.v1.ListAttachmentsRequest\x1a .task.v1.ListAttachmentsResponse\"\x00\x12Y\n" +
+	"\x10DeleteAttachment\x12 .task.v1.DeleteAttachmentRequest\x1a!.task.v1.DeleteAttachmentResponse\"\x00\x12J\n" +
+	"\vCreateBoard\x12\x1b.task.v1.CreateBoardRequest\x1a\x1c.task.v1.CreateBoardResponse\"\x00\x12A\n" +
+	"\bGetBoard\x12\x18.task.v1.GetBoardRequest\x1a\x19.task.v1.GetBoardResponse\"\x00\x12J\n" +
+	"\vUpdateBoard\x12\x1b.task.v1.UpdateBoardRequest\x1a\x1c.task.v1.UpdateBoardResponse\"\x00\x12S\n" +
+	"\x0eCreateTemplate\x12\x1e.task.v1.CreateTemplateRequest\x1a\x1f.task.v1.CreateTemplateResponse\"\x00\x12J\n" +
+	"\vGetTemplate\x12\x1b.task.v1.GetTemplateRequest\x1a\x1c.task.v1.GetTemplateResponse\"\x00\x12P\n" +
+	"\rListTemplates\x12\x1d.task.v1.ListTemplatesRequest\x1a\x1e.task.v1.ListTemplatesResponse\"\x00\x12S\n" +
+	"\x0eDeleteTemplate\x12\x1e.task.v1.DeleteTemplateRequest\x1a\x1f.task.v1.DeleteTemplateResponse\"\x00\x12b\n" +
+	"\x13SaveBoardAsTemplate\x12#.task.v1.SaveBoardAsTemplateRequest\x1a$.task.v1.SaveBoardAsTemplateResponse\"\x00\x12n\n" +
+	"\x17CreateBoardFromTemplate\x12'.task.v1.CreateBoardFromTemplateRequest\x1a(.task.v1.CreateBoardFromTemplateResponse\"\x00\x12k\n" +
+	"\x16CreateTaskFromTemplate\x12&.task.v1.CreateTaskFromTemplateRequest\x1a'.task.v1.CreateTaskFromTemplateResponse\"\x00\x12\\\n" +
+	"\x11CreateCustomField\x12!.task.v1.CreateCustomFieldRequest\x1a\".task.v1.CreateCustomFieldResponse\"\x00\x12\\\n" +
+	"\x11UpdateCustomField\x12!.task.v1.UpdateCustomFieldRequest\x1a\".task.v1.UpdateCustomFieldResponse\"\x00\x12\\\n" +
+	"\x11DeleteCustomField\x12!.task.v1.DeleteCustomFieldRequest\x1a\".task.v1.DeleteCustomFieldResponse\"\x00\x12Y\n" +
+	"\x10ListCustomFields\x12 .task.v1.ListCustomFieldsRequest\x1a!.task.v1.ListCustomFieldsResponse\"\x00\x12V\n" +
+	"\x0fCreateSavedView\x12\x1f.task.v1.CreateSavedViewRequest\x1a .task.v1.CreateSavedViewResponse\"\x00\x12M\n" +
+	"\fGetSavedView\x12\x1c.task.v1.GetSavedViewRequest\x1a\x1d.task.v1.GetSavedViewResponse\"\x00\x12S\n" +
+	"\x0eListSavedViews\x12\x1e.task.v1.ListSavedViewsRequest\x1a\x1f.task.v1.ListSavedViewsResponse\"\x00\x12V\n" +
+	"\x0fUpdateSavedView\x12\x1f.task.v1.UpdateSavedViewRequest\x1a .task.v1.UpdateSavedViewResponse\"\x00\x12V\n" +
+	"\x0fDeleteSavedView\x12\x1f.task.v1.DeleteSavedViewRequest\x1a .task.v1.DeleteSavedViewResponse\"\x00\x12I\n" +
+	"\vExportBoard\x12\x1b.task.v1.ExportBoardRequest\x1a\x19.task.v1.ExportBoardChunk\"\x000\x01\x12L\n" +
+	"\vImportBoard\x12\x1b.task.v1.ImportBoardRequest\x1a\x1c.task.v1.ImportBoardResponse\"\x00(\x01\x12_\n" +
+	"\x12CreateCalendarFeed\x12\".task.v1.CreateCalendarFeedRequest\x1a#.task.v1.CreateCalendarFeedResponse\"\x00\x12\\\n" +
+	"\x11ListCalendarFeeds\x12!.task.v1.ListCalendarFeedsRequest\x1a\".task.v1.ListCalendarFeedsResponse\"\x00\x12_\n" +
+	"\x12DeleteCalendarFeed\x12\".task.v1.DeleteCalendarFeedRequest\x1a#.task.v1.DeleteCalendarFeedResponse\"\x00\x12J\n" +
+	"\vGetCalendar\x12\x1b.task.v1.GetCalendarRequest\x1a\x1c.task.v1.GetCalendarResponse\"\x00\x12P\n" +
+	"\rCreateWebhook\x12\x1d.task.v1.CreateWebhookRequest\x1a\x1e.task.v1.CreateWebhookResponse\"\x00\x12G\n" +
+	"\n" +
+	"GetWebhook\x12\x1a.task.v1.GetWebhookRequest\x1a\x1b.task.v1.GetWebhookResponse\"\x00\x12M\n" +
+	"\fListWebhooks\x12\x1c.task.v1.ListWebhooksRequest\x1a\x1d.task.v1.ListWebhooksResponse\"\x00\x12P\n" +
+	"\rUpdateWebhook\x12\x1d.task.v1.UpdateWebhookRequest\x1a\x1e.task.v1.UpdateWebhookResponse\"\x00\x12P\n" +
+	"\rDeleteWebhook\x12\x1d.task.v1.DeleteWebhookRequest\x1a\x1e.task.v1.DeleteWebhookResponse\"\x00\x12h\n" +
+	"\x15ListWebhookDeliveries\x12%.task.v1.ListWebhookDeliveriesRequest\x1a&.task.v1.ListWebhookDeliveriesResponse\"\x00\x12q\n" +
+	"\x18RedeliverWebhookDelivery\x12(.task.v1.RedeliverWebhookDeliveryRequest\x1a).task.v1.RedeliverWebhookDeliveryResponse\"\x00\x12D\n" +
+	"\tWatchTask\x12\x19.task.v1.WatchTaskRequest\x1a\x1a.task.v1.WatchTaskResponse\"\x00\x12J\n" +
+	"\vUnwatchTask\x12\x1b.task.v1.UnwatchTaskRequest\x1a\x1c.task.v1.UnwatchTaskResponse\"\x00\x12Y\n" +
+	"\x10ListTaskWatchers\x12 .task.v1.ListTaskWatchersRequest\x1a!.task.v1.ListTaskWatchersResponse\"\x00\x12w\n" +
+	"\x1aGetNotificationPreferences\x12*.task.v1.GetNotificationPreferencesRequest\x1a+.task.v1.GetNotificationPreferencesResponse\"\x00\x12\x80\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12-.task.v1.UpdateNotificationPreferencesRequest\x1a..task.v1.UpdateNotificationPreferencesResponse\"\x00\x12k\n" +
+	"\x16ListInboxNotifications\x12&.task.v1.ListInboxNotificationsRequest\x1a'.task.v1.ListInboxNotificationsResponse\"\x00\x12b\n" +
+	"\x13GetInboxUnreadCount\x12#.task.v1.GetInboxUnreadCountRequest\x1a$.task.v1.GetInboxUnreadCountResponse\"\x00\x12t\n" +
+	"\x19MarkInboxNotificationRead\x12).task.v1.MarkInboxNotificationReadRequest\x1a*.task.v1.MarkInboxNotificationReadResponse\"\x00\x12\x80\x01\n" +
+	"\x1dMarkAllInboxNotificationsRead\x12-.task.v1.MarkAllInboxNotificationsReadRequest\x1a..task.v1.MarkAllInboxNotificationsReadResponse\"\x00\x12Y\n" +
+	"\x10ListBoardMembers\x12 .task.v1.ListBoardMembersRequest\x1a!.task.v1.ListBoardMembersResponse\"\x00\x12S\n" +
+	"\x0eAddBoardMember\x12\x1e.task.v1.AddBoardMemberRequest\x1a\x1f.task.v1.AddBoardMemberResponse\"\x00\x12\\\n" +
+	"\x11UpdateBoardMember\x12!.task.v1.UpdateBoardMemberRequest\x1a\".task.v1.UpdateBoardMemberResponse\"\x00\x12\\\n" +
+	"\x11RemoveBoardMember\x12!.task.v1.RemoveBoardMemberRequest\x1a\".task.v1.RemoveBoardMemberResponse\"\x00\x12V\n" +
+	"\x0fCreateWorkspace\x12\x1f.task.v1.CreateWorkspaceRequest\x1a .task.v1.CreateWorkspaceResponse\"\x00\x12S\n" +
+	"\x0eListWorkspaces\x12\x1e.task.v1.ListWorkspacesRequest\x1a\x1f.task.v1.ListWorkspacesResponse\"\x00\x12V\n" +
+	"\x0fUpdateWorkspace\x12\x1f.task.v1.UpdateWorkspaceRequest\x1a .task.v1.UpdateWorkspaceResponse\"\x00\x12e\n" +
+	"\x14ListWorkspaceMembers\x12$.task.v1.ListWorkspaceMembersRequest\x1a%.task.v1.ListWorkspaceMembersResponse\"\x00\x12_\n" +
+	"\x12AddWorkspaceMember\x12\".task.v1.AddWorkspaceMemberRequest\x1a#.task.v1.AddWorkspaceMemberResponse\"\x00\x12h\n" +
+	"\x15RemoveWorkspaceMember\x12%.task.v1.RemoveWorkspaceMemberRequest\x1a&.task.v1.RemoveWorkspaceMemberResponse\"\x00\x12S\n" +
+	"\x0eExportUserData\x12\x1e.task.v1.ExportUserDataRequest\x1a\x1f.task.v1.ExportUserDataResponse\"\x00\x12S\n" +
+	"\x0eDeleteUserData\x12\x1e.task.v1.DeleteUserDataRequest\x1a\x1f.task.v1.DeleteUserDataResponse\"\x00B8Z6github.com/zaouldyeck/taskboard/pkg/api/task/v1;taskv1b\x06proto3"
 
 var (
 	file_proto_task_v1_task_proto_rawDescOnce sync.Once
@@ -726,45 +11472,477 @@ func file_proto_task_v1_task_proto_rawDescGZIP() []byte {
 	return file_proto_task_v1_task_proto_rawDescData
 }
 
-var file_proto_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_task_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_proto_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 169)
 var file_proto_task_v1_task_proto_goTypes = []any{
-	(*Task)(nil),                  // 0: task.v1.Task
-	(*CreateTaskRequest)(nil),     // 1: task.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),    // 2: task.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),        // 3: task.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),       // 4: task.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),      // 5: task.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),     // 6: task.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),     // 7: task.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),    // 8: task.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),     // 9: task.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),    // 10: task.v1.DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(TimeReportGrouping)(0),                       // 0: task.v1.TimeReportGrouping
+	(TemplateKind)(0),                             // 1: task.v1.TemplateKind
+	(CustomFieldType)(0),                          // 2: task.v1.CustomFieldType
+	(CalendarComponent)(0),                        // 3: task.v1.CalendarComponent
+	(WebhookDeliveryStatus)(0),                    // 4: task.v1.WebhookDeliveryStatus
+	(EmailDelivery)(0),                            // 5: task.v1.EmailDelivery
+	(InboxNotificationKind)(0),                    // 6: task.v1.InboxNotificationKind
+	(BoardRole)(0),                                // 7: task.v1.BoardRole
+	(WorkspaceRole)(0),                            // 8: task.v1.WorkspaceRole
+	(DataFormat)(0),                               // 9: task.v1.DataFormat
+	(CustomFieldFilter_Op)(0),                     // 10: task.v1.CustomFieldFilter.Op
+	(*Task)(nil),                                  // 11: task.v1.Task
+	(*UserSummary)(nil),                           // 12: task.v1.UserSummary
+	(*CreateTaskRequest)(nil),                     // 13: task.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                    // 14: task.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                        // 15: task.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                       // 16: task.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),                      // 17: task.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                     // 18: task.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),                     // 19: task.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                    // 20: task.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),                     // 21: task.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                    // 22: task.v1.DeleteTaskResponse
+	(*TimeEntry)(nil),                             // 23: task.v1.TimeEntry
+	(*StartTimerRequest)(nil),                     // 24: task.v1.StartTimerRequest
+	(*StartTimerResponse)(nil),                    // 25: task.v1.StartTimerResponse
+	(*StopTimerRequest)(nil),                      // 26: task.v1.StopTimerRequest
+	(*StopTimerResponse)(nil),                     // 27: task.v1.StopTimerResponse
+	(*CreateTimeEntryRequest)(nil),                // 28: task.v1.CreateTimeEntryRequest
+	(*CreateTimeEntryResponse)(nil),               // 29: task.v1.CreateTimeEntryResponse
+	(*UpdateTimeEntryRequest)(nil),                // 30: task.v1.UpdateTimeEntryRequest
+	(*UpdateTimeEntryResponse)(nil),               // 31: task.v1.UpdateTimeEntryResponse
+	(*DeleteTimeEntryRequest)(nil),                // 32: task.v1.DeleteTimeEntryRequest
+	(*DeleteTimeEntryResponse)(nil),               // 33: task.v1.DeleteTimeEntryResponse
+	(*ListTimeEntriesRequest)(nil),                // 34: task.v1.ListTimeEntriesRequest
+	(*ListTimeEntriesResponse)(nil),               // 35: task.v1.ListTimeEntriesResponse
+	(*GetTimeReportRequest)(nil),                  // 36: task.v1.GetTimeReportRequest
+	(*TimeReportRow)(nil),                         // 37: task.v1.TimeReportRow
+	(*GetTimeReportResponse)(nil),                 // 38: task.v1.GetTimeReportResponse
+	(*Attachment)(nil),                            // 39: task.v1.Attachment
+	(*CreateAttachmentRequest)(nil),               // 40: task.v1.CreateAttachmentRequest
+	(*CreateAttachmentResponse)(nil),              // 41: task.v1.CreateAttachmentResponse
+	(*GetAttachmentRequest)(nil),                  // 42: task.v1.GetAttachmentRequest
+	(*GetAttachmentResponse)(nil),                 // 43: task.v1.GetAttachmentResponse
+	(*ListAttachmentsRequest)(nil),                // 44: task.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),               // 45: task.v1.ListAttachmentsResponse
+	(*DeleteAttachmentRequest)(nil),               // 46: task.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),              // 47: task.v1.DeleteAttachmentResponse
+	(*Board)(nil),                                 // 48: task.v1.Board
+	(*BoardColumn)(nil),                           // 49: task.v1.BoardColumn
+	(*BoardLabel)(nil),                            // 50: task.v1.BoardLabel
+	(*CreateBoardRequest)(nil),                    // 51: task.v1.CreateBoardRequest
+	(*CreateBoardResponse)(nil),                   // 52: task.v1.CreateBoardResponse
+	(*GetBoardRequest)(nil),                       // 53: task.v1.GetBoardRequest
+	(*GetBoardResponse)(nil),                      // 54: task.v1.GetBoardResponse
+	(*UpdateBoardRequest)(nil),                    // 55: task.v1.UpdateBoardRequest
+	(*UpdateBoardResponse)(nil),                   // 56: task.v1.UpdateBoardResponse
+	(*TaskTemplate)(nil),                          // 57: task.v1.TaskTemplate
+	(*BoardTemplate)(nil),                         // 58: task.v1.BoardTemplate
+	(*Template)(nil),                              // 59: task.v1.Template
+	(*CreateTemplateRequest)(nil),                 // 60: task.v1.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),                // 61: task.v1.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),                    // 62: task.v1.GetTemplateRequest
+	(*GetTemplateResponse)(nil),                   // 63: task.v1.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),                  // 64: task.v1.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),                 // 65: task.v1.ListTemplatesResponse
+	(*DeleteTemplateRequest)(nil),                 // 66: task.v1.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),                // 67: task.v1.DeleteTemplateResponse
+	(*SaveBoardAsTemplateRequest)(nil),            // 68: task.v1.SaveBoardAsTemplateRequest
+	(*SaveBoardAsTemplateResponse)(nil),           // 69: task.v1.SaveBoardAsTemplateResponse
+	(*CreateBoardFromTemplateRequest)(nil),        // 70: task.v1.CreateBoardFromTemplateRequest
+	(*CreateBoardFromTemplateResponse)(nil),       // 71: task.v1.CreateBoardFromTemplateResponse
+	(*CreateTaskFromTemplateRequest)(nil),         // 72: task.v1.CreateTaskFromTemplateRequest
+	(*CreateTaskFromTemplateResponse)(nil),        // 73: task.v1.CreateTaskFromTemplateResponse
+	(*CustomField)(nil),                           // 74: task.v1.CustomField
+	(*StringList)(nil),                            // 75: task.v1.StringList
+	(*CustomFieldValue)(nil),                      // 76: task.v1.CustomFieldValue
+	(*CustomFieldFilter)(nil),                     // 77: task.v1.CustomFieldFilter
+	(*CreateCustomFieldRequest)(nil),              // 78: task.v1.CreateCustomFieldRequest
+	(*CreateCustomFieldResponse)(nil),             // 79: task.v1.CreateCustomFieldResponse
+	(*UpdateCustomFieldRequest)(nil),              // 80: task.v1.UpdateCustomFieldRequest
+	(*UpdateCustomFieldResponse)(nil),             // 81: task.v1.UpdateCustomFieldResponse
+	(*DeleteCustomFieldRequest)(nil),              // 82: task.v1.DeleteCustomFieldRequest
+	(*DeleteCustomFieldResponse)(nil),             // 83: task.v1.DeleteCustomFieldResponse
+	(*ListCustomFieldsRequest)(nil),               // 84: task.v1.ListCustomFieldsRequest
+	(*ListCustomFieldsResponse)(nil),              // 85: task.v1.ListCustomFieldsResponse
+	(*SavedView)(nil),                             // 86: task.v1.SavedView
+	(*CreateSavedViewRequest)(nil),                // 87: task.v1.CreateSavedViewRequest
+	(*CreateSavedViewResponse)(nil),               // 88: task.v1.CreateSavedViewResponse
+	(*GetSavedViewRequest)(nil),                   // 89: task.v1.GetSavedViewRequest
+	(*GetSavedViewResponse)(nil),                  // 90: task.v1.GetSavedViewResponse
+	(*ListSavedViewsRequest)(nil),                 // 91: task.v1.ListSavedViewsRequest
+	(*ListSavedViewsResponse)(nil),                // 92: task.v1.ListSavedViewsResponse
+	(*UpdateSavedViewRequest)(nil),                // 93: task.v1.UpdateSavedViewRequest
+	(*UpdateSavedViewResponse)(nil),               // 94: task.v1.UpdateSavedViewResponse
+	(*DeleteSavedViewRequest)(nil),                // 95: task.v1.DeleteSavedViewRequest
+	(*DeleteSavedViewResponse)(nil),               // 96: task.v1.DeleteSavedViewResponse
+	(*CalendarFeed)(nil),                          // 97: task.v1.CalendarFeed
+	(*CreateCalendarFeedRequest)(nil),             // 98: task.v1.CreateCalendarFeedRequest
+	(*CreateCalendarFeedResponse)(nil),            // 99: task.v1.CreateCalendarFeedResponse
+	(*ListCalendarFeedsRequest)(nil),              // 100: task.v1.ListCalendarFeedsRequest
+	(*ListCalendarFeedsResponse)(nil),             // 101: task.v1.ListCalendarFeedsResponse
+	(*DeleteCalendarFeedRequest)(nil),             // 102: task.v1.DeleteCalendarFeedRequest
+	(*DeleteCalendarFeedResponse)(nil),            // 103: task.v1.DeleteCalendarFeedResponse
+	(*GetCalendarRequest)(nil),                    // 104: task.v1.GetCalendarRequest
+	(*GetCalendarResponse)(nil),                   // 105: task.v1.GetCalendarResponse
+	(*Webhook)(nil),                               // 106: task.v1.Webhook
+	(*CreateWebhookRequest)(nil),                  // 107: task.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                 // 108: task.v1.CreateWebhookResponse
+	(*GetWebhookRequest)(nil),                     // 109: task.v1.GetWebhookRequest
+	(*GetWebhookResponse)(nil),                    // 110: task.v1.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),                   // 111: task.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                  // 112: task.v1.ListWebhooksResponse
+	(*UpdateWebhookRequest)(nil),                  // 113: task.v1.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),                 // 114: task.v1.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),                  // 115: task.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                 // 116: task.v1.DeleteWebhookResponse
+	(*WebhookDelivery)(nil),                       // 117: task.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),          // 118: task.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),         // 119: task.v1.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookDeliveryRequest)(nil),       // 120: task.v1.RedeliverWebhookDeliveryRequest
+	(*RedeliverWebhookDeliveryResponse)(nil),      // 121: task.v1.RedeliverWebhookDeliveryResponse
+	(*WatchTaskRequest)(nil),                      // 122: task.v1.WatchTaskRequest
+	(*WatchTaskResponse)(nil),                     // 123: task.v1.WatchTaskResponse
+	(*UnwatchTaskRequest)(nil),                    // 124: task.v1.UnwatchTaskRequest
+	(*UnwatchTaskResponse)(nil),                   // 125: task.v1.UnwatchTaskResponse
+	(*ListTaskWatchersRequest)(nil),               // 126: task.v1.ListTaskWatchersRequest
+	(*ListTaskWatchersResponse)(nil),              // 127: task.v1.ListTaskWatchersResponse
+	(*NotificationPreferences)(nil),               // 128: task.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 129: task.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 130: task.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 131: task.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 132: task.v1.UpdateNotificationPreferencesResponse
+	(*InboxNotification)(nil),                     // 133: task.v1.InboxNotification
+	(*ListInboxNotificationsRequest)(nil),         // 134: task.v1.ListInboxNotificationsRequest
+	(*ListInboxNotificationsResponse)(nil),        // 135: task.v1.ListInboxNotificationsResponse
+	(*GetInboxUnreadCountRequest)(nil),            // 136: task.v1.GetInboxUnreadCountRequest
+	(*GetInboxUnreadCountResponse)(nil),           // 137: task.v1.GetInboxUnreadCountResponse
+	(*MarkInboxNotificationReadRequest)(nil),      // 138: task.v1.MarkInboxNotificationReadRequest
+	(*MarkInboxNotificationReadResponse)(nil),     // 139: task.v1.MarkInboxNotificationReadResponse
+	(*MarkAllInboxNotificationsReadRequest)(nil),  // 140: task.v1.MarkAllInboxNotificationsReadRequest
+	(*MarkAllInboxNotificationsReadResponse)(nil), // 141: task.v1.MarkAllInboxNotificationsReadResponse
+	(*BoardMember)(nil),                           // 142: task.v1.BoardMember
+	(*ListBoardMembersRequest)(nil),               // 143: task.v1.ListBoardMembersRequest
+	(*ListBoardMembersResponse)(nil),              // 144: task.v1.ListBoardMembersResponse
+	(*AddBoardMemberRequest)(nil),                 // 145: task.v1.AddBoardMemberRequest
+	(*AddBoardMemberResponse)(nil),                // 146: task.v1.AddBoardMemberResponse
+	(*UpdateBoardMemberRequest)(nil),              // 147: task.v1.UpdateBoardMemberRequest
+	(*UpdateBoardMemberResponse)(nil),             // 148: task.v1.UpdateBoardMemberResponse
+	(*RemoveBoardMemberRequest)(nil),              // 149: task.v1.RemoveBoardMemberRequest
+	(*RemoveBoardMemberResponse)(nil),             // 150: task.v1.RemoveBoardMemberResponse
+	(*Workspace)(nil),                             // 151: task.v1.Workspace
+	(*WorkspaceMember)(nil),                       // 152: task.v1.WorkspaceMember
+	(*CreateWorkspaceRequest)(nil),                // 153: task.v1.CreateWorkspaceRequest
+	(*CreateWorkspaceResponse)(nil),               // 154: task.v1.CreateWorkspaceResponse
+	(*UpdateWorkspaceRequest)(nil),                // 155: task.v1.UpdateWorkspaceRequest
+	(*UpdateWorkspaceResponse)(nil),               // 156: task.v1.UpdateWorkspaceResponse
+	(*ListWorkspacesRequest)(nil),                 // 157: task.v1.ListWorkspacesRequest
+	(*ListWorkspacesResponse)(nil),                // 158: task.v1.ListWorkspacesResponse
+	(*ListWorkspaceMembersRequest)(nil),           // 159: task.v1.ListWorkspaceMembersRequest
+	(*ListWorkspaceMembersResponse)(nil),          // 160: task.v1.ListWorkspaceMembersResponse
+	(*AddWorkspaceMemberRequest)(nil),             // 161: task.v1.AddWorkspaceMemberRequest
+	(*AddWorkspaceMemberResponse)(nil),            // 162: task.v1.AddWorkspaceMemberResponse
+	(*RemoveWorkspaceMemberRequest)(nil),          // 163: task.v1.RemoveWorkspaceMemberRequest
+	(*RemoveWorkspaceMemberResponse)(nil),         // 164: task.v1.RemoveWorkspaceMemberResponse
+	(*WorkspaceUserData)(nil),                     // 165: task.v1.WorkspaceUserData
+	(*ExportUserDataRequest)(nil),                 // 166: task.v1.ExportUserDataRequest
+	(*ExportUserDataResponse)(nil),                // 167: task.v1.ExportUserDataResponse
+	(*DeleteUserDataRequest)(nil),                 // 168: task.v1.DeleteUserDataRequest
+	(*DeleteUserDataResponse)(nil),                // 169: task.v1.DeleteUserDataResponse
+	(*ExportBoardRequest)(nil),                    // 170: task.v1.ExportBoardRequest
+	(*ExportBoardChunk)(nil),                      // 171: task.v1.ExportBoardChunk
+	(*ImportBoardHeader)(nil),                     // 172: task.v1.ImportBoardHeader
+	(*ImportBoardRequest)(nil),                    // 173: task.v1.ImportBoardRequest
+	(*ImportRowError)(nil),                        // 174: task.v1.ImportRowError
+	(*ImportBoardResponse)(nil),                   // 175: task.v1.ImportBoardResponse
+	nil,                                           // 176: task.v1.CreateBoardFromTemplateRequest.VariablesEntry
+	nil,                                           // 177: task.v1.CreateTaskFromTemplateRequest.VariablesEntry
+	nil,                                           // 178: task.v1.ImportBoardHeader.ColumnMappingEntry
+	nil,                                           // 179: task.v1.ImportBoardHeader.UserMapEntry
+	(*timestamppb.Timestamp)(nil),                 // 180: google.protobuf.Timestamp
 }
 var file_proto_task_v1_task_proto_depIdxs = []int32{
-	11, // 0: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
-	0,  // 3: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
-	0,  // 4: task.v1.ListTasksResponse.tasks:type_name -> task.v1.Task
-	0,  // 5: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
-	1,  // 6: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
-	3,  // 7: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
-	5,  // 8: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
-	7,  // 9: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
-	9,  // 10: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
-	5,  // 11: task.v1.TaskService.WatchTasks:input_type -> task.v1.ListTasksRequest
-	2,  // 12: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
-	4,  // 13: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
-	6,  // 14: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
-	8,  // 15: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
-	10, // 16: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
-	0,  // 17: task.v1.TaskService.WatchTasks:output_type -> task.v1.Task
-	12, // [12:18] is the sub-list for method output_type
-	6,  // [6:12] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	180, // 0: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	180, // 1: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 2: task.v1.Task.custom_fields:type_name -> task.v1.CustomFieldValue
+	180, // 3: task.v1.Task.due_at:type_name -> google.protobuf.Timestamp
+	12,  // 4: task.v1.Task.creator:type_name -> task.v1.UserSummary
+	12,  // 5: task.v1.Task.assignees:type_name -> task.v1.UserSummary
+	76,  // 6: task.v1.CreateTaskRequest.custom_fields:type_name -> task.v1.CustomFieldValue
+	180, // 7: task.v1.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	11,  // 8: task.v1.CreateTaskResponse.task:type_name -> task.v1.Task
+	11,  // 9: task.v1.GetTaskResponse.task:type_name -> task.v1.Task
+	77,  // 10: task.v1.ListTasksRequest.custom_field_filters:type_name -> task.v1.CustomFieldFilter
+	11,  // 11: task.v1.ListTasksResponse.tasks:type_name -> task.v1.Task
+	76,  // 12: task.v1.UpdateTaskRequest.custom_fields:type_name -> task.v1.CustomFieldValue
+	180, // 13: task.v1.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	75,  // 14: task.v1.UpdateTaskRequest.labels:type_name -> task.v1.StringList
+	75,  // 15: task.v1.UpdateTaskRequest.assignee_ids:type_name -> task.v1.StringList
+	11,  // 16: task.v1.UpdateTaskResponse.task:type_name -> task.v1.Task
+	180, // 17: task.v1.TimeEntry.started_at:type_name -> google.protobuf.Timestamp
+	180, // 18: task.v1.TimeEntry.ended_at:type_name -> google.protobuf.Timestamp
+	180, // 19: task.v1.TimeEntry.created_at:type_name -> google.protobuf.Timestamp
+	180, // 20: task.v1.TimeEntry.updated_at:type_name -> google.protobuf.Timestamp
+	23,  // 21: task.v1.StartTimerResponse.entry:type_name -> task.v1.TimeEntry
+	23,  // 22: task.v1.StopTimerResponse.entry:type_name -> task.v1.TimeEntry
+	180, // 23: task.v1.CreateTimeEntryRequest.started_at:type_name -> google.protobuf.Timestamp
+	180, // 24: task.v1.CreateTimeEntryRequest.ended_at:type_name -> google.protobuf.Timestamp
+	23,  // 25: task.v1.CreateTimeEntryResponse.entry:type_name -> task.v1.TimeEntry
+	180, // 26: task.v1.UpdateTimeEntryRequest.started_at:type_name -> google.protobuf.Timestamp
+	180, // 27: task.v1.UpdateTimeEntryRequest.ended_at:type_name -> google.protobuf.Timestamp
+	23,  // 28: task.v1.UpdateTimeEntryResponse.entry:type_name -> task.v1.TimeEntry
+	180, // 29: task.v1.ListTimeEntriesRequest.from:type_name -> google.protobuf.Timestamp
+	180, // 30: task.v1.ListTimeEntriesRequest.to:type_name -> google.protobuf.Timestamp
+	23,  // 31: task.v1.ListTimeEntriesResponse.entries:type_name -> task.v1.TimeEntry
+	180, // 32: task.v1.GetTimeReportRequest.from:type_name -> google.protobuf.Timestamp
+	180, // 33: task.v1.GetTimeReportRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 34: task.v1.GetTimeReportRequest.group_by:type_name -> task.v1.TimeReportGrouping
+	180, // 35: task.v1.TimeReportRow.period_start:type_name -> google.protobuf.Timestamp
+	37,  // 36: task.v1.GetTimeReportResponse.rows:type_name -> task.v1.TimeReportRow
+	180, // 37: task.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	39,  // 38: task.v1.CreateAttachmentResponse.attachment:type_name -> task.v1.Attachment
+	39,  // 39: task.v1.GetAttachmentResponse.attachment:type_name -> task.v1.Attachment
+	39,  // 40: task.v1.ListAttachmentsResponse.attachments:type_name -> task.v1.Attachment
+	39,  // 41: task.v1.DeleteAttachmentResponse.attachment:type_name -> task.v1.Attachment
+	49,  // 42: task.v1.Board.columns:type_name -> task.v1.BoardColumn
+	50,  // 43: task.v1.Board.labels:type_name -> task.v1.BoardLabel
+	180, // 44: task.v1.Board.created_at:type_name -> google.protobuf.Timestamp
+	50,  // 45: task.v1.CreateBoardRequest.labels:type_name -> task.v1.BoardLabel
+	48,  // 46: task.v1.CreateBoardResponse.board:type_name -> task.v1.Board
+	48,  // 47: task.v1.GetBoardResponse.board:type_name -> task.v1.Board
+	48,  // 48: task.v1.UpdateBoardResponse.board:type_name -> task.v1.Board
+	50,  // 49: task.v1.BoardTemplate.labels:type_name -> task.v1.BoardLabel
+	57,  // 50: task.v1.BoardTemplate.tasks:type_name -> task.v1.TaskTemplate
+	1,   // 51: task.v1.Template.kind:type_name -> task.v1.TemplateKind
+	58,  // 52: task.v1.Template.board:type_name -> task.v1.BoardTemplate
+	57,  // 53: task.v1.Template.task:type_name -> task.v1.TaskTemplate
+	180, // 54: task.v1.Template.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 55: task.v1.CreateTemplateRequest.board:type_name -> task.v1.BoardTemplate
+	57,  // 56: task.v1.CreateTemplateRequest.task:type_name -> task.v1.TaskTemplate
+	59,  // 57: task.v1.CreateTemplateResponse.template:type_name -> task.v1.Template
+	59,  // 58: task.v1.GetTemplateResponse.template:type_name -> task.v1.Template
+	1,   // 59: task.v1.ListTemplatesRequest.kind:type_name -> task.v1.TemplateKind
+	59,  // 60: task.v1.ListTemplatesResponse.templates:type_name -> task.v1.Template
+	59,  // 61: task.v1.SaveBoardAsTemplateResponse.template:type_name -> task.v1.Template
+	176, // 62: task.v1.CreateBoardFromTemplateRequest.variables:type_name -> task.v1.CreateBoardFromTemplateRequest.VariablesEntry
+	48,  // 63: task.v1.CreateBoardFromTemplateResponse.board:type_name -> task.v1.Board
+	11,  // 64: task.v1.CreateBoardFromTemplateResponse.tasks:type_name -> task.v1.Task
+	177, // 65: task.v1.CreateTaskFromTemplateRequest.variables:type_name -> task.v1.CreateTaskFromTemplateRequest.VariablesEntry
+	11,  // 66: task.v1.CreateTaskFromTemplateResponse.task:type_name -> task.v1.Task
+	2,   // 67: task.v1.CustomField.type:type_name -> task.v1.CustomFieldType
+	2,   // 68: task.v1.CustomFieldValue.field_type:type_name -> task.v1.CustomFieldType
+	75,  // 69: task.v1.CustomFieldValue.options:type_name -> task.v1.StringList
+	10,  // 70: task.v1.CustomFieldFilter.op:type_name -> task.v1.CustomFieldFilter.Op
+	2,   // 71: task.v1.CreateCustomFieldRequest.type:type_name -> task.v1.CustomFieldType
+	74,  // 72: task.v1.CreateCustomFieldResponse.field:type_name -> task.v1.CustomField
+	74,  // 73: task.v1.UpdateCustomFieldResponse.field:type_name -> task.v1.CustomField
+	74,  // 74: task.v1.ListCustomFieldsResponse.fields:type_name -> task.v1.CustomField
+	180, // 75: task.v1.SavedView.created_at:type_name -> google.protobuf.Timestamp
+	180, // 76: task.v1.SavedView.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 77: task.v1.CreateSavedViewResponse.view:type_name -> task.v1.SavedView
+	86,  // 78: task.v1.GetSavedViewResponse.view:type_name -> task.v1.SavedView
+	86,  // 79: task.v1.ListSavedViewsResponse.views:type_name -> task.v1.SavedView
+	86,  // 80: task.v1.UpdateSavedViewResponse.view:type_name -> task.v1.SavedView
+	3,   // 81: task.v1.CalendarFeed.component:type_name -> task.v1.CalendarComponent
+	180, // 82: task.v1.CalendarFeed.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 83: task.v1.CreateCalendarFeedRequest.component:type_name -> task.v1.CalendarComponent
+	97,  // 84: task.v1.CreateCalendarFeedResponse.feed:type_name -> task.v1.CalendarFeed
+	97,  // 85: task.v1.ListCalendarFeedsResponse.feeds:type_name -> task.v1.CalendarFeed
+	180, // 86: task.v1.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	180, // 87: task.v1.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	106, // 88: task.v1.CreateWebhookResponse.webhook:type_name -> task.v1.Webhook
+	106, // 89: task.v1.GetWebhookResponse.webhook:type_name -> task.v1.Webhook
+	106, // 90: task.v1.ListWebhooksResponse.webhooks:type_name -> task.v1.Webhook
+	106, // 91: task.v1.UpdateWebhookResponse.webhook:type_name -> task.v1.Webhook
+	4,   // 92: task.v1.WebhookDelivery.status:type_name -> task.v1.WebhookDeliveryStatus
+	180, // 93: task.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	180, // 94: task.v1.WebhookDelivery.last_attempt_at:type_name -> google.protobuf.Timestamp
+	180, // 95: task.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	4,   // 96: task.v1.ListWebhookDeliveriesRequest.status:type_name -> task.v1.WebhookDeliveryStatus
+	117, // 97: task.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> task.v1.WebhookDelivery
+	117, // 98: task.v1.RedeliverWebhookDeliveryResponse.delivery:type_name -> task.v1.WebhookDelivery
+	5,   // 99: task.v1.NotificationPreferences.email_delivery:type_name -> task.v1.EmailDelivery
+	128, // 100: task.v1.GetNotificationPreferencesResponse.preferences:type_name -> task.v1.NotificationPreferences
+	5,   // 101: task.v1.UpdateNotificationPreferencesRequest.email_delivery:type_name -> task.v1.EmailDelivery
+	128, // 102: task.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> task.v1.NotificationPreferences
+	6,   // 103: task.v1.InboxNotification.kind:type_name -> task.v1.InboxNotificationKind
+	180, // 104: task.v1.InboxNotification.created_at:type_name -> google.protobuf.Timestamp
+	180, // 105: task.v1.InboxNotification.read_at:type_name -> google.protobuf.Timestamp
+	133, // 106: task.v1.ListInboxNotificationsResponse.notifications:type_name -> task.v1.InboxNotification
+	133, // 107: task.v1.MarkInboxNotificationReadResponse.notification:type_name -> task.v1.InboxNotification
+	7,   // 108: task.v1.BoardMember.role:type_name -> task.v1.BoardRole
+	180, // 109: task.v1.BoardMember.created_at:type_name -> google.protobuf.Timestamp
+	142, // 110: task.v1.ListBoardMembersResponse.members:type_name -> task.v1.BoardMember
+	7,   // 111: task.v1.AddBoardMemberRequest.role:type_name -> task.v1.BoardRole
+	142, // 112: task.v1.AddBoardMemberResponse.member:type_name -> task.v1.BoardMember
+	7,   // 113: task.v1.UpdateBoardMemberRequest.role:type_name -> task.v1.BoardRole
+	142, // 114: task.v1.UpdateBoardMemberResponse.member:type_name -> task.v1.BoardMember
+	180, // 115: task.v1.Workspace.created_at:type_name -> google.protobuf.Timestamp
+	8,   // 116: task.v1.WorkspaceMember.role:type_name -> task.v1.WorkspaceRole
+	180, // 117: task.v1.WorkspaceMember.created_at:type_name -> google.protobuf.Timestamp
+	151, // 118: task.v1.CreateWorkspaceResponse.workspace:type_name -> task.v1.Workspace
+	151, // 119: task.v1.UpdateWorkspaceResponse.workspace:type_name -> task.v1.Workspace
+	151, // 120: task.v1.ListWorkspacesResponse.workspaces:type_name -> task.v1.Workspace
+	152, // 121: task.v1.ListWorkspaceMembersResponse.members:type_name -> task.v1.WorkspaceMember
+	8,   // 122: task.v1.AddWorkspaceMemberRequest.role:type_name -> task.v1.WorkspaceRole
+	152, // 123: task.v1.AddWorkspaceMemberResponse.member:type_name -> task.v1.WorkspaceMember
+	11,  // 124: task.v1.WorkspaceUserData.created_tasks:type_name -> task.v1.Task
+	11,  // 125: task.v1.WorkspaceUserData.assigned_tasks:type_name -> task.v1.Task
+	23,  // 126: task.v1.WorkspaceUserData.time_entries:type_name -> task.v1.TimeEntry
+	142, // 127: task.v1.WorkspaceUserData.board_memberships:type_name -> task.v1.BoardMember
+	86,  // 128: task.v1.WorkspaceUserData.saved_views:type_name -> task.v1.SavedView
+	97,  // 129: task.v1.WorkspaceUserData.calendar_feeds:type_name -> task.v1.CalendarFeed
+	133, // 130: task.v1.WorkspaceUserData.inbox_notifications:type_name -> task.v1.InboxNotification
+	165, // 131: task.v1.ExportUserDataResponse.workspaces:type_name -> task.v1.WorkspaceUserData
+	152, // 132: task.v1.ExportUserDataResponse.workspace_memberships:type_name -> task.v1.WorkspaceMember
+	128, // 133: task.v1.ExportUserDataResponse.notification_preferences:type_name -> task.v1.NotificationPreferences
+	9,   // 134: task.v1.ExportBoardRequest.format:type_name -> task.v1.DataFormat
+	9,   // 135: task.v1.ImportBoardHeader.format:type_name -> task.v1.DataFormat
+	178, // 136: task.v1.ImportBoardHeader.column_mapping:type_name -> task.v1.ImportBoardHeader.ColumnMappingEntry
+	179, // 137: task.v1.ImportBoardHeader.user_map:type_name -> task.v1.ImportBoardHeader.UserMapEntry
+	172, // 138: task.v1.ImportBoardRequest.header:type_name -> task.v1.ImportBoardHeader
+	174, // 139: task.v1.ImportBoardResponse.errors:type_name -> task.v1.ImportRowError
+	13,  // 140: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
+	15,  // 141: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
+	17,  // 142: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
+	19,  // 143: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
+	21,  // 144: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
+	17,  // 145: task.v1.TaskService.WatchTasks:input_type -> task.v1.ListTasksRequest
+	24,  // 146: task.v1.TaskService.StartTimer:input_type -> task.v1.StartTimerRequest
+	26,  // 147: task.v1.TaskService.StopTimer:input_type -> task.v1.StopTimerRequest
+	28,  // 148: task.v1.TaskService.CreateTimeEntry:input_type -> task.v1.CreateTimeEntryRequest
+	30,  // 149: task.v1.TaskService.UpdateTimeEntry:input_type -> task.v1.UpdateTimeEntryRequest
+	32,  // 150: task.v1.TaskService.DeleteTimeEntry:input_type -> task.v1.DeleteTimeEntryRequest
+	34,  // 151: task.v1.TaskService.ListTimeEntries:input_type -> task.v1.ListTimeEntriesRequest
+	36,  // 152: task.v1.TaskService.GetTimeReport:input_type -> task.v1.GetTimeReportRequest
+	40,  // 153: task.v1.TaskService.CreateAttachment:input_type -> task.v1.CreateAttachmentRequest
+	42,  // 154: task.v1.TaskService.GetAttachment:input_type -> task.v1.GetAttachmentRequest
+	44,  // 155: task.v1.TaskService.ListAttachments:input_type -> task.v1.ListAttachmentsRequest
+	46,  // 156: task.v1.TaskService.DeleteAttachment:input_type -> task.v1.DeleteAttachmentRequest
+	51,  // 157: task.v1.TaskService.CreateBoard:input_type -> task.v1.CreateBoardRequest
+	53,  // 158: task.v1.TaskService.GetBoard:input_type -> task.v1.GetBoardRequest
+	55,  // 159: task.v1.TaskService.UpdateBoard:input_type -> task.v1.UpdateBoardRequest
+	60,  // 160: task.v1.TaskService.CreateTemplate:input_type -> task.v1.CreateTemplateRequest
+	62,  // 161: task.v1.TaskService.GetTemplate:input_type -> task.v1.GetTemplateRequest
+	64,  // 162: task.v1.TaskService.ListTemplates:input_type -> task.v1.ListTemplatesRequest
+	66,  // 163: task.v1.TaskService.DeleteTemplate:input_type -> task.v1.DeleteTemplateRequest
+	68,  // 164: task.v1.TaskService.SaveBoardAsTemplate:input_type -> task.v1.SaveBoardAsTemplateRequest
+	70,  // 165: task.v1.TaskService.CreateBoardFromTemplate:input_type -> task.v1.CreateBoardFromTemplateRequest
+	72,  // 166: task.v1.TaskService.CreateTaskFromTemplate:input_type -> task.v1.CreateTaskFromTemplateRequest
+	78,  // 167: task.v1.TaskService.CreateCustomField:input_type -> task.v1.CreateCustomFieldRequest
+	80,  // 168: task.v1.TaskService.UpdateCustomField:input_type -> task.v1.UpdateCustomFieldRequest
+	82,  // 169: task.v1.TaskService.DeleteCustomField:input_type -> task.v1.DeleteCustomFieldRequest
+	84,  // 170: task.v1.TaskService.ListCustomFields:input_type -> task.v1.ListCustomFieldsRequest
+	87,  // 171: task.v1.TaskService.CreateSavedView:input_type -> task.v1.CreateSavedViewRequest
+	89,  // 172: task.v1.TaskService.GetSavedView:input_type -> task.v1.GetSavedViewRequest
+	91,  // 173: task.v1.TaskService.ListSavedViews:input_type -> task.v1.ListSavedViewsRequest
+	93,  // 174: task.v1.TaskService.UpdateSavedView:input_type -> task.v1.UpdateSavedViewRequest
+	95,  // 175: task.v1.TaskService.DeleteSavedView:input_type -> task.v1.DeleteSavedViewRequest
+	170, // 176: task.v1.TaskService.ExportBoard:input_type -> task.v1.ExportBoardRequest
+	173, // 177: task.v1.TaskService.ImportBoard:input_type -> task.v1.ImportBoardRequest
+	98,  // 178: task.v1.TaskService.CreateCalendarFeed:input_type -> task.v1.CreateCalendarFeedRequest
+	100, // 179: task.v1.TaskService.ListCalendarFeeds:input_type -> task.v1.ListCalendarFeedsRequest
+	102, // 180: task.v1.TaskService.DeleteCalendarFeed:input_type -> task.v1.DeleteCalendarFeedRequest
+	104, // 181: task.v1.TaskService.GetCalendar:input_type -> task.v1.GetCalendarRequest
+	107, // 182: task.v1.TaskService.CreateWebhook:input_type -> task.v1.CreateWebhookRequest
+	109, // 183: task.v1.TaskService.GetWebhook:input_type -> task.v1.GetWebhookRequest
+	111, // 184: task.v1.TaskService.ListWebhooks:input_type -> task.v1.ListWebhooksRequest
+	113, // 185: task.v1.TaskService.UpdateWebhook:input_type -> task.v1.UpdateWebhookRequest
+	115, // 186: task.v1.TaskService.DeleteWebhook:input_type -> task.v1.DeleteWebhookRequest
+	118, // 187: task.v1.TaskService.ListWebhookDeliveries:input_type -> task.v1.ListWebhookDeliveriesRequest
+	120, // 188: task.v1.TaskService.RedeliverWebhookDelivery:input_type -> task.v1.RedeliverWebhookDeliveryRequest
+	122, // 189: task.v1.TaskService.WatchTask:input_type -> task.v1.WatchTaskRequest
+	124, // 190: task.v1.TaskService.UnwatchTask:input_type -> task.v1.UnwatchTaskRequest
+	126, // 191: task.v1.TaskService.ListTaskWatchers:input_type -> task.v1.ListTaskWatchersRequest
+	129, // 192: task.v1.TaskService.GetNotificationPreferences:input_type -> task.v1.GetNotificationPreferencesRequest
+	131, // 193: task.v1.TaskService.UpdateNotificationPreferences:input_type -> task.v1.UpdateNotificationPreferencesRequest
+	134, // 194: task.v1.TaskService.ListInboxNotifications:input_type -> task.v1.ListInboxNotificationsRequest
+	136, // 195: task.v1.TaskService.GetInboxUnreadCount:input_type -> task.v1.GetInboxUnreadCountRequest
+	138, // 196: task.v1.TaskService.MarkInboxNotificationRead:input_type -> task.v1.MarkInboxNotificationReadRequest
+	140, // 197: task.v1.TaskService.MarkAllInboxNotificationsRead:input_type -> task.v1.MarkAllInboxNotificationsReadRequest
+	143, // 198: task.v1.TaskService.ListBoardMembers:input_type -> task.v1.ListBoardMembersRequest
+	145, // 199: task.v1.TaskService.AddBoardMember:input_type -> task.v1.AddBoardMemberRequest
+	147, // 200: task.v1.TaskService.UpdateBoardMember:input_type -> task.v1.UpdateBoardMemberRequest
+	149, // 201: task.v1.TaskService.RemoveBoardMember:input_type -> task.v1.RemoveBoardMemberRequest
+	153, // 202: task.v1.TaskService.CreateWorkspace:input_type -> task.v1.CreateWorkspaceRequest
+	157, // 203: task.v1.TaskService.ListWorkspaces:input_type -> task.v1.ListWorkspacesRequest
+	155, // 204: task.v1.TaskService.UpdateWorkspace:input_type -> task.v1.UpdateWorkspaceRequest
+	159, // 205: task.v1.TaskService.ListWorkspaceMembers:input_type -> task.v1.ListWorkspaceMembersRequest
+	161, // 206: task.v1.TaskService.AddWorkspaceMember:input_type -> task.v1.AddWorkspaceMemberRequest
+	163, // 207: task.v1.TaskService.RemoveWorkspaceMember:input_type -> task.v1.RemoveWorkspaceMemberRequest
+	166, // 208: task.v1.TaskService.ExportUserData:input_type -> task.v1.ExportUserDataRequest
+	168, // 209: task.v1.TaskService.DeleteUserData:input_type -> task.v1.DeleteUserDataRequest
+	14,  // 210: task.v1.TaskService.CreateTask:output_type -> task.v1.CreateTaskResponse
+	16,  // 211: task.v1.TaskService.GetTask:output_type -> task.v1.GetTaskResponse
+	18,  // 212: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
+	20,  // 213: task.v1.TaskService.UpdateTask:output_type -> task.v1.UpdateTaskResponse
+	22,  // 214: task.v1.TaskService.DeleteTask:output_type -> task.v1.DeleteTaskResponse
+	11,  // 215: task.v1.TaskService.WatchTasks:output_type -> task.v1.Task
+	25,  // 216: task.v1.TaskService.StartTimer:output_type -> task.v1.StartTimerResponse
+	27,  // 217: task.v1.TaskService.StopTimer:output_type -> task.v1.StopTimerResponse
+	29,  // 218: task.v1.TaskService.CreateTimeEntry:output_type -> task.v1.CreateTimeEntryResponse
+	31,  // 219: task.v1.TaskService.UpdateTimeEntry:output_type -> task.v1.UpdateTimeEntryResponse
+	33,  // 220: task.v1.TaskService.DeleteTimeEntry:output_type -> task.v1.DeleteTimeEntryResponse
+	35,  // 221: task.v1.TaskService.ListTimeEntries:output_type -> task.v1.ListTimeEntriesResponse
+	38,  // 222: task.v1.TaskService.GetTimeReport:output_type -> task.v1.GetTimeReportResponse
+	41,  // 223: task.v1.TaskService.CreateAttachment:output_type -> task.v1.CreateAttachmentResponse
+	43,  // 224: task.v1.TaskService.GetAttachment:output_type -> task.v1.GetAttachmentResponse
+	45,  // 225: task.v1.TaskService.ListAttachments:output_type -> task.v1.ListAttachmentsResponse
+	47,  // 226: task.v1.TaskService.DeleteAttachment:output_type -> task.v1.DeleteAttachmentResponse
+	52,  // 227: task.v1.TaskService.CreateBoard:output_type -> task.v1.CreateBoardResponse
+	54,  // 228: task.v1.TaskService.GetBoard:output_type -> task.v1.GetBoardResponse
+	56,  // 229: task.v1.TaskService.UpdateBoard:output_type -> task.v1.UpdateBoardResponse
+	61,  // 230: task.v1.TaskService.CreateTemplate:output_type -> task.v1.CreateTemplateResponse
+	63,  // 231: task.v1.TaskService.GetTemplate:output_type -> task.v1.GetTemplateResponse
+	65,  // 232: task.v1.TaskService.ListTemplates:output_type -> task.v1.ListTemplatesResponse
+	67,  // 233: task.v1.TaskService.DeleteTemplate:output_type -> task.v1.DeleteTemplateResponse
+	69,  // 234: task.v1.TaskService.SaveBoardAsTemplate:output_type -> task.v1.SaveBoardAsTemplateResponse
+	71,  // 235: task.v1.TaskService.CreateBoardFromTemplate:output_type -> task.v1.CreateBoardFromTemplateResponse
+	73,  // 236: task.v1.TaskService.CreateTaskFromTemplate:output_type -> task.v1.CreateTaskFromTemplateResponse
+	79,  // 237: task.v1.TaskService.CreateCustomField:output_type -> task.v1.CreateCustomFieldResponse
+	81,  // 238: task.v1.TaskService.UpdateCustomField:output_type -> task.v1.UpdateCustomFieldResponse
+	83,  // 239: task.v1.TaskService.DeleteCustomField:output_type -> task.v1.DeleteCustomFieldResponse
+	85,  // 240: task.v1.TaskService.ListCustomFields:output_type -> task.v1.ListCustomFieldsResponse
+	88,  // 241: task.v1.TaskService.CreateSavedView:output_type -> task.v1.CreateSavedViewResponse
+	90,  // 242: task.v1.TaskService.GetSavedView:output_type -> task.v1.GetSavedViewResponse
+	92,  // 243: task.v1.TaskService.ListSavedViews:output_type -> task.v1.ListSavedViewsResponse
+	94,  // 244: task.v1.TaskService.UpdateSavedView:output_type -> task.v1.UpdateSavedViewResponse
+	96,  // 245: task.v1.TaskService.DeleteSavedView:output_type -> task.v1.DeleteSavedViewResponse
+	171, // 246: task.v1.TaskService.ExportBoard:output_type -> task.v1.ExportBoardChunk
+	175, // 247: task.v1.TaskService.ImportBoard:output_type -> task.v1.ImportBoardResponse
+	99,  // 248: task.v1.TaskService.CreateCalendarFeed:output_type -> task.v1.CreateCalendarFeedResponse
+	101, // 249: task.v1.TaskService.ListCalendarFeeds:output_type -> task.v1.ListCalendarFeedsResponse
+	103, // 250: task.v1.TaskService.DeleteCalendarFeed:output_type -> task.v1.DeleteCalendarFeedResponse
+	105, // 251: task.v1.TaskService.GetCalendar:output_type -> task.v1.GetCalendarResponse
+	108, // 252: task.v1.TaskService.CreateWebhook:output_type -> task.v1.CreateWebhookResponse
+	110, // 253: task.v1.TaskService.GetWebhook:output_type -> task.v1.GetWebhookResponse
+	112, // 254: task.v1.TaskService.ListWebhooks:output_type -> task.v1.ListWebhooksResponse
+	114, // 255: task.v1.TaskService.UpdateWebhook:output_type -> task.v1.UpdateWebhookResponse
+	116, // 256: task.v1.TaskService.DeleteWebhook:output_type -> task.v1.DeleteWebhookResponse
+	119, // 257: task.v1.TaskService.ListWebhookDeliveries:output_type -> task.v1.ListWebhookDeliveriesResponse
+	121, // 258: task.v1.TaskService.RedeliverWebhookDelivery:output_type -> task.v1.RedeliverWebhookDeliveryResponse
+	123, // 259: task.v1.TaskService.WatchTask:output_type -> task.v1.WatchTaskResponse
+	125, // 260: task.v1.TaskService.UnwatchTask:output_type -> task.v1.UnwatchTaskResponse
+	127, // 261: task.v1.TaskService.ListTaskWatchers:output_type -> task.v1.ListTaskWatchersResponse
+	130, // 262: task.v1.TaskService.GetNotificationPreferences:output_type -> task.v1.GetNotificationPreferencesResponse
+	132, // 263: task.v1.TaskService.UpdateNotificationPreferences:output_type -> task.v1.UpdateNotificationPreferencesResponse
+	135, // 264: task.v1.TaskService.ListInboxNotifications:output_type -> task.v1.ListInboxNotificationsResponse
+	137, // 265: task.v1.TaskService.GetInboxUnreadCount:output_type -> task.v1.GetInboxUnreadCountResponse
+	139, // 266: task.v1.TaskService.MarkInboxNotificationRead:output_type -> task.v1.MarkInboxNotificationReadResponse
+	141, // 267: task.v1.TaskService.MarkAllInboxNotificationsRead:output_type -> task.v1.MarkAllInboxNotificationsReadResponse
+	144, // 268: task.v1.TaskService.ListBoardMembers:output_type -> task.v1.ListBoardMembersResponse
+	146, // 269: task.v1.TaskService.AddBoardMember:output_type -> task.v1.AddBoardMemberResponse
+	148, // 270: task.v1.TaskService.UpdateBoardMember:output_type -> task.v1.UpdateBoardMemberResponse
+	150, // 271: task.v1.TaskService.RemoveBoardMember:output_type -> task.v1.RemoveBoardMemberResponse
+	154, // 272: task.v1.TaskService.CreateWorkspace:output_type -> task.v1.CreateWorkspaceResponse
+	158, // 273: task.v1.TaskService.ListWorkspaces:output_type -> task.v1.ListWorkspacesResponse
+	156, // 274: task.v1.TaskService.UpdateWorkspace:output_type -> task.v1.UpdateWorkspaceResponse
+	160, // 275: task.v1.TaskService.ListWorkspaceMembers:output_type -> task.v1.ListWorkspaceMembersResponse
+	162, // 276: task.v1.TaskService.AddWorkspaceMember:output_type -> task.v1.AddWorkspaceMemberResponse
+	164, // 277: task.v1.TaskService.RemoveWorkspaceMember:output_type -> task.v1.RemoveWorkspaceMemberResponse
+	167, // 278: task.v1.TaskService.ExportUserData:output_type -> task.v1.ExportUserDataResponse
+	169, // 279: task.v1.TaskService.DeleteUserData:output_type -> task.v1.DeleteUserDataResponse
+	210, // [210:280] is the sub-list for method output_type
+	140, // [140:210] is the sub-list for method input_type
+	140, // [140:140] is the sub-list for extension type_name
+	140, // [140:140] is the sub-list for extension extendee
+	0,   // [0:140] is the sub-list for field type_name
 }
 
 func init() { file_proto_task_v1_task_proto_init() }
@@ -772,20 +11950,45 @@ func file_proto_task_v1_task_proto_init() {
 	if File_proto_task_v1_task_proto != nil {
 		return
 	}
-	file_proto_task_v1_task_proto_msgTypes[5].OneofWrappers = []any{}
-	file_proto_task_v1_task_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[6].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[44].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[48].OneofWrappers = []any{
+		(*Template_Board)(nil),
+		(*Template_Task)(nil),
+	}
+	file_proto_task_v1_task_proto_msgTypes[49].OneofWrappers = []any{
+		(*CreateTemplateRequest_Board)(nil),
+		(*CreateTemplateRequest_Task)(nil),
+	}
+	file_proto_task_v1_task_proto_msgTypes[65].OneofWrappers = []any{
+		(*CustomFieldValue_Text)(nil),
+		(*CustomFieldValue_Number)(nil),
+		(*CustomFieldValue_Date)(nil),
+		(*CustomFieldValue_Options)(nil),
+	}
+	file_proto_task_v1_task_proto_msgTypes[69].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[82].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[102].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[144].OneofWrappers = []any{}
+	file_proto_task_v1_task_proto_msgTypes[162].OneofWrappers = []any{
+		(*ImportBoardRequest_Header)(nil),
+		(*ImportBoardRequest_Data)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_task_v1_task_proto_rawDesc), len(file_proto_task_v1_task_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      11,
+			NumMessages:   169,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_task_v1_task_proto_goTypes,
 		DependencyIndexes: file_proto_task_v1_task_proto_depIdxs,
+		EnumInfos:         file_proto_task_v1_task_proto_enumTypes,
 		MessageInfos:      file_proto_task_v1_task_proto_msgTypes,
 	}.Build()
 	File_proto_task_v1_task_proto = out.File
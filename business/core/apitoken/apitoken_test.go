@@ -0,0 +1,84 @@
+package apitoken
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewToken(t *testing.T) {
+	token, prefix, hash, err := NewToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	if !IsToken(token) {
+		t.Errorf("expected token to start with %q, got %q", Prefix, token)
+	}
+	if !strings.HasPrefix(token, prefix) || len(prefix) >= len(token) {
+		t.Errorf("expected %q to be a proper prefix of the token", prefix)
+	}
+	if !bytes.Equal(hash, HashToken(token)) {
+		t.Error("expected hash to be the token's hash")
+	}
+	if IsToken("eyJhbGciOiJFZERTQSJ9.e30.sig") {
+		t.Error("expected an access token not to be an API token")
+	}
+
+	t.Log("✅ Generated API token")
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		tok  Token
+		want error
+	}{
+		{"valid", Token{Name: "ci", Scopes: []string{ScopeTasksWrite}}, nil},
+		{"expires later", Token{Name: "ci", Scopes: []string{ScopeTasksRead}, ExpiresAt: now.Add(time.Hour)}, nil},
+		{"no name", Token{Name: " ", Scopes: []string{ScopeTasksRead}}, ErrEmptyName},
+		{"long name", Token{Name: strings.Repeat("x", maxNameLen+1), Scopes: []string{ScopeTasksRead}}, ErrNameTooLong},
+		{"no scopes", Token{Name: "ci"}, ErrInvalidScope},
+		{"unknown scope", Token{Name: "ci", Scopes: []string{"tasks:delete"}}, ErrInvalidScope},
+		{"expired", Token{Name: "ci", Scopes: []string{ScopeTasksRead}, ExpiresAt: now.Add(-time.Hour)}, ErrExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tok.Validate(now)
+			if tt.want == nil && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		scopes []string
+		scope  string
+		want   bool
+	}{
+		{[]string{ScopeTasksRead}, ScopeTasksRead, true},
+		{[]string{ScopeTasksRead}, ScopeTasksWrite, false},
+		{[]string{ScopeTasksWrite}, ScopeTasksRead, true},
+		{[]string{ScopeTasksWrite}, ScopeBoardsAdmin, false},
+		{[]string{ScopeTasksRead, ScopeBoardsAdmin}, ScopeTasksWrite, true},
+		{[]string{ScopeBoardsAdmin}, "", false},
+	}
+
+	for _, tt := range tests {
+		tok := Token{Scopes: tt.scopes}
+		if got := tok.Allows(tt.scope); got != tt.want {
+			t.Errorf("%v allows %q: expected %v, got %v", tt.scopes, tt.scope, tt.want, got)
+		}
+	}
+
+	t.Log("✅ Checked token scopes")
+}
@@ -0,0 +1,152 @@
+package apitoken
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Store manages storing API tokens in DB. Only their hashes are stored, so
+// a token is shown once, when it's created.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore constructs an API token store.
+func NewStore(db *sql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Create stores a new token for tok.UserID, with tok's name, scopes,
+// workspace and expiry, and returns it. It fills in the other fields of tok.
+func (s *Store) Create(ctx context.Context, tok *Token) (string, error) {
+	token, prefix, hash, err := NewToken()
+	if err != nil {
+		return "", fmt.Errorf("generating API token: %w", err)
+	}
+
+	const q = `
+		INSERT INTO api_tokens (id, user_id, name, prefix, token_hash, scopes, workspace_id, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING created_at
+	`
+	var expiresAt sql.NullTime
+	if !tok.ExpiresAt.IsZero() {
+		expiresAt = sql.NullTime{Time: tok.ExpiresAt, Valid: true}
+	}
+	tok.ID = uuid.New().String()
+	tok.Prefix = prefix
+	err = s.db.QueryRowContext(ctx, q, tok.ID, tok.UserID, tok.Name, prefix, hash,
+		pq.Array(tok.Scopes), tok.WorkspaceID, expiresAt).Scan(&tok.CreatedAt)
+	if err != nil {
+		return "", fmt.Errorf("inserting API token: %w", err)
+	}
+	return token, nil
+}
+
+// Authenticate returns the token a request presented, recording that it
+// was used. Unknown, expired and revoked tokens return ErrInvalidToken.
+func (s *Store) Authenticate(ctx context.Context, token string) (Token, error) {
+	const q = `
+		UPDATE api_tokens SET last_used_at = NOW()
+		WHERE token_hash = $1 AND revoked_at IS NULL
+			AND (expires_at IS NULL OR expires_at > NOW())
+		RETURNING id, user_id, name, prefix, scopes, workspace_id, created_at, last_used_at, expires_at
+	`
+
+	tok, err := scanToken(s.db.QueryRowContext(ctx, q, HashToken(token)))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Token{}, ErrInvalidToken
+	}
+	if err != nil {
+		return Token{}, fmt.Errorf("using API token: %w", err)
+	}
+	return tok, nil
+}
+
+// List returns a user's tokens that haven't been revoked, newest first.
+// Expired ones are included, so users see why a script stopped working.
+func (s *Store) List(ctx context.Context, userID string) ([]Token, error) {
+	const q = `
+		SELECT id, user_id, name, prefix, scopes, workspace_id, created_at, last_used_at, expires_at
+		FROM api_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting API tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []Token
+	for rows.Next() {
+		tok, err := scanToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning API token: %w", err)
+		}
+		tokens = append(tokens, tok)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating API tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Revoke revokes one of a user's tokens. It stops working at once.
+func (s *Store) Revoke(ctx context.Context, userID, id string) error {
+	const q = `
+		UPDATE api_tokens SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := s.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		return fmt.Errorf("revoking API token: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("revoking API token: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RevokeAll revokes all of a user's tokens, as when they delete their
+// account.
+func (s *Store) RevokeAll(ctx context.Context, userID string) error {
+	const q = `UPDATE api_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
+
+	if _, err := s.db.ExecContext(ctx, q, userID); err != nil {
+		return fmt.Errorf("revoking API tokens: %w", err)
+	}
+	return nil
+}
+
+// scanner is a *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanToken scans a row of the columns Authenticate and List select.
+func scanToken(row scanner) (Token, error) {
+	var tok Token
+	var lastUsedAt, expiresAt sql.NullTime
+	err := row.Scan(&tok.ID, &tok.UserID, &tok.Name, &tok.Prefix, pq.Array(&tok.Scopes),
+		&tok.WorkspaceID, &tok.CreatedAt, &lastUsedAt, &expiresAt)
+	if err != nil {
+		return Token{}, err
+	}
+	tok.LastUsedAt = lastUsedAt.Time
+	tok.ExpiresAt = expiresAt.Time
+	return tok, nil
+}
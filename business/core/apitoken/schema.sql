@@ -0,0 +1,18 @@
+-- API tokens schema.
+-- This is what apitokendb.go expects to exist, besides the users table.
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+	id           TEXT PRIMARY KEY,                                    -- UUID as string.
+	user_id      TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	name         TEXT NOT NULL,
+	prefix       TEXT NOT NULL,                                       -- Start of the token, shown in listings.
+	token_hash   BYTEA NOT NULL UNIQUE,                               -- SHA-256 of the token.
+	scopes       TEXT[] NOT NULL,
+	workspace_id BIGINT NOT NULL DEFAULT 0,                           -- 0 if requests choose theirs.
+	created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMP WITH TIME ZONE,
+	expires_at   TIMESTAMP WITH TIME ZONE,                            -- NULL if it never expires.
+	revoked_at   TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_tokens_user_id ON api_tokens(user_id);
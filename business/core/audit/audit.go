@@ -0,0 +1,73 @@
+// Package audit keeps a log of authentication events: logins, failed and
+// refused ones, lockouts and unlocks, and changes to how users sign in.
+package audit
+
+import "time"
+
+// Kinds of events.
+const (
+	KindRegistered      = "registered"
+	KindLoginSucceeded  = "login_succeeded"
+	KindLoginFailed     = "login_failed"
+	KindLoginBlocked    = "login_blocked"
+	KindLocked          = "locked"
+	KindUnlocked        = "unlocked"
+	KindLogout          = "logout"
+	KindIdentitySignIn  = "identity_sign_in"
+	KindPasswordChanged = "password_changed"
+	KindPasswordReset   = "password_reset"
+	KindEmailChanged    = "email_changed"
+	KindAccountDeleted  = "account_deleted"
+
+	// Two-factor authentication.
+	KindMFAChallenged            = "mfa_challenged"
+	KindMFAFailed                = "mfa_failed"
+	KindMFAEnabled               = "mfa_enabled"
+	KindMFADisabled              = "mfa_disabled"
+	KindRecoveryCodeUsed         = "recovery_code_used"
+	KindRecoveryCodesRegenerated = "recovery_codes_regenerated"
+)
+
+// Event is something that happened to how a user signs in.
+type Event struct {
+	ID   int64
+	Kind string
+
+	// The user the event is about, if known, and the email given, for
+	// attempts on unknown ones.
+	UserID string
+	Email  string
+
+	// Where the request came from.
+	IP        string
+	UserAgent string
+
+	// The admin who made the change, for unlocks.
+	ActorID string
+
+	// More about the event, such as why a login failed.
+	Detail string
+
+	CreatedAt time.Time
+}
+
+// Filter narrows the events List returns. Zero fields match every event.
+type Filter struct {
+	UserID string
+	Email  string
+	IP     string
+	Kind   string
+
+	// Only events before this one, to page back through them.
+	BeforeID int64
+
+	// Limit caps the events returned: DefaultLimit if 0, and at most
+	// MaxLimit.
+	Limit int
+}
+
+// Bounds on the events List returns.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
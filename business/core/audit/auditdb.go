@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Store manages storing events in DB.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore constructs an audit store.
+func NewStore(db *sql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Record stores an event, filling in its ID and time.
+func (s *Store) Record(ctx context.Context, e *Event) error {
+	const q = `
+		INSERT INTO auth_events (kind, user_id, email, ip, user_agent, actor_id, detail)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	err := s.db.QueryRowContext(ctx, q, e.Kind, e.UserID, e.Email, e.IP, e.UserAgent, e.ActorID, e.Detail).
+		Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("recording auth event: %w", err)
+	}
+	return nil
+}
+
+// List returns the events matching a filter, newest first.
+func (s *Store) List(ctx context.Context, filter Filter) ([]Event, error) {
+	var conds []string
+	var args []any
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.UserID != "" {
+		add("user_id = $%d", filter.UserID)
+	}
+	if filter.Email != "" {
+		add("LOWER(email) = LOWER($%d)", filter.Email)
+	}
+	if filter.IP != "" {
+		add("ip = $%d", filter.IP)
+	}
+	if filter.Kind != "" {
+		add("kind = $%d", filter.Kind)
+	}
+	if filter.BeforeID > 0 {
+		add("id < $%d", filter.BeforeID)
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	q := `SELECT id, kind, user_id, email, ip, user_agent, actor_id, detail, created_at FROM auth_events`
+	if len(conds) > 0 {
+		q += ` WHERE ` + strings.Join(conds, " AND ")
+	}
+	q += fmt.Sprintf(` ORDER BY id DESC LIMIT %d`, limit)
+
+	rows, err := s.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("selecting auth events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []Event{}
+	for rows.Next() {
+		var e Event
+		err := rows.Scan(&e.ID, &e.Kind, &e.UserID, &e.Email, &e.IP, &e.UserAgent, &e.ActorID, &e.Detail, &e.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scanning auth event: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating auth events: %w", err)
+	}
+	return events, nil
+}
+
+// Prune deletes events older than before, and returns how many it deleted.
+func (s *Store) Prune(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM auth_events WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("pruning auth events: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("pruning auth events: %w", err)
+	}
+	return n, nil
+}
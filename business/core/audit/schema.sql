@@ -0,0 +1,20 @@
+-- Auth events schema.
+-- This is what auditdb.go expects to exist.
+
+-- Authentication events, kept for AUDIT_RETENTION. user_id has no foreign
+-- key, so events outlive what they're about.
+CREATE TABLE IF NOT EXISTS auth_events (
+	id         BIGSERIAL PRIMARY KEY,
+	kind       TEXT NOT NULL,                                     -- Such as "login_failed".
+	user_id    TEXT NOT NULL DEFAULT '',                          -- '' for unknown emails.
+	email      TEXT NOT NULL DEFAULT '',
+	ip         TEXT NOT NULL DEFAULT '',
+	user_agent TEXT NOT NULL DEFAULT '',
+	actor_id   TEXT NOT NULL DEFAULT '',                          -- The admin, for unlocks.
+	detail     TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_events_user_id ON auth_events(user_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_auth_events_ip ON auth_events(ip, id DESC);
+CREATE INDEX IF NOT EXISTS idx_auth_events_created_at ON auth_events(created_at);
@@ -0,0 +1,168 @@
+// Package boardio reads and writes boards as files, for export and import.
+//
+// Two formats are supported. JSON is lossless: a single document holding the
+// board, its custom field definitions and every task,
+//
+//	{"format": "taskboard.board", "version": 1, "board": {...}, "tasks": [...]}
+//
+// CSV holds one task per row with a header row naming the columns. Lists are
+// joined with ";" and custom fields are columns named cf:<field name>.
+//
+// Both formats are streamed: tasks are written and read one at a time, so a
+// file never needs to fit in memory.
+//
+// Boards can also be read from Trello board exports and GitHub issue
+// exports. Their tasks carry an ExternalID so imports can be rerun.
+package boardio
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Format and Version identify JSON exports.
+const (
+	Format  = "taskboard.board"
+	Version = 1
+)
+
+// Task fields, as CSV column names and column mapping targets.
+const (
+	ColumnID          = "id"
+	ColumnTitle       = "title"
+	ColumnDescription = "description"
+	ColumnCompleted   = "completed"
+	ColumnCreatedBy   = "created_by"
+	ColumnCreatedAt   = "created_at"
+	ColumnUpdatedAt   = "updated_at"
+	ColumnDueAt       = "due_at"
+	ColumnLabels      = "labels"
+	ColumnAssigneeIDs = "assignee_ids"
+
+	// CustomFieldPrefix starts the column name of a custom field.
+	CustomFieldPrefix = "cf:"
+)
+
+// taskColumns are the CSV columns of a task, in export order.
+var taskColumns = []string{
+	ColumnID, ColumnTitle, ColumnDescription, ColumnCompleted, ColumnCreatedBy,
+	ColumnCreatedAt, ColumnUpdatedAt, ColumnDueAt, ColumnLabels, ColumnAssigneeIDs,
+}
+
+// ListSeparator joins labels, assignees and multi select options in CSV.
+const ListSeparator = ";"
+
+// ErrInvalidFile is wrapped by errors that make the rest of a file unreadable.
+var ErrInvalidFile = errors.New("invalid file")
+
+// Board is a board's metadata.
+type Board struct {
+	// ExternalID identifies a board imported from another system.
+	ExternalID string `json:"-"`
+
+	Name         string        `json:"name"`
+	Description  string        `json:"description,omitempty"`
+	Columns      []string      `json:"columns,omitempty"`
+	Labels       []Label       `json:"labels,omitempty"`
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+}
+
+type Label struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// CustomField is a custom field definition. Type is a repository field
+// type such as "number" or "multi_select".
+type CustomField struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Options  []string `json:"options,omitempty"`
+	Required bool     `json:"required,omitempty"`
+	Position int      `json:"position,omitempty"`
+}
+
+// Task is a task as written to and read from files.
+type Task struct {
+	// ID is the exported task's ID. Imports ignore it.
+	ID int64 `json:"id,omitempty"`
+
+	// ExternalID identifies a task imported from another system, so
+	// imports can be rerun without duplicating it.
+	ExternalID string `json:"-"`
+
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	Completed   bool       `json:"completed"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	Labels      []string   `json:"labels,omitempty"`
+	AssigneeIDs []string   `json:"assignee_ids,omitempty"`
+
+	// CustomFields maps field names to values: a string, a float64 or a
+	// []string. Values read from CSV are always strings, as typed in the
+	// file; values read from JSON may also be []any.
+	CustomFields map[string]any `json:"custom_fields,omitempty"`
+}
+
+// RowError reports a row that could not be read. The rows after it can
+// still be read.
+type RowError struct {
+	// Row is the 1-based task number within the file.
+	Row    int
+	Column string
+	Msg    string
+}
+
+func (e *RowError) Error() string {
+	if e.Column != "" {
+		return fmt.Sprintf("row %d, column %s: %s", e.Row, e.Column, e.Msg)
+	}
+	return fmt.Sprintf("row %d: %s", e.Row, e.Msg)
+}
+
+// Writer writes tasks to a file.
+type Writer interface {
+	WriteTask(task *Task) error
+
+	// Close finishes the file. It does not close the underlying writer.
+	Close() error
+}
+
+// Reader reads tasks from a file.
+type Reader interface {
+	// Next returns the next task, or io.EOF after the last one. A
+	// *RowError skips a bad row; other errors end the file.
+	Next() (*Task, error)
+
+	// Row is the row number of the task last returned by Next.
+	Row() int
+}
+
+// parseTime accepts RFC 3339 timestamps and YYYY-MM-DD dates, which are
+// taken as midnight UTC.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC 3339 timestamp or YYYY-MM-DD date, got %q", s)
+	}
+	return t, nil
+}
+
+// splitList splits a CSV list cell, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ListSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
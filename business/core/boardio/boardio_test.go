@@ -0,0 +1,299 @@
+package boardio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	created = time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	updated = time.Date(2025, 3, 2, 10, 30, 0, 0, time.UTC)
+	due     = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testTasks() []*Task {
+	return []*Task{
+		{
+			ID:          7,
+			Title:       "Fix login, again",
+			Description: "Line one\nline \"two\"",
+			Completed:   true,
+			CreatedBy:   "3",
+			CreatedAt:   &created,
+			UpdatedAt:   &updated,
+			DueAt:       &due,
+			Labels:      []string{"bug", "auth"},
+			AssigneeIDs: []string{"user-1"},
+			CustomFields: map[string]any{
+				"Points":   float64(3),
+				"Platform": []string{"ios", "web"},
+				"Owner":    "user-2",
+			},
+		},
+		{ID: 8, Title: "Write docs", CreatedBy: "3", CreatedAt: &created, UpdatedAt: &created},
+	}
+}
+
+// readAll reads every task, failing the test on any error.
+func readAll(t *testing.T, r Reader) []*Task {
+	t.Helper()
+	var tasks []*Task
+	for {
+		task, err := r.Next()
+		if err == io.EOF {
+			return tasks
+		}
+		if err != nil {
+			t.Fatalf("failed to read row %d: %v", r.Row(), err)
+		}
+		tasks = append(tasks, task)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	board := &Board{
+		Name:    "Sprint",
+		Columns: []string{"To do", "Done"},
+		Labels:  []Label{{Name: "bug", Color: "#ff0000"}},
+		CustomFields: []CustomField{
+			{Name: "Points", Type: "number", Required: true},
+			{Name: "Platform", Type: "multi_select", Options: []string{"ios", "web"}, Position: 1},
+		},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewJSONWriter(&buf, board)
+	if err != nil {
+		t.Fatalf("failed to start export: %v", err)
+	}
+	for _, task := range testTasks() {
+		if err := w.WriteTask(task); err != nil {
+			t.Fatalf("failed to write task: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to finish export: %v", err)
+	}
+
+	r, err := NewJSONReader(&buf)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	if !reflect.DeepEqual(r.Board(), board) {
+		t.Errorf("board changed in round trip:\n got %+v\nwant %+v", r.Board(), board)
+	}
+
+	got := readAll(t, r)
+	if !reflect.DeepEqual(got, testTasks()) {
+		t.Errorf("tasks changed in round trip:\n got %+v\nwant %+v", got, testTasks())
+	}
+}
+
+func TestJSONRowErrors(t *testing.T) {
+	input := `{"tasks": [
+		{"title": "ok"},
+		{"title": "bad", "completed": "yes"},
+		{"title": "bad options", "custom_fields": {"Platform": ["ios", 1]}},
+		{"title": "also ok"}
+	], "extra": {"ignored": true}}`
+
+	r, err := NewJSONReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+	if r.Board() != nil {
+		t.Errorf("expected no board, got %+v", r.Board())
+	}
+
+	var titles []string
+	var rowErrs []*RowError
+	for {
+		task, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			rowErrs = append(rowErrs, rowErr)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		titles = append(titles, task.Title)
+	}
+
+	if !reflect.DeepEqual(titles, []string{"ok", "also ok"}) {
+		t.Errorf("expected the good rows to be read, got %v", titles)
+	}
+	if len(rowErrs) != 2 || rowErrs[0].Row != 2 || rowErrs[0].Column != "completed" ||
+		rowErrs[1].Row != 3 || rowErrs[1].Column != "cf:Platform" {
+		t.Errorf("unexpected row errors: %+v", rowErrs)
+	}
+}
+
+func TestJSONInvalidFile(t *testing.T) {
+	tests := []string{
+		``,
+		`[]`,
+		`{"format": "something.else", "tasks": []}`,
+		`{"version": 99, "tasks": []}`,
+		`{"tasks": [{"title": "cut off"`,
+	}
+
+	for _, input := range tests {
+		r, err := NewJSONReader(strings.NewReader(input))
+		for err == nil {
+			_, err = r.Next()
+		}
+		if !errors.Is(err, ErrInvalidFile) {
+			t.Errorf("%q: expected ErrInvalidFile, got %v", input, err)
+		}
+	}
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCSVWriter(&buf, []string{"Points", "Platform", "Owner"})
+	if err != nil {
+		t.Fatalf("failed to start export: %v", err)
+	}
+	for _, task := range testTasks() {
+		if err := w.WriteTask(task); err != nil {
+			t.Fatalf("failed to write task: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to finish export: %v", err)
+	}
+
+	r, err := NewCSVReader(&buf, nil)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	if names := r.CustomFieldNames(); !reflect.DeepEqual(names, []string{"Points", "Platform", "Owner"}) {
+		t.Errorf("unexpected custom field columns %v", names)
+	}
+
+	got := readAll(t, r)
+
+	// CSV loses IDs and custom field types; the task service converts
+	// custom field text by field type.
+	want := testTasks()
+	for _, task := range want {
+		task.ID = 0
+	}
+	want[0].CustomFields = map[string]any{"Points": "3", "Platform": "ios;web", "Owner": "user-2"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tasks changed in round trip:\n got %+v\nwant %+v", got[0], want[0])
+	}
+}
+
+func TestCSVColumnMapping(t *testing.T) {
+	input := "\ufeffSummary,Done,Due,Tags,Notes,Story points\n" +
+		"Ship it,yes,2025-04-01,a; b,ignored,5\n" +
+		"Broken,true,next week,,,\n" +
+		"Short row\n"
+
+	r, err := NewCSVReader(strings.NewReader(input), map[string]string{
+		"Summary":      "title",
+		"Done":         "completed",
+		"Due":          "due_at",
+		"Tags":         "labels",
+		"Notes":        "",
+		"Story points": "cf:Points",
+	})
+	if err != nil {
+		t.Fatalf("failed to read header: %v", err)
+	}
+
+	task, err := r.Next()
+	var rowErr *RowError
+	if !errors.As(err, &rowErr) || rowErr.Row != 1 || rowErr.Column != "Done" {
+		t.Errorf("expected an error for Done on row 1, got %v", err)
+	}
+
+	task, err = r.Next()
+	if !errors.As(err, &rowErr) || rowErr.Row != 2 || rowErr.Column != "Due" {
+		t.Errorf("expected an error for Due on row 2, got %v, %+v", err, task)
+	}
+
+	_, err = r.Next()
+	if !errors.As(err, &rowErr) || rowErr.Row != 3 {
+		t.Errorf("expected a column count error on row 3, got %v", err)
+	}
+
+	if _, err := r.Next(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+
+	// With valid values.
+	input = "Summary,Done,Due,Tags,Notes,Story points\nShip it,true,2025-04-01,a; b,ignored,5\n"
+	r, err = NewCSVReader(strings.NewReader(input), map[string]string{
+		"Summary": "title", "Done": "completed", "Due": "due_at", "Tags": "labels",
+		"Notes": "", "Story points": "cf:Points",
+	})
+	if err != nil {
+		t.Fatalf("failed to read header: %v", err)
+	}
+	tasks := readAll(t, r)
+	want := &Task{
+		Title:        "Ship it",
+		Completed:    true,
+		DueAt:        &due,
+		Labels:       []string{"a", "b"},
+		CustomFields: map[string]any{"Points": "5"},
+	}
+	if len(tasks) != 1 || !reflect.DeepEqual(tasks[0], want) {
+		t.Errorf("unexpected tasks %+v", tasks)
+	}
+}
+
+func TestCSVInvalidHeader(t *testing.T) {
+	tests := []struct {
+		input   string
+		mapping map[string]string
+	}{
+		{"", nil},
+		{"title,priority\n", nil},
+		{"description\n", nil},
+		{"title,Title\n", nil},
+		{"title,name\n", map[string]string{"name": "title"}},
+		{"title\n", map[string]string{"missing": "labels"}},
+		{"title,x\n", map[string]string{"x": "cf:"}},
+		{"\"title\n", nil},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewCSVReader(strings.NewReader(tt.input), tt.mapping); !errors.Is(err, ErrInvalidFile) {
+			t.Errorf("%q %v: expected ErrInvalidFile, got %v", tt.input, tt.mapping, err)
+		}
+	}
+}
+
+func TestReaderErrorsPassThrough(t *testing.T) {
+	errUpload := errors.New("upload cancelled")
+	r := io.MultiReader(strings.NewReader(`{"tasks": [`), &failingReader{err: errUpload})
+
+	jr, err := NewJSONReader(r)
+	if err == nil {
+		_, err = jr.Next()
+	}
+	if !errors.Is(err, errUpload) {
+		t.Errorf("expected the reader's error, got %v", err)
+	}
+}
+
+type failingReader struct {
+	err error
+}
+
+func (f *failingReader) Read([]byte) (int, error) {
+	return 0, f.err
+}
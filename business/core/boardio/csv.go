@@ -0,0 +1,264 @@
+package boardio
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSVWriter writes tasks as CSV rows.
+type CSVWriter struct {
+	w      *csv.Writer
+	fields []string
+	record []string
+}
+
+// NewCSVWriter writes the header row: the task columns followed by a
+// column for each of the named custom fields.
+func NewCSVWriter(w io.Writer, fieldNames []string) (*CSVWriter, error) {
+	cw := &CSVWriter{w: csv.NewWriter(w), fields: fieldNames}
+
+	header := append([]string{}, taskColumns...)
+	for _, name := range fieldNames {
+		header = append(header, CustomFieldPrefix+name)
+	}
+	if err := cw.w.Write(header); err != nil {
+		return nil, err
+	}
+	cw.record = make([]string, len(header))
+
+	return cw, nil
+}
+
+func (cw *CSVWriter) WriteTask(task *Task) error {
+	r := cw.record[:0]
+	r = append(r,
+		strconv.FormatInt(task.ID, 10),
+		task.Title,
+		task.Description,
+		strconv.FormatBool(task.Completed),
+		task.CreatedBy,
+		formatTime(task.CreatedAt),
+		formatTime(task.UpdatedAt),
+		formatTime(task.DueAt),
+		strings.Join(task.Labels, ListSeparator),
+		strings.Join(task.AssigneeIDs, ListSeparator),
+	)
+	for _, name := range cw.fields {
+		r = append(r, formatFieldValue(task.CustomFields[name]))
+	}
+	return cw.w.Write(r)
+}
+
+func (cw *CSVWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatFieldValue(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case []string:
+		return strings.Join(val, ListSeparator)
+	}
+	return ""
+}
+
+// csvColumn is a source column and the task field it is read into. An
+// empty target ignores the column.
+type csvColumn struct {
+	name   string
+	target string
+}
+
+// CSVReader reads tasks from CSV rows.
+type CSVReader struct {
+	r       *csv.Reader
+	columns []csvColumn
+	row     int
+}
+
+// NewCSVReader reads the header row. mapping maps source column names to
+// task fields; columns it doesn't mention must be named after a task field
+// or a cf:<name> custom field. The id column is ignored.
+func NewCSVReader(r io.Reader, mapping map[string]string) (*CSVReader, error) {
+	cr := &CSVReader{r: csv.NewReader(r)}
+	cr.r.ReuseRecord = true
+
+	header, err := cr.r.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidFile)
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+
+	known := map[string]bool{}
+	for _, col := range taskColumns {
+		known[col] = true
+	}
+
+	seenSource := map[string]bool{}
+	seenTarget := map[string]bool{}
+	for i, name := range header {
+		if i == 0 {
+			// Spreadsheets like to start files with a byte order mark.
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = strings.TrimSpace(name)
+		if seenSource[name] {
+			return nil, fmt.Errorf("%w: column %q appears more than once", ErrInvalidFile, name)
+		}
+		seenSource[name] = true
+
+		target, mapped := mapping[name]
+		if !mapped {
+			target = strings.ToLower(name)
+			if fieldName, ok := strings.CutPrefix(name, CustomFieldPrefix); ok {
+				target = CustomFieldPrefix + fieldName
+			}
+		}
+		if target == ColumnID {
+			target = ""
+		}
+
+		if target != "" {
+			fieldName, isField := strings.CutPrefix(target, CustomFieldPrefix)
+			if isField && strings.TrimSpace(fieldName) == "" {
+				return nil, fmt.Errorf("%w: column %q: custom field name is missing", ErrInvalidFile, name)
+			}
+			if !isField && !known[target] {
+				return nil, fmt.Errorf("%w: unknown column %q; map it to a task field, or to \"\" to ignore it",
+					ErrInvalidFile, name)
+			}
+			if seenTarget[target] {
+				return nil, fmt.Errorf("%w: more than one column maps to %s", ErrInvalidFile, target)
+			}
+			seenTarget[target] = true
+		}
+
+		cr.columns = append(cr.columns, csvColumn{name: name, target: target})
+	}
+
+	for name := range mapping {
+		if !seenSource[name] {
+			return nil, fmt.Errorf("%w: mapped column %q is not in the file", ErrInvalidFile, name)
+		}
+	}
+	if !seenTarget[ColumnTitle] {
+		return nil, fmt.Errorf("%w: no column maps to title", ErrInvalidFile)
+	}
+
+	return cr, nil
+}
+
+// CustomFieldNames returns the custom fields the file has columns for.
+func (cr *CSVReader) CustomFieldNames() []string {
+	var names []string
+	for _, col := range cr.columns {
+		if name, ok := strings.CutPrefix(col.target, CustomFieldPrefix); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (cr *CSVReader) Row() int {
+	return cr.row
+}
+
+func (cr *CSVReader) Next() (*Task, error) {
+	record, err := cr.r.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	cr.row++
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) && parseErr.Err == csv.ErrFieldCount {
+		return nil, &RowError{Row: cr.row, Msg: fmt.Sprintf(
+			"expected %d columns, got %d", len(cr.columns), len(record))}
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+
+	task := &Task{}
+	for i, col := range cr.columns {
+		if err := setField(task, col.target, record[i]); err != nil {
+			return nil, &RowError{Row: cr.row, Column: col.name, Msg: err.Error()}
+		}
+	}
+	return task, nil
+}
+
+// csvError wraps parse errors. Errors of the underlying reader, such as a
+// cancelled upload, are passed on as they are.
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	return err
+}
+
+// setField parses one cell into task. Empty cells leave fields unset.
+func setField(task *Task, target, value string) error {
+	if target == "" || value == "" {
+		return nil
+	}
+
+	parseTimeField := func() (*time.Time, error) {
+		t, err := parseTime(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		return &t, nil
+	}
+
+	var err error
+	switch target {
+	case ColumnTitle:
+		task.Title = value
+	case ColumnDescription:
+		task.Description = value
+	case ColumnCompleted:
+		task.Completed, err = strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("expected true or false, got %q", value)
+		}
+	case ColumnCreatedBy:
+		task.CreatedBy = strings.TrimSpace(value)
+	case ColumnCreatedAt:
+		task.CreatedAt, err = parseTimeField()
+	case ColumnUpdatedAt:
+		task.UpdatedAt, err = parseTimeField()
+	case ColumnDueAt:
+		task.DueAt, err = parseTimeField()
+	case ColumnLabels:
+		task.Labels = splitList(value)
+	case ColumnAssigneeIDs:
+		task.AssigneeIDs = splitList(value)
+	default:
+		name := strings.TrimPrefix(target, CustomFieldPrefix)
+		if task.CustomFields == nil {
+			task.CustomFields = map[string]any{}
+		}
+		task.CustomFields[name] = value
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+package boardio
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Sources of tasks imported from other systems. Assignees read from them
+// are "<source>:<username>" until mapped to taskboard users.
+const (
+	SourceTrello = "trello"
+	SourceGitHub = "github"
+)
+
+// ListField is the single select custom field that records which Trello
+// list a card was on.
+const ListField = "List"
+
+// comment is a comment on an imported card or issue.
+type comment struct {
+	author string
+	at     time.Time
+	body   string
+}
+
+type checklist struct {
+	name  string
+	items []checkItem
+}
+
+type checkItem struct {
+	name string
+	done bool
+}
+
+// composeDescription renders what taskboard has no field for into the task
+// description as Markdown: where the task came from, checklists and
+// comments.
+func composeDescription(body, origin string, checklists []checklist, comments []comment) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(body))
+
+	section := func(title string) {
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(title)
+	}
+
+	for _, cl := range checklists {
+		section("### " + cl.name + "\n")
+		for _, item := range cl.items {
+			mark := " "
+			if item.done {
+				mark = "x"
+			}
+			fmt.Fprintf(&b, "\n- [%s] %s", mark, item.name)
+		}
+	}
+
+	if len(comments) > 0 {
+		section("### Comments")
+		for _, c := range comments {
+			fmt.Fprintf(&b, "\n\n**%s** on %s:\n", c.author, c.at.UTC().Format(time.RFC3339))
+			for _, line := range strings.Split(strings.TrimSpace(c.body), "\n") {
+				b.WriteString("\n> " + line)
+			}
+		}
+	}
+
+	if origin != "" {
+		section("---\n" + origin)
+	}
+
+	return b.String()
+}
+
+// uniqueNames returns names without duplicates, replacing empty ones with
+// fallback, in their first order.
+func uniqueNames(names []string, fallback string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			name = fallback
+		}
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	return unique
+}
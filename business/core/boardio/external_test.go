@@ -0,0 +1,209 @@
+package boardio
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testTrelloExport = `{
+	"id": "65f1a2b3c4d5e6f708192a3b",
+	"name": "Roadmap",
+	"desc": "Q3 plans",
+	"lists": [
+		{"id": "l1", "name": "To Do"},
+		{"id": "l2", "name": "Done"},
+		{"id": "l3", "name": "Old", "closed": true}
+	],
+	"labels": [
+		{"id": "b1", "name": "bug", "color": "red"},
+		{"id": "b2", "name": "", "color": "green"}
+	],
+	"members": [{"id": "m1", "username": "bob", "fullName": "Bob Smith"}],
+	"cards": [
+		{
+			"id": "65f1a2b3c4d5e6f708192a3c", "name": "Fix login", "desc": "It fails.",
+			"idList": "l1", "idLabels": ["b1", "b2"], "idMembers": ["m1"],
+			"due": "2025-04-01T00:00:00.000Z", "dateLastActivity": "2025-03-02T10:30:00.000Z",
+			"shortUrl": "https://trello.com/c/abc"
+		},
+		{"id": "65f1a2b3c4d5e6f708192a3d", "name": "Shipped", "idList": "l2", "dueComplete": true},
+		{"id": "65f1a2b3c4d5e6f708192a3e", "name": "Forgotten", "idList": "l3"}
+	],
+	"checklists": [
+		{"id": "c1", "idCard": "65f1a2b3c4d5e6f708192a3c", "name": "Steps",
+		 "checkItems": [{"name": "Reproduce", "state": "complete"}, {"name": "Patch", "state": "incomplete"}]}
+	],
+	"actions": [
+		{"type": "commentCard", "date": "2025-03-02T09:00:00.000Z",
+		 "data": {"text": "Still broken", "card": {"id": "65f1a2b3c4d5e6f708192a3c"}},
+		 "memberCreator": {"username": "bob", "fullName": "Bob Smith"}},
+		{"type": "createCard", "date": "2025-03-01T09:00:00.000Z",
+		 "data": {"card": {"id": "65f1a2b3c4d5e6f708192a3c"}},
+		 "memberCreator": {"username": "bob", "fullName": "Bob Smith"}}
+	]
+}`
+
+func TestTrelloReader(t *testing.T) {
+	r, err := NewTrelloReader(strings.NewReader(testTrelloExport))
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+
+	board := r.Board()
+	if board.ExternalID != "65f1a2b3c4d5e6f708192a3b" || board.Name != "Roadmap" {
+		t.Errorf("got board %q (%s)", board.Name, board.ExternalID)
+	}
+	if want := []string{"To Do", "Done", "Old"}; !reflect.DeepEqual(board.Columns, want) {
+		t.Errorf("got columns %q, want %q", board.Columns, want)
+	}
+	if want := []Label{{"bug", "red"}, {"green", "green"}}; !reflect.DeepEqual(board.Labels, want) {
+		t.Errorf("got labels %v, want %v", board.Labels, want)
+	}
+	if len(board.CustomFields) != 1 || board.CustomFields[0].Name != ListField {
+		t.Errorf("got custom fields %v", board.CustomFields)
+	}
+
+	tasks := readAll(t, r)
+	if len(tasks) != 3 {
+		t.Fatalf("got %d tasks, want 3", len(tasks))
+	}
+
+	task := tasks[0]
+	if task.ExternalID != "65f1a2b3c4d5e6f708192a3c" || task.Completed {
+		t.Errorf("got task %q, completed %v", task.ExternalID, task.Completed)
+	}
+	if task.CreatedAt == nil || !task.CreatedAt.Equal(created) {
+		t.Errorf("got created at %v, want %v", task.CreatedAt, created)
+	}
+	if task.UpdatedAt == nil || !task.UpdatedAt.Equal(updated) {
+		t.Errorf("got updated at %v, want %v", task.UpdatedAt, updated)
+	}
+	if task.DueAt == nil || !task.DueAt.Equal(due) {
+		t.Errorf("got due at %v, want %v", task.DueAt, due)
+	}
+	if want := []string{"bug", "green"}; !reflect.DeepEqual(task.Labels, want) {
+		t.Errorf("got labels %q, want %q", task.Labels, want)
+	}
+	if want := []string{"trello:bob"}; !reflect.DeepEqual(task.AssigneeIDs, want) {
+		t.Errorf("got assignees %q, want %q", task.AssigneeIDs, want)
+	}
+	if task.CustomFields[ListField] != "To Do" {
+		t.Errorf("got list %v, want To Do", task.CustomFields[ListField])
+	}
+	for _, want := range []string{
+		"It fails.",
+		"### Steps\n\n- [x] Reproduce\n- [ ] Patch",
+		"**Bob Smith (@bob)** on 2025-03-02T09:00:00Z:\n\n> Still broken",
+		"Imported from Trello card https://trello.com/c/abc, created by Bob Smith (@bob).",
+	} {
+		if !strings.Contains(task.Description, want) {
+			t.Errorf("description %q lacks %q", task.Description, want)
+		}
+	}
+
+	if !tasks[1].Completed || !tasks[2].Completed {
+		t.Error("cards marked done or on archived lists should be completed")
+	}
+	// Without a creation action, the time comes from the card ID.
+	if want := time.Unix(0x65f1a2b3, 0); tasks[1].CreatedAt == nil || !tasks[1].CreatedAt.Equal(want) {
+		t.Errorf("got created at %v, want %v", tasks[1].CreatedAt, want)
+	}
+}
+
+func TestTrelloReaderInvalidFile(t *testing.T) {
+	for _, input := range []string{``, `[]`, `{"name": "no id"}`, `{"id": "x", "cards": {}}`} {
+		if _, err := NewTrelloReader(strings.NewReader(input)); !errors.Is(err, ErrInvalidFile) {
+			t.Errorf("%q: got error %v, want ErrInvalidFile", input, err)
+		}
+	}
+}
+
+func TestGitHubReader(t *testing.T) {
+	// Issue 12 is as the REST API exports it, issue 15 as gh does.
+	input := `[
+		{
+			"number": 12, "title": "Crash on start", "body": "Stack trace", "state": "open",
+			"html_url": "https://github.com/acme/app/issues/12",
+			"user": {"login": "alice"}, "assignees": [{"login": "bob"}],
+			"labels": [{"name": "bug"}], "milestone": {"due_on": "2025-04-01T00:00:00Z"},
+			"created_at": "2025-03-01T09:00:00Z", "updated_at": "2025-03-02T10:30:00Z",
+			"comments": 2
+		},
+		{"number": 13, "title": "A pull request", "html_url": "https://github.com/acme/app/pull/13",
+		 "pull_request": {"url": "x"}},
+		{"number": "14"},
+		{
+			"number": 15, "title": "Old bug", "state": "CLOSED",
+			"url": "https://github.com/acme/app/issues/15", "author": {"login": "carol"},
+			"createdAt": "2025-03-01T09:00:00Z",
+			"comments": [
+				{"author": {"login": "dave"}, "body": "Second", "createdAt": "2025-03-03T00:00:00Z"},
+				{"author": {"login": "erin"}, "body": "First", "createdAt": "2025-03-02T00:00:00Z"}
+			]
+		}
+	]`
+
+	r, err := NewGitHubReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+	if board := r.Board(); board == nil || board.ExternalID != "acme/app" {
+		t.Fatalf("got board %v, want acme/app", board)
+	}
+
+	task, err := r.Next()
+	if err != nil {
+		t.Fatalf("failed to read issue 12: %v", err)
+	}
+	if task.ExternalID != "acme/app#12" || task.Completed {
+		t.Errorf("got task %q, completed %v", task.ExternalID, task.Completed)
+	}
+	if task.CreatedAt == nil || !task.CreatedAt.Equal(created) || task.DueAt == nil || !task.DueAt.Equal(due) {
+		t.Errorf("got created at %v, due at %v", task.CreatedAt, task.DueAt)
+	}
+	if !reflect.DeepEqual(task.Labels, []string{"bug"}) || !reflect.DeepEqual(task.AssigneeIDs, []string{"github:bob"}) {
+		t.Errorf("got labels %q, assignees %q", task.Labels, task.AssigneeIDs)
+	}
+	if want := "Stack trace\n\n---\nImported from GitHub issue acme/app#12, opened by @alice."; task.Description != want {
+		t.Errorf("got description %q, want %q", task.Description, want)
+	}
+
+	// The pull request is skipped; the bad issue is a row error.
+	_, err = r.Next()
+	var rowErr *RowError
+	if !errors.As(err, &rowErr) || rowErr.Row != 3 {
+		t.Fatalf("got error %v, want a row 3 error", err)
+	}
+
+	task, err = r.Next()
+	if err != nil {
+		t.Fatalf("failed to read issue 15: %v", err)
+	}
+	if task.ExternalID != "acme/app#15" || !task.Completed {
+		t.Errorf("got task %q, completed %v", task.ExternalID, task.Completed)
+	}
+	first := strings.Index(task.Description, "First")
+	second := strings.Index(task.Description, "Second")
+	if first < 0 || second < first || !strings.Contains(task.Description, "opened by @carol") {
+		t.Errorf("got description %q", task.Description)
+	}
+
+	if tasks := readAll(t, r); len(tasks) != 0 {
+		t.Errorf("got %d tasks after the last issue", len(tasks))
+	}
+}
+
+func TestGitHubReaderInvalidFile(t *testing.T) {
+	for _, input := range []string{``, `{}`, `[{"number": 1`} {
+		r, err := NewGitHubReader(strings.NewReader(input))
+		if err == nil {
+			_, err = r.Next()
+		}
+		if !errors.Is(err, ErrInvalidFile) {
+			t.Errorf("%q: got error %v, want ErrInvalidFile", input, err)
+		}
+	}
+}
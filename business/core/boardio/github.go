@@ -0,0 +1,254 @@
+package boardio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// githubIssue is an issue as exported by the REST API (GET
+// /repos/{owner}/{repo}/issues) or by gh issue list --json. The two name
+// some fields differently.
+type githubIssue struct {
+	Number    int          `json:"number"`
+	Title     string       `json:"title"`
+	Body      string       `json:"body"`
+	State     string       `json:"state"`
+	HTMLURL   string       `json:"html_url"`
+	URL       string       `json:"url"`
+	RepoURL   string       `json:"repository_url"`
+	User      *githubUser  `json:"user"`
+	Author    *githubUser  `json:"author"`
+	Assignees []githubUser `json:"assignees"`
+	Labels    []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		DueOn  *time.Time `json:"due_on"`
+		DueOn2 *time.Time `json:"dueOn"`
+	} `json:"milestone"`
+	CreatedAt  *time.Time `json:"created_at"`
+	CreatedAt2 *time.Time `json:"createdAt"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	UpdatedAt2 *time.Time `json:"updatedAt"`
+
+	// The REST API gives a count; gh gives the comments.
+	Comments json.RawMessage `json:"comments"`
+
+	// Set on pull requests, which the REST API lists with issues.
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubComment struct {
+	Author     githubUser `json:"author"`
+	User       githubUser `json:"user"`
+	Body       string     `json:"body"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	CreatedAt2 time.Time  `json:"created_at"`
+}
+
+// repo returns the issue's "owner/name", from whichever URL it has.
+func (i *githubIssue) repo() string {
+	for _, raw := range []string{i.HTMLURL, i.URL, i.RepoURL} {
+		u, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) > 0 && parts[0] == "repos" {
+			parts = parts[1:]
+		}
+		if len(parts) >= 2 && parts[0] != "" && parts[1] != "" {
+			return parts[0] + "/" + parts[1]
+		}
+	}
+	return ""
+}
+
+func firstTime(times ...*time.Time) *time.Time {
+	for _, t := range times {
+		if t != nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// GitHubReader reads a JSON array of GitHub issues as tasks, one at a
+// time. Pull requests are skipped.
+//
+// Closed issues are completed, labels are kept, a milestone's due date
+// becomes the due date, and the author and any comments are added to the
+// description. The board is named after the first issue's repository.
+type GitHubReader struct {
+	dec  *json.Decoder
+	row  int
+	done bool
+
+	// The first issue is read ahead to name the board.
+	board   *Board
+	pending *githubIssue
+	pendErr error
+}
+
+func NewGitHubReader(r io.Reader) (*GitHubReader, error) {
+	gr := &GitHubReader{dec: json.NewDecoder(r)}
+
+	tok, err := gr.dec.Token()
+	if err != nil {
+		return nil, gr.invalid(err)
+	}
+	if tok != json.Delim('[') {
+		return nil, fmt.Errorf("%w: expected a JSON array of GitHub issues", ErrInvalidFile)
+	}
+
+	if !gr.dec.More() {
+		return gr, nil
+	}
+	gr.pending, gr.pendErr = gr.decode()
+	if gr.pendErr != nil && !isRowError(gr.pendErr) {
+		return nil, gr.pendErr
+	}
+	if gr.pending != nil {
+		if repo := gr.pending.repo(); repo != "" {
+			gr.board = &Board{ExternalID: repo, Name: repo}
+		}
+	}
+
+	return gr, nil
+}
+
+func isRowError(err error) bool {
+	var rowErr *RowError
+	return errors.As(err, &rowErr)
+}
+
+// Board returns the board named after the first issue's repository, or
+// nil if that isn't known.
+func (gr *GitHubReader) Board() *Board {
+	return gr.board
+}
+
+func (gr *GitHubReader) Row() int {
+	return gr.row
+}
+
+// decode reads the next array element.
+func (gr *GitHubReader) decode() (*githubIssue, error) {
+	gr.row++
+	issue := &githubIssue{}
+	if err := gr.dec.Decode(issue); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, &RowError{Row: gr.row, Column: typeErr.Field,
+				Msg: fmt.Sprintf("expected %s, got %s", typeErr.Type, typeErr.Value)}
+		}
+		return nil, gr.invalid(err)
+	}
+	return issue, nil
+}
+
+func (gr *GitHubReader) Next() (*Task, error) {
+	for {
+		var issue *githubIssue
+		var err error
+
+		switch {
+		case gr.pending != nil || gr.pendErr != nil:
+			issue, err = gr.pending, gr.pendErr
+			gr.pending, gr.pendErr = nil, nil
+		case gr.done:
+			return nil, io.EOF
+		case !gr.dec.More():
+			gr.done = true
+			if _, err := gr.dec.Token(); err != nil {
+				return nil, gr.invalid(err)
+			}
+			return nil, io.EOF
+		default:
+			issue, err = gr.decode()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+		return gr.task(issue)
+	}
+}
+
+func (gr *GitHubReader) task(issue *githubIssue) (*Task, error) {
+	repo := issue.repo()
+	if issue.Number == 0 || repo == "" {
+		return nil, &RowError{Row: gr.row, Msg: "an issue needs a number and a URL naming its repository"}
+	}
+
+	task := &Task{
+		ExternalID: fmt.Sprintf("%s#%d", repo, issue.Number),
+		Title:      issue.Title,
+		Completed:  strings.EqualFold(issue.State, "closed"),
+		CreatedAt:  firstTime(issue.CreatedAt, issue.CreatedAt2),
+		UpdatedAt:  firstTime(issue.UpdatedAt, issue.UpdatedAt2),
+	}
+	if issue.Milestone != nil {
+		task.DueAt = firstTime(issue.Milestone.DueOn, issue.Milestone.DueOn2)
+	}
+	for _, l := range issue.Labels {
+		task.Labels = append(task.Labels, l.Name)
+	}
+	for _, a := range issue.Assignees {
+		if a.Login != "" {
+			task.AssigneeIDs = append(task.AssigneeIDs, SourceGitHub+":"+a.Login)
+		}
+	}
+
+	var comments []comment
+	var ghComments []githubComment
+	if json.Unmarshal(issue.Comments, &ghComments) == nil {
+		for _, c := range ghComments {
+			author := c.Author.Login
+			if author == "" {
+				author = c.User.Login
+			}
+			at := c.CreatedAt
+			if at.IsZero() {
+				at = c.CreatedAt2
+			}
+			comments = append(comments, comment{author: "@" + author, at: at, body: c.Body})
+		}
+		sort.SliceStable(comments, func(i, j int) bool { return comments[i].at.Before(comments[j].at) })
+	}
+
+	origin := fmt.Sprintf("Imported from GitHub issue %s#%d", repo, issue.Number)
+	if issue.User != nil && issue.User.Login != "" {
+		origin += ", opened by @" + issue.User.Login
+	} else if issue.Author != nil && issue.Author.Login != "" {
+		origin += ", opened by @" + issue.Author.Login
+	}
+	origin += "."
+	task.Description = composeDescription(issue.Body, origin, nil, comments)
+
+	return task, nil
+}
+
+func (gr *GitHubReader) invalid(err error) error {
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		return fmt.Errorf("%w: unexpected end of file", ErrInvalidFile)
+	}
+	return err
+}
@@ -0,0 +1,222 @@
+package boardio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// JSONWriter writes a lossless JSON export, one task per line.
+type JSONWriter struct {
+	w     io.Writer
+	count int
+}
+
+// NewJSONWriter writes everything up to the first task.
+func NewJSONWriter(w io.Writer, board *Board) (*JSONWriter, error) {
+	boardJSON, err := json.Marshal(board)
+	if err != nil {
+		return nil, err
+	}
+	_, err = fmt.Fprintf(w, `{"format":%q,"version":%d,"board":%s,"tasks":[`, Format, Version, boardJSON)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONWriter{w: w}, nil
+}
+
+func (jw *JSONWriter) WriteTask(task *Task) error {
+	data, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+
+	sep := ",\n"
+	if jw.count == 0 {
+		sep = "\n"
+	}
+	jw.count++
+
+	if _, err := io.WriteString(jw.w, sep); err != nil {
+		return err
+	}
+	_, err = jw.w.Write(data)
+	return err
+}
+
+func (jw *JSONWriter) Close() error {
+	_, err := io.WriteString(jw.w, "\n]}\n")
+	return err
+}
+
+// JSONReader reads a JSON export. The board, if any, must come before the
+// tasks; keys it doesn't know are skipped.
+type JSONReader struct {
+	dec   *json.Decoder
+	board *Board
+	row   int
+	done  bool
+}
+
+// NewJSONReader reads everything up to the first task.
+func NewJSONReader(r io.Reader) (*JSONReader, error) {
+	jr := &JSONReader{dec: json.NewDecoder(r)}
+
+	if err := jr.expectDelim('{'); err != nil {
+		return nil, err
+	}
+
+	for jr.dec.More() {
+		key, err := jr.key()
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "format":
+			var format string
+			if err := jr.decode(&format); err != nil {
+				return nil, err
+			}
+			if format != Format {
+				return nil, fmt.Errorf("%w: format %q is not %q", ErrInvalidFile, format, Format)
+			}
+		case "version":
+			var version int
+			if err := jr.decode(&version); err != nil {
+				return nil, err
+			}
+			if version > Version {
+				return nil, fmt.Errorf("%w: version %d is newer than this server supports", ErrInvalidFile, version)
+			}
+		case "board":
+			jr.board = &Board{}
+			if err := jr.decode(jr.board); err != nil {
+				return nil, err
+			}
+		case "tasks":
+			return jr, jr.expectDelim('[')
+		default:
+			var skip json.RawMessage
+			if err := jr.decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// No tasks.
+	jr.done = true
+	return jr, nil
+}
+
+// Board returns the file's board, or nil if it has none.
+func (jr *JSONReader) Board() *Board {
+	return jr.board
+}
+
+func (jr *JSONReader) Row() int {
+	return jr.row
+}
+
+func (jr *JSONReader) Next() (*Task, error) {
+	if jr.done {
+		return nil, io.EOF
+	}
+
+	if !jr.dec.More() {
+		jr.done = true
+		if err := jr.expectDelim(']'); err != nil {
+			return nil, err
+		}
+		// Anything after the tasks is ignored, but must still be JSON.
+		for jr.dec.More() {
+			if _, err := jr.key(); err != nil {
+				return nil, err
+			}
+			var skip json.RawMessage
+			if err := jr.decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+		if err := jr.expectDelim('}'); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	jr.row++
+	task := &Task{}
+	if err := jr.dec.Decode(task); err != nil {
+		// The decoder has consumed the whole task, so the next one
+		// can still be read.
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, &RowError{Row: jr.row, Column: typeErr.Field,
+				Msg: fmt.Sprintf("expected %s, got %s", typeErr.Type, typeErr.Value)}
+		}
+		return nil, jr.invalid(err)
+	}
+
+	for name, value := range task.CustomFields {
+		list, ok := value.([]any)
+		if !ok {
+			continue
+		}
+		options := make([]string, len(list))
+		for i, item := range list {
+			s, ok := item.(string)
+			if !ok {
+				return nil, &RowError{Row: jr.row, Column: CustomFieldPrefix + name, Msg: "options must be strings"}
+			}
+			options[i] = s
+		}
+		task.CustomFields[name] = options
+	}
+
+	return task, nil
+}
+
+func (jr *JSONReader) decode(v any) error {
+	if err := jr.dec.Decode(v); err != nil {
+		return jr.invalid(err)
+	}
+	return nil
+}
+
+func (jr *JSONReader) key() (string, error) {
+	tok, err := jr.dec.Token()
+	if err != nil {
+		return "", jr.invalid(err)
+	}
+	key, ok := tok.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: expected an object key at offset %d", ErrInvalidFile, jr.dec.InputOffset())
+	}
+	return key, nil
+}
+
+func (jr *JSONReader) expectDelim(want json.Delim) error {
+	tok, err := jr.dec.Token()
+	if err != nil {
+		return jr.invalid(err)
+	}
+	if tok != want {
+		return fmt.Errorf("%w: expected %q at offset %d", ErrInvalidFile, want, jr.dec.InputOffset())
+	}
+	return nil
+}
+
+// invalid wraps decoding errors. Errors of the underlying reader, such as a
+// cancelled upload, are passed on as they are.
+func (jr *JSONReader) invalid(err error) error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	case err == io.EOF || err == io.ErrUnexpectedEOF:
+		return fmt.Errorf("%w: unexpected end of file", ErrInvalidFile)
+	}
+	return err
+}
@@ -0,0 +1,270 @@
+package boardio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// trelloExport is the part of a Trello board export (Menu > Print, export
+// and share > Export as JSON) that is imported.
+type trelloExport struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Desc       string `json:"desc"`
+	Lists      []trelloList
+	Labels     []trelloLabel
+	Cards      []trelloCard
+	Checklists []struct {
+		ID         string `json:"id"`
+		IDCard     string `json:"idCard"`
+		Name       string `json:"name"`
+		CheckItems []struct {
+			Name  string `json:"name"`
+			State string `json:"state"`
+		} `json:"checkItems"`
+	}
+	Members []trelloMember
+	Actions []struct {
+		Type string    `json:"type"`
+		Date time.Time `json:"date"`
+		Data struct {
+			Text string `json:"text"`
+			Card struct {
+				ID string `json:"id"`
+			} `json:"card"`
+		} `json:"data"`
+		MemberCreator trelloMember `json:"memberCreator"`
+	}
+}
+
+type trelloList struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Closed bool   `json:"closed"`
+}
+
+type trelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloCard struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Desc             string     `json:"desc"`
+	IDList           string     `json:"idList"`
+	Closed           bool       `json:"closed"`
+	Due              *time.Time `json:"due"`
+	DueComplete      bool       `json:"dueComplete"`
+	IDLabels         []string   `json:"idLabels"`
+	IDMembers        []string   `json:"idMembers"`
+	DateLastActivity *time.Time `json:"dateLastActivity"`
+	ShortURL         string     `json:"shortUrl"`
+}
+
+type trelloMember struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+func (m trelloMember) String() string {
+	if m.FullName != "" {
+		return fmt.Sprintf("%s (@%s)", m.FullName, m.Username)
+	}
+	return "@" + m.Username
+}
+
+// TrelloReader reads the cards of a Trello board export as tasks.
+//
+// Lists become board columns and the List custom field, labels are kept,
+// and checklists and comments are added to descriptions. Archived cards
+// and cards marked done are completed. Creation times and authors come
+// from the export's actions, which Trello limits to the most recent 1000;
+// cards without a creation action are dated from their ID.
+//
+// Trello exports are one JSON object whose sections come in no fixed
+// order, so the whole file is read up front.
+type TrelloReader struct {
+	board *Board
+	tasks []*Task
+	row   int
+}
+
+func NewTrelloReader(r io.Reader) (*TrelloReader, error) {
+	var export trelloExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &syntaxErr) || errors.As(err, &typeErr) ||
+			err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("%w: not a Trello board export: %v", ErrInvalidFile, err)
+		}
+		return nil, err
+	}
+	if export.ID == "" {
+		return nil, fmt.Errorf("%w: not a Trello board export: the board has no id", ErrInvalidFile)
+	}
+
+	tr := &TrelloReader{board: &Board{
+		ExternalID:  export.ID,
+		Name:        export.Name,
+		Description: export.Desc,
+	}}
+
+	lists := map[string]string{}
+	closedLists := map[string]bool{}
+	var listNames []string
+	for _, l := range export.Lists {
+		name := strings.TrimSpace(l.Name)
+		if name == "" {
+			name = "Untitled list"
+		}
+		lists[l.ID] = name
+		closedLists[l.ID] = l.Closed
+		listNames = append(listNames, name)
+	}
+	listNames = uniqueNames(listNames, "Untitled list")
+	tr.board.Columns = listNames
+	if len(listNames) > 0 {
+		tr.board.CustomFields = []CustomField{{Name: ListField, Type: "single_select", Options: listNames}}
+	}
+
+	// Trello labels may have only a color.
+	labels := map[string]string{}
+	seenLabels := map[string]bool{}
+	for _, l := range export.Labels {
+		name := strings.TrimSpace(l.Name)
+		if name == "" {
+			name = l.Color
+		}
+		if name == "" {
+			continue
+		}
+		labels[l.ID] = name
+		if !seenLabels[name] {
+			seenLabels[name] = true
+			tr.board.Labels = append(tr.board.Labels, Label{Name: name, Color: l.Color})
+		}
+	}
+
+	members := map[string]trelloMember{}
+	for _, m := range export.Members {
+		members[m.ID] = m
+	}
+
+	checklists := map[string][]checklist{}
+	for _, cl := range export.Checklists {
+		c := checklist{name: cl.Name}
+		for _, item := range cl.CheckItems {
+			c.items = append(c.items, checkItem{name: item.Name, done: item.State == "complete"})
+		}
+		checklists[cl.IDCard] = append(checklists[cl.IDCard], c)
+	}
+
+	// Actions are newest first.
+	comments := map[string][]comment{}
+	created := map[string]time.Time{}
+	authors := map[string]trelloMember{}
+	for _, a := range export.Actions {
+		cardID := a.Data.Card.ID
+		switch a.Type {
+		case "commentCard":
+			comments[cardID] = append(comments[cardID], comment{
+				author: a.MemberCreator.String(),
+				at:     a.Date,
+				body:   a.Data.Text,
+			})
+		case "createCard", "copyCard", "convertToCardFromCheckItem", "moveCardToBoard":
+			created[cardID] = a.Date
+			authors[cardID] = a.MemberCreator
+		}
+	}
+
+	for _, card := range export.Cards {
+		task := &Task{
+			ExternalID: card.ID,
+			Title:      card.Name,
+			Completed:  card.DueComplete || card.Closed,
+			DueAt:      card.Due,
+			UpdatedAt:  card.DateLastActivity,
+		}
+
+		if t, ok := created[card.ID]; ok {
+			task.CreatedAt = &t
+		} else if t, ok := trelloIDTime(card.ID); ok {
+			task.CreatedAt = &t
+		}
+
+		for _, id := range card.IDLabels {
+			if name, ok := labels[id]; ok {
+				task.Labels = append(task.Labels, name)
+			}
+		}
+		for _, id := range card.IDMembers {
+			if m, ok := members[id]; ok && m.Username != "" {
+				task.AssigneeIDs = append(task.AssigneeIDs, SourceTrello+":"+m.Username)
+			}
+		}
+		if list, ok := lists[card.IDList]; ok {
+			task.CustomFields = map[string]any{ListField: list}
+			if closedLists[card.IDList] {
+				task.Completed = true
+			}
+		}
+
+		origin := "Imported from Trello card"
+		if card.ShortURL != "" {
+			origin += " " + card.ShortURL
+		}
+		if author, ok := authors[card.ID]; ok {
+			origin += ", created by " + author.String()
+		}
+		origin += "."
+
+		cardComments := comments[card.ID]
+		sort.SliceStable(cardComments, func(i, j int) bool { return cardComments[i].at.Before(cardComments[j].at) })
+		task.Description = composeDescription(card.Desc, origin, checklists[card.ID], cardComments)
+
+		tr.tasks = append(tr.tasks, task)
+	}
+
+	return tr, nil
+}
+
+// trelloIDTime returns the creation time encoded in a Trello ID, which
+// starts with a Unix time in hex.
+func trelloIDTime(id string) (time.Time, bool) {
+	if len(id) < 8 {
+		return time.Time{}, false
+	}
+	secs, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, 0).UTC(), true
+}
+
+// Board returns the exported board.
+func (tr *TrelloReader) Board() *Board {
+	return tr.board
+}
+
+func (tr *TrelloReader) Row() int {
+	return tr.row
+}
+
+func (tr *TrelloReader) Next() (*Task, error) {
+	if tr.row >= len(tr.tasks) {
+		return nil, io.EOF
+	}
+	tr.row++
+	return tr.tasks[tr.row-1], nil
+}
@@ -0,0 +1,288 @@
+// Package ical renders tasks as an iCalendar (RFC 5545) feed that calendar
+// apps can subscribe to.
+//
+// Each task is a VEVENT at its due time, which every calendar app shows, or
+// a VTODO due then, which task-aware apps show as a to-do. Due times at
+// midnight are taken as dates and become all-day entries. Times are written
+// in the calendar's time zone, described by a VTIMEZONE built from the Go
+// time zone database, or in UTC if it has none.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Component is the kind of calendar entry tasks become.
+type Component string
+
+const (
+	ComponentEvent Component = "VEVENT"
+	ComponentTodo  Component = "VTODO"
+)
+
+// ProdID identifies the program that made a calendar.
+const ProdID = "-//taskboard//taskboard//EN"
+
+// Calendar is a feed of tasks.
+type Calendar struct {
+	Name      string
+	Component Component
+
+	// Location is the time zone entries are written in; nil means UTC.
+	Location *time.Location
+
+	Items []Item
+}
+
+// Item is a task with a due date.
+type Item struct {
+	// UID identifies the entry across versions of the feed.
+	UID         string
+	Summary     string
+	Description string
+	Categories  []string
+	Due         time.Time
+	Completed   bool
+	Created     time.Time
+	Modified    time.Time
+}
+
+const (
+	dateFormat     = "20060102"
+	localFormat    = "20060102T150405"
+	utcFormat      = "20060102T150405Z"
+	maxLineOctets  = 75
+	completedMark  = "✓ "
+	defaultDTStamp = "19700101T000000Z"
+)
+
+// Marshal renders the calendar. The output depends only on the calendar,
+// so it can be hashed for an ETag.
+func (c *Calendar) Marshal() []byte {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	component := c.Component
+	if component == "" {
+		component = ComponentEvent
+	}
+
+	var b bytes.Buffer
+	line := func(name, value string) {
+		writeLine(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", ProdID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escapeText(c.Name))
+	}
+	if loc != time.UTC {
+		line("X-WR-TIMEZONE", loc.String())
+		if from, to, ok := timedRange(c.Items, loc); ok {
+			writeTimezone(&b, loc, from, to)
+		}
+	}
+
+	for _, item := range c.Items {
+		line("BEGIN", string(component))
+		line("UID", escapeText(item.UID))
+		stamp := defaultDTStamp
+		if !item.Modified.IsZero() {
+			stamp = item.Modified.UTC().Format(utcFormat)
+		}
+		line("DTSTAMP", stamp)
+		if !item.Created.IsZero() {
+			line("CREATED", item.Created.UTC().Format(utcFormat))
+		}
+		if !item.Modified.IsZero() {
+			line("LAST-MODIFIED", item.Modified.UTC().Format(utcFormat))
+		}
+
+		summary := item.Summary
+		if component == ComponentEvent && item.Completed {
+			summary = completedMark + summary
+		}
+		line("SUMMARY", escapeText(summary))
+		if item.Description != "" {
+			line("DESCRIPTION", escapeText(item.Description))
+		}
+		if len(item.Categories) > 0 {
+			categories := make([]string, len(item.Categories))
+			for i, c := range item.Categories {
+				categories[i] = escapeText(c)
+			}
+			line("CATEGORIES", strings.Join(categories, ","))
+		}
+
+		due := "DUE"
+		if component == ComponentEvent {
+			due = "DTSTART"
+		}
+		writeLine(&b, due+dateTime(item.Due, loc))
+
+		if component == ComponentTodo {
+			if item.Completed {
+				line("STATUS", "COMPLETED")
+				line("PERCENT-COMPLETE", "100")
+			} else {
+				line("STATUS", "NEEDS-ACTION")
+			}
+		} else {
+			// Due dates mark a moment; they don't block time.
+			line("TRANSP", "TRANSPARENT")
+		}
+		line("END", string(component))
+	}
+
+	line("END", "VCALENDAR")
+	return b.Bytes()
+}
+
+// allDay reports whether t is a date rather than a time: midnight in loc.
+func allDay(t time.Time, loc *time.Location) bool {
+	t = t.In(loc)
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// dateTime formats t as the parameters and value of a DATE or DATE-TIME
+// property, starting with ";" or ":".
+func dateTime(t time.Time, loc *time.Location) string {
+	switch {
+	case allDay(t, loc):
+		return ";VALUE=DATE:" + t.In(loc).Format(dateFormat)
+	case loc == time.UTC:
+		return ":" + t.UTC().Format(utcFormat)
+	default:
+		return ";TZID=" + loc.String() + ":" + t.In(loc).Format(localFormat)
+	}
+}
+
+// timedRange returns the earliest and latest due times that are not all
+// day, which the VTIMEZONE must cover.
+func timedRange(items []Item, loc *time.Location) (from, to time.Time, ok bool) {
+	for _, item := range items {
+		if allDay(item.Due, loc) {
+			continue
+		}
+		if !ok || item.Due.Before(from) {
+			from = item.Due
+		}
+		if !ok || item.Due.After(to) {
+			to = item.Due
+		}
+		ok = true
+	}
+	return from, to, ok
+}
+
+// writeTimezone writes a VTIMEZONE with one observance for each period of
+// loc's offsets between from and to. Each observance starts at a
+// transition, in the local time before it, so no recurrence rules are
+// needed and historical changes are exact.
+func writeTimezone(b *bytes.Buffer, loc *time.Location, from, to time.Time) {
+	writeLine(b, "BEGIN:VTIMEZONE")
+	writeLine(b, "TZID:"+loc.String())
+
+	t := from.In(loc)
+	for {
+		name, offset := t.Zone()
+		start, end := t.ZoneBounds()
+
+		offsetFrom := offset
+		dtstart := "19700101T000000"
+		if !start.IsZero() {
+			_, offsetFrom = start.Add(-time.Second).In(loc).Zone()
+			dtstart = start.In(time.FixedZone("", offsetFrom)).Format(localFormat)
+		}
+
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		writeLine(b, "BEGIN:"+kind)
+		writeLine(b, "DTSTART:"+dtstart)
+		writeLine(b, "TZOFFSETFROM:"+formatOffset(offsetFrom))
+		writeLine(b, "TZOFFSETTO:"+formatOffset(offset))
+		if name != "" {
+			writeLine(b, "TZNAME:"+escapeText(name))
+		}
+		writeLine(b, "END:"+kind)
+
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		t = end.In(loc)
+	}
+
+	writeLine(b, "END:VTIMEZONE")
+}
+
+// formatOffset formats a UTC offset in seconds as a UTC-OFFSET value.
+func formatOffset(secs int) string {
+	sign := "+"
+	if secs < 0 {
+		sign = "-"
+		secs = -secs
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, secs/3600, secs/60%60)
+	if secs%60 != 0 {
+		s += fmt.Sprintf("%02d", secs%60)
+	}
+	return s
+}
+
+// escapeText escapes a TEXT value. Control characters other than tab,
+// which TEXT can't hold, are dropped.
+func escapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\', ';', ',':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				continue
+			}
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteByte(c)
+		default:
+			if c < 0x20 || c == 0x7f {
+				continue
+			}
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// writeLine writes a content line, folded so no line is longer than 75
+// octets. Folds never split a UTF-8 sequence.
+func writeLine(b *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// The leading space counts toward the limit.
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
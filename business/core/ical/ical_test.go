@@ -0,0 +1,163 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// unfold joins folded lines and splits the calendar into content lines.
+func unfold(t *testing.T, data []byte) []string {
+	t.Helper()
+	s := string(data)
+	if !strings.HasSuffix(s, "\r\n") {
+		t.Fatalf("calendar doesn't end with CRLF")
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(s, "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("fold split a UTF-8 sequence: %q", line)
+		}
+	}
+	return strings.Split(strings.ReplaceAll(strings.TrimSuffix(s, "\r\n"), "\r\n ", ""), "\r\n")
+}
+
+func contains(lines []string, want string) bool {
+	for _, line := range lines {
+		if line == want {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMarshalEvent(t *testing.T) {
+	modified := time.Date(2025, 3, 2, 10, 30, 0, 0, time.UTC)
+	cal := &Calendar{
+		Name: "Sprint, week 1",
+		Items: []Item{
+			{
+				UID:         "task-1@taskboard",
+				Summary:     "Fix login; again",
+				Description: "Line one\r\nline two, with a \\ and é",
+				Categories:  []string{"bug", "a,b"},
+				Due:         time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC),
+				Completed:   true,
+				Created:     modified.Add(-time.Hour),
+				Modified:    modified,
+			},
+			{UID: "task-2@taskboard", Summary: "Release", Due: time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	lines := unfold(t, cal.Marshal())
+	for _, want := range []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + ProdID,
+		`X-WR-CALNAME:Sprint\, week 1`,
+		"UID:task-1@taskboard",
+		"DTSTAMP:20250302T103000Z",
+		"CREATED:20250302T093000Z",
+		`SUMMARY:✓ Fix login\; again`,
+		`DESCRIPTION:Line one\nline two\, with a \\ and é`,
+		`CATEGORIES:bug,a\,b`,
+		"DTSTART:20250401T150000Z",
+		"DTSTART;VALUE=DATE:20250402",
+		"DTSTAMP:19700101T000000Z",
+		"END:VCALENDAR",
+	} {
+		if !contains(lines, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, strings.Join(lines, "\n"))
+		}
+	}
+	if contains(lines, "BEGIN:VTIMEZONE") {
+		t.Error("UTC calendars need no VTIMEZONE")
+	}
+}
+
+func TestMarshalTodo(t *testing.T) {
+	cal := &Calendar{
+		Component: ComponentTodo,
+		Items: []Item{
+			{UID: "a", Summary: "Done", Due: time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC), Completed: true},
+			{UID: "b", Summary: "Open", Due: time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	lines := unfold(t, cal.Marshal())
+	for _, want := range []string{
+		"BEGIN:VTODO", "SUMMARY:Done", "DUE:20250401T150000Z", "STATUS:COMPLETED", "STATUS:NEEDS-ACTION",
+	} {
+		if !contains(lines, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, strings.Join(lines, "\n"))
+		}
+	}
+}
+
+func TestMarshalTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+
+	cal := &Calendar{
+		Location: loc,
+		Items: []Item{
+			// Either side of the switch to summer time on 30 March 2025.
+			{UID: "a", Summary: "Winter", Due: time.Date(2025, 3, 1, 9, 30, 0, 0, loc)},
+			{UID: "b", Summary: "Summer", Due: time.Date(2025, 4, 1, 9, 30, 0, 0, loc)},
+			// Midnight in Paris is a date, though not in UTC.
+			{UID: "c", Summary: "Date", Due: time.Date(2025, 4, 2, 0, 0, 0, 0, loc)},
+		},
+	}
+
+	lines := unfold(t, cal.Marshal())
+	for _, want := range []string{
+		"X-WR-TIMEZONE:Europe/Paris",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Paris",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20250330T020000",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0200",
+		"TZNAME:CEST",
+		"DTSTART;TZID=Europe/Paris:20250301T093000",
+		"DTSTART;TZID=Europe/Paris:20250401T093000",
+		"DTSTART;VALUE=DATE:20250402",
+	} {
+		if !contains(lines, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, strings.Join(lines, "\n"))
+		}
+	}
+}
+
+func TestFolding(t *testing.T) {
+	cal := &Calendar{Items: []Item{{
+		UID:         "a",
+		Summary:     strings.Repeat("é", 100),
+		Description: strings.Repeat("abc ", 100),
+		Due:         time.Date(2025, 4, 1, 15, 0, 0, 0, time.UTC),
+	}}}
+
+	lines := unfold(t, cal.Marshal())
+	if !contains(lines, "SUMMARY:"+strings.Repeat("é", 100)) {
+		t.Errorf("summary doesn't unfold to the original")
+	}
+	if !contains(lines, "DESCRIPTION:"+strings.Repeat("abc ", 100)) {
+		t.Errorf("description doesn't unfold to the original")
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	for secs, want := range map[int]string{
+		0: "+0000", 3600: "+0100", -18000: "-0500", 19800: "+0530", -(3600 + 75): "-010115",
+	} {
+		if got := formatOffset(secs); got != want {
+			t.Errorf("formatOffset(%d) = %q, want %q", secs, got, want)
+		}
+	}
+}
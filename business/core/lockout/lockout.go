@@ -0,0 +1,131 @@
+// Package lockout slows password guessing down: it counts failed attempts
+// per account and per IP address, makes each attempt after a few failures
+// wait longer than the last, and locks an account or address out for a
+// while after too many.
+package lockout
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var ErrNotFound = errors.New("no failed attempts recorded")
+
+// Kinds of keys attempts are counted by.
+const (
+	KindAccount = "account"
+	KindIP      = "ip"
+)
+
+// AccountKey returns the key attempts on the account with an email are
+// counted by. Attempts on unknown emails count too, so they're answered
+// like those on accounts.
+func AccountKey(email string) string {
+	return KindAccount + ":" + strings.ToLower(strings.TrimSpace(email))
+}
+
+// IPKey returns the key attempts from an IP address are counted by.
+func IPKey(ip string) string {
+	return KindIP + ":" + ip
+}
+
+// kind returns the kind of a key.
+func kind(key string) string {
+	k, _, _ := strings.Cut(key, ":")
+	return k
+}
+
+// Policy sets how failed attempts are answered.
+type Policy struct {
+	// FreeAttempts is how many failures are let through without delay.
+	// Each one after doubles the delay before the next attempt, from
+	// BaseDelay up to MaxDelay.
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+
+	// AccountLimit and IPLimit are how many failures lock an account or an
+	// IP address out, for LockoutDuration. One address may be shared by
+	// many users, behind a NAT, so its limit is higher.
+	AccountLimit    int
+	IPLimit         int
+	LockoutDuration time.Duration
+
+	// Window is how long failures are remembered for, from the first.
+	Window time.Duration
+}
+
+// DefaultPolicy delays attempts after 3 failures, by 1 second doubling up
+// to a minute, and locks accounts out after 10 failures within an hour and
+// addresses after 100, for 15 minutes.
+func DefaultPolicy() Policy {
+	return Policy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		AccountLimit:    10,
+		IPLimit:         100,
+		LockoutDuration: 15 * time.Minute,
+		Window:          time.Hour,
+	}
+}
+
+// Delay returns how long to wait for an attempt after a number of
+// failures.
+func (p Policy) Delay(failures int) time.Duration {
+	if failures <= p.FreeAttempts {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := p.FreeAttempts + 1; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// limit returns how many failures lock a key out.
+func (p Policy) limit(key string) int {
+	if kind(key) == KindIP {
+		return p.IPLimit
+	}
+	return p.AccountLimit
+}
+
+// Entry is the failed attempts counted by one key.
+type Entry struct {
+	Key           string
+	Failures      int
+	FirstFailedAt time.Time
+	LastFailedAt  time.Time
+
+	// When the next attempt may be made, and when the key's lockout, if
+	// any, ends.
+	NextAttemptAt time.Time
+	LockedUntil   time.Time
+}
+
+// Locked reports whether the key is locked out at now.
+func (e Entry) Locked(now time.Time) bool {
+	return now.Before(e.LockedUntil)
+}
+
+// BlockedError is returned for attempts made too soon after failures, or
+// while locked out.
+type BlockedError struct {
+	Key string
+
+	// When an attempt may be made again.
+	Until time.Time
+
+	// Whether the key is locked out, rather than just delayed.
+	Locked bool
+}
+
+func (e *BlockedError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("too many failed attempts; locked until %s", e.Until.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("too many failed attempts; try again after %s", e.Until.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,46 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	policy := DefaultPolicy()
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{3, 0},
+		{4, time.Second},
+		{5, 2 * time.Second},
+		{6, 4 * time.Second},
+		{10, time.Minute},
+		{1000, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := policy.Delay(tt.failures); got != tt.want {
+			t.Errorf("Delay(%d) = %v, want %v", tt.failures, got, tt.want)
+		}
+	}
+
+	t.Log("✅ Delayed attempts progressively")
+}
+
+func TestKeys(t *testing.T) {
+	if AccountKey(" Alice@Example.com") != AccountKey("alice@example.com") {
+		t.Error("expected account keys to ignore case and spaces")
+	}
+
+	policy := DefaultPolicy()
+	if policy.limit(AccountKey("alice@example.com")) != policy.AccountLimit {
+		t.Error("expected account keys to have the account limit")
+	}
+	if policy.limit(IPKey("2001:db8::1")) != policy.IPLimit {
+		t.Error("expected IP keys to have the IP limit")
+	}
+
+	t.Log("✅ Keyed attempts by account and IP")
+}
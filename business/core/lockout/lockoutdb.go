@@ -0,0 +1,245 @@
+package lockout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Store counts failed attempts in DB, so every gateway replica sees them.
+type Store struct {
+	db     *sql.DB
+	policy Policy
+}
+
+// NewStore constructs a lockout store applying policy.
+func NewStore(db *sql.DB, policy Policy) *Store {
+	return &Store{
+		db:     db,
+		policy: policy,
+	}
+}
+
+// Policy returns the policy the store applies.
+func (s *Store) Policy() Policy {
+	return s.policy
+}
+
+const entryColumns = `key, failures, first_failed_at, last_failed_at, next_attempt_at, locked_until`
+
+// scanner is a *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEntry(row scanner) (Entry, error) {
+	var e Entry
+	var lockedUntil sql.NullTime
+	err := row.Scan(&e.Key, &e.Failures, &e.FirstFailedAt, &e.LastFailedAt, &e.NextAttemptAt, &lockedUntil)
+	e.LockedUntil = lockedUntil.Time
+	return e, err
+}
+
+// lockEntries locks the entries of keys in tx, creating those missing as of
+// now, in key order so concurrent attempts can't deadlock.
+func lockEntries(ctx context.Context, tx *sql.Tx, keys []string, now time.Time) ([]Entry, error) {
+	keys = slices.Clone(keys)
+	slices.Sort(keys)
+	keys = slices.Compact(keys)
+
+	entries := make([]Entry, 0, len(keys))
+	for _, key := range keys {
+		const create = `
+			INSERT INTO login_failures (key, first_failed_at, last_failed_at, next_attempt_at)
+			VALUES ($1, $2, $2, $2)
+			ON CONFLICT (key) DO NOTHING
+		`
+		if _, err := tx.ExecContext(ctx, create, key, now); err != nil {
+			return nil, fmt.Errorf("creating failed attempts: %w", err)
+		}
+
+		q := `SELECT ` + entryColumns + ` FROM login_failures WHERE key = $1 FOR UPDATE`
+		e, err := scanEntry(tx.QueryRowContext(ctx, q, key))
+		if err != nil {
+			return nil, fmt.Errorf("selecting failed attempts: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func updateEntry(ctx context.Context, tx *sql.Tx, e Entry) error {
+	const q = `
+		UPDATE login_failures
+		SET failures = $2, first_failed_at = $3, last_failed_at = $4, next_attempt_at = $5, locked_until = $6
+		WHERE key = $1
+	`
+	lockedUntil := sql.NullTime{Time: e.LockedUntil, Valid: !e.LockedUntil.IsZero()}
+	_, err := tx.ExecContext(ctx, q, e.Key, e.Failures, e.FirstFailedAt, e.LastFailedAt, e.NextAttemptAt, lockedUntil)
+	if err != nil {
+		return fmt.Errorf("updating failed attempts: %w", err)
+	}
+	return nil
+}
+
+// Attempt starts an attempt counted by keys, such as an account's and an
+// address's. If any key is delayed or locked out, it returns a
+// *BlockedError, and the attempt isn't made. Otherwise the attempt counts as
+// failed until Succeeded says otherwise, so concurrent attempts can't all
+// slip through before any fails; it returns the keys it locked out.
+func (s *Store) Attempt(ctx context.Context, keys ...string) ([]string, error) {
+	now := time.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	// Forgotten failures needn't be kept.
+	const prune = `
+		DELETE FROM login_failures
+		WHERE last_failed_at < $1 AND (locked_until IS NULL OR locked_until < $2)
+	`
+	if _, err := tx.ExecContext(ctx, prune, now.Add(-s.policy.Window), now); err != nil {
+		return nil, fmt.Errorf("pruning failed attempts: %w", err)
+	}
+
+	entries, err := lockEntries(ctx, tx, keys, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key blocking longest is reported.
+	var blocked *BlockedError
+	for _, e := range entries {
+		var b *BlockedError
+		switch {
+		case e.Locked(now):
+			b = &BlockedError{Key: e.Key, Until: e.LockedUntil, Locked: true}
+		case now.Before(e.NextAttemptAt):
+			b = &BlockedError{Key: e.Key, Until: e.NextAttemptAt}
+		default:
+			continue
+		}
+		if blocked == nil || b.Until.After(blocked.Until) {
+			blocked = b
+		}
+	}
+	if blocked != nil {
+		return nil, blocked
+	}
+
+	var locked []string
+	for _, e := range entries {
+		if e.Failures == 0 || now.Sub(e.FirstFailedAt) > s.policy.Window {
+			e.Failures = 0
+			e.FirstFailedAt = now
+		}
+		e.Failures++
+		e.LastFailedAt = now
+		e.NextAttemptAt = now.Add(s.policy.Delay(e.Failures))
+		if e.Failures >= s.policy.limit(e.Key) {
+			e.LockedUntil = now.Add(s.policy.LockoutDuration)
+			e.NextAttemptAt = e.LockedUntil
+			locked = append(locked, e.Key)
+		}
+		if err := updateEntry(ctx, tx, e); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing attempt: %w", err)
+	}
+	return locked, nil
+}
+
+// Succeeded records that an attempt Attempt started with keys succeeded.
+// Accounts' failures are forgotten; addresses are just refunded the
+// attempt, since others may share them.
+func (s *Store) Succeeded(ctx context.Context, keys ...string) error {
+	now := time.Now()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	entries, err := lockEntries(ctx, tx, keys, now)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		if kind(e.Key) == KindAccount || e.Failures <= 1 {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM login_failures WHERE key = $1`, e.Key); err != nil {
+				return fmt.Errorf("forgetting failed attempts: %w", err)
+			}
+			continue
+		}
+
+		e.Failures--
+		e.NextAttemptAt = now.Add(s.policy.Delay(e.Failures))
+		if e.Failures < s.policy.limit(e.Key) {
+			e.LockedUntil = time.Time{}
+		}
+		if err := updateEntry(ctx, tx, e); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing success: %w", err)
+	}
+	return nil
+}
+
+// ListBlocked returns the keys delayed or locked out now, most recently
+// failed first.
+func (s *Store) ListBlocked(ctx context.Context) ([]Entry, error) {
+	q := `
+		SELECT ` + entryColumns + `
+		FROM login_failures
+		WHERE next_attempt_at > $1 OR locked_until > $1
+		ORDER BY last_failed_at DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, q, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("selecting blocked keys: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []Entry{}
+	for rows.Next() {
+		e, err := scanEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning blocked key: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating blocked keys: %w", err)
+	}
+	return entries, nil
+}
+
+// Unlock forgets the failed attempts counted by a key, ending any delay or
+// lockout, and returns them.
+func (s *Store) Unlock(ctx context.Context, key string) (Entry, error) {
+	q := `DELETE FROM login_failures WHERE key = $1 RETURNING ` + entryColumns
+
+	e, err := scanEntry(s.db.QueryRowContext(ctx, q, key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Entry{}, ErrNotFound
+	}
+	if err != nil {
+		return Entry{}, fmt.Errorf("unlocking: %w", err)
+	}
+	return e, nil
+}
@@ -0,0 +1,15 @@
+-- Failed attempts schema.
+-- This is what lockoutdb.go expects to exist.
+
+-- Failed password attempts, by account or IP address. Rows are pruned once
+-- their failures are forgotten and any lockout has ended.
+CREATE TABLE IF NOT EXISTS login_failures (
+	key             TEXT PRIMARY KEY,                                 -- "account:<email>" or "ip:<address>".
+	failures        INTEGER NOT NULL DEFAULT 0,
+	first_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	last_failed_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),  -- Attempts before are refused.
+	locked_until    TIMESTAMP WITH TIME ZONE                          -- NULL unless locked out.
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_failures_last_failed_at ON login_failures(last_failed_at);
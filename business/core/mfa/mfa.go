@@ -0,0 +1,126 @@
+// Package mfa manages users' second factor: the TOTP authenticator they
+// enrol, the one-time recovery codes for when they lose it, and the
+// short-lived challenges that finish logging in with it.
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotEnrolled      = errors.New("no authenticator enrolled; start enrolling first")
+	ErrNotEnabled       = errors.New("two-factor authentication is not enabled")
+	ErrAlreadyEnabled   = errors.New("two-factor authentication is already enabled")
+	ErrInvalidCode      = errors.New("invalid code")
+	ErrInvalidChallenge = errors.New("invalid or expired challenge; log in again")
+)
+
+// RecoveryCodeCount is how many recovery codes users get at a time.
+const RecoveryCodeCount = 10
+
+// ChallengeTTL is how long users have to enter their code after their
+// password.
+const ChallengeTTL = 5 * time.Minute
+
+// Status is whether a user has two-factor authentication on.
+type Status struct {
+	Enabled   bool
+	EnabledAt time.Time
+
+	// RecoveryCodesLeft is how many of their recovery codes are unused.
+	RecoveryCodesLeft int
+}
+
+// Verification is how a code was verified.
+type Verification struct {
+	// Whether it was a recovery code, now used up, rather than the
+	// authenticator's.
+	Recovery bool
+
+	RecoveryCodesLeft int
+}
+
+// Challenge is a login waiting for its second factor: the user entered
+// their password, and has ChallengeTTL to enter a code.
+type Challenge struct {
+	UserID string
+
+	// The workspace the login asked to be scoped to.
+	WorkspaceID int64
+
+	ExpiresAt time.Time
+}
+
+// recoveryEncoding writes recovery codes in lowercase base32, which is
+// easy to read back and type.
+var recoveryEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// newRecoveryCodes returns RecoveryCodeCount random codes, formatted
+// xxxx-xxxx-xxxx-xxxx, and the hashes that are stored. At 80 bits each,
+// a plain hash is safe to store.
+func newRecoveryCodes() ([]string, [][]byte, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([][]byte, RecoveryCodeCount)
+	for i := range codes {
+		secret := make([]byte, 10)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, nil, err
+		}
+		s := recoveryEncoding.EncodeToString(secret)
+		codes[i] = s[0:4] + "-" + s[4:8] + "-" + s[8:12] + "-" + s[12:16]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// normalizeCode strips the spaces and hyphens users may type in codes, and
+// lowercases them.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// hashRecoveryCode returns the hash a recovery code is stored and looked
+// up by.
+func hashRecoveryCode(code string) []byte {
+	sum := sha256.Sum256([]byte(normalizeCode(code)))
+	return sum[:]
+}
+
+// isTOTPCode reports whether a code is an authenticator's, rather than a
+// recovery code.
+func isTOTPCode(code string) bool {
+	code = normalizeCode(code)
+	if len(code) != 6 {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newChallengeToken returns a random challenge token and the hash that is
+// stored.
+func newChallengeToken() (string, []byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	return token, hashChallengeToken(token), nil
+}
+
+// hashChallengeToken returns the hash a challenge token is stored and
+// looked up by.
+func hashChallengeToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
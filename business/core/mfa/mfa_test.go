@@ -0,0 +1,69 @@
+package mfa
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		t.Fatalf("newRecoveryCodes: %v", err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("expected %d codes, got %d codes and %d hashes", RecoveryCodeCount, len(codes), len(hashes))
+	}
+
+	format := regexp.MustCompile(`^[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}-[a-z2-7]{4}$`)
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if !format.MatchString(code) {
+			t.Errorf("unexpected code format %q", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate code %q", code)
+		}
+		seen[code] = true
+		if !bytes.Equal(hashes[i], hashRecoveryCode(code)) {
+			t.Errorf("hash of %q doesn't match", code)
+		}
+		if isTOTPCode(code) {
+			t.Errorf("recovery code %q taken for an authenticator's", code)
+		}
+	}
+
+	t.Log("✅ Generated recovery codes")
+}
+
+func TestHashRecoveryCode(t *testing.T) {
+	want := hashRecoveryCode("abcd-efgh-ijkl-mnop")
+	for _, typed := range []string{"abcdefghijklmnop", "ABCD-EFGH-IJKL-MNOP", " abcd efgh ijkl mnop "} {
+		if !bytes.Equal(hashRecoveryCode(typed), want) {
+			t.Errorf("expected %q to match the code", typed)
+		}
+	}
+	if bytes.Equal(hashRecoveryCode("abcd-efgh-ijkl-mnoq"), want) {
+		t.Error("expected another code not to match")
+	}
+
+	t.Log("✅ Matched recovery codes however typed")
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	tests := map[string]bool{
+		"123456":              true,
+		"123 456":             true,
+		"12345":               false,
+		"1234567":             false,
+		"12345a":              false,
+		"abcd-efgh-ijkl-mnop": false,
+	}
+	for code, want := range tests {
+		if got := isTOTPCode(code); got != want {
+			t.Errorf("isTOTPCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+
+	t.Log("✅ Told authenticator codes from recovery codes")
+}
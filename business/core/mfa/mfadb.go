@@ -0,0 +1,349 @@
+package mfa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/sys/totp"
+)
+
+// Store manages storing users' authenticators, recovery codes and login
+// challenges in DB. Recovery codes and challenge tokens are stored hashed;
+// authenticator secrets can't be, since codes are checked against them.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore constructs an MFA store.
+func NewStore(db *sql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Status returns whether a user has two-factor authentication on.
+func (s *Store) Status(ctx context.Context, userID string) (Status, error) {
+	const q = `
+		SELECT t.confirmed_at,
+			(SELECT COUNT(*) FROM recovery_codes c WHERE c.user_id = t.user_id AND c.used_at IS NULL)
+		FROM user_totp t
+		WHERE t.user_id = $1 AND t.confirmed_at IS NOT NULL
+	`
+	var st Status
+	err := s.db.QueryRowContext(ctx, q, userID).Scan(&st.EnabledAt, &st.RecoveryCodesLeft)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Status{}, nil
+	}
+	if err != nil {
+		return Status{}, fmt.Errorf("selecting MFA status: %w", err)
+	}
+	st.Enabled = true
+	return st, nil
+}
+
+// Enroll starts enrolling an authenticator for a user, and returns its
+// secret, to be confirmed with a code from it. Enrolling again before
+// confirming replaces the secret.
+func (s *Store) Enroll(ctx context.Context, userID string) (string, error) {
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return "", fmt.Errorf("generating secret: %w", err)
+	}
+
+	const q = `
+		INSERT INTO user_totp (user_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE
+		SET secret = EXCLUDED.secret, last_step = 0, created_at = NOW()
+		WHERE user_totp.confirmed_at IS NULL
+	`
+	res, err := s.db.ExecContext(ctx, q, userID, secret)
+	if err != nil {
+		return "", fmt.Errorf("storing secret: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return "", ErrAlreadyEnabled
+	}
+	return secret, nil
+}
+
+// Confirm checks a code from the authenticator being enrolled, and if it's
+// right, turns two-factor authentication on and returns the user's
+// recovery codes. They're shown once: only their hashes are kept.
+func (s *Store) Confirm(ctx context.Context, userID, code string) ([]string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	const q = `SELECT secret, confirmed_at IS NOT NULL FROM user_totp WHERE user_id = $1 FOR UPDATE`
+	var secret string
+	var confirmed bool
+	err = tx.QueryRowContext(ctx, q, userID).Scan(&secret, &confirmed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotEnrolled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("selecting secret: %w", err)
+	}
+	if confirmed {
+		return nil, ErrAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(secret, normalizeCode(code), time.Now(), 0)
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	const confirm = `UPDATE user_totp SET confirmed_at = NOW(), last_step = $2 WHERE user_id = $1`
+	if _, err := tx.ExecContext(ctx, confirm, userID, step); err != nil {
+		return nil, fmt.Errorf("confirming authenticator: %w", err)
+	}
+
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing confirmation: %w", err)
+	}
+	return codes, nil
+}
+
+// replaceRecoveryCodes replaces a user's recovery codes with new ones
+// within tx, and returns them.
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID string) ([]string, error) {
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, fmt.Errorf("generating recovery codes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM recovery_codes WHERE user_id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("deleting recovery codes: %w", err)
+	}
+	for _, hash := range hashes {
+		const q = `INSERT INTO recovery_codes (user_id, code_hash) VALUES ($1, $2)`
+		if _, err := tx.ExecContext(ctx, q, userID, hash); err != nil {
+			return nil, fmt.Errorf("inserting recovery code: %w", err)
+		}
+	}
+	return codes, nil
+}
+
+// verify checks a code, from the user's authenticator or one of their
+// recovery codes, within tx. Each works once: authenticator codes up to the
+// last accepted one are rejected, and recovery codes are used up.
+func verify(ctx context.Context, tx *sql.Tx, userID, code string) (Verification, error) {
+	// Locking the authenticator makes concurrent uses of a code wait, and
+	// then see it used.
+	const q = `
+		SELECT secret, last_step FROM user_totp
+		WHERE user_id = $1 AND confirmed_at IS NOT NULL
+		FOR UPDATE
+	`
+	var secret string
+	var lastStep int64
+	err := tx.QueryRowContext(ctx, q, userID).Scan(&secret, &lastStep)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Verification{}, ErrNotEnabled
+	}
+	if err != nil {
+		return Verification{}, fmt.Errorf("selecting secret: %w", err)
+	}
+
+	var v Verification
+	if isTOTPCode(code) {
+		step, ok := totp.Validate(secret, normalizeCode(code), time.Now(), lastStep)
+		if !ok {
+			return Verification{}, ErrInvalidCode
+		}
+		const use = `UPDATE user_totp SET last_step = $2 WHERE user_id = $1`
+		if _, err := tx.ExecContext(ctx, use, userID, step); err != nil {
+			return Verification{}, fmt.Errorf("using code: %w", err)
+		}
+	} else {
+		const use = `
+			UPDATE recovery_codes SET used_at = NOW()
+			WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+		`
+		res, err := tx.ExecContext(ctx, use, userID, hashRecoveryCode(code))
+		if err != nil {
+			return Verification{}, fmt.Errorf("using recovery code: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return Verification{}, ErrInvalidCode
+		}
+		v.Recovery = true
+	}
+
+	const left = `SELECT COUNT(*) FROM recovery_codes WHERE user_id = $1 AND used_at IS NULL`
+	if err := tx.QueryRowContext(ctx, left, userID).Scan(&v.RecoveryCodesLeft); err != nil {
+		return Verification{}, fmt.Errorf("counting recovery codes: %w", err)
+	}
+	return v, nil
+}
+
+// Verify checks a code, from the user's authenticator or one of their
+// recovery codes, for confirming changes to their second factor.
+func (s *Store) Verify(ctx context.Context, userID, code string) (Verification, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Verification{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	v, err := verify(ctx, tx, userID, code)
+	if err != nil {
+		return Verification{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Verification{}, fmt.Errorf("committing verification: %w", err)
+	}
+	return v, nil
+}
+
+// RegenerateRecoveryCodes replaces a user's recovery codes, used or not,
+// with new ones, and returns them.
+func (s *Store) RegenerateRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	const q = `SELECT 1 FROM user_totp WHERE user_id = $1 AND confirmed_at IS NOT NULL FOR UPDATE`
+	var one int
+	err = tx.QueryRowContext(ctx, q, userID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotEnabled
+	}
+	if err != nil {
+		return nil, fmt.Errorf("selecting authenticator: %w", err)
+	}
+
+	codes, err := replaceRecoveryCodes(ctx, tx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+// Disable turns a user's two-factor authentication off, forgetting their
+// authenticator, recovery codes and pending challenges. Disabling it when
+// it's off does nothing.
+func (s *Store) Disable(ctx context.Context, userID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	for _, table := range []string{"user_totp", "recovery_codes", "mfa_challenges"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE user_id = $1`, userID); err != nil {
+			return fmt.Errorf("deleting %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing disabling: %w", err)
+	}
+	return nil
+}
+
+// CreateChallenge returns a token for a login waiting for a user's second
+// factor, which expires after ChallengeTTL. Expired challenges are pruned.
+func (s *Store) CreateChallenge(ctx context.Context, userID string, workspaceID int64) (string, Challenge, error) {
+	token, hash, err := newChallengeToken()
+	if err != nil {
+		return "", Challenge{}, fmt.Errorf("generating challenge: %w", err)
+	}
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM mfa_challenges WHERE expires_at < NOW()`); err != nil {
+		return "", Challenge{}, fmt.Errorf("pruning challenges: %w", err)
+	}
+
+	ch := Challenge{UserID: userID, WorkspaceID: workspaceID, ExpiresAt: time.Now().Add(ChallengeTTL)}
+	const q = `
+		INSERT INTO mfa_challenges (token_hash, user_id, workspace_id, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`
+	if _, err := s.db.ExecContext(ctx, q, hash, userID, workspaceID, ch.ExpiresAt); err != nil {
+		return "", Challenge{}, fmt.Errorf("inserting challenge: %w", err)
+	}
+	return token, ch, nil
+}
+
+// Challenge returns the login a challenge token is for, if it's unused and
+// unexpired.
+func (s *Store) Challenge(ctx context.Context, token string) (Challenge, error) {
+	const q = `
+		SELECT user_id, workspace_id, expires_at FROM mfa_challenges
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+	`
+	var ch Challenge
+	err := s.db.QueryRowContext(ctx, q, hashChallengeToken(token)).Scan(&ch.UserID, &ch.WorkspaceID, &ch.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Challenge{}, ErrInvalidChallenge
+	}
+	if err != nil {
+		return Challenge{}, fmt.Errorf("selecting challenge: %w", err)
+	}
+	return ch, nil
+}
+
+// CompleteChallenge checks a code for the user a challenge was created for,
+// and if it's right, uses the challenge up. A wrong code leaves it to try
+// again.
+func (s *Store) CompleteChallenge(ctx context.Context, token, code string) (Challenge, Verification, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Challenge{}, Verification{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	// Locking the challenge makes concurrent uses of it wait, and then see
+	// it used.
+	const q = `
+		SELECT user_id, workspace_id, expires_at FROM mfa_challenges
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+		FOR UPDATE
+	`
+	hash := hashChallengeToken(token)
+	var ch Challenge
+	err = tx.QueryRowContext(ctx, q, hash).Scan(&ch.UserID, &ch.WorkspaceID, &ch.ExpiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Challenge{}, Verification{}, ErrInvalidChallenge
+	}
+	if err != nil {
+		return Challenge{}, Verification{}, fmt.Errorf("selecting challenge: %w", err)
+	}
+
+	v, err := verify(ctx, tx, ch.UserID, code)
+	if errors.Is(err, ErrNotEnabled) {
+		// Turned off since the challenge was created.
+		return Challenge{}, Verification{}, ErrInvalidChallenge
+	}
+	if err != nil {
+		return Challenge{}, Verification{}, err
+	}
+
+	const use = `UPDATE mfa_challenges SET used_at = NOW() WHERE token_hash = $1`
+	if _, err := tx.ExecContext(ctx, use, hash); err != nil {
+		return Challenge{}, Verification{}, fmt.Errorf("using challenge: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Challenge{}, Verification{}, fmt.Errorf("committing challenge: %w", err)
+	}
+	return ch, v, nil
+}
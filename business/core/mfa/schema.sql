@@ -0,0 +1,33 @@
+-- MFA schema.
+-- This is what mfadb.go expects to exist, besides the users table.
+
+-- Users' TOTP authenticators. Each user has at most one.
+CREATE TABLE IF NOT EXISTS user_totp (
+	user_id      TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+	secret       TEXT NOT NULL,                                       -- Base32.
+	last_step    BIGINT NOT NULL DEFAULT 0,                           -- Of the last code accepted, so each works once.
+	created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	confirmed_at TIMESTAMP WITH TIME ZONE                             -- NULL until a code confirms enrolment.
+);
+
+-- One-time codes for users who lost their authenticator.
+CREATE TABLE IF NOT EXISTS recovery_codes (
+	user_id    TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	code_hash  BYTEA NOT NULL,                                        -- SHA-256 of the normalized code.
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	used_at    TIMESTAMP WITH TIME ZONE,
+	PRIMARY KEY (user_id, code_hash)
+);
+
+-- Logins waiting for their second factor.
+CREATE TABLE IF NOT EXISTS mfa_challenges (
+	token_hash   BYTEA PRIMARY KEY,                                   -- SHA-256 of the token.
+	user_id      TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	workspace_id BIGINT NOT NULL DEFAULT 0,
+	created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+	used_at      TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_user_id ON mfa_challenges(user_id);
+CREATE INDEX IF NOT EXISTS idx_mfa_challenges_expires_at ON mfa_challenges(expires_at);
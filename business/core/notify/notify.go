@@ -0,0 +1,203 @@
+// Package notify decides who hears about a task event and renders the
+// emails that tell them.
+//
+// Task events come from the task service over NATS. Each one yields a
+// Notification per interested user: the task's creator, its assignees and
+// its watchers, as long as they can see the task's board. Notifications
+// are batched per user, immediately or into hourly or daily digests, and
+// rendered by Render.
+//
+// The in-app inbox is narrower: Inbox picks the users who were mentioned,
+// assigned or watch the task.
+package notify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kind is what happened to a task, from a recipient's point of view.
+type Kind string
+
+const (
+	KindMentioned Kind = "mentioned"
+	KindAssigned  Kind = "assigned"
+	KindUpdated   Kind = "updated"
+	KindCompleted Kind = "completed"
+	KindDeleted   Kind = "deleted"
+	KindOverdue   Kind = "overdue"
+)
+
+// Event is the part of a task event that notifications use.
+type Event struct {
+	Type        string     `json:"type"`
+	WorkspaceID int64      `json:"workspace_id"`
+	TaskID      int64      `json:"task_id"`
+	BoardID     int64      `json:"board_id"`
+	Title       string     `json:"title"`
+	Completed   *bool      `json:"completed"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	CreatedBy   string     `json:"created_by"`
+
+	AssigneeIDs      []string `json:"assignee_ids"`
+	AddedAssigneeIDs []string `json:"added_assignee_ids"`
+
+	// Only the members of a board that isn't open hear about its tasks.
+	OpenBoard bool     `json:"open_board"`
+	MemberIDs []string `json:"member_ids"`
+}
+
+// Notification tells one user about one event.
+type Notification struct {
+	UserID  string
+	Kind    Kind
+	TaskID  int64
+	BoardID int64
+	Title   string
+	DueAt   *time.Time
+}
+
+// Recipients returns the notifications for e, given the task's watchers.
+// Each user gets at most one: new assignees hear they were assigned, the
+// creator, other assignees and watchers that the task changed. Overdue
+// tasks are announced to their assignees, or to the creator of
+// unassigned ones, and to watchers.
+func Recipients(e Event, watchers []string) []Notification {
+	creator := e.creator()
+	var r recipients
+	switch e.Type {
+	case "created":
+		// Creators know about their own tasks.
+		r.add(e, KindAssigned, without(e.AddedAssigneeIDs, creator))
+	case "updated":
+		r.add(e, KindAssigned, e.AddedAssigneeIDs)
+		r.add(e, e.changeKind(), creator, e.AssigneeIDs, watchers)
+	case "deleted":
+		r.add(e, KindDeleted, creator, e.AssigneeIDs, watchers)
+	case "overdue":
+		if len(e.AssigneeIDs) > 0 {
+			r.add(e, KindOverdue, e.AssigneeIDs, watchers)
+		} else {
+			r.add(e, KindOverdue, creator, watchers)
+		}
+	}
+	return r
+}
+
+// Inbox returns the in-app notifications for e, given the users newly
+// mentioned in the task and its watchers. Each user gets at most one, the
+// first that applies: mentioned users hear they were mentioned, new
+// assignees that they were assigned, and watchers that the task was
+// updated, completed or deleted. Creators aren't told about mentions or
+// assignments in tasks they create.
+func Inbox(e Event, mentioned, watchers []string) []Notification {
+	var r recipients
+	switch e.Type {
+	case "created":
+		creator := e.creator()
+		r.add(e, KindMentioned, without(mentioned, creator))
+		r.add(e, KindAssigned, without(e.AddedAssigneeIDs, creator))
+	case "updated":
+		r.add(e, KindMentioned, mentioned)
+		r.add(e, KindAssigned, e.AddedAssigneeIDs)
+		r.add(e, e.changeKind(), watchers)
+	case "deleted":
+		r.add(e, KindDeleted, watchers)
+	}
+	return r
+}
+
+// creator returns the ID of e's creator, if known.
+func (e Event) creator() []string {
+	if e.CreatedBy == "" {
+		return nil
+	}
+	return []string{e.CreatedBy}
+}
+
+// changeKind is the kind of an "updated" event.
+func (e Event) changeKind() Kind {
+	if e.Completed != nil && *e.Completed {
+		return KindCompleted
+	}
+	return KindUpdated
+}
+
+// recipients collects notifications about an event, one per user who can
+// see its board.
+type recipients []Notification
+
+func (r *recipients) add(e Event, kind Kind, userIDs ...[]string) {
+	for _, ids := range userIDs {
+		for _, id := range ids {
+			if id == "" || slices.ContainsFunc(*r, func(n Notification) bool { return n.UserID == id }) {
+				continue
+			}
+			if !e.OpenBoard && !slices.Contains(e.MemberIDs, id) {
+				continue
+			}
+			*r = append(*r, Notification{
+				UserID:  id,
+				Kind:    kind,
+				TaskID:  e.TaskID,
+				BoardID: e.BoardID,
+				Title:   e.Title,
+				DueAt:   e.DueAt,
+			})
+		}
+	}
+}
+
+// mentionPattern matches "@" and a user ID. The "@" must not follow a
+// letter, digit or one of "_.@", so email addresses aren't mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([\p{L}\p{N}_][\p{L}\p{N}_.-]*)`)
+
+// Mentions returns the users @mentioned in text, once each, in order of
+// first mention. Trailing dots and dashes, as at the end of a sentence,
+// aren't part of the user ID.
+func Mentions(text string) []string {
+	var userIDs []string
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		id := strings.TrimRight(m[1], ".-")
+		if id != "" && !slices.Contains(userIDs, id) {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs
+}
+
+// NewMentions returns the users mentioned in text but not in previous.
+func NewMentions(previous, text string) []string {
+	return without(Mentions(text), Mentions(previous))
+}
+
+// without returns the ids not in exclude.
+func without(ids, exclude []string) []string {
+	var out []string
+	for _, id := range ids {
+		if !slices.Contains(exclude, id) {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// UnsubscribeToken returns the token that lets userID turn off their
+// emails without logging in.
+func UnsubscribeToken(secret []byte, userID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("unsubscribe:" + userID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidUnsubscribeToken reports whether token is userID's unsubscribe
+// token.
+func ValidUnsubscribeToken(secret []byte, userID, token string) bool {
+	want := UnsubscribeToken(secret, userID)
+	return hmac.Equal([]byte(token), []byte(want))
+}
@@ -0,0 +1,235 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func kinds(notifications []Notification) map[string]Kind {
+	got := map[string]Kind{}
+	for _, n := range notifications {
+		got[n.UserID] = n.Kind
+	}
+	return got
+}
+
+func TestRecipients(t *testing.T) {
+	completed := true
+	tests := []struct {
+		name     string
+		event    Event
+		watchers []string
+		want     map[string]Kind
+	}{
+		{
+			name:  "created notifies assignees but not the creator",
+			event: Event{Type: "created", OpenBoard: true, CreatedBy: "7", AssigneeIDs: []string{"7", "bob"}, AddedAssigneeIDs: []string{"7", "bob"}},
+			want:  map[string]Kind{"bob": KindAssigned},
+		},
+		{
+			name:     "updated tells new assignees they were assigned",
+			event:    Event{Type: "updated", OpenBoard: true, CreatedBy: "7", AssigneeIDs: []string{"bob", "carol"}, AddedAssigneeIDs: []string{"carol"}},
+			watchers: []string{"dave", "bob"},
+			want:     map[string]Kind{"7": KindUpdated, "bob": KindUpdated, "carol": KindAssigned, "dave": KindUpdated},
+		},
+		{
+			name:  "completed",
+			event: Event{Type: "updated", OpenBoard: true, CreatedBy: "7", Completed: &completed},
+			want:  map[string]Kind{"7": KindCompleted},
+		},
+		{
+			name:     "overdue goes to assignees and watchers",
+			event:    Event{Type: "overdue", OpenBoard: true, CreatedBy: "7", AssigneeIDs: []string{"bob"}},
+			watchers: []string{"dave"},
+			want:     map[string]Kind{"bob": KindOverdue, "dave": KindOverdue},
+		},
+		{
+			name:  "overdue unassigned tasks go to the creator",
+			event: Event{Type: "overdue", OpenBoard: true, CreatedBy: "7"},
+			want:  map[string]Kind{"7": KindOverdue},
+		},
+		{
+			name:  "deleted",
+			event: Event{Type: "deleted", OpenBoard: true, AssigneeIDs: []string{"bob"}},
+			want:  map[string]Kind{"bob": KindDeleted},
+		},
+		{
+			name:     "closed boards only notify members",
+			event:    Event{Type: "updated", MemberIDs: []string{"bob"}, AssigneeIDs: []string{"bob", "carol"}},
+			watchers: []string{"dave"},
+			want:     map[string]Kind{"bob": KindUpdated},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := kinds(Recipients(tt.event, tt.watchers))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for user, kind := range tt.want {
+				if got[user] != kind {
+					t.Errorf("%s: got %q, want %q", user, got[user], kind)
+				}
+			}
+		})
+	}
+}
+
+func TestInbox(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     Event
+		mentioned []string
+		watchers  []string
+		want      map[string]Kind
+	}{
+		{
+			name:      "created skips the creator",
+			event:     Event{Type: "created", OpenBoard: true, CreatedBy: "7", AddedAssigneeIDs: []string{"7", "bob"}},
+			mentioned: []string{"7", "carol"},
+			want:      map[string]Kind{"bob": KindAssigned, "carol": KindMentioned},
+		},
+		{
+			name:      "mentions come first",
+			event:     Event{Type: "updated", OpenBoard: true, CreatedBy: "7", AssigneeIDs: []string{"bob"}, AddedAssigneeIDs: []string{"bob"}},
+			mentioned: []string{"bob"},
+			watchers:  []string{"bob", "dave"},
+			want:      map[string]Kind{"bob": KindMentioned, "dave": KindUpdated},
+		},
+		{
+			name:     "updated tells only watchers of changes",
+			event:    Event{Type: "updated", OpenBoard: true, CreatedBy: "7", AssigneeIDs: []string{"bob"}},
+			watchers: []string{"dave"},
+			want:     map[string]Kind{"dave": KindUpdated},
+		},
+		{
+			name:     "deleted",
+			event:    Event{Type: "deleted", OpenBoard: true, AssigneeIDs: []string{"bob"}},
+			watchers: []string{"dave"},
+			want:     map[string]Kind{"dave": KindDeleted},
+		},
+		{
+			name:     "overdue",
+			event:    Event{Type: "overdue", OpenBoard: true, AssigneeIDs: []string{"bob"}},
+			watchers: []string{"dave"},
+			want:     map[string]Kind{},
+		},
+		{
+			name:      "closed boards only notify members",
+			event:     Event{Type: "updated", MemberIDs: []string{"bob"}},
+			mentioned: []string{"bob", "carol"},
+			want:      map[string]Kind{"bob": KindMentioned},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := kinds(Inbox(tt.event, tt.mentioned, tt.watchers))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for user, kind := range tt.want {
+				if got[user] != kind {
+					t.Errorf("%s: got %q, want %q", user, got[user], kind)
+				}
+			}
+		})
+	}
+}
+
+func TestMentions(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"", nil},
+		{"@alice please look", []string{"alice"}},
+		{"cc @bob, @carol.", []string{"bob", "carol"}},
+		{"(@dave) and @dave again", []string{"dave"}},
+		{"ask @first.last-name", []string{"first.last-name"}},
+		{"mail alice@example.com", nil},
+		{"@ nobody, @@twice", nil},
+		{"thanks @zoë!", []string{"zoë"}},
+	}
+
+	for _, tt := range tests {
+		got := Mentions(tt.text)
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("Mentions(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestNewMentions(t *testing.T) {
+	got := NewMentions("@alice and @bob", "@bob, @carol and @alice")
+	if len(got) != 1 || got[0] != "carol" {
+		t.Errorf("got %q, want [carol]", got)
+	}
+}
+
+func TestUnsubscribeToken(t *testing.T) {
+	secret := []byte("secret")
+	token := UnsubscribeToken(secret, "alice")
+
+	if !ValidUnsubscribeToken(secret, "alice", token) {
+		t.Error("expected token to be valid")
+	}
+	if ValidUnsubscribeToken(secret, "bob", token) {
+		t.Error("expected token to be invalid for another user")
+	}
+	if ValidUnsubscribeToken([]byte("other"), "alice", token) {
+		t.Error("expected token to be invalid with another secret")
+	}
+}
+
+func TestRender(t *testing.T) {
+	due := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	d := Digest{
+		Name: "Alice",
+		Items: []Notification{
+			{Kind: KindUpdated, TaskID: 1, Title: "Old title"},
+			{Kind: KindOverdue, TaskID: 2, Title: "<Taxes>", DueAt: &due},
+			{Kind: KindUpdated, TaskID: 1, Title: "New title"},
+		},
+		TaskURL:        "https://tasks.example.com/tasks/{id}",
+		UnsubscribeURL: "https://tasks.example.com/unsubscribe?user=alice&token=x",
+	}
+
+	email, err := Render(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if email.Subject != "2 task updates" {
+		t.Errorf("unexpected subject %q", email.Subject)
+	}
+	for _, want := range []string{
+		"“<Taxes>” is overdue; it was due Mon, 10 Mar 2025 09:00 UTC",
+		"https://tasks.example.com/tasks/2",
+		"“New title” was updated",
+		d.UnsubscribeURL,
+	} {
+		if !strings.Contains(email.Text, want) {
+			t.Errorf("text is missing %q:\n%s", want, email.Text)
+		}
+	}
+	if strings.Contains(email.Text, "Old title") {
+		t.Errorf("expected repeated updates to be collapsed:\n%s", email.Text)
+	}
+	if !strings.Contains(email.HTML, "&lt;Taxes&gt;") || strings.Contains(email.HTML, "<Taxes>") {
+		t.Errorf("expected HTML to escape titles:\n%s", email.HTML)
+	}
+	if !strings.Contains(email.HTML, `href="https://tasks.example.com/tasks/1"`) {
+		t.Errorf("expected task links in HTML:\n%s", email.HTML)
+	}
+
+	single, err := Render(Digest{Items: []Notification{{Kind: KindAssigned, TaskID: 3, Title: "Review"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.Subject != "You were assigned: Review" || strings.Contains(single.Text, "http") {
+		t.Errorf("unexpected single email: %+v", single)
+	}
+}
@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Digest is one email's worth of notifications for a user.
+type Digest struct {
+	// Name is the recipient's display name.
+	Name  string
+	Items []Notification
+
+	// TaskURL links to a task when "{id}" is replaced by its ID. Emails
+	// have no links if it's empty.
+	TaskURL        string
+	UnsubscribeURL string
+}
+
+// Email is a rendered digest.
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+var (
+	textTemplate = texttemplate.Must(texttemplate.New("digest.txt.tmpl").Funcs(texttemplate.FuncMap{
+		"describe": describe,
+		"link":     func(int64) string { return "" },
+	}).ParseFS(templateFS, "templates/digest.txt.tmpl"))
+
+	htmlTemplate = htmltemplate.Must(htmltemplate.New("digest.html.tmpl").Funcs(htmltemplate.FuncMap{
+		"describe": describe,
+		"link":     func(int64) string { return "" },
+	}).ParseFS(templateFS, "templates/digest.html.tmpl"))
+)
+
+// Render renders d as an email. Repeated notifications of the same kind
+// about a task are shown once, with its latest title.
+func Render(d Digest) (Email, error) {
+	d.Items = collapse(d.Items)
+	if len(d.Items) == 0 {
+		return Email{}, fmt.Errorf("digest has no notifications")
+	}
+
+	link := func(taskID int64) string {
+		if d.TaskURL == "" {
+			return ""
+		}
+		return strings.ReplaceAll(d.TaskURL, "{id}", strconv.FormatInt(taskID, 10))
+	}
+
+	var text, html bytes.Buffer
+	textTmpl := texttemplate.Must(textTemplate.Clone()).Funcs(texttemplate.FuncMap{"link": link})
+	if err := textTmpl.Execute(&text, d); err != nil {
+		return Email{}, fmt.Errorf("rendering text: %w", err)
+	}
+	htmlTmpl := htmltemplate.Must(htmlTemplate.Clone()).Funcs(htmltemplate.FuncMap{"link": link})
+	if err := htmlTmpl.Execute(&html, d); err != nil {
+		return Email{}, fmt.Errorf("rendering HTML: %w", err)
+	}
+
+	return Email{Subject: subject(d.Items), Text: text.String(), HTML: html.String()}, nil
+}
+
+// collapse keeps the last of each task's notifications of a kind, in the
+// order they were last seen.
+func collapse(items []Notification) []Notification {
+	type key struct {
+		taskID int64
+		kind   Kind
+	}
+	last := map[key]int{}
+	for i, n := range items {
+		last[key{n.TaskID, n.Kind}] = i
+	}
+	var out []Notification
+	for i, n := range items {
+		if last[key{n.TaskID, n.Kind}] == i {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func subject(items []Notification) string {
+	if len(items) > 1 {
+		return fmt.Sprintf("%d task updates", len(items))
+	}
+	n := items[0]
+	switch n.Kind {
+	case KindMentioned:
+		return "You were mentioned: " + n.Title
+	case KindAssigned:
+		return "You were assigned: " + n.Title
+	case KindCompleted:
+		return "Completed: " + n.Title
+	case KindDeleted:
+		return "Deleted: " + n.Title
+	case KindOverdue:
+		return "Overdue: " + n.Title
+	default:
+		return "Updated: " + n.Title
+	}
+}
+
+// describe returns a sentence about n.
+func describe(n Notification) string {
+	switch n.Kind {
+	case KindMentioned:
+		return fmt.Sprintf("You were mentioned in “%s”", n.Title)
+	case KindAssigned:
+		return fmt.Sprintf("You were assigned “%s”", n.Title)
+	case KindCompleted:
+		return fmt.Sprintf("“%s” was completed", n.Title)
+	case KindDeleted:
+		return fmt.Sprintf("“%s” was deleted", n.Title)
+	case KindOverdue:
+		if n.DueAt != nil {
+			return fmt.Sprintf("“%s” is overdue; it was due %s", n.Title, n.DueAt.UTC().Format("Mon, 2 Jan 2006 15:04 MST"))
+		}
+		return fmt.Sprintf("“%s” is overdue", n.Title)
+	default:
+		return fmt.Sprintf("“%s” was updated", n.Title)
+	}
+}
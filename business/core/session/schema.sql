@@ -0,0 +1,35 @@
+-- Sessions schema.
+-- This is what sessiondb.go expects to exist, besides the users table.
+
+CREATE TABLE IF NOT EXISTS sessions (
+	id           TEXT PRIMARY KEY,                                    -- UUID as string.
+	user_id      TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	user_agent   TEXT NOT NULL DEFAULT '',
+	ip           TEXT NOT NULL DEFAULT '',
+	created_at   TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	expires_at   TIMESTAMP WITH TIME ZONE NOT NULL,                  -- Of the current refresh token.
+	revoked_at   TIMESTAMP WITH TIME ZONE,
+	mfa          BOOLEAN NOT NULL DEFAULT FALSE                      -- Signed in with a second factor.
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+
+-- Every refresh token a session has had. Used ones are kept to detect reuse.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+	token_hash BYTEA PRIMARY KEY,                                      -- SHA-256 of the token.
+	session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	used_at    TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+
+-- Access tokens revoked before they expire, by jti.
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+	jti        TEXT PRIMARY KEY,
+	expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
@@ -0,0 +1,52 @@
+// Package session manages login sessions: the rotating refresh tokens that
+// keep a user logged in, and the access tokens revoked before they expire.
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotFound     = errors.New("session not found")
+	ErrInvalidToken = errors.New("invalid or expired refresh token")
+	ErrTokenReused  = errors.New("refresh token reused; session revoked")
+)
+
+// Session is a login on one device. Each refresh replaces its refresh
+// token; the tokens a session has had form a family, and presenting one
+// that was already replaced revokes the session, since one of its holders
+// must have stolen it.
+type Session struct {
+	ID         string
+	UserID     string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+
+	// When the current refresh token expires.
+	ExpiresAt time.Time
+
+	// Whether the user signed in with a second factor.
+	MFA bool
+}
+
+// NewToken returns a random refresh token and the hash that is stored.
+func NewToken() (string, []byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+	return token, HashToken(token), nil
+}
+
+// HashToken returns the hash a refresh token is stored and looked up by.
+func HashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,28 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewToken(t *testing.T) {
+	token, hash, err := NewToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	if token == "" {
+		t.Error("expected token, got empty string")
+	}
+
+	if !bytes.Equal(hash, HashToken(token)) {
+		t.Error("expected hash to be the token's hash")
+	}
+
+	other, _, _ := NewToken()
+	if other == token {
+		t.Error("expected tokens to differ")
+	}
+
+	t.Log("✅ Generated refresh token")
+}
@@ -0,0 +1,293 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+)
+
+// Store manages storing sessions in DB. It is also the auth.Revoker of
+// access tokens.
+type Store struct {
+	db *sql.DB
+}
+
+var _ auth.Revoker = (*Store)(nil)
+
+// NewStore constructs a session store.
+func NewStore(db *sql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Create starts a session for sess.UserID, signed in with a second factor if
+// sess.MFA, whose refresh token expires after ttl, and returns the token.
+// It fills in the other fields of sess.
+func (s *Store) Create(ctx context.Context, sess *Session, ttl time.Duration) (string, error) {
+	token, hash, err := NewToken()
+	if err != nil {
+		return "", fmt.Errorf("generating refresh token: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	// Forget the user's sessions that can no longer be used.
+	const prune = `
+		DELETE FROM sessions
+		WHERE user_id = $1 AND (expires_at < NOW() OR revoked_at IS NOT NULL)
+	`
+	if _, err := tx.ExecContext(ctx, prune, sess.UserID); err != nil {
+		return "", fmt.Errorf("pruning sessions: %w", err)
+	}
+
+	const q = `
+		INSERT INTO sessions (id, user_id, user_agent, ip, expires_at, mfa)
+		VALUES ($1, $2, $3, $4, NOW() + $5 * INTERVAL '1 second', $6)
+		RETURNING created_at, last_used_at, expires_at
+	`
+	sess.ID = uuid.New().String()
+	err = tx.QueryRowContext(ctx, q, sess.ID, sess.UserID, sess.UserAgent, sess.IP, ttl.Seconds(), sess.MFA).
+		Scan(&sess.CreatedAt, &sess.LastUsedAt, &sess.ExpiresAt)
+	if err != nil {
+		return "", fmt.Errorf("inserting session: %w", err)
+	}
+
+	if err := insertToken(ctx, tx, hash, sess.ID, sess.ExpiresAt); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing session: %w", err)
+	}
+	return token, nil
+}
+
+func insertToken(ctx context.Context, tx *sql.Tx, hash []byte, sessionID string, expiresAt time.Time) error {
+	const q = `
+		INSERT INTO refresh_tokens (token_hash, session_id, expires_at)
+		VALUES ($1, $2, $3)
+	`
+	if _, err := tx.ExecContext(ctx, q, hash, sessionID, expiresAt); err != nil {
+		return fmt.Errorf("inserting refresh token: %w", err)
+	}
+	return nil
+}
+
+// Refresh replaces a refresh token with a new one, which expires after
+// ttl, and returns it with its session. Presenting a token that was
+// already replaced revokes its session and returns ErrTokenReused.
+func (s *Store) Refresh(ctx context.Context, token string, ttl time.Duration) (Session, string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Session{}, "", fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	// Locking the token makes concurrent refreshes with it wait, and then
+	// see it used.
+	const q = `
+		SELECT s.id, s.user_id, s.user_agent, s.ip, s.created_at, s.mfa,
+			r.used_at IS NOT NULL, r.expires_at < NOW() OR s.revoked_at IS NOT NULL
+		FROM refresh_tokens r
+		JOIN sessions s ON s.id = r.session_id
+		WHERE r.token_hash = $1
+		FOR UPDATE OF r
+	`
+	var sess Session
+	var used, dead bool
+	err = tx.QueryRowContext(ctx, q, HashToken(token)).
+		Scan(&sess.ID, &sess.UserID, &sess.UserAgent, &sess.IP, &sess.CreatedAt, &sess.MFA, &used, &dead)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Session{}, "", ErrInvalidToken
+	}
+	if err != nil {
+		return Session{}, "", fmt.Errorf("selecting refresh token: %w", err)
+	}
+
+	switch {
+	case dead:
+		return Session{}, "", ErrInvalidToken
+	case used:
+		const revoke = `UPDATE sessions SET revoked_at = NOW() WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, revoke, sess.ID); err != nil {
+			return Session{}, "", fmt.Errorf("revoking session: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return Session{}, "", fmt.Errorf("committing revocation: %w", err)
+		}
+		return Session{}, "", ErrTokenReused
+	}
+
+	const use = `UPDATE refresh_tokens SET used_at = NOW() WHERE token_hash = $1`
+	if _, err := tx.ExecContext(ctx, use, HashToken(token)); err != nil {
+		return Session{}, "", fmt.Errorf("using refresh token: %w", err)
+	}
+
+	const extend = `
+		UPDATE sessions
+		SET last_used_at = NOW(), expires_at = NOW() + $2 * INTERVAL '1 second'
+		WHERE id = $1
+		RETURNING last_used_at, expires_at
+	`
+	if err := tx.QueryRowContext(ctx, extend, sess.ID, ttl.Seconds()).Scan(&sess.LastUsedAt, &sess.ExpiresAt); err != nil {
+		return Session{}, "", fmt.Errorf("extending session: %w", err)
+	}
+
+	newToken, hash, err := NewToken()
+	if err != nil {
+		return Session{}, "", fmt.Errorf("generating refresh token: %w", err)
+	}
+	if err := insertToken(ctx, tx, hash, sess.ID, sess.ExpiresAt); err != nil {
+		return Session{}, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Session{}, "", fmt.Errorf("committing refresh: %w", err)
+	}
+	return sess, newToken, nil
+}
+
+// List returns a user's active sessions, most recently used first.
+func (s *Store) List(ctx context.Context, userID string) ([]Session, error) {
+	const q = `
+		SELECT id, user_id, user_agent, ip, created_at, last_used_at, expires_at, mfa
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY last_used_at DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []Session
+	for rows.Next() {
+		var sess Session
+		err := rows.Scan(&sess.ID, &sess.UserID, &sess.UserAgent, &sess.IP,
+			&sess.CreatedAt, &sess.LastUsedAt, &sess.ExpiresAt, &sess.MFA)
+		if err != nil {
+			return nil, fmt.Errorf("scanning session: %w", err)
+		}
+		sessions = append(sessions, sess)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// Get returns one of a user's active sessions, or ErrNotFound.
+func (s *Store) Get(ctx context.Context, userID, id string) (Session, error) {
+	const q = `
+		SELECT id, user_id, user_agent, ip, created_at, last_used_at, expires_at, mfa
+		FROM sessions
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL AND expires_at > NOW()
+	`
+
+	var sess Session
+	err := s.db.QueryRowContext(ctx, q, id, userID).Scan(&sess.ID, &sess.UserID, &sess.UserAgent, &sess.IP,
+		&sess.CreatedAt, &sess.LastUsedAt, &sess.ExpiresAt, &sess.MFA)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Session{}, ErrNotFound
+	}
+	if err != nil {
+		return Session{}, fmt.Errorf("selecting session: %w", err)
+	}
+	return sess, nil
+}
+
+// Revoke ends one of a user's sessions. Its refresh token stops working,
+// and so do the access tokens issued for it.
+func (s *Store) Revoke(ctx context.Context, userID, id string) error {
+	const q = `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := s.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		return fmt.Errorf("revoking session: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("revoking session: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RevokeAll ends all of a user's sessions but except, which may be "", as
+// when their password changes.
+func (s *Store) RevokeAll(ctx context.Context, userID, except string) error {
+	const q = `
+		UPDATE sessions SET revoked_at = NOW()
+		WHERE user_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`
+
+	if _, err := s.db.ExecContext(ctx, q, userID, except); err != nil {
+		return fmt.Errorf("revoking sessions: %w", err)
+	}
+	return nil
+}
+
+// ClearMFA marks all of a user's sessions as signed in without a second
+// factor, as when they turn it off. Access tokens issued since say so.
+func (s *Store) ClearMFA(ctx context.Context, userID string) error {
+	const q = `UPDATE sessions SET mfa = FALSE WHERE user_id = $1 AND mfa`
+
+	if _, err := s.db.ExecContext(ctx, q, userID); err != nil {
+		return fmt.Errorf("clearing sessions' MFA: %w", err)
+	}
+	return nil
+}
+
+// RevokeToken revokes the access token with ID jti, which expires at
+// expiresAt. Revocations are forgotten once their tokens have expired.
+func (s *Store) RevokeToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	const prune = `DELETE FROM revoked_tokens WHERE expires_at < NOW()`
+	if _, err := s.db.ExecContext(ctx, prune); err != nil {
+		return fmt.Errorf("pruning revoked tokens: %w", err)
+	}
+
+	const q = `
+		INSERT INTO revoked_tokens (jti, expires_at)
+		VALUES ($1, $2)
+		ON CONFLICT (jti) DO NOTHING
+	`
+	if _, err := s.db.ExecContext(ctx, q, jti, expiresAt); err != nil {
+		return fmt.Errorf("inserting revoked token: %w", err)
+	}
+	return nil
+}
+
+// Revoked reports whether an access token was revoked, on its own or with
+// its session.
+func (s *Store) Revoked(ctx context.Context, claims *auth.TokenClaims) (bool, error) {
+	const q = `
+		SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)
+			OR ($2::text <> '' AND NOT EXISTS (
+				SELECT 1 FROM sessions WHERE id = $2 AND revoked_at IS NULL
+			))
+	`
+
+	var revoked bool
+	if err := s.db.QueryRowContext(ctx, q, claims.ID, claims.SessionID).Scan(&revoked); err != nil {
+		return false, fmt.Errorf("checking revocation: %w", err)
+	}
+	return revoked, nil
+}
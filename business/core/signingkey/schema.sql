@@ -0,0 +1,12 @@
+-- Signing keys schema.
+-- This is what signingkey.go expects to exist.
+
+-- The keys access tokens are signed with, shared by all gateway replicas.
+CREATE TABLE IF NOT EXISTS signing_keys (
+	kid         TEXT PRIMARY KEY,                                     -- UUID as string.
+	algorithm   TEXT NOT NULL,                                        -- RS256 or EdDSA.
+	private_key BYTEA NOT NULL,                                       -- PKCS #8, DER.
+	not_before  TIMESTAMP WITH TIME ZONE NOT NULL,                    -- Signs from.
+	not_after   TIMESTAMP WITH TIME ZONE,                             -- Verifies until; NULL until succeeded.
+	created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
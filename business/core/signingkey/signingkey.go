@@ -0,0 +1,144 @@
+// Package signingkey stores the keys access tokens are signed with, so that
+// all gateway replicas sign with, and publish, the same keys, and rotates
+// them.
+package signingkey
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+)
+
+// Store manages storing signing keys in DB. Private keys are stored
+// unencrypted, so access to the DB is access to them.
+type Store struct {
+	db *sql.DB
+}
+
+// NewStore constructs a signing key store.
+func NewStore(db *sql.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// Rotate adds or updates keys as the rotation policy says is due at now,
+// and returns the keys that haven't expired. Replicas rotating at once
+// take turns, so only one of them adds a successor.
+func (s *Store) Rotate(ctx context.Context, policy auth.Rotation, now time.Time) ([]auth.Key, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if _, err := tx.ExecContext(ctx, `LOCK TABLE signing_keys IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return nil, fmt.Errorf("locking signing keys: %w", err)
+	}
+
+	// Forget the keys no token can be verified with any more.
+	const prune = `DELETE FROM signing_keys WHERE not_after <= $1`
+	if _, err := tx.ExecContext(ctx, prune, now); err != nil {
+		return nil, fmt.Errorf("pruning signing keys: %w", err)
+	}
+
+	keys, err := queryKeys(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := policy.Next(keys, now)
+	if err != nil {
+		return nil, fmt.Errorf("rotating signing keys: %w", err)
+	}
+	if len(changed) == 0 {
+		return keys, nil
+	}
+
+	const q = `
+		INSERT INTO signing_keys (kid, algorithm, private_key, not_before, not_after)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (kid) DO UPDATE SET not_after = EXCLUDED.not_after
+	`
+	for _, k := range changed {
+		der, err := x509.MarshalPKCS8PrivateKey(k.Private)
+		if err != nil {
+			return nil, fmt.Errorf("encoding signing key %s: %w", k.ID, err)
+		}
+		var notAfter sql.NullTime
+		if !k.NotAfter.IsZero() {
+			notAfter = sql.NullTime{Time: k.NotAfter, Valid: true}
+		}
+		if _, err := tx.ExecContext(ctx, q, k.ID, k.Algorithm, der, k.NotBefore, notAfter); err != nil {
+			return nil, fmt.Errorf("storing signing key %s: %w", k.ID, err)
+		}
+	}
+
+	keys, err = queryKeys(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+// Keys returns the stored keys, oldest first, without rotating them, for
+// tools that sign as the gateway.
+func (s *Store) Keys(ctx context.Context) ([]auth.Key, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	return queryKeys(ctx, tx)
+}
+
+// queryKeys returns all stored keys, oldest first.
+func queryKeys(ctx context.Context, tx *sql.Tx) ([]auth.Key, error) {
+	const q = `
+		SELECT kid, algorithm, private_key, not_before, not_after
+		FROM signing_keys
+		ORDER BY not_before
+	`
+	rows, err := tx.QueryContext(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("selecting signing keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []auth.Key
+	for rows.Next() {
+		var k auth.Key
+		var der []byte
+		var notAfter sql.NullTime
+		if err := rows.Scan(&k.ID, &k.Algorithm, &der, &k.NotBefore, &notAfter); err != nil {
+			return nil, fmt.Errorf("scanning signing key: %w", err)
+		}
+
+		private, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("decoding signing key %s: %w", k.ID, err)
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("decoding signing key %s: unsupported key type %T", k.ID, private)
+		}
+		k.Private = signer
+		k.Public = signer.Public()
+		k.NotAfter = notAfter.Time
+
+		keys = append(keys, k)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating signing keys: %w", err)
+	}
+	return keys, nil
+}
@@ -0,0 +1,434 @@
+// Package taskquery parses the task filter language used to list tasks and
+// define saved views, for example
+//
+//	status:open assignee:me label:bug due<7d "login page"
+//
+// A query is a list of terms separated by spaces, all of which must match.
+// A term is either free text, matched against title and description, or a
+// field condition such as label:bug. Prefixing a term with - negates it.
+//
+// Parsing resolves "me" and relative dates, so a parsed Query can be turned
+// into SQL by the repository or matched against a task in memory.
+package taskquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Field is what a term matches on.
+type Field string
+
+const (
+	FieldText     Field = "text"
+	FieldStatus   Field = "status"
+	FieldAssignee Field = "assignee"
+	FieldLabel    Field = "label"
+	FieldDue      Field = "due"
+	FieldCreated  Field = "created"
+	FieldUpdated  Field = "updated"
+)
+
+// Term is one condition of a query.
+type Term struct {
+	Field  Field
+	Negate bool
+
+	// Value is the text, label or assignee user ID to match.
+	Value string
+
+	// None matches tasks without any assignee, label or due date.
+	None bool
+
+	// Completed is the wanted state for FieldStatus.
+	Completed bool
+
+	// After and Before bound time fields: After <= t < Before. Either
+	// may be nil for an open range.
+	After  *time.Time
+	Before *time.Time
+}
+
+// Query is a parsed filter. A task matches if every term matches.
+type Query struct {
+	Terms []Term
+}
+
+// Env holds what a query is resolved against.
+type Env struct {
+	// Now anchors relative dates such as 7d or today.
+	Now time.Time
+
+	// UserID replaces "me". Queries using "me" fail without it.
+	UserID string
+}
+
+// ParseError reports where and why a query could not be parsed.
+type ParseError struct {
+	// Pos is the byte offset of the offending term, from 0.
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("query column %d: %s", e.Pos+1, e.Msg)
+}
+
+// Parse parses s. An empty query matches every task.
+func Parse(s string, env Env) (*Query, error) {
+	p := parser{src: s, env: env}
+	q := &Query{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return q, nil
+		}
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		q.Terms = append(q.Terms, term)
+	}
+}
+
+type parser struct {
+	src string
+	pos int
+	env Env
+}
+
+func (p *parser) errorf(pos int, format string, args ...any) error {
+	return &ParseError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += size
+	}
+}
+
+func (p *parser) atSpace() bool {
+	if p.pos >= len(p.src) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return unicode.IsSpace(r)
+}
+
+// word reads up to the next space.
+func (p *parser) word() string {
+	start := p.pos
+	for !p.atSpace() {
+		_, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		p.pos += size
+	}
+	return p.src[start:p.pos]
+}
+
+// quoted reads a double quoted string. Backslash escapes the next byte.
+func (p *parser) quoted() (string, error) {
+	start := p.pos
+	p.pos++ // Opening quote.
+
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\\' && p.pos+1 < len(p.src):
+			b.WriteByte(p.src[p.pos+1])
+			p.pos += 2
+		case c == '"':
+			p.pos++
+			if !p.atSpace() {
+				return "", p.errorf(p.pos, "expected a space after closing quote")
+			}
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf(start, "unterminated quote")
+}
+
+// value reads a bare or quoted value.
+func (p *parser) value() (string, error) {
+	if p.pos < len(p.src) && p.src[p.pos] == '"' {
+		return p.quoted()
+	}
+	return p.word(), nil
+}
+
+func (p *parser) term() (Term, error) {
+	start := p.pos
+	term := Term{}
+
+	if p.src[p.pos] == '-' {
+		p.pos++
+		if p.atSpace() {
+			return term, p.errorf(start, "- must be followed by a term")
+		}
+		term.Negate = true
+	}
+
+	if p.src[p.pos] == '"' {
+		text, err := p.quoted()
+		if err != nil {
+			return term, err
+		}
+		if text == "" {
+			return term, p.errorf(start, "empty quoted text")
+		}
+		term.Field, term.Value = FieldText, text
+		return term, nil
+	}
+
+	// A field name is a run of letters followed by an operator.
+	keyStart := p.pos
+	for p.pos < len(p.src) && isKeyByte(p.src[p.pos]) {
+		p.pos++
+	}
+	key := p.src[keyStart:p.pos]
+	op := p.operator()
+	if key == "" || op == "" {
+		// Plain text.
+		p.pos = keyStart
+		term.Field, term.Value = FieldText, p.word()
+		return term, nil
+	}
+
+	valueStart := p.pos
+	value, err := p.value()
+	if err != nil {
+		return term, err
+	}
+	if value == "" {
+		return term, p.errorf(valueStart, "missing value after %s%s", key, op)
+	}
+
+	if err := p.resolve(&term, strings.ToLower(key), op, value, start); err != nil {
+		return term, err
+	}
+	return term, nil
+}
+
+func isKeyByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// operator reads :, =, <, <=, > or >=, returning "" if there is none.
+func (p *parser) operator() string {
+	if p.pos >= len(p.src) {
+		return ""
+	}
+	switch c := p.src[p.pos]; c {
+	case ':', '=':
+		p.pos++
+		return string(c)
+	case '<', '>':
+		p.pos++
+		if p.pos < len(p.src) && p.src[p.pos] == '=' {
+			p.pos++
+			return string(c) + "="
+		}
+		return string(c)
+	}
+	return ""
+}
+
+func (p *parser) resolve(term *Term, key, op, value string, pos int) error {
+	equality := op == ":" || op == "="
+
+	switch Field(key) {
+	case FieldStatus:
+		if !equality {
+			return p.errorf(pos, "status only supports status:open or status:done")
+		}
+		term.Field = FieldStatus
+		switch strings.ToLower(value) {
+		case "open":
+			term.Completed = false
+		case "done", "closed", "completed":
+			term.Completed = true
+		default:
+			return p.errorf(pos, "unknown status %q, expected open or done", value)
+		}
+
+	case FieldAssignee, FieldLabel:
+		if !equality {
+			return p.errorf(pos, "%s only supports %s:value", key, key)
+		}
+		term.Field = Field(key)
+		switch {
+		case value == "none":
+			term.None = true
+		case key == string(FieldAssignee) && value == "me":
+			if p.env.UserID == "" {
+				return p.errorf(pos, "assignee:me needs a signed-in user")
+			}
+			term.Value = p.env.UserID
+		default:
+			term.Value = value
+		}
+
+	case FieldDue, FieldCreated, FieldUpdated:
+		term.Field = Field(key)
+		if value == "none" {
+			if key != string(FieldDue) || !equality {
+				return p.errorf(pos, "only due:none is supported")
+			}
+			term.None = true
+			return nil
+		}
+		from, to, err := p.timeRange(value)
+		if err != nil {
+			return p.errorf(pos, "%s: %v", key, err)
+		}
+		switch op {
+		case "<":
+			term.Before = &from
+		case "<=":
+			term.Before = &to
+		case ">":
+			term.After = &to
+		case ">=":
+			term.After = &from
+		default:
+			term.After, term.Before = &from, &to
+		}
+
+	default:
+		return p.errorf(pos, "unknown field %q (quote text that contains %s)", key, op)
+	}
+
+	return nil
+}
+
+// timeRange resolves a date value to [from, to). Days cover the whole day;
+// instants such as 7d or an RFC 3339 time are a single microsecond, the
+// database's resolution.
+func (p *parser) timeRange(value string) (time.Time, time.Time, error) {
+	now := p.env.Now
+	day := func(t time.Time) (time.Time, time.Time, error) {
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 0, 1), nil
+	}
+	instant := func(t time.Time) (time.Time, time.Time, error) {
+		return t, t.Add(time.Microsecond), nil
+	}
+
+	switch strings.ToLower(value) {
+	case "today":
+		return day(now)
+	case "tomorrow":
+		return day(now.AddDate(0, 0, 1))
+	case "yesterday":
+		return day(now.AddDate(0, 0, -1))
+	}
+
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return day(t)
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return instant(t)
+	}
+
+	// Relative to now: 7d, -2w, 12h.
+	if len(value) >= 2 {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err == nil {
+			switch value[len(value)-1] {
+			case 'h':
+				return instant(now.Add(time.Duration(n) * time.Hour))
+			case 'd':
+				return instant(now.AddDate(0, 0, n))
+			case 'w':
+				return instant(now.AddDate(0, 0, 7*n))
+			}
+		}
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf(
+		"invalid date %q, expected YYYY-MM-DD, RFC 3339, today, tomorrow, yesterday or a relative time like 7d, -2w or 12h", value)
+}
+
+// Task is the subset of a task a query looks at.
+type Task struct {
+	Title       string
+	Description string
+	Completed   bool
+	Labels      []string
+	AssigneeIDs []string
+	DueAt       *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Match reports whether t satisfies every term of q. It agrees with the
+// SQL the repository builds for the same query.
+func (q *Query) Match(t Task) bool {
+	for _, term := range q.Terms {
+		if term.match(t) == term.Negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (term Term) match(t Task) bool {
+	switch term.Field {
+	case FieldText:
+		needle := strings.ToLower(term.Value)
+		return strings.Contains(strings.ToLower(t.Title), needle) ||
+			strings.Contains(strings.ToLower(t.Description), needle)
+	case FieldStatus:
+		return t.Completed == term.Completed
+	case FieldAssignee:
+		if term.None {
+			return len(t.AssigneeIDs) == 0
+		}
+		return contains(t.AssigneeIDs, term.Value)
+	case FieldLabel:
+		if term.None {
+			return len(t.Labels) == 0
+		}
+		return contains(t.Labels, term.Value)
+	case FieldDue:
+		if term.None {
+			return t.DueAt == nil
+		}
+		return t.DueAt != nil && term.inRange(*t.DueAt)
+	case FieldCreated:
+		return term.inRange(t.CreatedAt)
+	case FieldUpdated:
+		return term.inRange(t.UpdatedAt)
+	}
+	return false
+}
+
+func (term Term) inRange(t time.Time) bool {
+	if term.After != nil && t.Before(*term.After) {
+		return false
+	}
+	if term.Before != nil && !t.Before(*term.Before) {
+		return false
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
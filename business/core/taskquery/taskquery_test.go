@@ -0,0 +1,151 @@
+package taskquery
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+var testEnv = Env{
+	Now:    time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC),
+	UserID: "user-1",
+}
+
+func TestParse(t *testing.T) {
+	q, err := Parse(`status:open assignee:me label:bug due<7d "login page" -label:"won't fix"`, testEnv)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	if len(q.Terms) != 6 {
+		t.Fatalf("expected 6 terms, got %d", len(q.Terms))
+	}
+
+	if term := q.Terms[0]; term.Field != FieldStatus || term.Completed {
+		t.Errorf("expected status:open, got %+v", term)
+	}
+	if term := q.Terms[1]; term.Field != FieldAssignee || term.Value != "user-1" {
+		t.Errorf("expected assignee user-1, got %+v", term)
+	}
+	if term := q.Terms[2]; term.Field != FieldLabel || term.Value != "bug" {
+		t.Errorf("expected label bug, got %+v", term)
+	}
+
+	due := q.Terms[3]
+	wantBefore := testEnv.Now.AddDate(0, 0, 7)
+	if due.Field != FieldDue || due.After != nil || due.Before == nil || !due.Before.Equal(wantBefore) {
+		t.Errorf("expected due before %v, got %+v", wantBefore, due)
+	}
+
+	if term := q.Terms[4]; term.Field != FieldText || term.Value != "login page" {
+		t.Errorf("expected text term, got %+v", term)
+	}
+	if term := q.Terms[5]; term.Field != FieldLabel || !term.Negate || term.Value != "won't fix" {
+		t.Errorf("expected negated label, got %+v", term)
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	q, err := Parse("due:today created>=2025-03-01 updated>-2w", testEnv)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	due := q.Terms[0]
+	if !due.After.Equal(today) || !due.Before.Equal(today.AddDate(0, 0, 1)) {
+		t.Errorf("expected due:today to cover %v, got %v to %v", today, due.After, due.Before)
+	}
+
+	created := q.Terms[1]
+	if created.Before != nil || !created.After.Equal(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected created range: %v to %v", created.After, created.Before)
+	}
+
+	updated := q.Terms[2]
+	if updated.After == nil || updated.After.Before(testEnv.Now.AddDate(0, 0, -14)) {
+		t.Errorf("unexpected updated range: %v", updated.After)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+	}{
+		{`"unterminated`, 0},
+		{`label:bug status:maybe`, 10},
+		{`priority:high`, 0},
+		{`due<soon`, 0},
+		{`label: bug`, 6},
+		{`label<bug`, 0},
+		{`bug -`, 4},
+		{`created:none`, 0},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.query, testEnv)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected parse error, got %v", tt.query, err)
+			continue
+		}
+		if perr.Pos != tt.pos {
+			t.Errorf("%q: expected error at %d, got %d (%v)", tt.query, tt.pos, perr.Pos, perr)
+		}
+	}
+}
+
+func TestParseMeWithoutUser(t *testing.T) {
+	if _, err := Parse("assignee:me", Env{Now: testEnv.Now}); err == nil {
+		t.Error("expected assignee:me to fail without a user")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	due := testEnv.Now.AddDate(0, 0, 3)
+	task := Task{
+		Title:       "Fix login page",
+		Description: "Users can't sign in",
+		Labels:      []string{"bug"},
+		AssigneeIDs: []string{"user-1"},
+		DueAt:       &due,
+		CreatedAt:   testEnv.Now.AddDate(0, 0, -1),
+		UpdatedAt:   testEnv.Now,
+	}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{`status:open assignee:me label:bug due<7d "LOGIN"`, true},
+		{"sign", true},
+		{"status:done", false},
+		{"-label:bug", false},
+		{"label:none", false},
+		{"assignee:none", false},
+		{"assignee:user-2", false},
+		{"due<2d", false},
+		{"due:none", false},
+		{"-due<2d", true},
+		{"created>=yesterday", true},
+		{"created<yesterday", false},
+	}
+
+	for _, tt := range tests {
+		q, err := Parse(tt.query, testEnv)
+		if err != nil {
+			t.Fatalf("%q: failed to parse: %v", tt.query, err)
+		}
+		if got := q.Match(task); got != tt.want {
+			t.Errorf("%q: expected match %v, got %v", tt.query, tt.want, got)
+		}
+	}
+
+	// Negated date terms match tasks without a due date.
+	q, _ := Parse("-due<7d", testEnv)
+	if !q.Match(Task{Title: "no due date"}) {
+		t.Error("expected -due<7d to match a task without a due date")
+	}
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+var ErrEmailNotVerified = errors.New("identity provider hasn't verified the email")
+
+// Identity is a user's account at an identity provider, which they sign in
+// through.
+type Identity struct {
+	// The provider's name, and the user's ID there.
+	Provider string
+	Subject  string
+
+	Email         string
+	EmailVerified bool
+
+	// PreferredUsername is the provider's username for the user, if any.
+	PreferredUsername string
+}
+
+// suggestedUsername returns the username to give a user provisioned for
+// the identity: their username at the provider, or else the local part of
+// their email, keeping only characters @mentions match.
+func (id Identity) suggestedUsername() string {
+	name := id.PreferredUsername
+	if name == "" || strings.Contains(name, "@") {
+		name, _, _ = strings.Cut(id.Email, "@")
+	}
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '_':
+			b.WriteRune(r)
+		case (r == '.' || r == '-') && b.Len() > 0:
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "user"
+	}
+	return b.String()
+}
@@ -0,0 +1,157 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxUsernameSuffix bounds the numbered usernames tried for a provisioned
+// user before falling back to a random suffix.
+const maxUsernameSuffix = 100
+
+// SignInWithIdentity returns the user an identity is linked to. Identities
+// new to the store are linked to the user with their email, if the
+// provider verified it, or else to a new user without a password; either
+// way, the user's email is then verified.
+func (s *Store) SignInWithIdentity(ctx context.Context, id Identity) (User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	linked := `
+		SELECT ` + userColumns + `
+		FROM user_identities i
+		JOIN users u ON u.id = i.user_id
+		WHERE i.provider = $1 AND i.subject = $2
+	`
+	u, err := scanUser(tx.QueryRowContext(ctx, linked, id.Provider, id.Subject))
+	if err == nil {
+		return u, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return User{}, fmt.Errorf("selecting user by identity: %w", err)
+	}
+
+	// Linking by an unverified email would hand its account to whoever
+	// claimed it at the provider.
+	if !id.EmailVerified {
+		return User{}, ErrEmailNotVerified
+	}
+
+	byEmail := `
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE LOWER(u.email) = LOWER($1)
+	`
+	u, err = scanUser(tx.QueryRowContext(ctx, byEmail, id.Email))
+	switch {
+	case err == nil:
+	case errors.Is(err, sql.ErrNoRows):
+		if u, err = provision(ctx, tx, id); err != nil {
+			return User{}, err
+		}
+	default:
+		return User{}, fmt.Errorf("selecting user by email: %w", err)
+	}
+
+	const link = `
+		INSERT INTO user_identities (provider, subject, user_id)
+		VALUES ($1, $2, $3)
+	`
+	if _, err := tx.ExecContext(ctx, link, id.Provider, id.Subject, u.ID()); err != nil {
+		return User{}, fmt.Errorf("linking identity: %w", err)
+	}
+
+	const verify = `UPDATE users SET email_verified_at = COALESCE(email_verified_at, NOW()) WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, verify, u.ID()); err != nil {
+		return User{}, fmt.Errorf("verifying email: %w", err)
+	}
+	u.emailVerified = true
+
+	if err := tx.Commit(); err != nil {
+		return User{}, fmt.Errorf("committing identity: %w", err)
+	}
+	return u, nil
+}
+
+// provision creates a user for an identity, with its suggested username,
+// numbered if that's taken.
+func provision(ctx context.Context, tx *sql.Tx, id Identity) (User, error) {
+	base := id.suggestedUsername()
+	username := base
+	for n := 2; ; n++ {
+		var taken bool
+		const q = `SELECT EXISTS (SELECT 1 FROM users WHERE username = $1)`
+		if err := tx.QueryRowContext(ctx, q, username).Scan(&taken); err != nil {
+			return User{}, fmt.Errorf("checking username: %w", err)
+		}
+		if !taken {
+			break
+		}
+		if n > maxUsernameSuffix {
+			username = base + "-" + uuid.New().String()[:8]
+			break
+		}
+		username = base + "-" + strconv.Itoa(n)
+	}
+
+	u, err := NewExternal(id.Email, username)
+	if err != nil {
+		return User{}, err
+	}
+
+	const q = `
+		INSERT INTO users (id, email, username, password_hash)
+		VALUES ($1, $2, $3, $4)
+	`
+	if _, err := tx.ExecContext(ctx, q, u.ID(), u.Email(), u.Username(), u.PasswordHash()); err != nil {
+		if isUniqueViolation(err) {
+			return User{}, takenError(err)
+		}
+		return User{}, fmt.Errorf("inserting user: %w", err)
+	}
+	return u, nil
+}
+
+// LinkedIdentity is an identity linked to a user.
+type LinkedIdentity struct {
+	Provider  string
+	Subject   string
+	CreatedAt time.Time
+}
+
+// Identities returns the identities linked to a user, oldest first.
+func (s *Store) Identities(ctx context.Context, userID string) ([]LinkedIdentity, error) {
+	const q = `
+		SELECT provider, subject, created_at
+		FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at, provider
+	`
+	rows, err := s.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return nil, fmt.Errorf("selecting identities: %w", err)
+	}
+	defer rows.Close()
+
+	identities := []LinkedIdentity{}
+	for rows.Next() {
+		var id LinkedIdentity
+		if err := rows.Scan(&id.Provider, &id.Subject, &id.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scanning identity: %w", err)
+		}
+		identities = append(identities, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("selecting identities: %w", err)
+	}
+	return identities, nil
+}
@@ -0,0 +1,160 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Bounds on the users Search returns.
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+// UpdateProfile stores a user's username, email, display name and time
+// zone, set with their setters. A changed email is stored unverified.
+func (s *Store) UpdateProfile(ctx context.Context, u User) error {
+	const q = `
+		UPDATE users
+		SET username = $2, email = $3, display_name = $4, time_zone = $5,
+			email_verified_at = CASE WHEN email = $3 THEN email_verified_at END,
+			updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+	res, err := s.db.ExecContext(ctx, q, u.ID(), u.Username(), u.Email(), u.DisplayName(), u.TimeZone())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return takenError(err)
+		}
+		return fmt.Errorf("updating profile: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SetAvatar sets the blob key and content type of a user's avatar, or
+// clears it given "", and returns the key of the avatar it replaced, if
+// any, for the caller to delete.
+func (s *Store) SetAvatar(ctx context.Context, userID, key, contentType string) (string, error) {
+	const q = `
+		UPDATE users u
+		SET avatar_key = $2, avatar_type = $3, updated_at = NOW()
+		FROM (SELECT id, avatar_key FROM users WHERE id = $1 AND deleted_at IS NULL FOR UPDATE) old
+		WHERE u.id = old.id
+		RETURNING old.avatar_key
+	`
+	var previous string
+	err := s.db.QueryRowContext(ctx, q, userID, key, contentType).Scan(&previous)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("setting avatar: %w", err)
+	}
+	return previous, nil
+}
+
+// SearchFilter narrows the users Search returns.
+type SearchFilter struct {
+	// Query matches the start of usernames or display names, or whole
+	// emails, ignoring case. Empty matches everyone.
+	Query string
+
+	// IDs, if not nil, limits the users to those with these IDs, such as
+	// a workspace's members.
+	IDs []string
+
+	// Limit caps the users returned: DefaultSearchLimit if 0, and at most
+	// MaxSearchLimit.
+	Limit int
+}
+
+// Search returns the users matching a filter, for assignee pickers and
+// such, by username. Deleted users are never returned.
+func (s *Store) Search(ctx context.Context, filter SearchFilter) ([]User, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+
+	query := strings.TrimSpace(filter.Query)
+	prefix := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(query) + "%"
+
+	q := `
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.deleted_at IS NULL
+			AND ($1 = '' OR u.username ILIKE $2 OR u.display_name ILIKE $2 OR LOWER(u.email) = LOWER($1))
+			AND ($3::TEXT[] IS NULL OR u.id = ANY($3))
+		ORDER BY LOWER(u.username), u.id
+		LIMIT $4
+	`
+	rows, err := s.db.QueryContext(ctx, q, query, prefix, pq.Array(filter.IDs), limit)
+	if err != nil {
+		return nil, fmt.Errorf("searching users: %w", err)
+	}
+	defer rows.Close()
+
+	users := []User{}
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("searching users: %w", err)
+	}
+	return users, nil
+}
+
+// Delete anonymises a user, and returns the key of their avatar, if any,
+// for the caller to delete. The user's row stays, so what they created
+// stays credited to someone, but with a placeholder email and username
+// they can't sign in with, and without their identities or tokens.
+// Deleting a deleted user does nothing.
+func (s *Store) Delete(ctx context.Context, userID string) (string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	const anonymise = `
+		UPDATE users u
+		SET email = u.id || '@deleted.invalid', username = 'deleted-' || u.id,
+			display_name = 'Deleted user', password_hash = '', time_zone = 'UTC',
+			avatar_key = '', avatar_type = '', email_verified_at = NULL, is_admin = FALSE,
+			deleted_at = COALESCE(u.deleted_at, NOW()), updated_at = NOW()
+		FROM (SELECT id, avatar_key FROM users WHERE id = $1 FOR UPDATE) old
+		WHERE u.id = old.id
+		RETURNING old.avatar_key
+	`
+	var avatar string
+	if err := tx.QueryRowContext(ctx, anonymise, userID).Scan(&avatar); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("anonymising user: %w", err)
+	}
+
+	for _, table := range []string{"user_identities", "user_tokens"} {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE user_id = $1`, userID); err != nil {
+			return "", fmt.Errorf("deleting user's %s: %w", table, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing user deletion: %w", err)
+	}
+	return avatar, nil
+}
@@ -5,15 +5,47 @@ CREATE TABLE IF NOT EXISTS users (
 	id            TEXT PRIMARY KEY,                -- UUID as string.
 	email         TEXT UNIQUE NOT NULL,            -- Email login.
 	username      TEXT UNIQUE NOT NULL,            -- Display name.
-	password_hash TEXT NOT NULL,                   -- Bcrypt hash.
+	password_hash TEXT NOT NULL,                   -- Bcrypt hash; '' without a password.
 	created_at    TIMESTAMP DEFAULT NOW(),
-	updated_at    TIMESTAMP DEFAULT NOW()
+	updated_at    TIMESTAMP DEFAULT NOW(),
+	email_verified_at TIMESTAMP WITH TIME ZONE,    -- NULL until the user proves they own it.
+	display_name  TEXT NOT NULL DEFAULT '',        -- Shown instead of the username, if set.
+	time_zone     TEXT NOT NULL DEFAULT 'UTC',     -- IANA time zone.
+	avatar_key    TEXT NOT NULL DEFAULT '',        -- Blob key of the avatar; '' without one.
+	avatar_type   TEXT NOT NULL DEFAULT '',        -- Content type of the avatar.
+	deleted_at    TIMESTAMP WITH TIME ZONE,        -- Set once the user is anonymised.
+	is_admin      BOOLEAN NOT NULL DEFAULT FALSE   -- Administers Taskboard itself.
 );
 
 -- Index for fast lookups.
 CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
 CREATE INDEX IF NOT EXISTS idx_users_username ON users(username);
 
+-- Accounts at identity providers users sign in through.
+CREATE TABLE IF NOT EXISTS user_identities (
+	provider   TEXT NOT NULL,                                       -- Configured provider name.
+	subject    TEXT NOT NULL,                                       -- User's ID at the provider.
+	user_id    TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+
+-- One-time tokens emailed to users, to reset their password or verify their
+-- email, bound to the email they were sent to.
+CREATE TABLE IF NOT EXISTS user_tokens (
+	token_hash BYTEA PRIMARY KEY,                                      -- SHA-256 of the token.
+	user_id    TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	purpose    TEXT NOT NULL CHECK (purpose IN ('password_reset', 'email_verification')),
+	email      TEXT NOT NULL,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+	used_at    TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id ON user_tokens(user_id);
+
 -- Example queries this schema supports:
 -- SELECT * FROM users WHERE email = 'paul@example.com';   -- Fast (indexed).
 -- SELECT * FROM users WHERE username = 'paul';            -- Fast (indexed).
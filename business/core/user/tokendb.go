@@ -0,0 +1,163 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CreateToken returns a one-time token for a purpose, which expires after
+// ttl, replacing the user's earlier unused ones for it. Tokens are bound to
+// the user's email at the time, so they stop working once it changes.
+func (s *Store) CreateToken(ctx context.Context, userID, purpose string, ttl time.Duration) (string, error) {
+	token, hash, err := newToken()
+	if err != nil {
+		return "", fmt.Errorf("generating token: %w", err)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	const replace = `
+		DELETE FROM user_tokens
+		WHERE user_id = $1 AND purpose = $2 AND (used_at IS NULL OR expires_at < NOW())
+	`
+	if _, err := tx.ExecContext(ctx, replace, userID, purpose); err != nil {
+		return "", fmt.Errorf("replacing tokens: %w", err)
+	}
+
+	const q = `
+		INSERT INTO user_tokens (token_hash, user_id, purpose, email, expires_at)
+		SELECT $1, id, $3, email, NOW() + $4 * INTERVAL '1 second'
+		FROM users
+		WHERE id = $2
+	`
+	result, err := tx.ExecContext(ctx, q, hash, userID, purpose, ttl.Seconds())
+	if err != nil {
+		return "", fmt.Errorf("inserting token: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("inserting token: %w", err)
+	}
+	if n == 0 {
+		return "", ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing token: %w", err)
+	}
+	return token, nil
+}
+
+// useToken marks a token for a purpose used, and returns its user, if it
+// hasn't been used or expired and the user's email hasn't changed since it
+// was created.
+func useToken(ctx context.Context, tx *sql.Tx, token, purpose string) (User, error) {
+	// Locking the token makes concurrent uses of it wait, and then see it
+	// used.
+	q := `
+		SELECT ` + userColumns + `
+		FROM user_tokens t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.token_hash = $1 AND t.purpose = $2 AND t.used_at IS NULL
+			AND t.expires_at > NOW() AND t.email = u.email
+		FOR UPDATE OF t
+	`
+	u, err := scanUser(tx.QueryRowContext(ctx, q, hashToken(token), purpose))
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, ErrInvalidToken
+	}
+	if err != nil {
+		return User{}, fmt.Errorf("selecting token: %w", err)
+	}
+
+	const use = `UPDATE user_tokens SET used_at = NOW() WHERE token_hash = $1`
+	if _, err := tx.ExecContext(ctx, use, hashToken(token)); err != nil {
+		return User{}, fmt.Errorf("using token: %w", err)
+	}
+	return u, nil
+}
+
+// ResetPassword sets the password of the user a password reset token was
+// created for, and returns them. Since the token was emailed to them, it
+// verifies their email too. A weak password doesn't use up the token.
+func (s *Store) ResetPassword(ctx context.Context, token, password string) (User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	u, err := useToken(ctx, tx, token, PurposePasswordReset)
+	if err != nil {
+		return User{}, err
+	}
+	if err := u.SetPassword(password); err != nil {
+		return User{}, err
+	}
+
+	const q = `
+		UPDATE users
+		SET password_hash = $2, email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+		WHERE id = $1
+	`
+	if _, err := tx.ExecContext(ctx, q, u.ID(), u.PasswordHash()); err != nil {
+		return User{}, fmt.Errorf("updating password: %w", err)
+	}
+	u.emailVerified = true
+
+	if err := tx.Commit(); err != nil {
+		return User{}, fmt.Errorf("committing password reset: %w", err)
+	}
+	return u, nil
+}
+
+// VerifyEmail marks the email of the user an email verification token was
+// created for verified, and returns them.
+func (s *Store) VerifyEmail(ctx context.Context, token string) (User, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return User{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	u, err := useToken(ctx, tx, token, PurposeEmailVerification)
+	if err != nil {
+		return User{}, err
+	}
+
+	const q = `UPDATE users SET email_verified_at = COALESCE(email_verified_at, NOW()) WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, q, u.ID()); err != nil {
+		return User{}, fmt.Errorf("verifying email: %w", err)
+	}
+	u.emailVerified = true
+
+	if err := tx.Commit(); err != nil {
+		return User{}, fmt.Errorf("committing email verification: %w", err)
+	}
+	return u, nil
+}
+
+// UpdatePassword stores a user's new password, set with SetPassword.
+func (s *Store) UpdatePassword(ctx context.Context, u User) error {
+	const q = `UPDATE users SET password_hash = $2, updated_at = NOW() WHERE id = $1`
+
+	result, err := s.db.ExecContext(ctx, q, u.ID(), u.PasswordHash())
+	if err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
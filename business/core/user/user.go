@@ -3,11 +3,22 @@ package user
 import (
 	"errors"
 	"regexp"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // Time zones are checked wherever the gateway runs.
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// maxDisplayNameLen bounds display names, in characters.
+const maxDisplayNameLen = 100
+
+// DefaultTimeZone is the time zone of users who haven't chosen one.
+const DefaultTimeZone = "UTC"
+
 var (
 	ErrNotFound           = errors.New("user not found")
 	ErrInvalidEmail       = errors.New("invalid email format")
@@ -15,6 +26,9 @@ var (
 	ErrEmailTaken         = errors.New("email already taken")
 	ErrInvalidCredentials = errors.New("invalid email or password")
 	ErrEmptyUsername      = errors.New("username cannot be empty")
+	ErrUsernameTaken      = errors.New("username already taken")
+	ErrDisplayNameTooLong = errors.New("display name must be at most 100 characters")
+	ErrInvalidTimeZone    = errors.New("unknown time zone")
 )
 
 type User struct {
@@ -22,6 +36,22 @@ type User struct {
 	email    string
 	username string
 	hash     string
+
+	// Whether the user proved they own their email.
+	emailVerified bool
+
+	// Profile and preferences.
+	displayName string
+	timeZone    string
+	avatarKey   string
+	avatarType  string
+
+	// Deleted users keep their ID, so what they created stays theirs, but
+	// nothing else.
+	deleted bool
+
+	// Admins unlock accounts and read the authentication audit log.
+	admin bool
 }
 
 func New(email, username, password string) (User, error) {
@@ -35,25 +65,61 @@ func New(email, username, password string) (User, error) {
 		return User{}, ErrEmptyUsername
 	}
 
+	hash, err := hashPassword(password)
+	if err != nil {
+		return User{}, err
+	}
+
+	// Generate unique ID.
+	id := uuid.New().String()
+
+	return User{
+		id:       id,
+		email:    email,
+		username: username,
+		hash:     hash,
+	}, nil
+}
+
+// hashPassword checks a password's strength, and hashes it.
+func hashPassword(password string) (string, error) {
 	// Validate password strength.
 	if len(password) < 8 {
-		return User{}, ErrWeakPassword
+		return "", ErrWeakPassword
 	}
 
 	// Hash password. bcrypt.DefaultCost = 10 (balancing security and speed).
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
-		return User{}, err
+		return "", err
 	}
+	return string(hash), nil
+}
 
-	// Generate unique ID.
-	id := uuid.New().String()
+// SetPassword replaces the user's password.
+func (u *User) SetPassword(password string) error {
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+	u.hash = hash
+	return nil
+}
+
+// NewExternal creates a user who signs in through an identity provider,
+// and so has no password.
+func NewExternal(email, username string) (User, error) {
+	if !isValidEmail(email) {
+		return User{}, ErrInvalidEmail
+	}
+	if username == "" {
+		return User{}, ErrEmptyUsername
+	}
 
 	return User{
-		id:       id,
+		id:       uuid.New().String(),
 		email:    email,
 		username: username,
-		hash:     string(hash),
 	}, nil
 }
 
@@ -63,12 +129,74 @@ func isValidEmail(email string) bool {
 	return re.MatchString(email)
 }
 
-// Validates that the provided password is correct.
+// Validates that the provided password is correct. Users without a
+// password never authenticate with one.
 func (u User) Authenticate(password string) bool {
+	if u.hash == "" {
+		FakeAuthenticate(password)
+		return false
+	}
 	err := bcrypt.CompareHashAndPassword([]byte(u.hash), []byte(password))
 	return err == nil
 }
 
+// dummyHash is a hash no password is checked against in earnest.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("taskboard dummy password"), bcrypt.DefaultCost)
+	return hash
+})
+
+// FakeAuthenticate takes as long as Authenticate, and fails, so logins with
+// unknown emails, or for users without a password, can't be told apart by
+// how long they take.
+func FakeAuthenticate(password string) {
+	bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+}
+
+// SetEmail changes the user's email, which then needs verifying again.
+func (u *User) SetEmail(email string) error {
+	if !isValidEmail(email) {
+		return ErrInvalidEmail
+	}
+	if email != u.email {
+		u.email = email
+		u.emailVerified = false
+	}
+	return nil
+}
+
+func (u *User) SetUsername(username string) error {
+	if username == "" {
+		return ErrEmptyUsername
+	}
+	u.username = username
+	return nil
+}
+
+// SetDisplayName sets the name shown for the user, trimmed. Empty shows
+// their username.
+func (u *User) SetDisplayName(name string) error {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxDisplayNameLen {
+		return ErrDisplayNameTooLong
+	}
+	u.displayName = name
+	return nil
+}
+
+// SetTimeZone sets the user's IANA time zone, such as Europe/Paris. Empty
+// sets DefaultTimeZone.
+func (u *User) SetTimeZone(tz string) error {
+	if tz == "" {
+		tz = DefaultTimeZone
+	}
+	if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+		return ErrInvalidTimeZone
+	}
+	u.timeZone = tz
+	return nil
+}
+
 // Getter functions for uid, email and username below.
 
 func (u User) ID() string {
@@ -83,11 +211,64 @@ func (u User) Username() string {
 	return u.username
 }
 
-// Unmarshal reconstructs a User from DB data.
+// EmailVerified reports whether the user proved they own their email.
+func (u User) EmailVerified() bool {
+	return u.emailVerified
+}
+
+// DisplayName returns the name shown for the user, or "" to show their
+// username.
+func (u User) DisplayName() string {
+	return u.displayName
+}
+
+// Name returns the name to show for the user.
+func (u User) Name() string {
+	if u.displayName != "" {
+		return u.displayName
+	}
+	return u.username
+}
+
+func (u User) TimeZone() string {
+	if u.timeZone == "" {
+		return DefaultTimeZone
+	}
+	return u.timeZone
+}
+
+// AvatarKey returns the blob key of the user's avatar, or "" if they have
+// none, and AvatarType its content type.
+func (u User) AvatarKey() string {
+	return u.avatarKey
+}
+
+func (u User) AvatarType() string {
+	return u.avatarType
+}
+
+// Deleted reports whether the user deleted their account.
+func (u User) Deleted() bool {
+	return u.deleted
+}
+
+// Admin reports whether the user administers Taskboard itself.
+func (u User) Admin() bool {
+	return u.admin
+}
+
+// HasPassword reports whether the user can log in with a password, rather
+// than only through an identity provider.
+func (u User) HasPassword() bool {
+	return u.hash != ""
+}
+
+// Unmarshal reconstructs a User from DB data. The hash is empty for users
+// without a password.
 // This function used only by the db layer.
 func Unmarshal(id, email, username, hash string) (User, error) {
 	// Sanity checks.
-	if id == "" || email == "" || username == "" || hash == "" {
+	if id == "" || email == "" || username == "" {
 		return User{}, errors.New("invalid user data from database")
 	}
 
@@ -99,7 +280,8 @@ func Unmarshal(id, email, username, hash string) (User, error) {
 	}, nil
 }
 
-// PasswordHash returns pw hash for db persistance.
+// PasswordHash returns pw hash for db persistance, or "" if the user has no
+// password.
 func (u User) PasswordHash() string {
 	return u.hash
 }
@@ -1,6 +1,9 @@
 package user
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestNew(t *testing.T) {
 	t.Run("valid user", func(t *testing.T) {
@@ -143,3 +146,135 @@ func TestUnmarshalInvalidData(t *testing.T) {
 
 	t.Log("✅ Correctly rejected invalid unmarshal data")
 }
+
+func TestNewExternal(t *testing.T) {
+	user, err := NewExternal("alice@example.com", "alice")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	if user.PasswordHash() != "" {
+		t.Error("expected no password hash")
+	}
+
+	if user.Authenticate("") {
+		t.Error("users without a password should never authenticate with one")
+	}
+
+	if _, err := NewExternal("invalid-email", "alice"); err != ErrInvalidEmail {
+		t.Errorf("expected ErrInvalidEmail, got: %v", err)
+	}
+
+	t.Log("✅ Created user without a password")
+}
+
+func TestSuggestedUsername(t *testing.T) {
+	tests := []struct {
+		id   Identity
+		want string
+	}{
+		{Identity{PreferredUsername: "alice", Email: "a@example.com"}, "alice"},
+		{Identity{PreferredUsername: "alice@corp.example.com", Email: "a.smith@example.com"}, "a.smith"},
+		{Identity{Email: "bob+ci@example.com"}, "bobci"},
+		{Identity{PreferredUsername: ".-José Núñez"}, "JoséNúñez"},
+		{Identity{Email: "+@example.com"}, "user"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.id.suggestedUsername(); got != tt.want {
+			t.Errorf("%+v: expected %q, got %q", tt.id, tt.want, got)
+		}
+	}
+
+	t.Log("✅ Suggested usernames for identities")
+}
+
+func TestSetPassword(t *testing.T) {
+	user, _ := NewExternal("alice@example.com", "alice")
+
+	if err := user.SetPassword("short"); err != ErrWeakPassword {
+		t.Errorf("expected ErrWeakPassword, got: %v", err)
+	}
+	if user.HasPassword() {
+		t.Error("expected a weak password not to be set")
+	}
+
+	if err := user.SetPassword("new-password"); err != nil {
+		t.Fatalf("failed to set password: %v", err)
+	}
+	if !user.HasPassword() || !user.Authenticate("new-password") {
+		t.Error("expected user to authenticate with the new password")
+	}
+
+	t.Log("✅ Set password")
+}
+
+func TestSetEmail(t *testing.T) {
+	user, _ := NewExternal("alice@example.com", "alice")
+	user.emailVerified = true
+
+	if err := user.SetEmail("not-an-email"); err != ErrInvalidEmail {
+		t.Errorf("expected ErrInvalidEmail, got: %v", err)
+	}
+
+	if err := user.SetEmail("alice@example.com"); err != nil || !user.EmailVerified() {
+		t.Errorf("expected an unchanged email to stay verified, got: %v", err)
+	}
+
+	if err := user.SetEmail("alice@example.org"); err != nil {
+		t.Fatalf("failed to set email: %v", err)
+	}
+	if user.Email() != "alice@example.org" || user.EmailVerified() {
+		t.Error("expected the new email, unverified")
+	}
+
+	t.Log("✅ Set email")
+}
+
+func TestSetDisplayName(t *testing.T) {
+	user, _ := NewExternal("alice@example.com", "alice")
+
+	if user.Name() != "alice" {
+		t.Errorf("expected name alice without a display name, got: %s", user.Name())
+	}
+
+	if err := user.SetDisplayName("  Alice Liddell "); err != nil {
+		t.Fatalf("failed to set display name: %v", err)
+	}
+	if user.DisplayName() != "Alice Liddell" || user.Name() != "Alice Liddell" {
+		t.Errorf("expected trimmed display name, got: %q", user.DisplayName())
+	}
+
+	if err := user.SetDisplayName(strings.Repeat("é", 101)); err != ErrDisplayNameTooLong {
+		t.Errorf("expected ErrDisplayNameTooLong, got: %v", err)
+	}
+
+	t.Log("✅ Set display name")
+}
+
+func TestSetTimeZone(t *testing.T) {
+	user, _ := NewExternal("alice@example.com", "alice")
+
+	if user.TimeZone() != DefaultTimeZone {
+		t.Errorf("expected default time zone, got: %s", user.TimeZone())
+	}
+
+	if err := user.SetTimeZone("Europe/Paris"); err != nil {
+		t.Fatalf("failed to set time zone: %v", err)
+	}
+	if user.TimeZone() != "Europe/Paris" {
+		t.Errorf("expected Europe/Paris, got: %s", user.TimeZone())
+	}
+
+	for _, tz := range []string{"Mars/Olympus", "Local"} {
+		if err := user.SetTimeZone(tz); err != ErrInvalidTimeZone {
+			t.Errorf("expected ErrInvalidTimeZone for %q, got: %v", tz, err)
+		}
+	}
+
+	if err := user.SetTimeZone(""); err != nil || user.TimeZone() != DefaultTimeZone {
+		t.Errorf("expected empty to reset the time zone, got: %s, %v", user.TimeZone(), err)
+	}
+
+	t.Log("✅ Set time zone")
+}
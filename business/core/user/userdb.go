@@ -21,11 +21,47 @@ type dbUser struct {
 	PasswordHash string `db:"password_hash"`
 	CreatedAt    string `db:"created_at"`
 	UpdatedAt    string `db:"updated_at"`
+
+	// Whether email_verified_at is set.
+	EmailVerified bool `db:"email_verified"`
+
+	DisplayName string `db:"display_name"`
+	TimeZone    string `db:"time_zone"`
+	AvatarKey   string `db:"avatar_key"`
+	AvatarType  string `db:"avatar_type"`
+
+	// Whether deleted_at is set.
+	Deleted bool `db:"deleted"`
+
+	Admin bool `db:"is_admin"`
+}
+
+// userColumns are the columns of users, as u, that scanUser scans.
+const userColumns = `u.id, u.email, u.username, u.password_hash, u.email_verified_at IS NOT NULL,
+	u.display_name, u.time_zone, u.avatar_key, u.avatar_type, u.deleted_at IS NOT NULL, u.is_admin`
+
+// scanUser scans a row of userColumns.
+func scanUser(row interface{ Scan(...any) error }) (User, error) {
+	var dbu dbUser
+	err := row.Scan(&dbu.Id, &dbu.Email, &dbu.Username, &dbu.PasswordHash, &dbu.EmailVerified,
+		&dbu.DisplayName, &dbu.TimeZone, &dbu.AvatarKey, &dbu.AvatarType, &dbu.Deleted, &dbu.Admin)
+	if err != nil {
+		return User{}, err
+	}
+	return dbu.toDomain()
 }
 
 // toDomain converts dbUser to domain User type.
 func (dbu dbUser) toDomain() (User, error) {
-	return Unmarshal(dbu.Id, dbu.Email, dbu.Username, dbu.PasswordHash)
+	u, err := Unmarshal(dbu.Id, dbu.Email, dbu.Username, dbu.PasswordHash)
+	u.emailVerified = dbu.EmailVerified
+	u.displayName = dbu.DisplayName
+	u.timeZone = dbu.TimeZone
+	u.avatarKey = dbu.AvatarKey
+	u.avatarType = dbu.AvatarType
+	u.deleted = dbu.Deleted
+	u.admin = dbu.Admin
+	return u, err
 }
 
 // NewStore constructs a user store.
@@ -51,9 +87,9 @@ func (s *Store) Create(ctx context.Context, user User) error {
 		user.PasswordHash(),
 	)
 	if err != nil {
-		// If email is already registered.
+		// If email or username is already registered.
 		if isUniqueViolation(err) {
-			return ErrEmailTaken
+			return takenError(err)
 		}
 		return fmt.Errorf("inserting user: %w", err)
 	}
@@ -72,16 +108,23 @@ func isUniqueViolation(err error) bool {
 	return strings.Contains(errMsg, "unique") || strings.Contains(errMsg, "duplicate")
 }
 
+// takenError returns the error for a unique violation of users: whether
+// its username or its email is taken.
+func takenError(err error) error {
+	if strings.Contains(err.Error(), "username") {
+		return ErrUsernameTaken
+	}
+	return ErrEmailTaken
+}
+
 func (s *Store) QueryByEmail(ctx context.Context, email string) (User, error) {
 	const q = `
-		SELECT id, email, username, password_hash
-		FROM users
-		WHERE email = $1
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.email = $1
 	`
 
-	var dbu dbUser
-
-	err := s.db.QueryRowContext(ctx, q, email).Scan(&dbu.Id, &dbu.Email, &dbu.Username, &dbu.PasswordHash)
+	u, err := scanUser(s.db.QueryRowContext(ctx, q, email))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return User{}, ErrNotFound
@@ -89,20 +132,17 @@ func (s *Store) QueryByEmail(ctx context.Context, email string) (User, error) {
 		return User{}, fmt.Errorf("selecting user by email: %w", err)
 	}
 
-	// Convert DB data to domain representation.
-	return dbu.toDomain()
+	return u, nil
 }
 
 func (s *Store) QueryById(ctx context.Context, id string) (User, error) {
 	const q = `
-		SELECT id, email, username, password_hash
-		FROM users
-		WHERE id = $1
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.id = $1
 	`
 
-	var dbu dbUser
-
-	err := s.db.QueryRowContext(ctx, q, id).Scan(&dbu.Id, &dbu.Email, &dbu.Username, &dbu.PasswordHash)
+	u, err := scanUser(s.db.QueryRowContext(ctx, q, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return User{}, ErrNotFound
@@ -110,20 +150,17 @@ func (s *Store) QueryById(ctx context.Context, id string) (User, error) {
 		return User{}, fmt.Errorf("selecting user by id: %w", err)
 	}
 
-	// Convert DB data to domain representation.
-	return dbu.toDomain()
+	return u, nil
 }
 
 func (s *Store) QueryByUsername(ctx context.Context, username string) (User, error) {
 	const q = `
-		SELECT id, email, username, password_hash
-		FROM users
-		WHERE username = $1
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.username = $1
 	`
 
-	var dbu dbUser
-
-	err := s.db.QueryRowContext(ctx, q, username).Scan(&dbu.Id, &dbu.Email, &dbu.Username, &dbu.PasswordHash)
+	u, err := scanUser(s.db.QueryRowContext(ctx, q, username))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return User{}, ErrNotFound
@@ -131,6 +168,5 @@ func (s *Store) QueryByUsername(ctx context.Context, username string) (User, err
 		return User{}, fmt.Errorf("selecting user by username: %w", err)
 	}
 
-	// Convert from DB data to domain representation.
-	return dbu.toDomain()
+	return u, nil
 }
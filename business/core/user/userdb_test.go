@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,7 +34,7 @@ func setupTestDb(t *testing.T) (*sql.DB, func()) {
 
 	// Create new schema for this test.
 	schema := `
-		DROP TABLE IF EXISTS users CASCADE;
+		DROP TABLE IF EXISTS user_tokens, user_identities, users CASCADE;
 
 		CREATE TABLE users (
 			id TEXT PRIMARY KEY,
@@ -41,11 +42,36 @@ func setupTestDb(t *testing.T) (*sql.DB, func()) {
 			username TEXT UNIQUE NOT NULL,
 			password_hash TEXT NOT NULL,
 			created_at TIMESTAMP DEFAULT NOW(),
-			updated_at TIMESTAMP DEFAULT NOW()
+			updated_at TIMESTAMP DEFAULT NOW(),
+			email_verified_at TIMESTAMP WITH TIME ZONE,
+			display_name TEXT NOT NULL DEFAULT '',
+			time_zone TEXT NOT NULL DEFAULT 'UTC',
+			avatar_key TEXT NOT NULL DEFAULT '',
+			avatar_type TEXT NOT NULL DEFAULT '',
+			deleted_at TIMESTAMP WITH TIME ZONE,
+			is_admin BOOLEAN NOT NULL DEFAULT FALSE
 		);
 
 		CREATE INDEX idx_users_email ON users(email);
 		CREATE INDEX idx_users_username ON users(username);
+
+		CREATE TABLE user_identities (
+			provider TEXT NOT NULL,
+			subject TEXT NOT NULL,
+			user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (provider, subject)
+		);
+
+		CREATE TABLE user_tokens (
+			token_hash BYTEA PRIMARY KEY,
+			user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+			purpose TEXT NOT NULL,
+			email TEXT NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+			expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			used_at TIMESTAMP WITH TIME ZONE
+		);
 	`
 
 	if _, err := db.Exec(schema); err != nil {
@@ -54,7 +80,7 @@ func setupTestDb(t *testing.T) (*sql.DB, func()) {
 
 	// Instantiate cleanup function.
 	cleanup := func() {
-		db.Exec("DROP TABLE IF EXISTS users CASCADE")
+		db.Exec("DROP TABLE IF EXISTS user_tokens, user_identities, users CASCADE")
 		db.Close()
 	}
 
@@ -209,3 +235,365 @@ func TestStoreQueryByUsername(t *testing.T) {
 
 	t.Log("✅ Successfully queried user by username")
 }
+
+func TestStoreSignInWithIdentity(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	existing, _ := New("alice@example.com", "alice", "password123")
+	store.Create(ctx, existing)
+
+	t.Run("link by verified email", func(t *testing.T) {
+		id := Identity{Provider: "corp", Subject: "sub-1", Email: "Alice@example.com", EmailVerified: true}
+		u, err := store.SignInWithIdentity(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to sign in: %v", err)
+		}
+		if u.ID() != existing.ID() {
+			t.Errorf("expected existing user %s, got %s", existing.ID(), u.ID())
+		}
+
+		// The link holds after the email changes at the provider.
+		id.Email, id.EmailVerified = "alice@corp.example.com", false
+		u, err = store.SignInWithIdentity(ctx, id)
+		if err != nil || u.ID() != existing.ID() {
+			t.Errorf("expected linked user %s, got %s, %v", existing.ID(), u.ID(), err)
+		}
+
+		t.Log("✅ Linked identity to existing user")
+	})
+
+	t.Run("provision new user", func(t *testing.T) {
+		id := Identity{Provider: "corp", Subject: "sub-2", Email: "alice@other.example.com", EmailVerified: true, PreferredUsername: "alice"}
+		u, err := store.SignInWithIdentity(ctx, id)
+		if err != nil {
+			t.Fatalf("failed to sign in: %v", err)
+		}
+		if u.Username() != "alice-2" {
+			t.Errorf("expected username alice-2, got %s", u.Username())
+		}
+		if u.Authenticate("") {
+			t.Error("expected provisioned user to have no password")
+		}
+
+		t.Log("✅ Provisioned user for identity")
+	})
+
+	t.Run("reject unverified email", func(t *testing.T) {
+		id := Identity{Provider: "corp", Subject: "sub-3", Email: "alice@example.com"}
+		if _, err := store.SignInWithIdentity(ctx, id); err != ErrEmailNotVerified {
+			t.Errorf("expected ErrEmailNotVerified, got: %v", err)
+		}
+	})
+}
+
+func TestStoreResetPassword(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	original, _ := New("test@example.com", "testuser", "password123")
+	store.Create(ctx, original)
+
+	token, err := store.CreateToken(ctx, original.ID(), PurposePasswordReset, time.Hour)
+	if err != nil {
+		t.Fatalf("failed to create token: %v", err)
+	}
+
+	t.Run("reject weak password", func(t *testing.T) {
+		if _, err := store.ResetPassword(ctx, token, "short"); err != ErrWeakPassword {
+			t.Errorf("expected ErrWeakPassword, got: %v", err)
+		}
+	})
+
+	t.Run("reject verification token", func(t *testing.T) {
+		other, _ := store.CreateToken(ctx, original.ID(), PurposeEmailVerification, time.Hour)
+		if _, err := store.ResetPassword(ctx, other, "new-password"); err != ErrInvalidToken {
+			t.Errorf("expected ErrInvalidToken, got: %v", err)
+		}
+	})
+
+	t.Run("reset password", func(t *testing.T) {
+		u, err := store.ResetPassword(ctx, token, "new-password")
+		if err != nil {
+			t.Fatalf("failed to reset password: %v", err)
+		}
+		if !u.EmailVerified() {
+			t.Error("expected reset to verify the email")
+		}
+
+		found, _ := store.QueryById(ctx, original.ID())
+		if !found.Authenticate("new-password") || found.Authenticate("password123") {
+			t.Error("expected only the new password to authenticate")
+		}
+
+		t.Log("✅ Reset password")
+	})
+
+	t.Run("token works once", func(t *testing.T) {
+		if _, err := store.ResetPassword(ctx, token, "another-password"); err != ErrInvalidToken {
+			t.Errorf("expected ErrInvalidToken, got: %v", err)
+		}
+	})
+
+	t.Run("newer token replaces older", func(t *testing.T) {
+		older, _ := store.CreateToken(ctx, original.ID(), PurposePasswordReset, time.Hour)
+		store.CreateToken(ctx, original.ID(), PurposePasswordReset, time.Hour)
+		if _, err := store.ResetPassword(ctx, older, "another-password"); err != ErrInvalidToken {
+			t.Errorf("expected ErrInvalidToken, got: %v", err)
+		}
+	})
+}
+
+func TestStoreVerifyEmail(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	original, _ := New("test@example.com", "testuser", "password123")
+	store.Create(ctx, original)
+
+	t.Run("reject expired token", func(t *testing.T) {
+		token, _ := store.CreateToken(ctx, original.ID(), PurposeEmailVerification, -time.Minute)
+		if _, err := store.VerifyEmail(ctx, token); err != ErrInvalidToken {
+			t.Errorf("expected ErrInvalidToken, got: %v", err)
+		}
+	})
+
+	t.Run("reject token for old email", func(t *testing.T) {
+		token, _ := store.CreateToken(ctx, original.ID(), PurposeEmailVerification, time.Hour)
+		db.Exec(`UPDATE users SET email = 'new@example.com' WHERE id = $1`, original.ID())
+		defer db.Exec(`UPDATE users SET email = 'test@example.com' WHERE id = $1`, original.ID())
+
+		if _, err := store.VerifyEmail(ctx, token); err != ErrInvalidToken {
+			t.Errorf("expected ErrInvalidToken, got: %v", err)
+		}
+	})
+
+	t.Run("verify email", func(t *testing.T) {
+		token, _ := store.CreateToken(ctx, original.ID(), PurposeEmailVerification, time.Hour)
+		if _, err := store.VerifyEmail(ctx, token); err != nil {
+			t.Fatalf("failed to verify email: %v", err)
+		}
+
+		found, _ := store.QueryById(ctx, original.ID())
+		if !found.EmailVerified() {
+			t.Error("expected email to be verified")
+		}
+
+		t.Log("✅ Verified email")
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		if _, err := store.CreateToken(ctx, "missing", PurposeEmailVerification, time.Hour); err != ErrNotFound {
+			t.Errorf("expected ErrNotFound, got: %v", err)
+		}
+	})
+}
+
+func TestStoreUpdateProfile(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	original, _ := New("test@example.com", "testuser", "password123")
+	store.Create(ctx, original)
+	other, _ := New("other@example.com", "otheruser", "password123")
+	store.Create(ctx, other)
+	db.Exec(`UPDATE users SET email_verified_at = NOW() WHERE id = $1`, original.ID())
+
+	t.Run("reject taken username", func(t *testing.T) {
+		u, _ := store.QueryById(ctx, original.ID())
+		u.SetUsername("otheruser")
+		if err := store.UpdateProfile(ctx, u); err != ErrUsernameTaken {
+			t.Errorf("expected ErrUsernameTaken, got: %v", err)
+		}
+	})
+
+	t.Run("reject taken email", func(t *testing.T) {
+		u, _ := store.QueryById(ctx, original.ID())
+		u.SetEmail("other@example.com")
+		if err := store.UpdateProfile(ctx, u); err != ErrEmailTaken {
+			t.Errorf("expected ErrEmailTaken, got: %v", err)
+		}
+	})
+
+	t.Run("update profile", func(t *testing.T) {
+		u, _ := store.QueryById(ctx, original.ID())
+		u.SetUsername("renamed")
+		u.SetEmail("renamed@example.com")
+		u.SetDisplayName("Renamed User")
+		u.SetTimeZone("America/New_York")
+		if err := store.UpdateProfile(ctx, u); err != nil {
+			t.Fatalf("failed to update profile: %v", err)
+		}
+
+		found, _ := store.QueryById(ctx, original.ID())
+		if found.Username() != "renamed" || found.Email() != "renamed@example.com" ||
+			found.DisplayName() != "Renamed User" || found.TimeZone() != "America/New_York" {
+			t.Errorf("expected updated profile, got: %+v", found)
+		}
+		if found.EmailVerified() {
+			t.Error("expected the changed email to be unverified")
+		}
+
+		t.Log("✅ Updated profile")
+	})
+}
+
+func TestStoreSetAvatar(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	original, _ := New("test@example.com", "testuser", "password123")
+	store.Create(ctx, original)
+
+	if previous, err := store.SetAvatar(ctx, original.ID(), "avatars/1", "image/png"); err != nil || previous != "" {
+		t.Fatalf("expected no previous avatar, got: %q, %v", previous, err)
+	}
+	if previous, err := store.SetAvatar(ctx, original.ID(), "avatars/2", "image/jpeg"); err != nil || previous != "avatars/1" {
+		t.Fatalf("expected previous avatar avatars/1, got: %q, %v", previous, err)
+	}
+
+	found, _ := store.QueryById(ctx, original.ID())
+	if found.AvatarKey() != "avatars/2" || found.AvatarType() != "image/jpeg" {
+		t.Errorf("expected avatars/2 as image/jpeg, got: %s as %s", found.AvatarKey(), found.AvatarType())
+	}
+
+	if _, err := store.SetAvatar(ctx, "missing", "", ""); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+
+	t.Log("✅ Set avatar")
+}
+
+func TestStoreSearch(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	alice, _ := New("alice@example.com", "alice", "password123")
+	store.Create(ctx, alice)
+	albert, _ := New("albert@example.com", "albert", "password123")
+	store.Create(ctx, albert)
+	bob, _ := New("bob@example.com", "bob", "password123")
+	store.Create(ctx, bob)
+	db.Exec(`UPDATE users SET display_name = 'Alan Bob' WHERE id = $1`, bob.ID())
+
+	usernames := func(users []User) string {
+		names := []string{}
+		for _, u := range users {
+			names = append(names, u.Username())
+		}
+		return strings.Join(names, ",")
+	}
+
+	tests := []struct {
+		name   string
+		filter SearchFilter
+		want   string
+	}{
+		{"everyone", SearchFilter{}, "albert,alice,bob"},
+		{"username prefix", SearchFilter{Query: "ALI"}, "alice"},
+		{"display name prefix", SearchFilter{Query: "alan"}, "bob"},
+		{"email", SearchFilter{Query: "Bob@Example.com"}, "bob"},
+		{"email prefix", SearchFilter{Query: "bob@"}, ""},
+		{"wildcards are literal", SearchFilter{Query: "%"}, ""},
+		{"limited IDs", SearchFilter{Query: "al", IDs: []string{alice.ID(), bob.ID()}}, "alice,bob"},
+		{"limit", SearchFilter{Limit: 1}, "albert"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, err := store.Search(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("failed to search users: %v", err)
+			}
+			if got := usernames(users); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStoreDelete(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return
+	}
+	defer cleanup()
+
+	store := NewStore(db)
+	ctx := context.Background()
+
+	original, _ := New("test@example.com", "testuser", "password123")
+	store.Create(ctx, original)
+	store.SetAvatar(ctx, original.ID(), "avatars/1", "image/png")
+	store.CreateToken(ctx, original.ID(), PurposePasswordReset, time.Hour)
+
+	avatar, err := store.Delete(ctx, original.ID())
+	if err != nil {
+		t.Fatalf("failed to delete user: %v", err)
+	}
+	if avatar != "avatars/1" {
+		t.Errorf("expected avatar avatars/1 to delete, got: %q", avatar)
+	}
+
+	found, err := store.QueryById(ctx, original.ID())
+	if err != nil {
+		t.Fatalf("expected the anonymised user to remain, got: %v", err)
+	}
+	if !found.Deleted() || found.HasPassword() || found.Email() == "test@example.com" || found.Username() == "testuser" {
+		t.Errorf("expected an anonymised user, got: %+v", found)
+	}
+
+	if _, err := store.QueryByEmail(ctx, "test@example.com"); err != ErrNotFound {
+		t.Errorf("expected the email to be free, got: %v", err)
+	}
+	var tokens int
+	db.QueryRow(`SELECT COUNT(*) FROM user_tokens WHERE user_id = $1`, original.ID()).Scan(&tokens)
+	if tokens != 0 {
+		t.Errorf("expected no tokens, got %d", tokens)
+	}
+
+	if users, _ := store.Search(ctx, SearchFilter{}); len(users) != 0 {
+		t.Errorf("expected deleted users not to be found, got %d", len(users))
+	}
+
+	if _, err := store.Delete(ctx, original.ID()); err != nil {
+		t.Errorf("expected deleting again to do nothing, got: %v", err)
+	}
+
+	t.Log("✅ Deleted user")
+}
@@ -1,55 +1,127 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // Auth is used for JWT generation and validation.
 type Auth struct {
-	secret []byte
+	keys    *KeyRing
+	revoker Revoker
 }
 
 // TokenClaims is data payload stored in the JWT.
 type TokenClaims struct {
-	UserId int64  `json:"user_id"`
+	UserId string `json:"user_id"`
 	Email  string `json:"email"`
+
+	// The session the token was issued for, if any.
+	SessionID string `json:"sid,omitempty"`
+
+	// The workspace the token's requests are scoped to; 0 for the
+	// default workspace.
+	WorkspaceID int64 `json:"workspace_id,omitempty"`
+
+	// Whether the user signed in with a second factor.
+	MFA bool `json:"mfa,omitempty"`
+
 	jwt.RegisteredClaims
 }
 
+// Revoker reports whether a token was revoked before it expired.
+type Revoker interface {
+	Revoked(ctx context.Context, claims *TokenClaims) (bool, error)
+}
+
 var (
 	ErrInvalidToken = errors.New("invalid token")
 	ErrExpiredToken = errors.New("token expired")
+	ErrRevokedToken = errors.New("token revoked")
 )
 
-func NewAuth(secret string) *Auth {
+// NewAuth constructs an Auth that signs and verifies tokens with the keys
+// in a ring; with keys that only verify, it only validates tokens. Tokens
+// the revoker reports are rejected; it may be nil.
+func NewAuth(keys *KeyRing, revoker Revoker) *Auth {
 	return &Auth{
-		secret: []byte(secret),
+		keys:    keys,
+		revoker: revoker,
 	}
 }
 
-// GenerateToken creates new JWT for user.
-func (a *Auth) GenerateToken(userId int64, email string, duration time.Duration) (string, error) {
+// GenerateToken creates new JWT for user, scoped to a workspace and
+// belonging to a session, signed with the current signing key, saying
+// whether the session was signed in with a second factor. Each token gets
+// a unique ID (jti), by which it can be revoked.
+func (a *Auth) GenerateToken(userId, email, sessionID string, workspaceID int64, mfa bool, duration time.Duration) (string, error) {
+	now := time.Now()
+	key, ok := a.keys.signingKey(now)
+	if !ok {
+		return "", ErrNoSigningKey
+	}
+
 	// Create claims with data payload.
+	claims := &TokenClaims{
+		UserId:      userId,
+		Email:       email,
+		SessionID:   sessionID,
+		WorkspaceID: workspaceID,
+		MFA:         mfa,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	// Create unsigned token with claims, naming the key that signs it.
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+
+	// Sign token with key.
+	tokenString, err := token.SignedString(key.Private)
+	if err != nil {
+		return "", fmt.Errorf("signing token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// GenerateCallToken creates a short-lived JWT for a service to call
+// another, named by audience, on behalf of a user, saying whether they
+// signed in with a second factor. It isn't an access token: ValidateToken
+// rejects it.
+func (a *Auth) GenerateCallToken(userId, audience string, mfa bool, duration time.Duration) (string, error) {
 	now := time.Now()
+	key, ok := a.keys.signingKey(now)
+	if !ok {
+		return "", ErrNoSigningKey
+	}
+
 	claims := &TokenClaims{
 		UserId: userId,
-		Email:  email,
+		MFA:    mfa,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userId,
+			Audience:  jwt.ClaimStrings{audience},
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 		},
 	}
 
-	// Create unsigned token with claims.
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
 
-	// Sign token with secret.
-	tokenString, err := token.SignedString(a.secret)
+	tokenString, err := token.SignedString(key.Private)
 	if err != nil {
 		return "", fmt.Errorf("signing token: %w", err)
 	}
@@ -57,16 +129,55 @@ func (a *Auth) GenerateToken(userId int64, email string, duration time.Duration)
 	return tokenString, nil
 }
 
-// ValidateToken verifies a JWT and returns claims.
-func (a *Auth) ValidateToken(tokenString string) (*TokenClaims, error) {
+// ValidateToken verifies an access token, and that it wasn't revoked, and
+// returns claims.
+func (a *Auth) ValidateToken(ctx context.Context, tokenString string) (*TokenClaims, error) {
+	claims, err := a.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call tokens are for other services.
+	if len(claims.Audience) > 0 {
+		return nil, ErrInvalidToken
+	}
+
+	if a.revoker != nil {
+		revoked, err := a.revoker.Revoked(ctx, claims)
+		if err != nil {
+			return nil, fmt.Errorf("checking revocation: %w", err)
+		}
+		if revoked {
+			return nil, ErrRevokedToken
+		}
+	}
+
+	return claims, nil
+}
+
+// ValidateCallToken verifies a call token for audience, and returns
+// claims.
+func (a *Auth) ValidateCallToken(tokenString, audience string) (*TokenClaims, error) {
+	return a.parse(tokenString, jwt.WithAudience(audience))
+}
+
+// parse verifies a JWT and returns its claims.
+func (a *Auth) parse(tokenString string, opts ...jwt.ParserOption) (*TokenClaims, error) {
+	opts = append(opts, jwt.WithValidMethods([]string{RS256, EdDSA}))
+
 	// Parse and validate JWT.
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (any, error) {
-		// Verify signing method.
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		// Select the key by kid, and verify it's the key's signing method.
+		id, _ := token.Header["kid"].(string)
+		key, ok := a.keys.verificationKey(id, time.Now())
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+		if token.Method.Alg() != key.Algorithm {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
-		return a.secret, nil
-	})
+		return key.Public, nil
+	}, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("parsing token: %w", err)
 	}
@@ -79,3 +190,9 @@ func (a *Auth) ValidateToken(tokenString string) (*TokenClaims, error) {
 
 	return claims, nil
 }
+
+// JWKS returns the public keys tokens are verified with, for other services
+// to verify them.
+func (a *Auth) JWKS() JWKS {
+	return a.keys.JWKS(time.Now())
+}
@@ -1,14 +1,30 @@
 package auth
 
 import (
+	"context"
+	"crypto/ed25519"
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/golang-jwt/jwt/v5"
 )
 
+// newTestAuth returns an Auth with a new EdDSA signing key.
+func newTestAuth(t *testing.T, revoker Revoker) *Auth {
+	t.Helper()
+
+	key, err := GenerateKey(EdDSA, time.Now())
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return NewAuth(NewKeyRing(key), revoker)
+}
+
 func TestGenerateToken(t *testing.T) {
-	auth := NewAuth("test-secret-key")
+	auth := newTestAuth(t, nil)
 
-	token, err := auth.GenerateToken(123, "paul@example.com", 24*time.Hour)
+	token, err := auth.GenerateToken("user-123", "paul@example.com", "", 0, false, 24*time.Hour)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
@@ -21,34 +37,50 @@ func TestGenerateToken(t *testing.T) {
 }
 
 func TestValidateToken(t *testing.T) {
-	auth := NewAuth("test-secret-key")
+	auth := newTestAuth(t, nil)
 
 	// Generate a JWT.
-	token, _ := auth.GenerateToken(123, "paul@example.com", 24*time.Hour)
+	token, _ := auth.GenerateToken("user-123", "paul@example.com", "session-1", 7, true, 24*time.Hour)
 
 	// Validate the JWT.
-	claims, err := auth.ValidateToken(token)
+	claims, err := auth.ValidateToken(context.Background(), token)
 	if err != nil {
 		t.Fatalf("failed to validate token: %v", err)
 	}
 
 	// Verify claims.
-	if claims.UserId != 123 {
-		t.Errorf("expected user_id 123, got %d", claims.UserId)
+	if claims.UserId != "user-123" {
+		t.Errorf("expected user_id user-123, got %s", claims.UserId)
 	}
 
 	if claims.Email != "paul@example.com" {
 		t.Errorf("expected email paul@example.com, got %s", claims.Email)
 	}
 
+	if claims.WorkspaceID != 7 {
+		t.Errorf("expected workspace_id 7, got %d", claims.WorkspaceID)
+	}
+
+	if claims.SessionID != "session-1" {
+		t.Errorf("expected sid session-1, got %s", claims.SessionID)
+	}
+
+	if !claims.MFA {
+		t.Error("expected mfa claim")
+	}
+
+	if claims.ID == "" {
+		t.Error("expected jti to be generated")
+	}
+
 	t.Log("✅ Token validated successfully")
 }
 
 func TestValidateInvalidToken(t *testing.T) {
-	auth := NewAuth("test-secret-key")
+	auth := newTestAuth(t, nil)
 
 	// Try to validate garbage token.
-	_, err := auth.ValidateToken("invalid-token")
+	_, err := auth.ValidateToken(context.Background(), "invalid-token")
 	if err == nil {
 		t.Error("expected error for invalid token")
 	}
@@ -57,13 +89,13 @@ func TestValidateInvalidToken(t *testing.T) {
 }
 
 func TestValidateExpiredToken(t *testing.T) {
-	auth := NewAuth("test-secret-key")
+	auth := newTestAuth(t, nil)
 
 	// Generate JWT that expires immediately.
-	token, _ := auth.GenerateToken(123, "paul@example.com", -1*time.Second)
+	token, _ := auth.GenerateToken("user-123", "paul@example.com", "", 0, false, -1*time.Second)
 
 	// Try to validate expired token.
-	_, err := auth.ValidateToken(token)
+	_, err := auth.ValidateToken(context.Background(), token)
 	if err == nil {
 		t.Error("expected error for expired token")
 	}
@@ -71,18 +103,101 @@ func TestValidateExpiredToken(t *testing.T) {
 	t.Log("✅ Correctly rejected expired token")
 }
 
-func TestValidateWrongSecret(t *testing.T) {
-	auth1 := NewAuth("secret-1")
-	auth2 := NewAuth("secret-2")
+func TestValidateWrongKey(t *testing.T) {
+	auth1 := newTestAuth(t, nil)
+	auth2 := newTestAuth(t, nil)
 
 	// Generate with auth1.
-	token, _ := auth1.GenerateToken(123, "paul@example.com", 24*time.Hour)
+	token, _ := auth1.GenerateToken("user-123", "paul@example.com", "", 0, false, 24*time.Hour)
 
-	// Try to validate with wrong secret.
-	_, err := auth2.ValidateToken(token)
-	if err == nil {
-		t.Error("expected error for wrong secret")
+	// Try to validate with wrong key.
+	_, err := auth2.ValidateToken(context.Background(), token)
+	if !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("expected ErrUnknownKey, got %v", err)
+	}
+
+	t.Log("✅ Correctly rejected token signed with different key")
+}
+
+func TestValidateHMACToken(t *testing.T) {
+	key, _ := GenerateKey(EdDSA, time.Now())
+	auth := NewAuth(NewKeyRing(key), nil)
+
+	// An HS256 token naming the key, "signed" with its public part.
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &TokenClaims{UserId: "user-123"})
+	token.Header["kid"] = key.ID
+	forged, err := token.SignedString([]byte(key.Public.(ed25519.PublicKey)))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+
+	if _, err := auth.ValidateToken(context.Background(), forged); err == nil {
+		t.Error("expected error for HS256 token")
+	}
+
+	t.Log("✅ Correctly rejected token with unexpected signing method")
+}
+
+// revokedIDs revokes tokens by ID.
+type revokedIDs map[string]bool
+
+func (r revokedIDs) Revoked(ctx context.Context, claims *TokenClaims) (bool, error) {
+	return r[claims.ID], nil
+}
+
+func TestValidateRevokedToken(t *testing.T) {
+	revoked := revokedIDs{}
+	auth := newTestAuth(t, revoked)
+
+	token, _ := auth.GenerateToken("user-123", "paul@example.com", "session-1", 0, false, 24*time.Hour)
+	claims, err := auth.ValidateToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+
+	// Revoke the token.
+	revoked[claims.ID] = true
+
+	_, err = auth.ValidateToken(context.Background(), token)
+	if !errors.Is(err, ErrRevokedToken) {
+		t.Errorf("expected ErrRevokedToken, got %v", err)
+	}
+
+	t.Log("✅ Correctly rejected revoked token")
+}
+
+func TestCallToken(t *testing.T) {
+	auth := newTestAuth(t, nil)
+
+	token, err := auth.GenerateCallToken("user-123", "task-service", true, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate call token: %v", err)
+	}
+
+	claims, err := auth.ValidateCallToken(token, "task-service")
+	if err != nil {
+		t.Fatalf("failed to validate call token: %v", err)
+	}
+	if claims.UserId != "user-123" {
+		t.Errorf("expected user_id user-123, got %s", claims.UserId)
+	}
+	if !claims.MFA {
+		t.Error("expected mfa claim")
+	}
+
+	// Call tokens are for their audience only, and aren't access tokens.
+	if _, err := auth.ValidateCallToken(token, "notifier"); err == nil {
+		t.Error("expected error for call token of another audience")
+	}
+	if _, err := auth.ValidateToken(context.Background(), token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expected ErrInvalidToken for call token, got %v", err)
+	}
+
+	// Nor are access tokens call tokens.
+	access, _ := auth.GenerateToken("user-123", "paul@example.com", "", 0, false, time.Hour)
+	if _, err := auth.ValidateCallToken(access, "task-service"); err == nil {
+		t.Error("expected error for access token")
 	}
 
-	t.Log("✅ Correctly rejected token signed with different secret")
+	t.Log("✅ Call tokens are only valid for their audience")
 }
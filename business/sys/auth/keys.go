@@ -0,0 +1,301 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Algorithms tokens can be signed with.
+const (
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+var (
+	ErrNoSigningKey         = errors.New("no signing key")
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
+
+// Key is a key that signs tokens, identified in their kid header, or, if
+// it has no private part, only verifies them.
+type Key struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+
+	// The key signs tokens from NotBefore, until a newer key does, and
+	// verifies them until NotAfter; zero if it hasn't been succeeded.
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// GenerateKey returns a new key for an algorithm, signing from notBefore.
+func GenerateKey(algorithm string, notBefore time.Time) (Key, error) {
+	var private crypto.Signer
+	var err error
+	switch algorithm {
+	case RS256:
+		private, err = rsa.GenerateKey(rand.Reader, 2048)
+	case EdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return Key{}, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return Key{}, fmt.Errorf("generating %s key: %w", algorithm, err)
+	}
+
+	return Key{
+		ID:        uuid.New().String(),
+		Algorithm: algorithm,
+		Private:   private,
+		Public:    private.Public(),
+		NotBefore: notBefore,
+	}, nil
+}
+
+// expired reports whether the key no longer verifies tokens at now.
+func (k Key) expired(now time.Time) bool {
+	return !k.NotAfter.IsZero() && !now.Before(k.NotAfter)
+}
+
+func (k Key) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// KeyRing holds the keys an Auth signs and verifies tokens with. It's safe
+// for concurrent use, so the keys can be rotated while it's in use.
+type KeyRing struct {
+	mu   sync.RWMutex
+	keys []Key
+}
+
+// NewKeyRing constructs a KeyRing holding keys.
+func NewKeyRing(keys ...Key) *KeyRing {
+	return &KeyRing{keys: keys}
+}
+
+// Set replaces the keys in the ring.
+func (r *KeyRing) Set(keys []Key) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = slices.Clone(keys)
+}
+
+// signingKey returns the key that signs tokens at now: the newest one with
+// a private part that has started signing and hasn't expired.
+func (r *KeyRing) signingKey(now time.Time) (Key, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var signing Key
+	var ok bool
+	for _, k := range r.keys {
+		if k.Private == nil || k.NotBefore.After(now) || k.expired(now) {
+			continue
+		}
+		if !ok || k.NotBefore.After(signing.NotBefore) {
+			signing, ok = k, true
+		}
+	}
+	return signing, ok
+}
+
+// verificationKey returns the key with an ID, if it verifies tokens at now.
+func (r *KeyRing) verificationKey(id string, now time.Time) (Key, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, k := range r.keys {
+		if k.ID == id && !k.expired(now) {
+			return k, true
+		}
+	}
+	return Key{}, false
+}
+
+// JWK is the public part of a key, as a JSON Web Key (RFC 7517).
+type JWK struct {
+	KeyType   string `json:"kty"`
+	ID        string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+
+	// RSA keys.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// Ed25519 keys.
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public parts of the keys that verify tokens at now,
+// including those yet to sign any, so verifiers know them in time.
+func (r *KeyRing) JWKS(now time.Time) JWKS {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	set := JWKS{Keys: []JWK{}}
+	for _, k := range r.keys {
+		if k.expired(now) {
+			continue
+		}
+		jwk := JWK{ID: k.ID, Use: "sig", Algorithm: k.Algorithm}
+		switch public := k.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+// ParseJWKS returns the keys of a JSON Web Key Set, which only verify
+// tokens. Keys of unsupported types are left out; those that don't name
+// their algorithm get the one their type is used with.
+func ParseJWKS(data []byte) ([]Key, error) {
+	var set JWKS
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("decoding JWKS: %w", err)
+	}
+
+	keys := make([]Key, 0, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key := Key{ID: jwk.ID, Algorithm: jwk.Algorithm}
+		switch {
+		case jwk.KeyType == "RSA" && (jwk.Algorithm == RS256 || jwk.Algorithm == ""):
+			key.Algorithm = RS256
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil {
+				return nil, fmt.Errorf("decoding key %s: %w", jwk.ID, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil {
+				return nil, fmt.Errorf("decoding key %s: %w", jwk.ID, err)
+			}
+			key.Public = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case jwk.KeyType == "OKP" && jwk.Curve == "Ed25519" && (jwk.Algorithm == EdDSA || jwk.Algorithm == ""):
+			key.Algorithm = EdDSA
+			x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+			if err != nil || len(x) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("decoding key %s: invalid Ed25519 key", jwk.ID)
+			}
+			key.Public = ed25519.PublicKey(x)
+		default:
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// FetchJWKS returns the keys of the JSON Web Key Set at url.
+func FetchJWKS(ctx context.Context, client *http.Client, url string) ([]Key, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating JWKS request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching JWKS: %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS: %w", err)
+	}
+	return ParseJWKS(data)
+}
+
+// Rotation is a schedule for rotating signing keys.
+type Rotation struct {
+	Algorithm string
+
+	// Each key signs tokens for Every before its successor takes over.
+	Every time.Duration
+
+	// Successors are added Overlap before they take over, so that
+	// verifiers caching the JWKS for less than that know them in time.
+	Overlap time.Duration
+
+	// Keys verify tokens for TokenTTL after they're succeeded, until the
+	// tokens they signed expire.
+	TokenTTL time.Duration
+}
+
+// Next returns the keys to add to or update in keys at now, if rotation is
+// due: the first key, signing at once, or, Overlap before the newest key
+// has signed for Every, its successor, and the keys it succeeds, which
+// expire TokenTTL after it takes over.
+func (p Rotation) Next(keys []Key, now time.Time) ([]Key, error) {
+	var newest *Key
+	for i := range keys {
+		if !keys[i].expired(now) && (newest == nil || keys[i].NotBefore.After(newest.NotBefore)) {
+			newest = &keys[i]
+		}
+	}
+
+	notBefore := now
+	if newest != nil {
+		due := newest.NotBefore.Add(p.Every)
+		if now.Before(due.Add(-p.Overlap)) {
+			return nil, nil
+		}
+		if due.After(now) {
+			notBefore = due
+		}
+	}
+
+	next, err := GenerateKey(p.Algorithm, notBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := []Key{next}
+	for _, k := range keys {
+		if k.NotAfter.IsZero() {
+			k.NotAfter = notBefore.Add(p.TokenTTL)
+			changed = append(changed, k)
+		}
+	}
+	return changed, nil
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAlgorithms(t *testing.T) {
+	for _, alg := range []string{RS256, EdDSA} {
+		t.Run(alg, func(t *testing.T) {
+			key, err := GenerateKey(alg, time.Now())
+			if err != nil {
+				t.Fatalf("failed to generate key: %v", err)
+			}
+			auth := NewAuth(NewKeyRing(key), nil)
+
+			token, err := auth.GenerateToken("user-123", "paul@example.com", "", 0, false, time.Hour)
+			if err != nil {
+				t.Fatalf("failed to generate token: %v", err)
+			}
+			if _, err := auth.ValidateToken(context.Background(), token); err != nil {
+				t.Errorf("failed to validate token: %v", err)
+			}
+		})
+	}
+
+	if _, err := GenerateKey("HS256", time.Now()); !errors.Is(err, ErrUnsupportedAlgorithm) {
+		t.Errorf("expected ErrUnsupportedAlgorithm, got %v", err)
+	}
+
+	t.Log("✅ Signed and verified tokens with each algorithm")
+}
+
+func TestKeyRingSigningKey(t *testing.T) {
+	now := time.Now()
+	old, _ := GenerateKey(EdDSA, now.Add(-2*time.Hour))
+	current, _ := GenerateKey(EdDSA, now.Add(-time.Hour))
+	upcoming, _ := GenerateKey(EdDSA, now.Add(time.Hour))
+	current.NotAfter = upcoming.NotBefore.Add(time.Hour)
+	old.NotAfter = current.NotBefore.Add(time.Minute)
+
+	ring := NewKeyRing(old, current, upcoming)
+	auth := NewAuth(ring, nil)
+
+	key, ok := ring.signingKey(now)
+	if !ok || key.ID != current.ID {
+		t.Errorf("expected current key to sign, got %q", key.ID)
+	}
+
+	// Tokens signed by the old key no longer verify.
+	_, ok = ring.verificationKey(old.ID, now)
+	if ok {
+		t.Error("expected expired key not to verify")
+	}
+	if n := len(ring.JWKS(now).Keys); n != 2 {
+		t.Errorf("expected 2 published keys, got %d", n)
+	}
+
+	// Once the upcoming key takes over, tokens the current one signed
+	// still verify.
+	token, _ := auth.GenerateToken("user-123", "paul@example.com", "", 0, false, time.Hour)
+	ring.Set([]Key{current, upcoming})
+	key, _ = ring.signingKey(now.Add(90 * time.Minute))
+	if key.ID != upcoming.ID {
+		t.Errorf("expected upcoming key to sign, got %q", key.ID)
+	}
+	if _, err := auth.ValidateToken(context.Background(), token); err != nil {
+		t.Errorf("failed to validate token: %v", err)
+	}
+
+	if _, ok := NewKeyRing().signingKey(now); ok {
+		t.Error("expected empty ring to have no signing key")
+	}
+
+	t.Log("✅ Selected signing and verification keys")
+}
+
+func TestJWKS(t *testing.T) {
+	rsaKey, _ := GenerateKey(RS256, time.Now())
+	edKey, _ := GenerateKey(EdDSA, time.Now().Add(-time.Minute))
+	signer := NewAuth(NewKeyRing(rsaKey, edKey), nil)
+
+	data, err := json.Marshal(NewKeyRing(rsaKey, edKey).JWKS(time.Now()))
+	if err != nil {
+		t.Fatalf("failed to encode JWKS: %v", err)
+	}
+	keys, err := ParseJWKS(data)
+	if err != nil {
+		t.Fatalf("failed to parse JWKS: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 keys, got %d", len(keys))
+	}
+	for _, k := range keys {
+		if k.Private != nil {
+			t.Errorf("expected key %s to only verify", k.ID)
+		}
+	}
+
+	// Public keys alone verify tokens, but don't sign them.
+	verifier := NewAuth(NewKeyRing(keys...), nil)
+	token, _ := signer.GenerateToken("user-123", "paul@example.com", "", 0, false, time.Hour)
+	claims, err := verifier.ValidateToken(context.Background(), token)
+	if err != nil {
+		t.Fatalf("failed to validate token: %v", err)
+	}
+	if claims.UserId != "user-123" {
+		t.Errorf("expected user_id user-123, got %s", claims.UserId)
+	}
+	if _, err := verifier.GenerateToken("user-123", "paul@example.com", "", 0, false, time.Hour); !errors.Is(err, ErrNoSigningKey) {
+		t.Errorf("expected ErrNoSigningKey, got %v", err)
+	}
+
+	t.Log("✅ Verified tokens with the published keys")
+}
+
+func TestFetchJWKS(t *testing.T) {
+	key, _ := GenerateKey(EdDSA, time.Now())
+	ring := NewKeyRing(key)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ring.JWKS(time.Now()))
+	}))
+	defer srv.Close()
+
+	keys, err := FetchJWKS(context.Background(), srv.Client(), srv.URL)
+	if err != nil {
+		t.Fatalf("failed to fetch JWKS: %v", err)
+	}
+	if len(keys) != 1 || keys[0].ID != key.ID {
+		t.Fatalf("expected key %s, got %+v", key.ID, keys)
+	}
+
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+	if _, err := FetchJWKS(context.Background(), missing.Client(), missing.URL); err == nil {
+		t.Error("expected error for missing JWKS")
+	}
+
+	t.Log("✅ Fetched the published keys")
+}
+
+func TestRotationNext(t *testing.T) {
+	policy := Rotation{Algorithm: EdDSA, Every: 24 * time.Hour, Overlap: time.Hour, TokenTTL: 15 * time.Minute}
+	now := time.Now()
+
+	// The first key signs at once.
+	changed, err := policy.Next(nil, now)
+	if err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+	if len(changed) != 1 || !changed[0].NotBefore.Equal(now) {
+		t.Fatalf("expected a key signing now, got %+v", changed)
+	}
+	first := changed[0]
+
+	// Its successor isn't due until Overlap before it has signed for Every.
+	if changed, _ := policy.Next([]Key{first}, now.Add(22*time.Hour)); len(changed) != 0 {
+		t.Errorf("expected no rotation, got %d keys", len(changed))
+	}
+
+	changed, err = policy.Next([]Key{first}, now.Add(23*time.Hour))
+	if err != nil {
+		t.Fatalf("failed to rotate: %v", err)
+	}
+	if len(changed) != 2 {
+		t.Fatalf("expected successor and succeeded key, got %d keys", len(changed))
+	}
+	successor, succeeded := changed[0], changed[1]
+	if !successor.NotBefore.Equal(now.Add(24 * time.Hour)) {
+		t.Errorf("expected successor to sign from %v, got %v", now.Add(24*time.Hour), successor.NotBefore)
+	}
+	if succeeded.ID != first.ID || !succeeded.NotAfter.Equal(successor.NotBefore.Add(policy.TokenTTL)) {
+		t.Errorf("expected first key to expire TokenTTL after it's succeeded, got %+v", succeeded)
+	}
+
+	t.Log("✅ Rotated keys on schedule")
+}
@@ -0,0 +1,74 @@
+// Package blob stores opaque binary objects, such as task attachments,
+// behind a small interface with local filesystem and S3 implementations.
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Store saves and loads blobs by key.
+type Store interface {
+	// Put stores r under key. Size is the number of bytes in r, or -1 if unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get opens the blob stored under key. Caller must close the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the blob stored under key. Deleting a missing blob is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Config selects and configures a Store.
+type Config struct {
+	// Backend is "local" or "s3".
+	Backend string
+
+	// LocalDir is the root directory of the local backend.
+	LocalDir string
+
+	S3 S3Config
+}
+
+// NewStore constructs the Store selected by cfg.Backend.
+func NewStore(cfg Config) (Store, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocalStore(cfg.LocalDir)
+	case "s3":
+		return NewS3Store(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown blob backend %q", cfg.Backend)
+	}
+}
+
+// ConfigFromEnv reads blob store config from BLOB_* env vars.
+func ConfigFromEnv() Config {
+	return Config{
+		Backend:  os.Getenv("BLOB_BACKEND"),
+		LocalDir: getEnv("BLOB_LOCAL_DIR", "/var/lib/taskboard/blobs"),
+		S3: S3Config{
+			Endpoint:  os.Getenv("BLOB_S3_ENDPOINT"),
+			Region:    getEnv("BLOB_S3_REGION", "us-east-1"),
+			Bucket:    os.Getenv("BLOB_S3_BUCKET"),
+			AccessKey: os.Getenv("BLOB_S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("BLOB_S3_SECRET_KEY"),
+			PathStyle: os.Getenv("BLOB_S3_PATH_STYLE") == "true",
+		},
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,132 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// roundTrip exercises Put, Get and Delete against any Store.
+func roundTrip(t *testing.T, store Store, size int64) {
+	t.Helper()
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "tasks/1/report.log", strings.NewReader("hello blob"), size, "text/plain"); err != nil {
+		t.Fatalf("put failed: %v", err)
+	}
+
+	rc, err := store.Get(ctx, "tasks/1/report.log")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	data, _ := io.ReadAll(rc)
+	rc.Close()
+	if string(data) != "hello blob" {
+		t.Errorf("expected %q, got %q", "hello blob", data)
+	}
+
+	if err := store.Delete(ctx, "tasks/1/report.log"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := store.Get(ctx, "tasks/1/report.log"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound after delete, got: %v", err)
+	}
+
+	// Deleting twice is fine.
+	if err := store.Delete(ctx, "tasks/1/report.log"); err != nil {
+		t.Errorf("expected no error deleting missing blob, got: %v", err)
+	}
+}
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	roundTrip(t, store, -1)
+
+	t.Log("✅ Local store round trip works")
+}
+
+func TestLocalStoreRejectsTraversal(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	for _, key := range []string{"", "../escape", "/etc/passwd", "a/../../b"} {
+		err := store.Put(context.Background(), key, strings.NewReader("x"), 1, "")
+		if err != ErrInvalidKey {
+			t.Errorf("key %q: expected ErrInvalidKey, got: %v", key, err)
+		}
+	}
+
+	t.Log("✅ Correctly rejected keys escaping the root")
+}
+
+// fakeS3 is a minimal in-memory S3 that only checks requests are signed.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+		http.Error(w, "unsigned", http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			http.Error(w, "length required", http.StatusLengthRequired)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{objects: map[string][]byte{}})
+	defer server.Close()
+
+	store, err := NewS3Store(S3Config{
+		Endpoint:  server.URL,
+		Bucket:    "attachments",
+		AccessKey: "key",
+		SecretKey: "secret",
+		PathStyle: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	t.Run("known size", func(t *testing.T) {
+		roundTrip(t, store, int64(len("hello blob")))
+	})
+
+	t.Run("unknown size is spooled", func(t *testing.T) {
+		roundTrip(t, store, -1)
+	})
+
+	t.Log("✅ S3 store round trip works")
+}
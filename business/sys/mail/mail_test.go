@@ -0,0 +1,70 @@
+package mail
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestMessageBytes(t *testing.T) {
+	msg := Message{
+		From:    "Taskboard <noreply@example.com>",
+		To:      "alice@example.com",
+		Subject: "Überfällig: Steuer",
+		Text:    "Hello\nWorld",
+		HTML:    "<p>Hello</p>",
+		Headers: map[string]string{"List-Unsubscribe": "<https://example.com/u>\r\nBcc: evil@example.com"},
+	}
+
+	data, err := msg.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != msg.Subject {
+		t.Errorf("subject = %q, %v; want %q", subject, err, msg.Subject)
+	}
+	if got := parsed.Header.Get("Bcc"); got != "" {
+		t.Errorf("header injection added Bcc: %q", got)
+	}
+	if !strings.HasSuffix(parsed.Header.Get("Message-Id"), "@example.com>") {
+		t.Errorf("unexpected Message-ID %q", parsed.Header.Get("Message-Id"))
+	}
+
+	_, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mr := multipart.NewReader(parsed.Body, params["boundary"])
+	var bodies []string
+	for {
+		part, err := mr.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(quotedprintable.NewReader(part))
+		bodies = append(bodies, part.Header.Get("Content-Type")+"|"+string(body))
+	}
+	want := []string{"text/plain; charset=utf-8|Hello\r\nWorld", "text/html; charset=utf-8|<p>Hello</p>"}
+	if strings.Join(bodies, "\n") != strings.Join(want, "\n") {
+		t.Errorf("parts = %q, want %q", bodies, want)
+	}
+}
+
+func TestMessageBytesInvalidAddress(t *testing.T) {
+	if _, err := (Message{From: "noreply@example.com", To: "not an address"}).Bytes(); err == nil {
+		t.Error("expected an error for an invalid recipient")
+	}
+}
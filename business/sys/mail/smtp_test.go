@@ -0,0 +1,122 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+)
+
+// smtpSink is a minimal SMTP server that records what it receives.
+type smtpSink struct {
+	ln       net.Listener
+	commands []string
+	data     string
+
+	// Closed when the session is over.
+	done chan struct{}
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := &smtpSink{ln: ln, done: make(chan struct{})}
+	t.Cleanup(func() {
+		ln.Close()
+		<-sink.done
+	})
+
+	go func() {
+		defer close(sink.done)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		sink.serve(conn)
+	}()
+	return sink
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 sink ready")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		s.commands = append(s.commands, line)
+
+		switch verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); verb {
+		case "EHLO":
+			reply("250-sink")
+			reply("250 8BITMIME")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil || l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	sink := newSMTPSink(t)
+
+	sender, err := NewSMTPSender(SMTPConfig{Addr: sink.ln.Addr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sender.Send(context.Background(), Message{
+		From:    "Taskboard <noreply@example.com>",
+		To:      "Alice <alice@example.com>",
+		Subject: "Assigned",
+		Text:    "You were assigned a task.",
+	})
+	if err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+	<-sink.done
+
+	commands := strings.Join(sink.commands, "\n")
+	for _, want := range []string{"MAIL FROM:<noreply@example.com>", "RCPT TO:<alice@example.com>", "QUIT"} {
+		if !strings.Contains(commands, want) {
+			t.Errorf("expected %q in session:\n%s", want, commands)
+		}
+	}
+	if !strings.Contains(sink.data, "Subject: Assigned\r\n") || !strings.Contains(sink.data, "You were assigned a task.") {
+		t.Errorf("unexpected message:\n%s", sink.data)
+	}
+}
+
+func TestSMTPSenderRequiresStartTLS(t *testing.T) {
+	sink := newSMTPSink(t)
+
+	sender, err := NewSMTPSender(SMTPConfig{Addr: sink.ln.Addr().String(), TLS: "starttls"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sender.Send(context.Background(), Message{From: "noreply@example.com", To: "alice@example.com"})
+	if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Errorf("expected a STARTTLS error, got %v", err)
+	}
+}
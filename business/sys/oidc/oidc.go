@@ -0,0 +1,364 @@
+// Package oidc signs users in through OpenID Connect identity providers,
+// with the authorization code flow and PKCE.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+)
+
+var (
+	ErrInvalidIDToken = errors.New("invalid ID token")
+	ErrNonceMismatch  = errors.New("ID token nonce mismatch")
+	ErrExchange       = errors.New("authorization code exchange failed")
+)
+
+// keysRefetchInterval limits how often a provider's JWKS is fetched again
+// for ID tokens signed by keys it doesn't know.
+const keysRefetchInterval = time.Minute
+
+// Config configures a provider.
+type Config struct {
+	// Name identifies the provider in login URLs and linked identities.
+	Name string
+
+	// Issuer is the provider's issuer URL, which its discovery document is
+	// found under.
+	Issuer string
+
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the gateway's callback URL registered with the
+	// provider.
+	RedirectURL string
+
+	// Scopes requested besides openid.
+	Scopes []string
+
+	// TrustEmail treats emails as verified even without an email_verified
+	// claim, for providers that only hand out verified ones but don't say
+	// so.
+	TrustEmail bool
+}
+
+// ConfigsFromEnv reads provider configs from OIDC_* env vars: the comma
+// separated provider names in OIDC_PROVIDERS, and, for each, its
+// OIDC_<NAME>_ISSUER, _CLIENT_ID, _CLIENT_SECRET, _REDIRECT_URL, _SCOPES
+// (space separated, "email profile" by default) and _TRUST_EMAIL.
+func ConfigsFromEnv() ([]Config, error) {
+	var configs []Config
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		cfg := Config{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "email profile")),
+			TrustEmail:   os.Getenv(prefix+"TRUST_EMAIL") == "true",
+		}
+		if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+			return nil, fmt.Errorf("provider %s needs %sISSUER, %sCLIENT_ID and %sREDIRECT_URL", name, prefix, prefix, prefix)
+		}
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// Metadata is the part of a provider's discovery document the flow uses.
+type Metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Claims are the claims of an ID token the gateway uses.
+type Claims struct {
+	Email             string `json:"email"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Nonce             string `json:"nonce"`
+
+	// The authorized party, for tokens with several audiences.
+	AuthorizedParty string `json:"azp,omitempty"`
+
+	jwt.RegisteredClaims
+}
+
+// Provider is an identity provider. It discovers the provider's endpoints
+// and keys when first used, so the gateway starts while it's down.
+type Provider struct {
+	cfg    Config
+	client *http.Client
+
+	mu          sync.Mutex
+	metadata    *Metadata
+	keys        []auth.Key
+	keysFetched time.Time
+}
+
+// NewProvider constructs a provider, which makes its requests with client.
+func NewProvider(cfg Config, client *http.Client) *Provider {
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+// Name returns the provider's name.
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// discover returns the provider's metadata, fetching its discovery document
+// if it hasn't been yet.
+func (p *Provider) discover(ctx context.Context) (Metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.metadata != nil {
+		return *p.metadata, nil
+	}
+
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	var md Metadata
+	if err := p.getJSON(ctx, wellKnown, &md); err != nil {
+		return Metadata{}, fmt.Errorf("discovering %s: %w", p.cfg.Name, err)
+	}
+	// The issuer must be the one configured, or its tokens would be
+	// accepted as another's.
+	if md.Issuer != p.cfg.Issuer {
+		return Metadata{}, fmt.Errorf("discovering %s: issuer %q doesn't match %q", p.cfg.Name, md.Issuer, p.cfg.Issuer)
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return Metadata{}, fmt.Errorf("discovering %s: incomplete discovery document", p.cfg.Name)
+	}
+	p.metadata = &md
+	return md, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(v)
+}
+
+// AuthCodeURL returns the URL to send a user to, to sign in. The state
+// comes back with the code; the nonce, in the ID token; and the verifier
+// is presented to exchange the code.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(md.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("parsing authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(append([]string{"openid"}, p.cfg.Scopes...), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", Challenge(verifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange trades an authorization code for the claims of the ID token
+// issued with it, which must carry nonce.
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"code_verifier": {verifier},
+	}
+	// Public clients have no secret, and identify themselves in the form.
+	if p.cfg.ClientSecret == "" {
+		form.Set("client_id", p.cfg.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: decoding token response: %v", ErrExchange, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s %s", ErrExchange, body.Error, body.ErrorDescription)
+	}
+	if body.IDToken == "" {
+		return nil, fmt.Errorf("%w: no ID token", ErrExchange)
+	}
+
+	return p.VerifyIDToken(ctx, body.IDToken, nonce)
+}
+
+// VerifyIDToken returns the claims of an ID token, if the provider issued
+// it to the gateway, it hasn't expired, and it carries nonce.
+func (p *Provider) VerifyIDToken(ctx context.Context, raw, nonce string) (*Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{}
+	_, err = jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := p.key(ctx, md.JWKSURI, kid)
+		if err != nil {
+			return nil, err
+		}
+		if t.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("key %s is for %s", kid, key.Algorithm)
+		}
+		return key.Public, nil
+	},
+		jwt.WithValidMethods([]string{auth.RS256, auth.EdDSA}),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIDToken)
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID {
+		return nil, fmt.Errorf("%w: issued to %q", ErrInvalidIDToken, claims.AuthorizedParty)
+	}
+	if claims.Nonce != nonce {
+		return nil, ErrNonceMismatch
+	}
+	return claims, nil
+}
+
+// key returns the provider's key with an ID, fetching its JWKS again if
+// the key is new to it, at most every keysRefetchInterval.
+func (p *Provider) key(ctx context.Context, jwksURI, kid string) (auth.Key, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	find := func() (auth.Key, bool) {
+		i := slices.IndexFunc(p.keys, func(k auth.Key) bool { return k.ID == kid || kid == "" })
+		if i < 0 {
+			return auth.Key{}, false
+		}
+		return p.keys[i], true
+	}
+	if k, ok := find(); ok {
+		return k, nil
+	}
+	if time.Since(p.keysFetched) < keysRefetchInterval {
+		return auth.Key{}, fmt.Errorf("%w: %s", auth.ErrUnknownKey, kid)
+	}
+
+	keys, err := auth.FetchJWKS(ctx, p.client, jwksURI)
+	if err != nil {
+		return auth.Key{}, err
+	}
+	p.keys, p.keysFetched = keys, time.Now()
+	if k, ok := find(); ok {
+		return k, nil
+	}
+	return auth.Key{}, fmt.Errorf("%w: %s", auth.ErrUnknownKey, kid)
+}
+
+// EmailVerified reports whether the provider vouches for the token's
+// email.
+func (p *Provider) EmailVerified(claims *Claims) bool {
+	if claims.Email == "" {
+		return false
+	}
+	if claims.EmailVerified == nil {
+		return p.cfg.TrustEmail
+	}
+	return *claims.EmailVerified
+}
+
+// RandomString returns a random, URL-safe string, for states, nonces and
+// verifiers.
+func RandomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Challenge returns the S256 PKCE code challenge of a verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
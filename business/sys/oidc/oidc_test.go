@@ -0,0 +1,260 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+)
+
+// standIn is a minimal identity provider. It issues one code per sign-in,
+// remembering the challenge and nonce it was requested with.
+type standIn struct {
+	t      *testing.T
+	srv    *httptest.Server
+	key    auth.Key
+	claims Claims
+
+	challenge string
+	nonce     string
+}
+
+func newStandIn(t *testing.T) *standIn {
+	t.Helper()
+
+	key, err := auth.GenerateKey(auth.RS256, time.Now())
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	s := &standIn{t: t, key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(Metadata{
+			Issuer:                s.srv.URL,
+			AuthorizationEndpoint: s.srv.URL + "/authorize",
+			TokenEndpoint:         s.srv.URL + "/token",
+			JWKSURI:               s.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(auth.NewKeyRing(key).JWKS(time.Now()))
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code-1" || Challenge(r.FormValue("code_verifier")) != s.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		if id, secret, _ := r.BasicAuth(); id != "taskboard" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		claims := s.claims
+		claims.Nonce = s.nonce
+		json.NewEncoder(w).Encode(map[string]string{"id_token": s.sign(claims)})
+	})
+	s.srv = httptest.NewServer(mux)
+	t.Cleanup(s.srv.Close)
+
+	exp := jwt.NewNumericDate(time.Now().Add(time.Hour))
+	s.claims = Claims{
+		Email:            "alice@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{Issuer: s.srv.URL, Subject: "sub-1", Audience: jwt.ClaimStrings{"taskboard"}, ExpiresAt: exp},
+	}
+	return s
+}
+
+func (s *standIn) sign(claims Claims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = s.key.ID
+	signed, err := token.SignedString(s.key.Private)
+	if err != nil {
+		s.t.Fatalf("failed to sign ID token: %v", err)
+	}
+	return signed
+}
+
+func (s *standIn) provider() *Provider {
+	return NewProvider(Config{
+		Name:         "corp",
+		Issuer:       s.srv.URL,
+		ClientID:     "taskboard",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:8080/api/auth/oidc/corp/callback",
+		Scopes:       []string{"email"},
+	}, s.srv.Client())
+}
+
+func TestAuthCodeFlow(t *testing.T) {
+	s := newStandIn(t)
+	p := s.provider()
+	ctx := context.Background()
+
+	state, _ := RandomString()
+	nonce, _ := RandomString()
+	verifier, _ := RandomString()
+
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		t.Fatalf("failed to build auth URL: %v", err)
+	}
+	u, _ := url.Parse(authURL)
+	q := u.Query()
+	if u.Path != "/authorize" || q.Get("state") != state || q.Get("scope") != "openid email" || q.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected auth URL %s", authURL)
+	}
+	s.challenge, s.nonce = q.Get("code_challenge"), q.Get("nonce")
+
+	t.Run("wrong verifier", func(t *testing.T) {
+		other, _ := RandomString()
+		if _, err := p.Exchange(ctx, "code-1", other, nonce); !errors.Is(err, ErrExchange) {
+			t.Errorf("expected ErrExchange, got %v", err)
+		}
+	})
+
+	t.Run("exchange", func(t *testing.T) {
+		claims, err := p.Exchange(ctx, "code-1", verifier, nonce)
+		if err != nil {
+			t.Fatalf("failed to exchange code: %v", err)
+		}
+		if claims.Subject != "sub-1" || claims.Email != "alice@example.com" {
+			t.Errorf("unexpected claims %+v", claims)
+		}
+
+		t.Log("✅ Signed in with the code flow and PKCE")
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		if _, err := p.Exchange(ctx, "code-1", verifier, "other"); !errors.Is(err, ErrNonceMismatch) {
+			t.Errorf("expected ErrNonceMismatch, got %v", err)
+		}
+	})
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	s := newStandIn(t)
+	p := s.provider()
+	ctx := context.Background()
+
+	valid := s.claims
+	valid.Nonce = "n"
+
+	wrongAudience := valid
+	wrongAudience.Audience = jwt.ClaimStrings{"someone-else"}
+
+	wrongIssuer := valid
+	wrongIssuer.Issuer = "https://evil.example.com"
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	otherParty := valid
+	otherParty.Audience = jwt.ClaimStrings{"taskboard", "someone-else"}
+	otherParty.AuthorizedParty = "someone-else"
+
+	tests := []struct {
+		name   string
+		claims Claims
+		want   error
+	}{
+		{"valid", valid, nil},
+		{"wrong audience", wrongAudience, ErrInvalidIDToken},
+		{"wrong issuer", wrongIssuer, ErrInvalidIDToken},
+		{"expired", expired, ErrInvalidIDToken},
+		{"other authorized party", otherParty, ErrInvalidIDToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.VerifyIDToken(ctx, s.sign(tt.claims), "n")
+			if tt.want == nil && err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	// Tokens signed by keys the provider doesn't publish are rejected.
+	forged, _ := auth.GenerateKey(auth.RS256, time.Now())
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, valid)
+	token.Header["kid"] = forged.ID
+	signed, _ := token.SignedString(forged.Private)
+	if _, err := p.VerifyIDToken(ctx, signed, "n"); !errors.Is(err, ErrInvalidIDToken) {
+		t.Errorf("expected ErrInvalidIDToken, got %v", err)
+	}
+
+	t.Log("✅ Verified ID tokens")
+}
+
+func TestDiscoverIssuerMismatch(t *testing.T) {
+	s := newStandIn(t)
+	p := NewProvider(Config{Name: "corp", Issuer: s.srv.URL + "/other", ClientID: "taskboard"}, s.srv.Client())
+
+	if _, err := p.AuthCodeURL(context.Background(), "s", "n", "v"); err == nil {
+		t.Error("expected discovery to fail")
+	}
+}
+
+func TestEmailVerified(t *testing.T) {
+	yes, no := true, false
+	tests := []struct {
+		name       string
+		claims     Claims
+		trustEmail bool
+		want       bool
+	}{
+		{"verified", Claims{Email: "a@example.com", EmailVerified: &yes}, false, true},
+		{"unverified", Claims{Email: "a@example.com", EmailVerified: &no}, true, false},
+		{"unstated", Claims{Email: "a@example.com"}, false, false},
+		{"unstated but trusted", Claims{Email: "a@example.com"}, true, true},
+		{"no email", Claims{EmailVerified: &yes}, true, false},
+	}
+	for _, tt := range tests {
+		p := NewProvider(Config{TrustEmail: tt.trustEmail}, nil)
+		if got := p.EmailVerified(&tt.claims); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestConfigsFromEnv(t *testing.T) {
+	t.Setenv("OIDC_PROVIDERS", "corp, google")
+	t.Setenv("OIDC_CORP_ISSUER", "https://sso.example.com")
+	t.Setenv("OIDC_CORP_CLIENT_ID", "taskboard")
+	t.Setenv("OIDC_CORP_REDIRECT_URL", "http://localhost:8080/api/auth/oidc/corp/callback")
+	t.Setenv("OIDC_CORP_TRUST_EMAIL", "true")
+	t.Setenv("OIDC_GOOGLE_ISSUER", "https://accounts.google.com")
+	t.Setenv("OIDC_GOOGLE_CLIENT_ID", "123.apps.googleusercontent.com")
+	t.Setenv("OIDC_GOOGLE_REDIRECT_URL", "http://localhost:8080/api/auth/oidc/google/callback")
+	t.Setenv("OIDC_GOOGLE_SCOPES", "email")
+
+	configs, err := ConfigsFromEnv()
+	if err != nil {
+		t.Fatalf("failed to read configs: %v", err)
+	}
+	if len(configs) != 2 {
+		t.Fatalf("expected 2 providers, got %d", len(configs))
+	}
+	if configs[0].Name != "corp" || !configs[0].TrustEmail || len(configs[0].Scopes) != 2 {
+		t.Errorf("unexpected corp config %+v", configs[0])
+	}
+	if configs[1].Name != "google" || len(configs[1].Scopes) != 1 {
+		t.Errorf("unexpected google config %+v", configs[1])
+	}
+
+	t.Setenv("OIDC_GOOGLE_CLIENT_ID", "")
+	if _, err := ConfigsFromEnv(); err == nil {
+		t.Error("expected error for incomplete config")
+	}
+}
@@ -0,0 +1,132 @@
+// Package totp generates and checks time-based one-time passwords
+// (RFC 6238), as authenticator apps show: 6 digits, derived from a shared
+// secret and the current 30-second step with HMAC-SHA1.
+//
+// Secrets are shared with apps through an otpauth:// provisioning URI,
+// usually shown as a QR code.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Parameters of the codes, which authenticator apps assume.
+const (
+	Digits = 6
+	Period = 30 * time.Second
+)
+
+// Skew is how many steps either side of the current one codes are
+// accepted from, for clocks that are a little off.
+const Skew = 1
+
+// secretSize is the size of secrets in bytes, as RFC 4226 recommends.
+const secretSize = 20
+
+var ErrInvalidSecret = errors.New("invalid TOTP secret")
+
+// encoding is how secrets are written: unpadded base32, as apps expect.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a random secret, base32-encoded.
+func NewSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// decodeSecret decodes a base32 secret, ignoring case, spaces and padding,
+// as users may type it.
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := encoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+	return key, nil
+}
+
+// Step returns the step t falls in.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Code returns the code for a secret at t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, uint64(Step(t)), Digits), nil
+}
+
+// code computes the HOTP (RFC 4226) of key and counter, to digits digits.
+func code(key []byte, counter uint64, digits int) string {
+	mac := hmac.New(sha1.New, key)
+	binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// Validate checks a code for a secret at t, within Skew steps, and returns
+// the step it was for. Codes for steps up to after are rejected, so each
+// can be used only once: callers pass the step of the last code accepted.
+func Validate(secret, input string, t time.Time, after int64) (int64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	input = strings.ReplaceAll(input, " ", "")
+	if len(input) != Digits {
+		return 0, false
+	}
+
+	now := Step(t)
+	for step := now - Skew; step <= now+Skew; step++ {
+		if step <= after || step < 0 {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(code(key, uint64(step), Digits)), []byte(input)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI returns the otpauth:// URI that adds an account with a
+// secret to authenticator apps, which label it "issuer: account".
+func ProvisioningURI(issuer, account, secret string) string {
+	u := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + issuer + ":" + account,
+	}
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period/time.Second)))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,108 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 secret of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFCVectors(t *testing.T) {
+	key, err := decodeSecret(rfcSecret)
+	if err != nil {
+		t.Fatalf("decoding secret: %v", err)
+	}
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, tt := range tests {
+		got := code(key, uint64(Step(time.Unix(tt.unix, 0))), 8)
+		if got != tt.want {
+			t.Errorf("code at %d = %s, want %s", tt.unix, got, tt.want)
+		}
+
+		// 6-digit codes are the last 6 digits.
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("Code: %v", err)
+		}
+		if got != tt.want[2:] {
+			t.Errorf("Code at %d = %s, want %s", tt.unix, got, tt.want[2:])
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := NewSecret()
+	if err != nil {
+		t.Fatalf("NewSecret: %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	code, _ := Code(secret, now)
+
+	step, ok := Validate(secret, code, now, 0)
+	if !ok || step != Step(now) {
+		t.Fatalf("expected code valid at step %d, got %d, %v", Step(now), step, ok)
+	}
+
+	// Clocks a step off are tolerated, but not more.
+	if _, ok := Validate(secret, code, now.Add(Period), 0); !ok {
+		t.Error("expected code valid a step later")
+	}
+	if _, ok := Validate(secret, code, now.Add(3*Period), 0); ok {
+		t.Error("expected code invalid three steps later")
+	}
+
+	// Used codes are rejected.
+	if _, ok := Validate(secret, code, now, step); ok {
+		t.Error("expected code invalid once used")
+	}
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	if _, ok := Validate(secret, wrong, now, 0); ok {
+		t.Error("expected wrong code invalid")
+	}
+	if _, ok := Validate(secret, code[:5], now, 0); ok {
+		t.Error("expected short code invalid")
+	}
+	if _, ok := Validate("not base32!", code, now, 0); ok {
+		t.Error("expected invalid secret to fail")
+	}
+
+	// Lowercase and spaced secrets, as users type them, work.
+	spaced := secret[:4] + " " + secret[4:]
+	if _, ok := Validate(spaced, code, now, 0); !ok {
+		t.Error("expected spaced secret to work")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Taskboard", "alice@example.com", "JBSWY3DPEHPK3PXP")
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("parsing URI: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/Taskboard:alice@example.com" {
+		t.Errorf("unexpected URI %s", uri)
+	}
+	q := u.Query()
+	if q.Get("secret") != "JBSWY3DPEHPK3PXP" || q.Get("issuer") != "Taskboard" || q.Get("digits") != "6" {
+		t.Errorf("unexpected query %v", q)
+	}
+}
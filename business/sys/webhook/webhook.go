@@ -0,0 +1,308 @@
+// Package webhook sends signed event notifications over HTTP.
+//
+// Each request carries the headers
+//
+//	X-Taskboard-Event:     the event type, such as task.created
+//	X-Taskboard-Delivery:  the delivery ID, new for every redelivery
+//	X-Taskboard-Signature: t=<unix time>,v1=<hex HMAC-SHA256>
+//
+// The signature is the HMAC-SHA256, keyed with the subscription's secret,
+// of the time, a ".", and the request body. Receivers recompute it with
+// Verify and should reject old timestamps to stop replays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Request headers.
+const (
+	HeaderEvent     = "X-Taskboard-Event"
+	HeaderDelivery  = "X-Taskboard-Delivery"
+	HeaderSignature = "X-Taskboard-Signature"
+)
+
+// maxResponseBody bounds how much of a response is kept for the delivery log.
+const maxResponseBody = 2048
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrExpiredSignature = errors.New("webhook signature timestamp out of tolerance")
+	ErrBlockedAddress   = errors.New("webhook address is on an internal network")
+)
+
+// Sign returns the signature header value for body sent at t.
+func Sign(secret []byte, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + hex.EncodeToString(mac(secret, ts, body))
+}
+
+func mac(secret []byte, ts string, body []byte) []byte {
+	m := hmac.New(sha256.New, secret)
+	m.Write([]byte(ts))
+	m.Write([]byte("."))
+	m.Write(body)
+	return m.Sum(nil)
+}
+
+// Verify checks a signature header against body. Signatures made more
+// than tolerance from now are rejected; a zero tolerance disables the
+// check. Several v1 values, as sent while a secret is rotated, are
+// accepted if any matches.
+func Verify(secret []byte, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var sigs [][]byte
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			if sig, err := hex.DecodeString(value); err == nil {
+				sigs = append(sigs, sig)
+			}
+		}
+	}
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(sigs) == 0 {
+		return ErrInvalidSignature
+	}
+
+	want := mac(secret, ts, body)
+	valid := false
+	for _, sig := range sigs {
+		if hmac.Equal(sig, want) {
+			valid = true
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	if tolerance > 0 {
+		if d := now.Sub(time.Unix(secs, 0)); d > tolerance || d < -tolerance {
+			return ErrExpiredSignature
+		}
+	}
+	return nil
+}
+
+// RetryPolicy spaces out delivery attempts exponentially.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts before a delivery is dead.
+	MaxAttempts int
+
+	// Base is the delay after the first failed attempt, doubling after
+	// each one up to Max.
+	Base time.Duration
+	Max  time.Duration
+}
+
+// DefaultRetryPolicy retries for about eight hours.
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 10, Base: 30 * time.Second, Max: 4 * time.Hour}
+
+// Next returns the delay before the attempt after the given number of
+// failed attempts, or false if there should be no more.
+func (p RetryPolicy) Next(attempts int) (time.Duration, bool) {
+	if attempts >= p.MaxAttempts {
+		return 0, false
+	}
+	delay := p.Base
+	for i := 1; i < attempts && delay < p.Max; i++ {
+		delay *= 2
+	}
+	return min(delay, p.Max), true
+}
+
+// Request is one delivery attempt.
+type Request struct {
+	URL        string
+	Secret     []byte
+	EventType  string
+	DeliveryID string
+	Body       []byte
+}
+
+// Result is the outcome of an attempt. Err is set for failures that got
+// no response, and for non-2xx responses.
+type Result struct {
+	StatusCode int
+
+	// ResponseBody is the start of the response body.
+	ResponseBody string
+	Duration     time.Duration
+	Err          error
+}
+
+// OK reports whether the receiver accepted the event.
+func (r Result) OK() bool {
+	return r.Err == nil
+}
+
+// blockedNetworks are the internal ranges netip has no predicate for:
+// "this network", shared (carrier-grade NAT) addresses, IETF protocol
+// assignments, and local-use NAT64.
+var blockedNetworks = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// ipv4Embeddings are IPv6 ranges that carry an IPv4 address, and where it
+// starts: NAT64, IPv4-translated, IPv4-compatible and 6to4 addresses.
+var ipv4Embeddings = []struct {
+	prefix netip.Prefix
+	offset int
+}{
+	{netip.MustParsePrefix("64:ff9b::/96"), 12},
+	{netip.MustParsePrefix("::ffff:0:0:0/96"), 12},
+	{netip.MustParsePrefix("::/96"), 12},
+	{netip.MustParsePrefix("2002::/16"), 2},
+}
+
+// embeddedIPv4 returns the IPv4 address ip carries, or ip if it carries
+// none.
+func embeddedIPv4(ip netip.Addr) netip.Addr {
+	ip = ip.Unmap()
+	if !ip.Is6() {
+		return ip
+	}
+	b := ip.As16()
+	for _, e := range ipv4Embeddings {
+		if e.prefix.Contains(ip) {
+			return netip.AddrFrom4([4]byte(b[e.offset : e.offset+4]))
+		}
+	}
+	return ip
+}
+
+// Blocked reports whether deliveries to ip are refused. Loopback, private,
+// link-local, unspecified and other internal addresses are, as are IPv6
+// addresses carrying one, so subscriptions can't reach internal services,
+// unless one of allowed contains them.
+func Blocked(ip netip.Addr, allowed []netip.Prefix) bool {
+	ip = embeddedIPv4(ip)
+	for _, p := range allowed {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	for _, p := range blockedNetworks {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast()
+}
+
+// ParseNetworks parses a comma-separated list of CIDR prefixes and
+// addresses, such as "10.1.0.0/16,192.168.1.5", for the allowed argument
+// of NewHTTPClient.
+func ParseNetworks(list string) ([]netip.Prefix, error) {
+	var networks []netip.Prefix
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if ip, err := netip.ParseAddr(entry); err == nil {
+			networks = append(networks, netip.PrefixFrom(ip.Unmap(), ip.Unmap().BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", entry, err)
+		}
+		networks = append(networks, p.Masked())
+	}
+	return networks, nil
+}
+
+// NewHTTPClient returns a client for deliveries. Redirects are not
+// followed: subscriptions must name the URL that takes the events.
+// Connections to addresses Blocked with allowed fail with
+// ErrBlockedAddress. They are checked once names are resolved, so names
+// that resolve to internal addresses are refused too, and no proxy is
+// used, as it would connect on the client's behalf.
+func NewHTTPClient(timeout time.Duration, allowed []netip.Prefix) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if Blocked(ip, allowed) {
+				return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Send POSTs req.Body to req.URL, signed with req.Secret.
+func Send(ctx context.Context, client *http.Client, req Request) Result {
+	start := time.Now()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return Result{Err: err}
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "taskboard-webhooks/1")
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, req.DeliveryID)
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, start, req.Body))
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return Result{Duration: time.Since(start), Err: err}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	// Drain a little more so the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	result := Result{
+		StatusCode:   resp.StatusCode,
+		ResponseBody: strings.ToValidUTF8(string(body), "�"),
+		Duration:     time.Since(start),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		result.Err = fmt.Errorf("endpoint responded %s", resp.Status)
+	}
+	return result
+}
@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSignVerify(t *testing.T) {
+	secret := []byte("s3cret")
+	body := []byte(`{"type":"task.created"}`)
+	now := time.Unix(1700000000, 0)
+
+	header := Sign(secret, now, body)
+	if !strings.HasPrefix(header, "t=1700000000,v1=") {
+		t.Fatalf("unexpected header %q", header)
+	}
+	if err := Verify(secret, header, body, now.Add(time.Minute), 5*time.Minute); err != nil {
+		t.Errorf("expected valid signature, got: %v", err)
+	}
+
+	if err := Verify([]byte("other"), header, body, now, 0); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for wrong secret, got: %v", err)
+	}
+	if err := Verify(secret, header, []byte(`{}`), now, 0); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature for changed body, got: %v", err)
+	}
+	if err := Verify(secret, "v1=abc", body, now, 0); err != ErrInvalidSignature {
+		t.Errorf("expected ErrInvalidSignature without timestamp, got: %v", err)
+	}
+	if err := Verify(secret, header, body, now.Add(time.Hour), 5*time.Minute); err != ErrExpiredSignature {
+		t.Errorf("expected ErrExpiredSignature, got: %v", err)
+	}
+
+	// Any matching v1 value is accepted.
+	rotated := "t=1700000000,v1=00ff," + strings.Split(header, ",")[1]
+	if err := Verify(secret, rotated, body, now, 0); err != nil {
+		t.Errorf("expected one matching v1 to be enough, got: %v", err)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, Base: time.Second, Max: 5 * time.Second}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		got, ok := p.Next(i + 1)
+		if !ok || got != w {
+			t.Errorf("Next(%d) = %v, %v; want %v, true", i+1, got, ok, w)
+		}
+	}
+	if _, ok := p.Next(5); ok {
+		t.Error("expected no retry after MaxAttempts")
+	}
+}
+
+func TestSend(t *testing.T) {
+	secret := []byte("s3cret")
+	var gotHeader http.Header
+	var gotBody []byte
+	status := http.StatusNoContent
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		io.WriteString(w, "thanks")
+	}))
+	defer srv.Close()
+
+	req := Request{URL: srv.URL, Secret: secret, EventType: "task.updated", DeliveryID: "42", Body: []byte(`{"a":1}`)}
+	// The test server listens on loopback, which must be allowed.
+	client := NewHTTPClient(time.Second, []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")})
+
+	result := Send(context.Background(), client, req)
+	if !result.OK() || result.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected success, got %+v", result)
+	}
+	if gotHeader.Get(HeaderEvent) != "task.updated" || gotHeader.Get(HeaderDelivery) != "42" {
+		t.Errorf("unexpected headers: %v", gotHeader)
+	}
+	if err := Verify(secret, gotHeader.Get(HeaderSignature), gotBody, time.Now(), time.Minute); err != nil {
+		t.Errorf("receiver could not verify signature: %v", err)
+	}
+
+	status = http.StatusInternalServerError
+	result = Send(context.Background(), client, req)
+	if result.OK() || result.StatusCode != http.StatusInternalServerError || result.ResponseBody != "thanks" {
+		t.Errorf("expected a failed attempt with the response kept, got %+v", result)
+	}
+
+	// Redirects are failures, not followed.
+	status = http.StatusFound
+	if result = Send(context.Background(), client, req); result.OK() {
+		t.Errorf("expected redirect to fail, got %+v", result)
+	}
+
+	srv.Close()
+	if result = Send(context.Background(), client, req); result.OK() || result.StatusCode != 0 {
+		t.Errorf("expected connection failure, got %+v", result)
+	}
+}
+
+func TestBlocked(t *testing.T) {
+	allowed := []netip.Prefix{netip.MustParsePrefix("10.1.0.0/16")}
+
+	tests := []struct {
+		addr    string
+		blocked bool
+	}{
+		{"93.184.216.34", false},
+		{"2606:2800:220:1::1", false},
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"10.0.0.5", true},
+		{"10.1.2.3", false}, // Allowed.
+		{"172.16.0.1", true},
+		{"192.168.1.1", true},
+		{"fd00::1", true},
+		{"169.254.169.254", true},
+		{"fe80::1", true},
+		{"0.0.0.0", true},
+		{"::", true},
+		{"::ffff:127.0.0.1", true},
+		{"::ffff:10.1.0.1", false},
+		{"0.1.2.3", true},
+		{"100.64.0.1", true},
+		{"100.127.255.254", true},
+		{"100.128.0.1", false},
+		{"192.0.0.170", true},
+		{"192.0.2.1", false},
+		{"64:ff9b::a9fe:a9fe", true},   // NAT64 169.254.169.254.
+		{"64:ff9b::5db8:d822", false},  // NAT64 93.184.216.34.
+		{"64:ff9b::a01:203", false},    // NAT64 10.1.2.3, allowed.
+		{"64:ff9b:1::5db8:d822", true}, // Local-use NAT64.
+		{"::ffff:0:7f00:1", true},      // IPv4-translated 127.0.0.1.
+		{"::7f00:1", true},             // IPv4-compatible 127.0.0.1.
+		{"::5db8:d822", false},         // IPv4-compatible 93.184.216.34.
+		{"2002:a00:5::1", true},        // 6to4 10.0.0.5.
+		{"2002:5db8:d822::1", false},   // 6to4 93.184.216.34.
+	}
+
+	for _, tt := range tests {
+		if got := Blocked(netip.MustParseAddr(tt.addr), allowed); got != tt.blocked {
+			t.Errorf("Blocked(%s) = %v; want %v", tt.addr, got, tt.blocked)
+		}
+	}
+}
+
+func TestParseNetworks(t *testing.T) {
+	networks, err := ParseNetworks(" 10.1.0.0/16, 192.168.1.5 ,,fd00::/8")
+	if err != nil {
+		t.Fatalf("failed to parse networks: %v", err)
+	}
+	want := []string{"10.1.0.0/16", "192.168.1.5/32", "fd00::/8"}
+	if len(networks) != len(want) {
+		t.Fatalf("expected %v, got %v", want, networks)
+	}
+	for i, w := range want {
+		if networks[i].String() != w {
+			t.Errorf("expected %s, got %s", w, networks[i])
+		}
+	}
+
+	if networks, err := ParseNetworks(""); err != nil || len(networks) != 0 {
+		t.Errorf("expected no networks, got %v, %v", networks, err)
+	}
+	if _, err := ParseNetworks("10.0.0.0/33"); err == nil {
+		t.Error("expected error for invalid prefix")
+	}
+}
+
+func TestSendBlocked(t *testing.T) {
+	var received bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer srv.Close()
+
+	client := NewHTTPClient(time.Second, nil)
+	port := srv.URL[strings.LastIndex(srv.URL, ":"):]
+
+	// Names are checked once resolved, so localhost is refused like
+	// 127.0.0.1.
+	for _, url := range []string{srv.URL, "http://localhost" + port} {
+		result := Send(context.Background(), client, Request{URL: url, Secret: []byte("s"), Body: []byte(`{}`)})
+		if !errors.Is(result.Err, ErrBlockedAddress) {
+			t.Errorf("%s: expected ErrBlockedAddress, got %+v", url, result)
+		}
+	}
+	if received {
+		t.Error("expected no request to reach the loopback server")
+	}
+}
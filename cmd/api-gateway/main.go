@@ -3,36 +3,62 @@ package main
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
+	"net/netip"
+	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/nats-io/nats.go"
 
+	"github.com/zaouldyeck/taskboard/business/core/apitoken"
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/lockout"
+	"github.com/zaouldyeck/taskboard/business/core/mfa"
+	"github.com/zaouldyeck/taskboard/business/core/session"
+	"github.com/zaouldyeck/taskboard/business/core/signingkey"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+	"github.com/zaouldyeck/taskboard/business/sys/blob"
+	"github.com/zaouldyeck/taskboard/business/sys/mail"
+	"github.com/zaouldyeck/taskboard/business/sys/oidc"
+	"github.com/zaouldyeck/taskboard/internal/database"
 	"github.com/zaouldyeck/taskboard/internal/gateway/grpcclient"
 	"github.com/zaouldyeck/taskboard/internal/gateway/handlers"
 	ws "github.com/zaouldyeck/taskboard/internal/gateway/websocket"
 )
 
-// HTTP to WebSocket upgrader config.
+// Signing keys are reloaded every keyRefreshInterval, to pick up those
+// other replicas added, and rotated if due.
+const keyRefreshInterval = time.Minute
+
+// Auth events older than AUDIT_RETENTION are deleted every
+// auditPruneInterval.
+const auditPruneInterval = time.Hour
+
+// HTTP to WebSocket upgrader config. main sets CheckOrigin from
+// WS_ALLOWED_ORIGINS.
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
-	CheckOrigin: func(r *http.Request) bool {
-		return true // All origins. DEV ONLY!
-	},
 }
 
 // // HELPER FUNCTIONS // //
 
 // setupRoutes configures HTTP routes.
-func setupRoutes(taskHandler *handlers.TaskHandler, hub *ws.Hub) *http.ServeMux {
+func setupRoutes(taskHandler *handlers.TaskHandler, attachmentHandler *handlers.AttachmentHandler,
+	authHandler *handlers.AuthHandler, userHandler *handlers.UserHandler, adminHandler *handlers.AdminHandler, hub *ws.Hub,
+	sessions *session.Store, tokens *apitoken.Store,
+) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	// Health check endpoint
@@ -43,23 +69,59 @@ func setupRoutes(taskHandler *handlers.TaskHandler, hub *ws.Hub) *http.ServeMux
 
 	// WebSocket endpoint.
 	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
-		serveWs(hub, w, r)
+		serveWs(hub, sessions, tokens, w, r)
 	})
 	log.Println("✅ WebSocket endpoint registered at /ws")
 
+	// Accounts. Every other endpoint but the health check and calendar
+	// feeds needs the token these issue, or an API token. Users sign in
+	// with a password or through an identity provider.
+	mux.HandleFunc("POST /api/auth/register", authHandler.Register)
+	mux.HandleFunc("POST /api/auth/login", authHandler.Login)
+	mux.HandleFunc("POST /api/auth/login/mfa", authHandler.CompleteLogin)
+	mux.HandleFunc("POST /api/auth/refresh", authHandler.Refresh)
+	mux.HandleFunc("POST /api/auth/logout", authHandler.Logout)
+	mux.HandleFunc("POST /api/auth/password-reset", authHandler.RequestPasswordReset)
+	mux.HandleFunc("POST /api/auth/password-reset/confirm", authHandler.ConfirmPasswordReset)
+	mux.HandleFunc("POST /api/auth/verify-email", authHandler.RequestEmailVerification)
+	mux.HandleFunc("POST /api/auth/verify-email/confirm", authHandler.ConfirmEmail)
+	mux.HandleFunc("POST /api/auth/change-password", authHandler.ChangePassword)
+	mux.HandleFunc("GET /api/auth/mfa", authHandler.MFAStatus)
+	mux.HandleFunc("DELETE /api/auth/mfa", authHandler.DisableMFA)
+	mux.HandleFunc("POST /api/auth/mfa/totp", authHandler.EnrollTOTP)
+	mux.HandleFunc("POST /api/auth/mfa/totp/confirm", authHandler.ConfirmTOTP)
+	mux.HandleFunc("POST /api/auth/mfa/recovery-codes", authHandler.RegenerateRecoveryCodes)
+	mux.HandleFunc("GET /api/me", authHandler.Me)
+	mux.HandleFunc("PUT /api/me", userHandler.UpdateProfile)
+	mux.HandleFunc("DELETE /api/me", userHandler.DeleteAccount)
+	mux.HandleFunc("GET /api/me/export", userHandler.ExportData)
+	mux.HandleFunc("GET /api/me/preferences", userHandler.GetPreferences)
+	mux.HandleFunc("PUT /api/me/preferences", userHandler.UpdatePreferences)
+	mux.HandleFunc("PUT /api/me/avatar", userHandler.UploadAvatar)
+	mux.HandleFunc("DELETE /api/me/avatar", userHandler.DeleteAvatar)
+	mux.HandleFunc("GET /api/me/auth-events", userHandler.ListAuthEvents)
+	mux.HandleFunc("GET /.well-known/jwks.json", authHandler.JWKS)
+	mux.HandleFunc("GET /api/auth/oidc/providers", authHandler.ListProviders)
+	mux.HandleFunc("GET /api/auth/oidc/{provider}/login", authHandler.OIDCLogin)
+	mux.HandleFunc("GET /api/auth/oidc/{provider}/callback", authHandler.OIDCCallback)
+	mux.HandleFunc("GET /api/sessions", authHandler.ListSessions)
+	mux.HandleFunc("DELETE /api/sessions/{id}", authHandler.RevokeSession)
+	mux.HandleFunc("GET /api/tokens", authHandler.ListAPITokens)
+	mux.HandleFunc("POST /api/tokens", authHandler.CreateAPIToken)
+	mux.HandleFunc("DELETE /api/tokens/{id}", authHandler.RevokeAPIToken)
+
+	// Finding users to assign and mention, and their avatars.
+	mux.HandleFunc("GET /api/users", userHandler.SearchUsers)
+	mux.HandleFunc("GET /api/users/{id}/avatar", userHandler.GetAvatar)
+
+	// Administering Taskboard itself: lockouts and the auth audit log.
+	mux.HandleFunc("GET /api/admin/lockouts", adminHandler.ListLockouts)
+	mux.HandleFunc("DELETE /api/admin/lockouts/{key}", adminHandler.Unlock)
+	mux.HandleFunc("POST /api/admin/users/{id}/unlock", adminHandler.UnlockUser)
+	mux.HandleFunc("GET /api/admin/auth-events", adminHandler.ListAuthEvents)
+
 	// Task endpoints - /api/tasks
 	mux.HandleFunc("/api/tasks", func(w http.ResponseWriter, r *http.Request) {
-		// Enable CORS for development
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-		// Handle preflight requests
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-
 		// Route based on HTTP method
 		switch r.Method {
 		case http.MethodGet:
@@ -73,17 +135,6 @@ func setupRoutes(taskHandler *handlers.TaskHandler, hub *ws.Hub) *http.ServeMux
 
 	// Task endpoints - /api/tasks/:id
 	mux.HandleFunc("/api/tasks/", func(w http.ResponseWriter, r *http.Request) {
-		// Enable CORS
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-		// Handle preflight requests
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-
 		// Route based on HTTP method
 		switch r.Method {
 		case http.MethodGet:
@@ -97,9 +148,408 @@ func setupRoutes(taskHandler *handlers.TaskHandler, hub *ws.Hub) *http.ServeMux
 		}
 	})
 
+	// Time tracking endpoints.
+	mux.HandleFunc("POST /api/tasks/{id}/timer/start", taskHandler.StartTimer)
+	mux.HandleFunc("POST /api/timer/stop", taskHandler.StopTimer)
+	mux.HandleFunc("GET /api/tasks/{id}/time-entries", taskHandler.ListTaskTimeEntries)
+	mux.HandleFunc("POST /api/tasks/{id}/time-entries", taskHandler.CreateTimeEntry)
+	mux.HandleFunc("GET /api/time-entries", taskHandler.ListTimeEntries)
+	mux.HandleFunc("PUT /api/time-entries/{id}", taskHandler.UpdateTimeEntry)
+	mux.HandleFunc("DELETE /api/time-entries/{id}", taskHandler.DeleteTimeEntry)
+	mux.HandleFunc("GET /api/time-report", taskHandler.GetTimeReport)
+
+	// Board and template endpoints.
+	mux.HandleFunc("POST /api/boards", taskHandler.CreateBoard)
+	mux.HandleFunc("GET /api/boards/{id}", taskHandler.GetBoard)
+	mux.HandleFunc("PUT /api/boards/{id}", taskHandler.UpdateBoard)
+	mux.HandleFunc("POST /api/boards/{id}/template", taskHandler.SaveBoardAsTemplate)
+	mux.HandleFunc("GET /api/boards/{id}/custom-fields", taskHandler.ListCustomFields)
+	mux.HandleFunc("POST /api/boards/{id}/custom-fields", taskHandler.CreateCustomField)
+	mux.HandleFunc("PUT /api/custom-fields/{id}", taskHandler.UpdateCustomField)
+	mux.HandleFunc("DELETE /api/custom-fields/{id}", taskHandler.DeleteCustomField)
+	mux.HandleFunc("GET /api/boards/{id}/export", taskHandler.ExportBoard)
+	mux.HandleFunc("POST /api/boards/{id}/import", taskHandler.ImportBoard)
+	mux.HandleFunc("POST /api/boards/import", taskHandler.ImportBoard)
+	mux.HandleFunc("GET /api/boards/{id}/members", taskHandler.ListBoardMembers)
+	mux.HandleFunc("POST /api/boards/{id}/members", taskHandler.AddBoardMember)
+	mux.HandleFunc("PUT /api/boards/{id}/members/{user_id}", taskHandler.UpdateBoardMember)
+	mux.HandleFunc("DELETE /api/boards/{id}/members/{user_id}", taskHandler.RemoveBoardMember)
+
+	// Saved view endpoints.
+	mux.HandleFunc("GET /api/views", taskHandler.ListSavedViews)
+	mux.HandleFunc("POST /api/views", taskHandler.CreateSavedView)
+	mux.HandleFunc("GET /api/views/{id}", taskHandler.GetSavedView)
+	mux.HandleFunc("PUT /api/views/{id}", taskHandler.UpdateSavedView)
+	mux.HandleFunc("DELETE /api/views/{id}", taskHandler.DeleteSavedView)
+	mux.HandleFunc("GET /api/views/{id}/tasks", taskHandler.ListViewTasks)
+	mux.HandleFunc("GET /api/templates", taskHandler.ListTemplates)
+	mux.HandleFunc("POST /api/templates", taskHandler.CreateTemplate)
+	mux.HandleFunc("GET /api/templates/{id}", taskHandler.GetTemplate)
+	mux.HandleFunc("DELETE /api/templates/{id}", taskHandler.DeleteTemplate)
+	mux.HandleFunc("POST /api/templates/{id}/boards", taskHandler.CreateBoardFromTemplate)
+	mux.HandleFunc("POST /api/templates/{id}/tasks", taskHandler.CreateTaskFromTemplate)
+
+	// Calendar feeds. The feed itself is read with the token in its URL.
+	mux.HandleFunc("GET /api/calendar/feeds", taskHandler.ListCalendarFeeds)
+	mux.HandleFunc("POST /api/calendar/feeds", taskHandler.CreateCalendarFeed)
+	mux.HandleFunc("DELETE /api/calendar/feeds/{id}", taskHandler.DeleteCalendarFeed)
+	mux.HandleFunc("GET /api/calendar/{file}", taskHandler.GetCalendar)
+
+	// Webhooks and their delivery history.
+	mux.HandleFunc("GET /api/webhooks", taskHandler.ListWebhooks)
+	mux.HandleFunc("POST /api/webhooks", taskHandler.CreateWebhook)
+	mux.HandleFunc("GET /api/webhooks/{id}", taskHandler.GetWebhook)
+	mux.HandleFunc("PUT /api/webhooks/{id}", taskHandler.UpdateWebhook)
+	mux.HandleFunc("DELETE /api/webhooks/{id}", taskHandler.DeleteWebhook)
+	mux.HandleFunc("GET /api/webhooks/{id}/deliveries", taskHandler.ListWebhookDeliveries)
+	mux.HandleFunc("POST /api/webhooks/{id}/deliveries/{delivery_id}/redeliver", taskHandler.RedeliverWebhookDelivery)
+
+	// Task watchers and email notification settings.
+	mux.HandleFunc("GET /api/tasks/{id}/watchers", taskHandler.ListTaskWatchers)
+	mux.HandleFunc("PUT /api/tasks/{id}/watchers/{user_id}", taskHandler.WatchTask)
+	mux.HandleFunc("DELETE /api/tasks/{id}/watchers/{user_id}", taskHandler.UnwatchTask)
+	mux.HandleFunc("GET /api/notifications/preferences", taskHandler.GetNotificationPreferences)
+	mux.HandleFunc("PUT /api/notifications/preferences", taskHandler.UpdateNotificationPreferences)
+
+	// In-app inbox.
+	mux.HandleFunc("GET /api/inbox", taskHandler.ListInboxNotifications)
+	mux.HandleFunc("GET /api/inbox/unread-count", taskHandler.GetInboxUnreadCount)
+	mux.HandleFunc("POST /api/inbox/{id}/read", taskHandler.MarkInboxNotificationRead)
+	mux.HandleFunc("POST /api/inbox/read-all", taskHandler.MarkAllInboxNotificationsRead)
+
+	// Workspaces. Other endpoints are scoped to the workspace in the
+	// X-Workspace-ID header, or the default one.
+	mux.HandleFunc("GET /api/workspaces", taskHandler.ListWorkspaces)
+	mux.HandleFunc("POST /api/workspaces", taskHandler.CreateWorkspace)
+	mux.HandleFunc("PUT /api/workspaces/{id}", taskHandler.UpdateWorkspace)
+	mux.HandleFunc("GET /api/workspaces/{id}/members", taskHandler.ListWorkspaceMembers)
+	mux.HandleFunc("POST /api/workspaces/{id}/members", taskHandler.AddWorkspaceMember)
+	mux.HandleFunc("DELETE /api/workspaces/{id}/members/{user_id}", taskHandler.RemoveWorkspaceMember)
+
+	// Attachment endpoints.
+	mux.HandleFunc("GET /api/tasks/{id}/attachments", attachmentHandler.List)
+	mux.HandleFunc("POST /api/tasks/{id}/attachments", attachmentHandler.Upload)
+	mux.HandleFunc("GET /api/attachments/{id}", attachmentHandler.Download)
+	mux.HandleFunc("DELETE /api/attachments/{id}", attachmentHandler.Delete)
+
 	return mux
 }
 
+// corsMiddleware enables CORS on every route and answers preflight requests.
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Enable CORS for development
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Workspace-ID")
+
+		// Handle preflight requests
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// publicRoutes are the route patterns served without a token.
+var publicRoutes = map[string]bool{
+	"/health":                  true,
+	"POST /api/auth/register":  true,
+	"POST /api/auth/login":     true,
+	"POST /api/auth/login/mfa": true,
+	"POST /api/auth/refresh":   true,
+	// Emailed tokens are proof enough.
+	"POST /api/auth/password-reset":         true,
+	"POST /api/auth/password-reset/confirm": true,
+	"POST /api/auth/verify-email/confirm":   true,
+	"GET /.well-known/jwks.json":            true,
+	// Signing in through an identity provider.
+	"GET /api/auth/oidc/providers":           true,
+	"GET /api/auth/oidc/{provider}/login":    true,
+	"GET /api/auth/oidc/{provider}/callback": true,
+	// Calendar apps authenticate with the token in the feed's URL.
+	"GET /api/calendar/{file}": true,
+	// So pages can show avatars with <img>.
+	"GET /api/users/{id}/avatar": true,
+}
+
+// accountRoutes are the route patterns API tokens can't be used on, so a
+// leaked one can't log its user out, mint or revoke tokens, change, export
+// or delete their account, or act as an admin.
+var accountRoutes = map[string]bool{
+	"POST /api/auth/logout":             true,
+	"POST /api/auth/verify-email":       true,
+	"POST /api/auth/change-password":    true,
+	"GET /api/auth/mfa":                 true,
+	"DELETE /api/auth/mfa":              true,
+	"POST /api/auth/mfa/totp":           true,
+	"POST /api/auth/mfa/totp/confirm":   true,
+	"POST /api/auth/mfa/recovery-codes": true,
+	"PUT /api/me":                       true,
+	"DELETE /api/me":                    true,
+	"GET /api/me/export":                true,
+	"PUT /api/me/avatar":                true,
+	"DELETE /api/me/avatar":             true,
+	"GET /api/me/auth-events":           true,
+	"GET /api/sessions":                 true,
+	"DELETE /api/sessions/{id}":         true,
+	"GET /api/tokens":                   true,
+	"POST /api/tokens":                  true,
+	"DELETE /api/tokens/{id}":           true,
+	"GET /api/admin/lockouts":           true,
+	"DELETE /api/admin/lockouts/{key}":  true,
+	"POST /api/admin/users/{id}/unlock": true,
+	"GET /api/admin/auth-events":        true,
+}
+
+// adminRoutes are the route patterns that change how boards and workspaces
+// are set up, for which API tokens need the boards:admin scope.
+var adminRoutes = map[string]bool{
+	"POST /api/boards":                                           true,
+	"PUT /api/boards/{id}":                                       true,
+	"POST /api/boards/{id}/template":                             true,
+	"POST /api/boards/{id}/custom-fields":                        true,
+	"PUT /api/custom-fields/{id}":                                true,
+	"DELETE /api/custom-fields/{id}":                             true,
+	"POST /api/boards/{id}/import":                               true,
+	"POST /api/boards/import":                                    true,
+	"POST /api/boards/{id}/members":                              true,
+	"PUT /api/boards/{id}/members/{user_id}":                     true,
+	"DELETE /api/boards/{id}/members/{user_id}":                  true,
+	"POST /api/templates/{id}/boards":                            true,
+	"GET /api/webhooks":                                          true,
+	"POST /api/webhooks":                                         true,
+	"GET /api/webhooks/{id}":                                     true,
+	"PUT /api/webhooks/{id}":                                     true,
+	"DELETE /api/webhooks/{id}":                                  true,
+	"GET /api/webhooks/{id}/deliveries":                          true,
+	"POST /api/webhooks/{id}/deliveries/{delivery_id}/redeliver": true,
+	"POST /api/workspaces":                                       true,
+	"PUT /api/workspaces/{id}":                                   true,
+	"POST /api/workspaces/{id}/members":                          true,
+	"DELETE /api/workspaces/{id}/members/{user_id}":              true,
+}
+
+// requiredScope returns the scope an API token needs for a request: to
+// read, to write, or to administer boards. It returns "" for requests API
+// tokens can't make.
+func requiredScope(r *http.Request, pattern string) string {
+	switch {
+	case accountRoutes[pattern]:
+		return ""
+	case adminRoutes[pattern]:
+		return apitoken.ScopeBoardsAdmin
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		return apitoken.ScopeTasksRead
+	default:
+		return apitoken.ScopeTasksWrite
+	}
+}
+
+// errMissingScope is returned for API tokens without the scope a request
+// needs.
+var errMissingScope = errors.New("missing API token scope")
+
+// authenticate returns the claims of the user a request is made by, from
+// its access token or API token. API token requests act as their user,
+// limited to the token's workspace and scopes.
+func authenticate(r *http.Request, pattern string, authn *auth.Auth, tokens *apitoken.Store) (*auth.TokenClaims, error) {
+	token := bearerToken(r, pattern)
+	if !apitoken.IsToken(token) {
+		return authn.ValidateToken(r.Context(), token)
+	}
+
+	tok, err := tokens.Authenticate(r.Context(), token)
+	if err != nil {
+		if !errors.Is(err, apitoken.ErrInvalidToken) {
+			log.Printf("ERROR: Failed to authenticate API token: %v", err)
+		}
+		return nil, err
+	}
+	if !tok.Allows(requiredScope(r, pattern)) {
+		return nil, errMissingScope
+	}
+	// API tokens never count as signed in with a second factor: they
+	// outlive the session that created them, and are used without one.
+	return &auth.TokenClaims{UserId: tok.UserID, WorkspaceID: tok.WorkspaceID}, nil
+}
+
+// bearerToken returns the access token a request carries: the bearer token
+// in its Authorization header, or, for WebSockets, which browsers open
+// without headers, the access_token query parameter.
+func bearerToken(r *http.Request, pattern string) string {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return token
+	}
+	if pattern == "/ws" {
+		return r.URL.Query().Get("access_token")
+	}
+	return ""
+}
+
+// requestWorkspace returns the workspace a request is scoped to: the one
+// its token is scoped to, else the one in the X-Workspace-ID header or
+// workspace_id query parameter, else the default one. It returns an HTTP
+// status if the request names an invalid workspace or one its token isn't
+// scoped to.
+func requestWorkspace(r *http.Request, claims *auth.TokenClaims) (int64, int) {
+	value := r.Header.Get("X-Workspace-ID")
+	if value == "" {
+		value = r.URL.Query().Get("workspace_id")
+	}
+
+	id := claims.WorkspaceID
+	if value != "" {
+		named, err := strconv.ParseInt(value, 10, 64)
+		switch {
+		case err != nil || named <= 0:
+			return 0, http.StatusBadRequest
+		case id != 0 && named != id:
+			return 0, http.StatusForbidden
+		}
+		id = named
+	}
+	if id == 0 {
+		id = grpcclient.DefaultWorkspaceID
+	}
+	return id, 0
+}
+
+// authMiddleware requires a valid access token, or an API token with the
+// scope the request needs, on all but publicRoutes, and makes the task
+// service calls of a request on behalf of its user, whose board and
+// workspace roles the task service checks, scoped to the request's
+// workspace.
+func authMiddleware(authn *auth.Auth, tokens *apitoken.Store, mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, pattern := mux.Handler(r)
+		if publicRoutes[pattern] {
+			mux.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := authenticate(r, pattern, authn, tokens)
+		if errors.Is(err, errMissingScope) {
+			http.Error(w, "Token lacks the scope this request needs", http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="taskboard"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		workspace, code := requestWorkspace(r, claims)
+		switch code {
+		case http.StatusBadRequest:
+			http.Error(w, "Invalid workspace ID", code)
+			return
+		case http.StatusForbidden:
+			http.Error(w, "Token is scoped to another workspace", code)
+			return
+		}
+
+		ctx := handlers.WithUser(r.Context(), claims)
+		ctx = grpcclient.WithCaller(ctx, claims.UserId)
+		ctx = grpcclient.WithWorkspace(ctx, workspace)
+		if claims.MFA {
+			ctx = grpcclient.WithMFA(ctx)
+		}
+		mux.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// realIPMiddleware sets the remote address of requests through trusted
+// proxies, such as the ingress, to the client's, from X-Forwarded-For, so
+// logins are throttled and logged per client rather than per proxy. The
+// header is read from the right, past the trusted proxies, since clients
+// can put anything on its left.
+func realIPMiddleware(trusted []netip.Prefix, next http.Handler) http.Handler {
+	isTrusted := func(addr netip.Addr) bool {
+		for _, p := range trusted {
+			if p.Contains(addr.Unmap()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		peer, err := netip.ParseAddr(host)
+		if err != nil || !isTrusted(peer) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+			if !isTrusted(addr) || i == 0 {
+				r2 := r.Clone(r.Context())
+				r2.RemoteAddr = net.JoinHostPort(addr.Unmap().String(), "0")
+				r = r2
+				break
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// parseTrustedProxies parses a comma-separated list of CIDRs, or single
+// addresses.
+func parseTrustedProxies(value string) ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// checkOrigin allows WebSocket upgrades from pages on the gateway's own
+// host or one of origins, a comma-separated list such as
+// "https://app.example.com", so other sites' pages can't connect as their
+// visitors. Clients that aren't browsers send no Origin, and are allowed.
+func checkOrigin(origins string) func(r *http.Request) bool {
+	allowed := map[string]bool{}
+	for _, origin := range strings.Split(origins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+		}
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowed[strings.ToLower(origin)] {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
+
 // responseWriter wraps http.ResponseWriter to include status code.
 type responseWriter struct {
 	http.ResponseWriter
@@ -124,6 +574,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 // Implement http.Hijacker interface for WebSocket support.
 func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	h, ok := rw.ResponseWriter.(http.Hijacker)
@@ -140,8 +595,75 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// rotateKeys reloads the signing keys into a ring every keyRefreshInterval,
+// rotating them if due, until ctx is cancelled.
+func rotateKeys(ctx context.Context, store *signingkey.Store, ring *auth.KeyRing, rotation auth.Rotation) {
+	ticker := time.NewTicker(keyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		keys, err := store.Rotate(ctx, rotation, time.Now())
+		if err != nil {
+			log.Printf("ERROR: Failed to rotate signing keys: %v", err)
+			continue
+		}
+		ring.Set(keys)
+	}
+}
+
+// pruneAuditLog deletes auth events older than retention every
+// auditPruneInterval, until ctx is cancelled.
+func pruneAuditLog(ctx context.Context, events *audit.Store, retention time.Duration) {
+	ticker := time.NewTicker(auditPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := events.Prune(ctx, time.Now().Add(-retention))
+		if err != nil {
+			log.Printf("ERROR: Failed to prune auth events: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("Pruned %d auth events", n)
+		}
+	}
+}
+
+// wsCredentials returns whether the session or API token a WebSocket
+// connected with is still valid, for the hub to close it once revoked.
+func wsCredentials(r *http.Request, claims *auth.TokenClaims, sessions *session.Store,
+	tokens *apitoken.Store,
+) func(ctx context.Context) (bool, error) {
+	token := bearerToken(r, "/ws")
+	if apitoken.IsToken(token) {
+		return func(ctx context.Context) (bool, error) {
+			_, err := tokens.Authenticate(ctx, token)
+			if errors.Is(err, apitoken.ErrInvalidToken) {
+				return false, nil
+			}
+			return err == nil, err
+		}
+	}
+	return func(ctx context.Context) (bool, error) {
+		revoked, err := sessions.Revoked(ctx, claims)
+		return !revoked, err
+	}
+}
+
 // serveWs handles websocket requests from clients.
-func serveWs(hub *ws.Hub, w http.ResponseWriter, r *http.Request) {
+func serveWs(hub *ws.Hub, sessions *session.Store, tokens *apitoken.Store, w http.ResponseWriter, r *http.Request) {
 	// Upgrade HTTP connection to WebSocket.
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -149,11 +671,20 @@ func serveWs(hub *ws.Hub, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create new websocket client.
+	// Create new websocket client. It gets its user's inbox notifications,
+	// and events of the boards and tasks it subscribes to, of those they
+	// can see in the workspace it connects to, which authMiddleware has
+	// already checked.
+	claims, _ := handlers.UserFromContext(r.Context())
+	workspace, _ := requestWorkspace(r, claims)
 	client := &ws.Client{
-		Hub:  hub,
-		Conn: conn,
-		Send: make(chan []byte, 256),
+		Hub:         hub,
+		Conn:        conn,
+		Send:        make(chan []byte, 256),
+		UserID:      claims.UserId,
+		MFA:         claims.MFA,
+		WorkspaceID: workspace,
+		Active:      wsCredentials(r, claims, sessions, tokens),
 	}
 
 	client.Hub.Register <- client
@@ -192,29 +723,172 @@ func main() {
 	defer nc.Close()
 	log.Printf("Connected to NATS successfully. Server: %s", nc.ConnectedUrl())
 
-	// Create WebSocket hub.
-	hub := ws.NewHub(nc)
-	go hub.Run()
-	log.Println("✅ WebSocket Hub started")
+	// Users, the sessions and access tokens they log in with, and their API
+	// tokens. The task service owns the schema.
+	cfg := database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     5432,
+		User:     getEnv("DB_USER", "taskboard_app"),
+		Password: getEnv("DB_PASSWORD", "taskboard_app"),
+		Database: getEnv("DB_NAME", "taskboard"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+	}
+	log.Printf("Connecting to db at %s:%d...", cfg.Host, cfg.Port)
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	log.Println("Connected to DB successfully.")
+
+	// Row-level security only applies to ordinary roles.
+	switch err := database.CheckRowSecurity(db); {
+	case errors.Is(err, database.ErrBypassesRowSecurity) && getEnv("DB_ALLOW_BYPASS_RLS", "") == "true":
+		log.Printf("WARNING: %v; only the services' own queries keep workspaces apart", err)
+	case errors.Is(err, database.ErrBypassesRowSecurity):
+		log.Fatalf("%v; connect as an ordinary role, or set DB_ALLOW_BYPASS_RLS=true", err)
+	case err != nil:
+		log.Fatal(err)
+	}
+
+	tokenTTL, err := time.ParseDuration(getEnv("JWT_TTL", "15m"))
+	if err != nil {
+		log.Fatalf("Invalid JWT_TTL: %v", err)
+	}
+	refreshTTL, err := time.ParseDuration(getEnv("REFRESH_TTL", "720h"))
+	if err != nil {
+		log.Fatalf("Invalid REFRESH_TTL: %v", err)
+	}
+
+	// Signing keys, rotated on schedule.
+	rotation := auth.Rotation{
+		Algorithm: getEnv("JWT_ALGORITHM", auth.EdDSA),
+		TokenTTL:  tokenTTL,
+	}
+	if rotation.Every, err = time.ParseDuration(getEnv("JWT_KEY_ROTATION", "720h")); err != nil {
+		log.Fatalf("Invalid JWT_KEY_ROTATION: %v", err)
+	}
+	if rotation.Overlap, err = time.ParseDuration(getEnv("JWT_KEY_OVERLAP", "1h")); err != nil {
+		log.Fatalf("Invalid JWT_KEY_OVERLAP: %v", err)
+	}
+	// Successors must reach every replica and JWKS cache before they sign.
+	if rotation.Overlap < keyRefreshInterval+handlers.JWKSMaxAge {
+		log.Fatalf("JWT_KEY_OVERLAP must be at least %v", keyRefreshInterval+handlers.JWKSMaxAge)
+	}
+	if rotation.Every <= rotation.Overlap {
+		log.Fatal("JWT_KEY_ROTATION must be longer than JWT_KEY_OVERLAP")
+	}
+	signingKeys := signingkey.NewStore(db)
+	keys, err := signingKeys.Rotate(context.Background(), rotation, time.Now())
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+	keyRing := auth.NewKeyRing(keys...)
+	rotateCtx, stopRotating := context.WithCancel(context.Background())
+	defer stopRotating()
+	go rotateKeys(rotateCtx, signingKeys, keyRing, rotation)
+
+	sessions := session.NewStore(db)
+	authn := auth.NewAuth(keyRing, sessions)
 
 	// Init gRPC client.
 	log.Printf("Connecting to task svc at %s...", taskServiceAddr)
-	taskClient, err := grpcclient.NewTaskClient(taskServiceAddr)
+	taskClient, err := grpcclient.NewTaskClient(taskServiceAddr, authn)
 	if err != nil {
 		log.Fatalf("Failed to create task client: %v", err)
 	}
 	defer taskClient.Close()
 
+	// Create WebSocket hub.
+	hub := ws.NewHub(nc, taskClient, taskClient)
+	go hub.Run()
+	log.Println("✅ WebSocket Hub started")
+
+	// Identity providers users can sign in through. They're discovered when
+	// first used.
+	oidcConfigs, err := oidc.ConfigsFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid OIDC config: %v", err)
+	}
+	oidcClient := &http.Client{Timeout: 10 * time.Second}
+	var providers []*oidc.Provider
+	for _, cfg := range oidcConfigs {
+		providers = append(providers, oidc.NewProvider(cfg, oidcClient))
+		log.Printf("✅ Sign-in through %s enabled", cfg.Name)
+	}
+
+	// Emails that reset passwords and verify emails.
+	mailCfg := mail.ConfigFromEnv()
+	sender, err := mail.NewSender(mailCfg)
+	if err != nil {
+		log.Fatalf("Failed to create mail sender: %v", err)
+	}
+	accountMail := handlers.AccountMail{
+		Sender:    sender,
+		From:      getEnv("MAIL_FROM", "Taskboard <noreply@taskboard.local>"),
+		ResetURL:  os.Getenv("PASSWORD_RESET_URL"),
+		VerifyURL: os.Getenv("EMAIL_VERIFY_URL"),
+	}
+
+	// Blob store for attachment contents.
+	blobs, err := blob.NewStore(blob.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+	maxUploadBytes, err := strconv.ParseInt(getEnv("MAX_UPLOAD_BYTES", "26214400"), 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid MAX_UPLOAD_BYTES: %v", err)
+	}
+
+	// Password guessing is throttled, and authentication events logged.
+	policy := lockout.DefaultPolicy()
+	if policy.AccountLimit, err = strconv.Atoi(getEnv("LOCKOUT_ACCOUNT_LIMIT", strconv.Itoa(policy.AccountLimit))); err != nil {
+		log.Fatalf("Invalid LOCKOUT_ACCOUNT_LIMIT: %v", err)
+	}
+	if policy.IPLimit, err = strconv.Atoi(getEnv("LOCKOUT_IP_LIMIT", strconv.Itoa(policy.IPLimit))); err != nil {
+		log.Fatalf("Invalid LOCKOUT_IP_LIMIT: %v", err)
+	}
+	if policy.LockoutDuration, err = time.ParseDuration(getEnv("LOCKOUT_DURATION", policy.LockoutDuration.String())); err != nil {
+		log.Fatalf("Invalid LOCKOUT_DURATION: %v", err)
+	}
+	auditRetention, err := time.ParseDuration(getEnv("AUDIT_RETENTION", "2160h"))
+	if err != nil {
+		log.Fatalf("Invalid AUDIT_RETENTION: %v", err)
+	}
+	trustedProxies, err := parseTrustedProxies(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+	guard := handlers.LoginGuard{
+		Lockout: lockout.NewStore(db, policy),
+		Events:  audit.NewStore(db),
+	}
+	pruneCtx, stopPruning := context.WithCancel(context.Background())
+	defer stopPruning()
+	go pruneAuditLog(pruneCtx, guard.Events, auditRetention)
+
 	// Init handlers.
 	taskHandler := handlers.NewTaskHandler(taskClient)
+	apiTokens := apitoken.NewStore(db)
+	users := user.NewStore(db)
+	secondFactor := handlers.SecondFactor{
+		Store:  mfa.NewStore(db),
+		Issuer: getEnv("MFA_ISSUER", "Taskboard"),
+	}
+	authHandler := handlers.NewAuthHandler(users, sessions, apiTokens, providers, accountMail, guard, secondFactor,
+		authn, tokenTTL, refreshTTL)
+	userHandler := handlers.NewUserHandler(users, sessions, apiTokens, secondFactor.Store, taskClient, blobs, accountMail, guard)
+	adminHandler := handlers.NewAdminHandler(users, guard)
+	attachmentHandler := handlers.NewAttachmentHandler(taskClient, blobs, maxUploadBytes)
 
 	// Setup HTTP router.
-	mux := setupRoutes(taskHandler, hub)
+	upgrader.CheckOrigin = checkOrigin(os.Getenv("WS_ALLOWED_ORIGINS"))
+	mux := setupRoutes(taskHandler, attachmentHandler, authHandler, userHandler, adminHandler, hub, sessions, apiTokens)
 
 	// Create HTTP server.
 	server := &http.Server{
 		Addr:         ":" + httpPort,
-		Handler:      loggingMiddleware(mux),
+		Handler:      realIPMiddleware(trustedProxies, loggingMiddleware(corsMiddleware(authMiddleware(authn, apiTokens, mux)))),
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
 		IdleTimeout:  60 * time.Second,
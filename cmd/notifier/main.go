@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/zaouldyeck/taskboard/business/sys/mail"
+	"github.com/zaouldyeck/taskboard/internal/database"
+	"github.com/zaouldyeck/taskboard/internal/notifier"
+)
+
+// Only used when NOTIFIER_UNSUBSCRIBE_SECRET isn't set.
+const devUnsubscribeSecret = "dev-unsubscribe-secret"
+
+func main() {
+	// Setup logging.
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	log.Println("Starting notifier...")
+
+	// Connect to DB. The task service owns the schema.
+	cfg := database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     5432,
+		User:     getEnv("DB_USER", "taskboard_app"),
+		Password: getEnv("DB_PASSWORD", "taskboard_app"),
+		Database: getEnv("DB_NAME", "taskboard"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+	}
+	log.Printf("Connecting to db at %s:%d...", cfg.Host, cfg.Port)
+	db, err := database.NewPostgresDB(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+	log.Println("Connected to DB successfully.")
+
+	// Row-level security only applies to ordinary roles.
+	switch err := database.CheckRowSecurity(db); {
+	case errors.Is(err, database.ErrBypassesRowSecurity) && getEnv("DB_ALLOW_BYPASS_RLS", "") == "true":
+		log.Printf("WARNING: %v; only the services' own queries keep workspaces apart", err)
+	case errors.Is(err, database.ErrBypassesRowSecurity):
+		log.Fatalf("%v; connect as an ordinary role, or set DB_ALLOW_BYPASS_RLS=true", err)
+	case err != nil:
+		log.Fatal(err)
+	}
+
+	// Connect to NATS.
+	natsURL := getEnv("NATS_URL", "nats://nats:4222")
+	log.Printf("Connecting to NATS at %s...", natsURL)
+
+	nc, err := nats.Connect(natsURL,
+		nats.Timeout(10*time.Second),
+		nats.ReconnectWait(2*time.Second),
+		nats.MaxReconnects(-1), // Forever reconnect.
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			log.Printf("NATS disconnected: %v", err)
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			log.Printf("NATS reconnected to %s", nc.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		log.Fatalf("Failed to connect to NATS: %v", err)
+	}
+	defer nc.Close()
+	log.Printf("Connected to NATS successfully. Server: %s", nc.ConnectedUrl())
+
+	// Mail transport.
+	mailCfg := mail.ConfigFromEnv()
+	sender, err := mail.NewSender(mailCfg)
+	if err != nil {
+		log.Fatalf("Failed to create mail sender: %v", err)
+	}
+	log.Printf("Sending mail via %s (%s)", getEnv("MAIL_TRANSPORT", "smtp"), mailCfg.SMTP.Addr)
+
+	secret := os.Getenv("NOTIFIER_UNSUBSCRIBE_SECRET")
+	if secret == "" {
+		log.Println("WARNING: NOTIFIER_UNSUBSCRIBE_SECRET not set; unsubscribe links use a development secret")
+		secret = devUnsubscribeSecret
+	}
+
+	n := notifier.New(notifier.Config{
+		From:              getEnv("MAIL_FROM", "Taskboard <noreply@taskboard.local>"),
+		TaskURL:           os.Getenv("TASK_URL"),
+		PublicURL:         getEnv("NOTIFIER_PUBLIC_URL", "http://localhost:8081"),
+		UnsubscribeSecret: []byte(secret),
+	}, notifier.NewStore(db), sender)
+
+	// A queue group, so each event is handled by one notifier replica.
+	sub, err := nc.QueueSubscribe("workspaces.*.tasks.>", "notifier", func(msg *nats.Msg) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := n.HandleEvent(ctx, msg.Data); err != nil {
+			log.Printf("ERROR: Failed to handle %s: %v", msg.Subject, err)
+		}
+	})
+	if err != nil {
+		log.Fatalf("Failed to subscribe to task events: %v", err)
+	}
+	defer sub.Unsubscribe()
+	log.Println("Subscribed to task events.")
+
+	// Send queued notifications in the background.
+	runCtx, stopRun := context.WithCancel(context.Background())
+	defer stopRun()
+	go n.Run(runCtx)
+
+	port := getEnv("HTTP_PORT", "8081")
+	server := &http.Server{
+		Addr:         fmt.Sprintf(":%s", port),
+		Handler:      n.Handler(),
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+
+	// Shutdown gracefully when interrupt signal is caught.
+	// (Ctrl + C)
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		log.Printf("HTTP server listening on port %s", port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve: %v", err)
+		}
+	}()
+
+	<-sigChan
+	log.Println("Shutting down gracefully...")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("Server shutdown error: %v", err)
+	}
+	log.Println("Server stopped.")
+}
+
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -2,18 +2,37 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/signingkey"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+	"github.com/zaouldyeck/taskboard/internal/database"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 func main() {
+	addr := flag.String("addr", "localhost:50051", "task service address")
+	userID := flag.String("user", "", "user to call as")
+	token := flag.String("token", os.Getenv("TASK_CALL_TOKEN"), "call token to use instead of signing one for -user")
+	boardID := flag.Int64("board", 1, "board to create the task on")
+	flag.Parse()
+
+	if *token == "" {
+		if *userID == "" {
+			log.Fatal("Pass -user to sign a call token, or -token")
+		}
+		*token = signCallToken(*userID)
+	}
+
 	conn, err := grpc.Dial(
-		"localhost:50051",
+		*addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()), // No TLS. Only in dev!
 
 	)
@@ -26,14 +45,14 @@ func main() {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
 
 	// Create task.
 	fmt.Println("Creating a task...")
 	createResp, err := client.CreateTask(ctx, &pb.CreateTaskRequest{
-		BoardId:     1,
+		BoardId:     *boardID,
 		Title:       "Learn grpc",
 		Description: "build a task svc with grpc and postgres",
-		CreatedBy:   100,
 	})
 	if err != nil {
 		log.Fatalf("Failed to create task: %v", err)
@@ -72,9 +91,9 @@ func main() {
 	fmt.Printf("  Updated at: %s\n", updateResp.Task.UpdatedAt.AsTime().Format(time.RFC3339))
 
 	// List tasks.
-	fmt.Println("\nListing all tasks for board 1...")
+	fmt.Printf("\nListing all tasks for board %d...\n", *boardID)
 	listResp, err := client.ListTasks(ctx, &pb.ListTasksRequest{
-		BoardId:    1,
+		BoardId:    *boardID,
 		PageSize:   10,
 		PageNumber: 1,
 	})
@@ -106,3 +125,38 @@ func main() {
 
 	fmt.Println("\n✨ All operations completed successfully!")
 }
+
+// signCallToken signs a call token for userID with the gateway's signing
+// keys, read from the DB, as the gateway does for each call.
+func signCallToken(userID string) string {
+	db, err := database.NewPostgresDB(database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     5432,
+		User:     getEnv("DB_USER", "taskboard_app"),
+		Password: getEnv("DB_PASSWORD", "taskboard_app"),
+		Database: getEnv("DB_NAME", "taskboard"),
+		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	keys, err := signingkey.NewStore(db).Keys(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to load signing keys: %v", err)
+	}
+	token, err := auth.NewAuth(auth.NewKeyRing(keys...), nil).GenerateCallToken(userID, "task-service", false, time.Minute)
+	if err != nil {
+		log.Fatalf("Failed to sign call token: %v", err)
+	}
+	return token
+}
+
+func getEnv(key, defaultValue string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
@@ -1,16 +1,25 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	// Calendar feeds use IANA time zones, and the image has no zoneinfo.
+	_ "time/tzdata"
+
 	"github.com/nats-io/nats.go"
 	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+	"github.com/zaouldyeck/taskboard/business/sys/blob"
+	"github.com/zaouldyeck/taskboard/business/sys/webhook"
 	"github.com/zaouldyeck/taskboard/internal/database"
 	"github.com/zaouldyeck/taskboard/internal/task/repository"
 	"github.com/zaouldyeck/taskboard/internal/task/service"
@@ -18,6 +27,13 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+// The gateway's published keys are fetched every jwksRefreshInterval, or
+// every jwksRetryInterval until they first are.
+const (
+	jwksRefreshInterval = time.Minute
+	jwksRetryInterval   = 5 * time.Second
+)
+
 func main() {
 	// Setup logging.
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
@@ -27,8 +43,8 @@ func main() {
 	cfg := database.Config{
 		Host:     getEnv("DB_HOST", "localhost"),
 		Port:     5432,
-		User:     getEnv("DB_USER", "taskboard"),
-		Password: getEnv("DB_PASSWORD", "taskboard"),
+		User:     getEnv("DB_USER", "taskboard_app"),
+		Password: getEnv("DB_PASSWORD", "taskboard_app"),
 		Database: getEnv("DB_NAME", "taskboard"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
 	}
@@ -40,6 +56,16 @@ func main() {
 	defer db.Close()
 	log.Println("Connected to DB successfully.")
 
+	// Row-level security only applies to ordinary roles.
+	switch err := database.CheckRowSecurity(db); {
+	case errors.Is(err, database.ErrBypassesRowSecurity) && getEnv("DB_ALLOW_BYPASS_RLS", "") == "true":
+		log.Printf("WARNING: %v; only the services' own queries keep workspaces apart", err)
+	case errors.Is(err, database.ErrBypassesRowSecurity):
+		log.Fatalf("%v; connect as an ordinary role, or set DB_ALLOW_BYPASS_RLS=true", err)
+	case err != nil:
+		log.Fatal(err)
+	}
+
 	// Init of DB schema.
 	log.Println("Initializing DB schema...")
 	if err := database.InitSchema(db); err != nil {
@@ -68,10 +94,51 @@ func main() {
 	defer nc.Close()
 	log.Printf("Connected to NATS successfully. Server: %s", nc.ConnectedUrl())
 
+	// Blob store holding attachment contents.
+	blobs, err := blob.NewStore(blob.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("Failed to create blob store: %v", err)
+	}
+
+	// Calls are made on behalf of users with call tokens the gateway signs,
+	// verified with the keys it publishes. Until they're fetched, calls
+	// are rejected.
+	jwksURL := getEnv("JWKS_URL", "http://api-gateway:8080/.well-known/jwks.json")
+	keyRing := auth.NewKeyRing()
+	jwksCtx, stopJWKS := context.WithCancel(context.Background())
+	defer stopJWKS()
+	go refreshKeys(jwksCtx, keyRing, jwksURL)
+
+	// Webhooks aren't delivered to loopback, private or link-local
+	// addresses, except to receivers on these networks.
+	webhookNetworks, err := webhook.ParseNetworks(os.Getenv("WEBHOOK_ALLOWED_NETWORKS"))
+	if err != nil {
+		log.Fatalf("Invalid WEBHOOK_ALLOWED_NETWORKS: %v", err)
+	}
+
 	// Bootstrap postgres repo and services.
 	repo := repository.NewPostgresRepository(db)
-	taskService := service.NewTaskService(repo, nc)
-	grpcServer := grpc.NewServer()
+	taskService := service.NewTaskService(repo, nc, blobs, auth.NewAuth(keyRing, nil), webhookNetworks)
+
+	// Delete blobs of removed attachments and tasks in the background.
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	defer stopCleanup()
+	go taskService.RunBlobCleanup(cleanupCtx)
+
+	// Deliver task events to webhooks in the background.
+	webhookCtx, stopWebhooks := context.WithCancel(context.Background())
+	defer stopWebhooks()
+	go taskService.RunWebhooks(webhookCtx)
+
+	// Announce tasks as they fall due.
+	overdueCtx, stopOverdue := context.WithCancel(context.Background())
+	defer stopOverdue()
+	go taskService.RunOverdueScan(overdueCtx)
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(taskService.UnaryInterceptor()),
+		grpc.StreamInterceptor(taskService.StreamInterceptor()),
+	)
 	pb.RegisterTaskServiceServer(grpcServer, taskService)
 	reflection.Register(grpcServer)
 
@@ -100,6 +167,29 @@ func main() {
 	log.Println("Server stopped.")
 }
 
+// refreshKeys fetches the keys published at url into a ring until ctx is
+// cancelled.
+func refreshKeys(ctx context.Context, ring *auth.KeyRing, url string) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	interval := jwksRetryInterval
+
+	for {
+		keys, err := auth.FetchJWKS(ctx, client, url)
+		if err != nil {
+			log.Printf("ERROR: Failed to fetch JWKS from %s: %v", url, err)
+		} else {
+			ring.Set(keys)
+			interval = jwksRefreshInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
@@ -2,10 +2,11 @@ package database
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type Config struct {
@@ -17,8 +18,28 @@ type Config struct {
 	SSLMode  string
 }
 
+// ErrBypassesRowSecurity is returned by CheckRowSecurity for roles that
+// row-level security doesn't apply to.
+var ErrBypassesRowSecurity = errors.New("database role is a superuser or bypasses row-level security")
+
+// CheckRowSecurity checks that row-level security applies to the role db
+// connects as, so its policies keep workspaces apart.
+func CheckRowSecurity(db *sql.DB) error {
+	var superuser, bypassRLS bool
+	err := db.QueryRow(`SELECT rolsuper, rolbypassrls FROM pg_roles WHERE rolname = current_user`).
+		Scan(&superuser, &bypassRLS)
+	if err != nil {
+		return fmt.Errorf("failed to check database role: %w", err)
+	}
+	if superuser || bypassRLS {
+		return ErrBypassesRowSecurity
+	}
+	return nil
+}
+
 // NewPostgresDB creates a postgres connection.
-// Returns a *sql.DB connection pool.
+// Returns a *sql.DB connection pool, whose connections are scoped to the
+// workspace of each query's context.
 func NewPostgresDB(cfg Config) (*sql.DB, error) {
 	dsn := fmt.Sprintf(
 		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
@@ -30,10 +51,11 @@ func NewPostgresDB(cfg Config) (*sql.DB, error) {
 		cfg.SSLMode,
 	)
 
-	db, err := sql.Open("postgres", dsn)
+	connector, err := pq.NewConnector(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
+	db := sql.OpenDB(workspaceConnector{connector})
 
 	db.SetMaxOpenConns(25)                 // Concurrent connections.
 	db.SetMaxIdleConns(5)                  // Keep 5 connections "warm".
@@ -54,7 +76,7 @@ func InitSchema(db *sql.DB) error {
 		title TEXT NOT NULL,
 		description TEXT,
 		completed BOOLEAN NOT NULL DEFAULT false,
-		created_by BIGINT NOT NULL,
+		created_by TEXT NOT NULL,
 		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
 		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
 	);
@@ -64,6 +86,563 @@ func InitSchema(db *sql.DB) error {
 
 	-- Index by completion status for faster filtering.
 	CREATE INDEX IF NOT EXISTS idx_tasks_completed ON tasks(completed);
+
+	-- Due dates and labels, added after the table was first created.
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_at TIMESTAMP WITH TIME ZONE;
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}';
+
+	-- Creators are user IDs, which are strings. Earlier numeric IDs are
+	-- kept as their decimal text.
+	ALTER TABLE tasks ALTER COLUMN created_by TYPE TEXT USING created_by::text;
+
+	CREATE INDEX IF NOT EXISTS idx_tasks_due_at ON tasks(due_at);
+	CREATE INDEX IF NOT EXISTS idx_tasks_labels ON tasks USING GIN (labels);
+
+	CREATE TABLE IF NOT EXISTS task_assignees (
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		PRIMARY KEY (task_id, user_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_task_assignees_user_id ON task_assignees(user_id);
+
+	CREATE TABLE IF NOT EXISTS time_entries (
+		id BIGSERIAL PRIMARY KEY,
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		note TEXT NOT NULL DEFAULT '',
+		started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		ended_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		CHECK (ended_at IS NULL OR ended_at >= started_at)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries(task_id);
+	CREATE INDEX IF NOT EXISTS idx_time_entries_user_started ON time_entries(user_id, started_at);
+
+	CREATE TABLE IF NOT EXISTS attachments (
+		id BIGSERIAL PRIMARY KEY,
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		filename TEXT NOT NULL,
+		content_type TEXT NOT NULL,
+		size_bytes BIGINT NOT NULL,
+		storage_key TEXT NOT NULL UNIQUE,
+		uploaded_by TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_attachments_task_id ON attachments(task_id);
+
+	-- Boards. tasks.board_id predates this table, so it is not a foreign key.
+	CREATE TABLE IF NOT EXISTS boards (
+		id BIGSERIAL PRIMARY KEY,
+		name TEXT NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE TABLE IF NOT EXISTS board_columns (
+		id BIGSERIAL PRIMARY KEY,
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		name TEXT NOT NULL,
+		position INT NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_board_columns_board_id ON board_columns(board_id);
+
+	CREATE TABLE IF NOT EXISTS board_labels (
+		id BIGSERIAL PRIMARY KEY,
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		name TEXT NOT NULL,
+		color TEXT NOT NULL DEFAULT '',
+		UNIQUE (board_id, name)
+	);
+
+	-- Saved board and task templates. Content is the layout as JSON.
+	CREATE TABLE IF NOT EXISTS templates (
+		id BIGSERIAL PRIMARY KEY,
+		kind TEXT NOT NULL CHECK (kind IN ('board', 'task')),
+		name TEXT NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		content JSONB NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	-- Per-board custom fields and their values on tasks.
+	CREATE TABLE IF NOT EXISTS custom_fields (
+		id BIGSERIAL PRIMARY KEY,
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		name TEXT NOT NULL,
+		type TEXT NOT NULL CHECK (type IN ('text', 'number', 'single_select', 'multi_select', 'date', 'user', 'url')),
+		options TEXT[] NOT NULL DEFAULT '{}',
+		required BOOLEAN NOT NULL DEFAULT FALSE,
+		position INT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		UNIQUE (board_id, name)
+	);
+
+	-- Values are JSON: a string, a number, or an array of option strings.
+	CREATE TABLE IF NOT EXISTS task_field_values (
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		field_id BIGINT NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+		value JSONB NOT NULL,
+		PRIMARY KEY (task_id, field_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_task_field_values_field ON task_field_values(field_id);
+
+	-- Saved task queries. A NULL board_id spans all boards.
+	CREATE TABLE IF NOT EXISTS saved_views (
+		id BIGSERIAL PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		query TEXT NOT NULL DEFAULT '',
+		sort TEXT NOT NULL DEFAULT '',
+		board_id BIGINT REFERENCES boards(id) ON DELETE CASCADE,
+		shared BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	-- Boards and tasks imported from other systems, so imports can be
+	-- rerun. A deleted task keeps its row, so a rerun doesn't bring it back.
+	CREATE TABLE IF NOT EXISTS imported_boards (
+		source TEXT NOT NULL,
+		external_id TEXT NOT NULL,
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE
+	);
+
+	CREATE TABLE IF NOT EXISTS imported_tasks (
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		source TEXT NOT NULL,
+		external_id TEXT NOT NULL,
+		task_id BIGINT REFERENCES tasks(id) ON DELETE SET NULL,
+		imported_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (board_id, source, external_id)
+	);
+
+	-- iCalendar feeds, read with a token whose SHA-256 hash is stored. A
+	-- NULL board_id lists the owner's assigned tasks on every board.
+	CREATE TABLE IF NOT EXISTS calendar_feeds (
+		id BIGSERIAL PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		board_id BIGINT REFERENCES boards(id) ON DELETE CASCADE,
+		component TEXT NOT NULL,
+		time_zone TEXT NOT NULL DEFAULT '',
+		token_hash BYTEA NOT NULL UNIQUE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_calendar_feeds_owner_id ON calendar_feeds(owner_id);
+
+	-- Outbound webhooks. Empty event_types or board_ids match everything.
+	-- failing_since is when the current run of failed attempts began.
+	CREATE TABLE IF NOT EXISTS webhooks (
+		id BIGSERIAL PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		url TEXT NOT NULL,
+		secret TEXT NOT NULL,
+		event_types TEXT[] NOT NULL DEFAULT '{}',
+		board_ids BIGINT[] NOT NULL DEFAULT '{}',
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		disabled_reason TEXT NOT NULL DEFAULT '',
+		consecutive_failures INTEGER NOT NULL DEFAULT 0,
+		failing_since TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_webhooks_owner_id ON webhooks(owner_id);
+
+	-- Status is pending, succeeded or dead.
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id BIGSERIAL PRIMARY KEY,
+		webhook_id BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+		event_id TEXT NOT NULL,
+		event_type TEXT NOT NULL,
+		payload BYTEA NOT NULL,
+		status TEXT NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		response_status INTEGER NOT NULL DEFAULT 0,
+		response_body TEXT NOT NULL DEFAULT '',
+		error TEXT NOT NULL DEFAULT '',
+		redelivery_of BIGINT REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		last_attempt_at TIMESTAMP WITH TIME ZONE,
+		next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, id DESC);
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at)
+		WHERE status = 'pending';
+
+	-- Users, as in business/core/user/schema.sql. The notifier emails
+	-- them.
+	CREATE TABLE IF NOT EXISTS users (
+		id TEXT PRIMARY KEY,
+		email TEXT UNIQUE NOT NULL,
+		username TEXT UNIQUE NOT NULL,
+		password_hash TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT NOW(),
+		updated_at TIMESTAMP DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
+	CREATE INDEX IF NOT EXISTS idx_users_username ON users(username);
+
+	-- Accounts at identity providers users sign in through, as in
+	-- business/core/user/schema.sql.
+	CREATE TABLE IF NOT EXISTS user_identities (
+		provider TEXT NOT NULL,
+		subject TEXT NOT NULL,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (provider, subject)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities(user_id);
+
+	-- Whether users proved they own their email, and the one-time tokens
+	-- emailed to them, as in business/core/user/schema.sql.
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP WITH TIME ZONE;
+
+	CREATE TABLE IF NOT EXISTS user_tokens (
+		token_hash BYTEA PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		purpose TEXT NOT NULL CHECK (purpose IN ('password_reset', 'email_verification')),
+		email TEXT NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		used_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id ON user_tokens(user_id);
+
+	-- Users' profiles and preferences, and when they deleted their
+	-- account, as in business/core/user/schema.sql.
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS display_name TEXT NOT NULL DEFAULT '';
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS time_zone TEXT NOT NULL DEFAULT 'UTC';
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_key TEXT NOT NULL DEFAULT '';
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_type TEXT NOT NULL DEFAULT '';
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+	-- Who administers Taskboard itself, as in
+	-- business/core/user/schema.sql.
+	ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+	-- Login sessions, as in business/core/session/schema.sql: every
+	-- refresh token a session has had, and access tokens revoked before
+	-- they expire.
+	CREATE TABLE IF NOT EXISTS sessions (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		user_agent TEXT NOT NULL DEFAULT '',
+		ip TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		revoked_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
+
+	CREATE TABLE IF NOT EXISTS refresh_tokens (
+		token_hash BYTEA PRIMARY KEY,
+		session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		used_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+
+	CREATE TABLE IF NOT EXISTS revoked_tokens (
+		jti TEXT PRIMARY KEY,
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+
+	-- Whether sessions signed in with a second factor, as in
+	-- business/core/session/schema.sql.
+	ALTER TABLE sessions ADD COLUMN IF NOT EXISTS mfa BOOLEAN NOT NULL DEFAULT FALSE;
+
+	-- The keys access tokens are signed with, as in
+	-- business/core/signingkey/schema.sql.
+	CREATE TABLE IF NOT EXISTS signing_keys (
+		kid TEXT PRIMARY KEY,
+		algorithm TEXT NOT NULL,
+		private_key BYTEA NOT NULL,
+		not_before TIMESTAMP WITH TIME ZONE NOT NULL,
+		not_after TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	-- Personal access tokens for automation, as in
+	-- business/core/apitoken/schema.sql.
+	CREATE TABLE IF NOT EXISTS api_tokens (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		name TEXT NOT NULL,
+		prefix TEXT NOT NULL,
+		token_hash BYTEA NOT NULL UNIQUE,
+		scopes TEXT[] NOT NULL,
+		workspace_id BIGINT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		last_used_at TIMESTAMP WITH TIME ZONE,
+		expires_at TIMESTAMP WITH TIME ZONE,
+		revoked_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_api_tokens_user_id ON api_tokens(user_id);
+
+	-- Users' TOTP authenticators, recovery codes, and logins waiting for
+	-- their second factor, as in business/core/mfa/schema.sql.
+	CREATE TABLE IF NOT EXISTS user_totp (
+		user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+		secret TEXT NOT NULL,
+		last_step BIGINT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		confirmed_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE TABLE IF NOT EXISTS recovery_codes (
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		code_hash BYTEA NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		used_at TIMESTAMP WITH TIME ZONE,
+		PRIMARY KEY (user_id, code_hash)
+	);
+
+	CREATE TABLE IF NOT EXISTS mfa_challenges (
+		token_hash BYTEA PRIMARY KEY,
+		user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		workspace_id BIGINT NOT NULL DEFAULT 0,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+		used_at TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_mfa_challenges_user_id ON mfa_challenges(user_id);
+	CREATE INDEX IF NOT EXISTS idx_mfa_challenges_expires_at ON mfa_challenges(expires_at);
+
+	-- Failed password attempts, by account and IP address, as in
+	-- business/core/lockout/schema.sql.
+	CREATE TABLE IF NOT EXISTS login_failures (
+		key TEXT PRIMARY KEY,
+		failures INTEGER NOT NULL DEFAULT 0,
+		first_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		locked_until TIMESTAMP WITH TIME ZONE
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_login_failures_last_failed_at ON login_failures(last_failed_at);
+
+	-- Authentication events, as in business/core/audit/schema.sql.
+	CREATE TABLE IF NOT EXISTS auth_events (
+		id BIGSERIAL PRIMARY KEY,
+		kind TEXT NOT NULL,
+		user_id TEXT NOT NULL DEFAULT '',
+		email TEXT NOT NULL DEFAULT '',
+		ip TEXT NOT NULL DEFAULT '',
+		user_agent TEXT NOT NULL DEFAULT '',
+		actor_id TEXT NOT NULL DEFAULT '',
+		detail TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_auth_events_user_id ON auth_events(user_id, id DESC);
+	CREATE INDEX IF NOT EXISTS idx_auth_events_ip ON auth_events(ip, id DESC);
+	CREATE INDEX IF NOT EXISTS idx_auth_events_created_at ON auth_events(created_at);
+
+	-- Users notified of changes to tasks besides their creator and
+	-- assignees.
+	CREATE TABLE IF NOT EXISTS task_watchers (
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (task_id, user_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_task_watchers_user_id ON task_watchers(user_id);
+
+	-- The due date an overdue event was last published for, so each due
+	-- date is announced once.
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS overdue_notified_due_at TIMESTAMP WITH TIME ZONE;
+
+	-- Email settings. email_delivery is immediate, hourly, daily or off;
+	-- last_email_at paces digests.
+	CREATE TABLE IF NOT EXISTS notification_preferences (
+		user_id TEXT PRIMARY KEY,
+		email_delivery TEXT NOT NULL DEFAULT 'immediate',
+		last_email_at TIMESTAMP WITH TIME ZONE,
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	-- Notifications waiting to be emailed by the notifier, which deletes
+	-- them once sent.
+	CREATE TABLE IF NOT EXISTS notification_outbox (
+		id BIGSERIAL PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		task_id BIGINT NOT NULL,
+		board_id BIGINT NOT NULL,
+		title TEXT NOT NULL,
+		due_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_notification_outbox_user_id ON notification_outbox(user_id, id);
+
+	-- In-app inbox. Notifications outlive their tasks, so task_id isn't a
+	-- foreign key.
+	CREATE TABLE IF NOT EXISTS inbox_notifications (
+		id BIGSERIAL PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		task_id BIGINT NOT NULL,
+		board_id BIGINT NOT NULL,
+		title TEXT NOT NULL,
+		read_at TIMESTAMP WITH TIME ZONE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_inbox_notifications_user_id ON inbox_notifications(user_id, id DESC);
+	CREATE INDEX IF NOT EXISTS idx_inbox_notifications_unread ON inbox_notifications(user_id) WHERE read_at IS NULL;
+
+	-- Board membership. Boards without members are open to everyone.
+	CREATE TABLE IF NOT EXISTS board_members (
+		board_id BIGINT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member', 'viewer')),
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (board_id, user_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_board_members_user_id ON board_members(user_id);
+
+	-- Workspaces own boards and everything in them. Like boards, those
+	-- without members are open to everyone. The default workspace holds
+	-- data from before workspaces.
+	CREATE TABLE IF NOT EXISTS workspaces (
+		id BIGSERIAL PRIMARY KEY,
+		name TEXT NOT NULL,
+		slug TEXT NOT NULL UNIQUE,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	INSERT INTO workspaces (id, name, slug) VALUES (1, 'Default', 'default') ON CONFLICT DO NOTHING;
+	SELECT setval('workspaces_id_seq', GREATEST((SELECT MAX(id) FROM workspaces), 1));
+
+	CREATE TABLE IF NOT EXISTS workspace_members (
+		workspace_id BIGINT NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		role TEXT NOT NULL CHECK (role IN ('owner', 'member')),
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		PRIMARY KEY (workspace_id, user_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS idx_workspace_members_user_id ON workspace_members(user_id);
+
+	-- Workspaces and boards that only members who signed in with a
+	-- second factor may use.
+	ALTER TABLE workspaces ADD COLUMN IF NOT EXISTS require_mfa BOOLEAN NOT NULL DEFAULT FALSE;
+	ALTER TABLE boards ADD COLUMN IF NOT EXISTS require_mfa BOOLEAN NOT NULL DEFAULT FALSE;
+
+	-- Data migrations that run once, recorded by name.
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		name TEXT PRIMARY KEY,
+		applied_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	-- Task creators and assignees are users. Those that aren't, such as
+	-- the numeric IDs from before accounts or unmapped Trello and GitHub
+	-- assignees, move to legacy columns until they are mapped to users
+	-- (see the README). Row security is lifted while they move; the
+	-- workspace schema below restores it.
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS legacy_created_by TEXT;
+	ALTER TABLE tasks ADD COLUMN IF NOT EXISTS legacy_assignee_ids TEXT[] NOT NULL DEFAULT '{}';
+	ALTER TABLE tasks ALTER COLUMN created_by DROP NOT NULL;
+
+	DO $$ BEGIN
+		IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'task_users') THEN
+			ALTER TABLE tasks NO FORCE ROW LEVEL SECURITY;
+			ALTER TABLE task_assignees NO FORCE ROW LEVEL SECURITY;
+
+			UPDATE tasks SET legacy_created_by = created_by, created_by = NULL
+				WHERE created_by NOT IN (SELECT id FROM users);
+
+			UPDATE tasks SET legacy_assignee_ids = legacy_assignee_ids || ARRAY(
+					SELECT ta.user_id FROM task_assignees ta
+					WHERE ta.task_id = tasks.id AND ta.user_id NOT IN (SELECT id FROM users)
+					ORDER BY ta.user_id)
+				WHERE id IN (SELECT task_id FROM task_assignees WHERE user_id NOT IN (SELECT id FROM users));
+			DELETE FROM task_assignees WHERE user_id NOT IN (SELECT id FROM users);
+
+			INSERT INTO schema_migrations (name) VALUES ('task_users') ON CONFLICT DO NOTHING;
+		END IF;
+	END $$;
+
+	DO $$ BEGIN
+		ALTER TABLE tasks ADD CONSTRAINT tasks_created_by_fkey
+			FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL;
+	EXCEPTION WHEN duplicate_object THEN NULL;
+	END $$;
+
+	DO $$ BEGIN
+		ALTER TABLE task_assignees ADD CONSTRAINT task_assignees_user_id_fkey
+			FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+	EXCEPTION WHEN duplicate_object THEN NULL;
+	END $$;
+
+	CREATE INDEX IF NOT EXISTS idx_tasks_created_by ON tasks(created_by);
+	CREATE INDEX IF NOT EXISTS idx_tasks_legacy_created_by ON tasks(legacy_created_by)
+		WHERE legacy_created_by IS NOT NULL;
+	` + workspaceSchema() + `
+	-- At most one running timer per user in each workspace.
+	DROP INDEX IF EXISTS idx_time_entries_running;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running_workspace
+		ON time_entries(workspace_id, user_id) WHERE ended_at IS NULL;
+
+	-- View names are unique per owner in each workspace.
+	ALTER TABLE saved_views DROP CONSTRAINT IF EXISTS saved_views_owner_id_name_key;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_saved_views_name
+		ON saved_views(workspace_id, owner_id, name);
+
+	-- Imports are keyed per workspace, so one can be rerun in each.
+	ALTER TABLE imported_boards DROP CONSTRAINT IF EXISTS imported_boards_pkey;
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_imported_boards_key
+		ON imported_boards(workspace_id, source, external_id);
+
+	-- Who created each board. While a board is open, its creator may give
+	-- it its first owner. Boards from before this was recorded have none.
+	ALTER TABLE boards ADD COLUMN IF NOT EXISTS created_by TEXT;
+
+	-- Open boards whose creator is a user are owned by them. Each
+	-- workspace's boards are only visible with it set.
+	DO $$ DECLARE w record; BEGIN
+		IF NOT EXISTS (SELECT 1 FROM schema_migrations WHERE name = 'board_creators') THEN
+			FOR w IN SELECT id FROM workspaces LOOP
+				PERFORM set_config('` + workspaceSetting + `', w.id::text, true);
+				INSERT INTO board_members (board_id, user_id, role)
+					SELECT id, created_by, 'owner' FROM boards
+					WHERE created_by IN (SELECT id FROM users)
+						AND NOT EXISTS (SELECT 1 FROM board_members m WHERE m.board_id = boards.id)
+					ON CONFLICT DO NOTHING;
+			END LOOP;
+			PERFORM set_config('` + workspaceSetting + `', '', true);
+
+			INSERT INTO schema_migrations (name) VALUES ('board_creators') ON CONFLICT DO NOTHING;
+		END IF;
+	END $$;
+
+	-- Who created each template, and the board it was saved from. Source
+	-- boards aren't foreign keys: templates of deleted boards stay with
+	-- their creators.
+	ALTER TABLE templates ADD COLUMN IF NOT EXISTS created_by TEXT;
+	ALTER TABLE templates ADD COLUMN IF NOT EXISTS source_board_id BIGINT;
 	`
 
 	_, err := db.Exec(schema)
@@ -0,0 +1,234 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/lib/pq"
+)
+
+// Test DB settings. Assumes port-forward of 5432:5432 from localhost to postgres svc is in place.
+var testDBConfig = Config{
+	Host:     "localhost",
+	Port:     5432,
+	User:     "taskboard",
+	Password: "taskboard",
+	Database: "taskboard_test",
+	SSLMode:  "disable",
+}
+
+// dropTables removes every table of the test db.
+const dropTables = `
+	DO $$ DECLARE r record; BEGIN
+		FOR r IN SELECT tablename FROM pg_tables WHERE schemaname = 'public' LOOP
+			EXECUTE format('DROP TABLE IF EXISTS %I CASCADE', r.tablename);
+		END LOOP;
+	END $$;
+`
+
+// legacySchema is the schema from before task creators and assignees were
+// users: numeric creators, and assignees that may be any string.
+const legacySchema = `
+	CREATE TABLE tasks (
+		id BIGSERIAL PRIMARY KEY,
+		board_id BIGINT NOT NULL,
+		title TEXT NOT NULL,
+		description TEXT,
+		completed BOOLEAN NOT NULL DEFAULT false,
+		created_by BIGINT NOT NULL,
+		created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+		updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+	);
+
+	CREATE TABLE task_assignees (
+		task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id TEXT NOT NULL,
+		PRIMARY KEY (task_id, user_id)
+	);
+
+	CREATE TABLE users (
+		id TEXT PRIMARY KEY,
+		email TEXT UNIQUE NOT NULL,
+		username TEXT UNIQUE NOT NULL,
+		password_hash TEXT NOT NULL,
+		created_at TIMESTAMP DEFAULT NOW(),
+		updated_at TIMESTAMP DEFAULT NOW()
+	);
+
+	INSERT INTO users (id, email, username, password_hash) VALUES ('100', 'a@example.com', 'alice', 'x');
+
+	INSERT INTO tasks (board_id, title, created_by) VALUES (1, 'Mapped', 100), (1, 'Unmapped', 7);
+	INSERT INTO task_assignees (task_id, user_id) VALUES (1, '100'), (1, 'octocat'), (2, 'ghost');
+`
+
+// setupLegacyDb creates the legacy schema for each test.
+func setupLegacyDb(t *testing.T) (*sql.DB, func()) {
+	t.Helper()
+
+	db, err := NewPostgresDB(testDBConfig)
+	if err != nil {
+		t.Skip("Skipping test: cannot connect to test db")
+		return nil, nil
+	}
+
+	if _, err := db.Exec(dropTables); err != nil {
+		t.Fatalf("failed to drop tables: %v", err)
+	}
+	if _, err := db.Exec(legacySchema); err != nil {
+		t.Fatalf("failed to create legacy schema: %v", err)
+	}
+
+	cleanup := func() {
+		db.Exec(dropTables)
+		db.Close()
+	}
+
+	return db, cleanup
+}
+
+// taskUsers is what a task records of its users.
+type taskUsers struct {
+	createdBy       sql.NullString
+	legacyCreatedBy sql.NullString
+	assignees       []string
+	legacyAssignees []string
+}
+
+func getTaskUsers(t *testing.T, ctx context.Context, db *sql.DB, id int64) taskUsers {
+	t.Helper()
+
+	var u taskUsers
+	err := db.QueryRowContext(ctx, `
+		SELECT created_by, legacy_created_by, legacy_assignee_ids,
+			ARRAY(SELECT user_id FROM task_assignees WHERE task_id = tasks.id ORDER BY user_id)
+		FROM tasks WHERE id = $1
+	`, id).Scan(&u.createdBy, &u.legacyCreatedBy, pq.Array(&u.legacyAssignees), pq.Array(&u.assignees))
+	if err != nil {
+		t.Fatalf("failed to get task %d: %v", id, err)
+	}
+	return u
+}
+
+func checkMigratedUsers(t *testing.T, ctx context.Context, db *sql.DB) {
+	t.Helper()
+
+	mapped := getTaskUsers(t, ctx, db, 1)
+	if mapped.createdBy.String != "100" || mapped.legacyCreatedBy.Valid {
+		t.Errorf("expected creator 100 kept, got %+v", mapped)
+	}
+	if len(mapped.assignees) != 1 || mapped.assignees[0] != "100" {
+		t.Errorf("expected assignee 100 kept, got %v", mapped.assignees)
+	}
+	if len(mapped.legacyAssignees) != 1 || mapped.legacyAssignees[0] != "octocat" {
+		t.Errorf("expected legacy assignee octocat, got %v", mapped.legacyAssignees)
+	}
+
+	unmapped := getTaskUsers(t, ctx, db, 2)
+	if unmapped.createdBy.Valid || unmapped.legacyCreatedBy.String != "7" {
+		t.Errorf("expected creator 7 moved to legacy, got %+v", unmapped)
+	}
+	if len(unmapped.assignees) != 0 {
+		t.Errorf("expected no assignees, got %v", unmapped.assignees)
+	}
+	if len(unmapped.legacyAssignees) != 1 || unmapped.legacyAssignees[0] != "ghost" {
+		t.Errorf("expected legacy assignee ghost, got %v", unmapped.legacyAssignees)
+	}
+}
+
+func TestInitSchemaLegacyUsers(t *testing.T) {
+	db, cleanup := setupLegacyDb(t)
+	if db == nil {
+		return // Test was skipped.
+	}
+	defer cleanup()
+
+	ctx := WithWorkspace(context.Background(), DefaultWorkspaceID)
+	if err := InitSchema(db); err != nil {
+		t.Fatalf("failed to migrate schema: %v", err)
+	}
+
+	t.Run("move non-users to legacy columns", func(t *testing.T) {
+		checkMigratedUsers(t, ctx, db)
+
+		t.Log("✅ Non-user creators and assignees moved to legacy columns")
+	})
+
+	t.Run("rerun", func(t *testing.T) {
+		if err := InitSchema(db); err != nil {
+			t.Fatalf("failed to rerun schema: %v", err)
+		}
+		checkMigratedUsers(t, ctx, db)
+
+		var applied int
+		err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM schema_migrations WHERE name = 'task_users'`).Scan(&applied)
+		if err != nil {
+			t.Fatalf("failed to get migrations: %v", err)
+		}
+		if applied != 1 {
+			t.Errorf("expected the migration recorded once, got %d", applied)
+		}
+
+		t.Log("✅ Rerunning the migration changes nothing")
+	})
+
+	t.Run("reject unknown users", func(t *testing.T) {
+		var pqErr *pq.Error
+
+		_, err := db.ExecContext(ctx, `INSERT INTO task_assignees (task_id, user_id) VALUES (1, 'nobody')`)
+		if !errors.As(err, &pqErr) || pqErr.Code != "23503" {
+			t.Errorf("expected foreign key violation for assignee, got: %v", err)
+		}
+
+		_, err = db.ExecContext(ctx, `INSERT INTO tasks (workspace_id, board_id, title, created_by) VALUES (1, 1, 'x', 'nobody')`)
+		if !errors.As(err, &pqErr) || pqErr.Code != "23503" {
+			t.Errorf("expected foreign key violation for creator, got: %v", err)
+		}
+
+		t.Log("✅ Correctly rejected unknown users")
+	})
+
+	t.Run("deleting a user", func(t *testing.T) {
+		if _, err := db.ExecContext(ctx, `DELETE FROM users WHERE id = '100'`); err != nil {
+			t.Fatalf("failed to delete user: %v", err)
+		}
+
+		u := getTaskUsers(t, ctx, db, 1)
+		if u.createdBy.Valid {
+			t.Errorf("expected creator cleared, got %q", u.createdBy.String)
+		}
+		if len(u.assignees) != 0 {
+			t.Errorf("expected assignment removed, got %v", u.assignees)
+		}
+
+		t.Log("✅ Tasks outlive their users; assignments don't")
+	})
+}
+
+func TestCheckRowSecurity(t *testing.T) {
+	db, err := NewPostgresDB(testDBConfig)
+	if err != nil {
+		t.Skip("Skipping test: cannot connect to test db")
+		return
+	}
+	defer db.Close()
+
+	var superuser, bypassRLS bool
+	err = db.QueryRow(`SELECT rolsuper, rolbypassrls FROM pg_roles WHERE rolname = current_user`).
+		Scan(&superuser, &bypassRLS)
+	if err != nil {
+		t.Fatalf("failed to get role: %v", err)
+	}
+
+	err = CheckRowSecurity(db)
+	if superuser || bypassRLS {
+		if !errors.Is(err, ErrBypassesRowSecurity) {
+			t.Errorf("expected ErrBypassesRowSecurity, got: %v", err)
+		}
+	} else if err != nil {
+		t.Errorf("expected ordinary role accepted, got: %v", err)
+	}
+
+	t.Log("✅ Roles that bypass row-level security are reported")
+}
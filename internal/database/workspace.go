@@ -0,0 +1,195 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultWorkspaceID is the workspace that data from before workspaces
+// were added belongs to.
+const DefaultWorkspaceID int64 = 1
+
+type workspaceKey struct{}
+
+// WithWorkspace scopes queries run with ctx to a workspace. Row-level
+// security hides other workspaces' rows from them.
+func WithWorkspace(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, id)
+}
+
+// WorkspaceID returns the workspace ctx is scoped to.
+func WorkspaceID(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(workspaceKey{}).(int64)
+	return id, ok
+}
+
+// workspaceSetting is the session setting row-level security policies
+// read the current workspace from.
+const workspaceSetting = "app.workspace_id"
+
+// pqConn is what lib/pq connections implement and workspaceConn relies on.
+type pqConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+}
+
+// workspaceConnector opens connections that set the workspace setting from
+// each query's context.
+type workspaceConnector struct {
+	driver.Connector
+}
+
+func (c workspaceConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pc, ok := conn.(pqConn)
+	if !ok {
+		conn.Close()
+		return nil, fmt.Errorf("unsupported driver connection %T", conn)
+	}
+	return &workspaceConn{pqConn: pc}, nil
+}
+
+// workspaceConn sets the session's workspace before each statement, so
+// pooled connections never carry one request's workspace into another.
+// Contexts without a workspace clear it, which hides every scoped row.
+type workspaceConn struct {
+	pqConn
+
+	// The workspace last set on the session, valid if set is true.
+	workspace string
+	set       bool
+}
+
+func (c *workspaceConn) scope(ctx context.Context) error {
+	want := ""
+	if id, ok := WorkspaceID(ctx); ok {
+		want = strconv.FormatInt(id, 10)
+	}
+	if c.set && c.workspace == want {
+		return nil
+	}
+
+	_, err := c.pqConn.ExecContext(ctx, `SELECT set_config('`+workspaceSetting+`', $1, false)`,
+		[]driver.NamedValue{{Ordinal: 1, Value: want}})
+	if err != nil {
+		c.set = false
+		return fmt.Errorf("failed to set workspace: %w", err)
+	}
+	c.workspace, c.set = want, true
+	return nil
+}
+
+func (c *workspaceConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.QueryContext(ctx, query, args)
+}
+
+func (c *workspaceConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.ExecContext(ctx, query, args)
+}
+
+func (c *workspaceConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	return c.pqConn.PrepareContext(ctx, query)
+}
+
+func (c *workspaceConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.scope(ctx); err != nil {
+		return nil, err
+	}
+	tx, err := c.pqConn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &workspaceTx{Tx: tx, conn: c}, nil
+}
+
+// workspaceTx forgets the session's workspace on rollback, which undoes
+// settings changed within the transaction.
+type workspaceTx struct {
+	driver.Tx
+	conn *workspaceConn
+}
+
+func (tx *workspaceTx) Rollback() error {
+	tx.conn.set = false
+	return tx.Tx.Rollback()
+}
+
+// Tables scoped to a workspace by their own workspace_id column.
+var workspaceTables = []string{
+	"boards",
+	"tasks",
+	"time_entries",
+	"templates",
+	"saved_views",
+	"imported_boards",
+	"calendar_feeds",
+	"webhooks",
+	"inbox_notifications",
+}
+
+// Tables scoped through the row they belong to.
+var workspaceChildTables = []struct{ table, condition string }{
+	{"board_columns", "board_id IN (SELECT id FROM boards)"},
+	{"board_labels", "board_id IN (SELECT id FROM boards)"},
+	{"board_members", "board_id IN (SELECT id FROM boards)"},
+	{"custom_fields", "board_id IN (SELECT id FROM boards)"},
+	{"imported_tasks", "board_id IN (SELECT id FROM boards)"},
+	{"task_assignees", "task_id IN (SELECT id FROM tasks)"},
+	{"task_watchers", "task_id IN (SELECT id FROM tasks)"},
+	{"task_field_values", "task_id IN (SELECT id FROM tasks)"},
+	{"attachments", "task_id IN (SELECT id FROM tasks)"},
+	{"webhook_deliveries", "webhook_id IN (SELECT id FROM webhooks)"},
+}
+
+// workspaceSchema adds workspace_id to the scoped tables, with existing
+// rows in the default workspace, and enables row-level security on them.
+// The policies are a second line of defence behind the repository's own
+// workspace conditions; superusers and roles with BYPASSRLS skip them.
+func workspaceSchema() string {
+	var b strings.Builder
+	current := `NULLIF(current_setting('` + workspaceSetting + `', true), '')::bigint`
+
+	for _, table := range workspaceTables {
+		fmt.Fprintf(&b, `
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS workspace_id BIGINT NOT NULL DEFAULT %[2]d REFERENCES workspaces(id);
+	ALTER TABLE %[1]s ALTER COLUMN workspace_id DROP DEFAULT;
+	CREATE INDEX IF NOT EXISTS idx_%[1]s_workspace_id ON %[1]s(workspace_id);
+	ALTER TABLE %[1]s ENABLE ROW LEVEL SECURITY;
+	ALTER TABLE %[1]s FORCE ROW LEVEL SECURITY;
+	DROP POLICY IF EXISTS workspace_isolation ON %[1]s;
+	CREATE POLICY workspace_isolation ON %[1]s USING (workspace_id = %[3]s);
+`, table, DefaultWorkspaceID, current)
+	}
+
+	for _, child := range workspaceChildTables {
+		fmt.Fprintf(&b, `
+	ALTER TABLE %[1]s ENABLE ROW LEVEL SECURITY;
+	ALTER TABLE %[1]s FORCE ROW LEVEL SECURITY;
+	DROP POLICY IF EXISTS workspace_isolation ON %[1]s;
+	CREATE POLICY workspace_isolation ON %[1]s USING (%[2]s);
+`, child.table, child.condition)
+	}
+
+	return b.String()
+}
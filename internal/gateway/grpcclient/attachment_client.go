@@ -0,0 +1,40 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateAttachment(ctx context.Context, req *pb.CreateAttachmentRequest) (*pb.Attachment, error) {
+	resp, err := c.client.CreateAttachment(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+	return resp.Attachment, nil
+}
+
+func (c *TaskClient) GetAttachment(ctx context.Context, id int64) (*pb.Attachment, error) {
+	resp, err := c.client.GetAttachment(ctx, &pb.GetAttachmentRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return resp.Attachment, nil
+}
+
+func (c *TaskClient) ListAttachments(ctx context.Context, taskID int64) ([]*pb.Attachment, error) {
+	resp, err := c.client.ListAttachments(ctx, &pb.ListAttachmentsRequest{TaskId: taskID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return resp.Attachments, nil
+}
+
+func (c *TaskClient) DeleteAttachment(ctx context.Context, id int64) error {
+	_, err := c.client.DeleteAttachment(ctx, &pb.DeleteAttachmentRequest{Id: id})
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateBoard(ctx context.Context, req *pb.CreateBoardRequest) (*pb.Board, error) {
+	resp, err := c.client.CreateBoard(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create board: %w", err)
+	}
+	return resp.Board, nil
+}
+
+func (c *TaskClient) GetBoard(ctx context.Context, id int64) (*pb.Board, error) {
+	resp, err := c.client.GetBoard(ctx, &pb.GetBoardRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	return resp.Board, nil
+}
+
+func (c *TaskClient) UpdateBoard(ctx context.Context, req *pb.UpdateBoardRequest) (*pb.Board, error) {
+	resp, err := c.client.UpdateBoard(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update board: %w", err)
+	}
+	return resp.Board, nil
+}
+
+func (c *TaskClient) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.Template, error) {
+	resp, err := c.client.CreateTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+	return resp.Template, nil
+}
+
+func (c *TaskClient) GetTemplate(ctx context.Context, id int64) (*pb.Template, error) {
+	resp, err := c.client.GetTemplate(ctx, &pb.GetTemplateRequest{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	return resp.Template, nil
+}
+
+func (c *TaskClient) ListTemplates(ctx context.Context, kind pb.TemplateKind) ([]*pb.Template, error) {
+	resp, err := c.client.ListTemplates(ctx, &pb.ListTemplatesRequest{Kind: kind})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	return resp.Templates, nil
+}
+
+func (c *TaskClient) DeleteTemplate(ctx context.Context, id int64) error {
+	_, err := c.client.DeleteTemplate(ctx, &pb.DeleteTemplateRequest{Id: id})
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) SaveBoardAsTemplate(ctx context.Context, req *pb.SaveBoardAsTemplateRequest) (*pb.Template, error) {
+	resp, err := c.client.SaveBoardAsTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save board as template: %w", err)
+	}
+	return resp.Template, nil
+}
+
+func (c *TaskClient) CreateBoardFromTemplate(ctx context.Context,
+	req *pb.CreateBoardFromTemplateRequest,
+) (*pb.CreateBoardFromTemplateResponse, error) {
+	resp, err := c.client.CreateBoardFromTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create board from template: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) CreateTaskFromTemplate(ctx context.Context, req *pb.CreateTaskFromTemplateRequest) (*pb.Task, error) {
+	resp, err := c.client.CreateTaskFromTemplate(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task from template: %w", err)
+	}
+	return resp.Task, nil
+}
@@ -0,0 +1,40 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CreateCalendarFeedResponse, error) {
+	resp, err := c.client.CreateCalendarFeed(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) ListCalendarFeeds(ctx context.Context, userID string) ([]*pb.CalendarFeed, error) {
+	resp, err := c.client.ListCalendarFeeds(ctx, &pb.ListCalendarFeedsRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+	return resp.Feeds, nil
+}
+
+func (c *TaskClient) DeleteCalendarFeed(ctx context.Context, id int64, userID string) error {
+	_, err := c.client.DeleteCalendarFeed(ctx, &pb.DeleteCalendarFeedRequest{Id: id, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) GetCalendar(ctx context.Context, token, ifNoneMatch string) (*pb.GetCalendarResponse, error) {
+	resp, err := c.client.GetCalendar(ctx, &pb.GetCalendarRequest{Token: token, IfNoneMatch: ifNoneMatch})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar: %w", err)
+	}
+	return resp, nil
+}
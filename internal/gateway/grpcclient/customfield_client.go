@@ -0,0 +1,40 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateCustomField(ctx context.Context, req *pb.CreateCustomFieldRequest) (*pb.CustomField, error) {
+	resp, err := c.client.CreateCustomField(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom field: %w", err)
+	}
+	return resp.Field, nil
+}
+
+func (c *TaskClient) UpdateCustomField(ctx context.Context, req *pb.UpdateCustomFieldRequest) (*pb.CustomField, error) {
+	resp, err := c.client.UpdateCustomField(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update custom field: %w", err)
+	}
+	return resp.Field, nil
+}
+
+func (c *TaskClient) DeleteCustomField(ctx context.Context, id int64) error {
+	_, err := c.client.DeleteCustomField(ctx, &pb.DeleteCustomFieldRequest{Id: id})
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) ListCustomFields(ctx context.Context, boardID int64) ([]*pb.CustomField, error) {
+	resp, err := c.client.ListCustomFields(ctx, &pb.ListCustomFieldsRequest{BoardId: boardID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom fields: %w", err)
+	}
+	return resp.Fields, nil
+}
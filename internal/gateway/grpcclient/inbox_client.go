@@ -0,0 +1,40 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) ListInboxNotifications(ctx context.Context, req *pb.ListInboxNotificationsRequest) (*pb.ListInboxNotificationsResponse, error) {
+	resp, err := c.client.ListInboxNotifications(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbox notifications: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) GetInboxUnreadCount(ctx context.Context, userID string) (*pb.GetInboxUnreadCountResponse, error) {
+	resp, err := c.client.GetInboxUnreadCount(ctx, &pb.GetInboxUnreadCountRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inbox unread count: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) MarkInboxNotificationRead(ctx context.Context, id int64, userID string) (*pb.MarkInboxNotificationReadResponse, error) {
+	resp, err := c.client.MarkInboxNotificationRead(ctx, &pb.MarkInboxNotificationReadRequest{Id: id, UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark inbox notification read: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) MarkAllInboxNotificationsRead(ctx context.Context, userID string) (*pb.MarkAllInboxNotificationsReadResponse, error) {
+	resp, err := c.client.MarkAllInboxNotificationsRead(ctx, &pb.MarkAllInboxNotificationsReadRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark inbox notifications read: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) ListBoardMembers(ctx context.Context, boardID int64) ([]*pb.BoardMember, error) {
+	resp, err := c.client.ListBoardMembers(ctx, &pb.ListBoardMembersRequest{BoardId: boardID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board members: %w", err)
+	}
+	return resp.Members, nil
+}
+
+func (c *TaskClient) AddBoardMember(ctx context.Context, req *pb.AddBoardMemberRequest) (*pb.BoardMember, error) {
+	resp, err := c.client.AddBoardMember(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add board member: %w", err)
+	}
+	return resp.Member, nil
+}
+
+func (c *TaskClient) UpdateBoardMember(ctx context.Context, req *pb.UpdateBoardMemberRequest) (*pb.BoardMember, error) {
+	resp, err := c.client.UpdateBoardMember(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update board member: %w", err)
+	}
+	return resp.Member, nil
+}
+
+func (c *TaskClient) RemoveBoardMember(ctx context.Context, boardID int64, userID string) error {
+	_, err := c.client.RemoveBoardMember(ctx, &pb.RemoveBoardMemberRequest{BoardId: boardID, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to remove board member: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) WatchTask(ctx context.Context, taskID int64, userID string) error {
+	_, err := c.client.WatchTask(ctx, &pb.WatchTaskRequest{TaskId: taskID, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to watch task: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) UnwatchTask(ctx context.Context, taskID int64, userID string) error {
+	_, err := c.client.UnwatchTask(ctx, &pb.UnwatchTaskRequest{TaskId: taskID, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to unwatch task: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) ListTaskWatchers(ctx context.Context, taskID int64) ([]string, error) {
+	resp, err := c.client.ListTaskWatchers(ctx, &pb.ListTaskWatchersRequest{TaskId: taskID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task watchers: %w", err)
+	}
+	return resp.UserIds, nil
+}
+
+func (c *TaskClient) GetNotificationPreferences(ctx context.Context, userID string) (*pb.NotificationPreferences, error) {
+	resp, err := c.client.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return resp.Preferences, nil
+}
+
+func (c *TaskClient) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	resp, err := c.client.UpdateNotificationPreferences(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification preferences: %w", err)
+	}
+	return resp.Preferences, nil
+}
@@ -0,0 +1,48 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateSavedView(ctx context.Context, req *pb.CreateSavedViewRequest) (*pb.SavedView, error) {
+	resp, err := c.client.CreateSavedView(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved view: %w", err)
+	}
+	return resp.View, nil
+}
+
+func (c *TaskClient) GetSavedView(ctx context.Context, id int64, userID string) (*pb.SavedView, error) {
+	resp, err := c.client.GetSavedView(ctx, &pb.GetSavedViewRequest{Id: id, UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+	return resp.View, nil
+}
+
+func (c *TaskClient) ListSavedViews(ctx context.Context, userID string, boardID int64) ([]*pb.SavedView, error) {
+	resp, err := c.client.ListSavedViews(ctx, &pb.ListSavedViewsRequest{UserId: userID, BoardId: boardID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	return resp.Views, nil
+}
+
+func (c *TaskClient) UpdateSavedView(ctx context.Context, req *pb.UpdateSavedViewRequest) (*pb.SavedView, error) {
+	resp, err := c.client.UpdateSavedView(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update saved view: %w", err)
+	}
+	return resp.View, nil
+}
+
+func (c *TaskClient) DeleteSavedView(ctx context.Context, id int64, userID string) error {
+	_, err := c.client.DeleteSavedView(ctx, &pb.DeleteSavedViewRequest{Id: id, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -16,7 +17,9 @@ type TaskClient struct {
 	conn   *grpc.ClientConn
 }
 
-func NewTaskClient(taskServiceAddr string) (*TaskClient, error) {
+// NewTaskClient connects to the task service. Calls made on behalf of a
+// user carry a call token for them, signed by authn.
+func NewTaskClient(taskServiceAddr string, authn *auth.Auth) (*TaskClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -26,6 +29,8 @@ func NewTaskClient(taskServiceAddr string) (*TaskClient, error) {
 		taskServiceAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(callerUnaryInterceptor(authn)),
+		grpc.WithStreamInterceptor(callerStreamInterceptor(authn)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to task service at %s: %w",
@@ -60,20 +65,7 @@ func (c *TaskClient) GetTask(ctx context.Context, taskID int64) (*pb.Task, error
 	return resp.Task, nil
 }
 
-func (c *TaskClient) ListTasks(ctx context.Context, boardID int64, completed *bool, pageSize,
-	pageNumber int32,
-) ([]*pb.Task, int32, error) {
-	req := &pb.ListTasksRequest{
-		BoardId:    boardID,
-		PageSize:   pageSize,
-		PageNumber: pageNumber,
-	}
-
-	// Only set completed filter if provided.
-	if completed != nil {
-		req.Completed = completed
-	}
-
+func (c *TaskClient) ListTasks(ctx context.Context, req *pb.ListTasksRequest) ([]*pb.Task, int32, error) {
 	resp, err := c.client.ListTasks(ctx, req)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list tasks: %w", err)
@@ -0,0 +1,64 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) StartTimer(ctx context.Context, req *pb.StartTimerRequest) (*pb.TimeEntry, error) {
+	resp, err := c.client.StartTimer(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start timer: %w", err)
+	}
+	return resp.Entry, nil
+}
+
+func (c *TaskClient) StopTimer(ctx context.Context, userID string) (*pb.TimeEntry, error) {
+	resp, err := c.client.StopTimer(ctx, &pb.StopTimerRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop timer: %w", err)
+	}
+	return resp.Entry, nil
+}
+
+func (c *TaskClient) CreateTimeEntry(ctx context.Context, req *pb.CreateTimeEntryRequest) (*pb.TimeEntry, error) {
+	resp, err := c.client.CreateTimeEntry(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create time entry: %w", err)
+	}
+	return resp.Entry, nil
+}
+
+func (c *TaskClient) UpdateTimeEntry(ctx context.Context, req *pb.UpdateTimeEntryRequest) (*pb.TimeEntry, error) {
+	resp, err := c.client.UpdateTimeEntry(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update time entry: %w", err)
+	}
+	return resp.Entry, nil
+}
+
+func (c *TaskClient) DeleteTimeEntry(ctx context.Context, id int64) error {
+	_, err := c.client.DeleteTimeEntry(ctx, &pb.DeleteTimeEntryRequest{Id: id})
+	if err != nil {
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) ListTimeEntries(ctx context.Context, req *pb.ListTimeEntriesRequest) ([]*pb.TimeEntry, error) {
+	resp, err := c.client.ListTimeEntries(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+	return resp.Entries, nil
+}
+
+func (c *TaskClient) GetTimeReport(ctx context.Context, req *pb.GetTimeReportRequest) (*pb.GetTimeReportResponse, error) {
+	resp, err := c.client.GetTimeReport(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get time report: %w", err)
+	}
+	return resp, nil
+}
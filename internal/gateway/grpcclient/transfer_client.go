@@ -0,0 +1,71 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// importChunkSize is the size of the data messages ImportBoard sends.
+const importChunkSize = 32 * 1024
+
+// ExportBoard copies an exported board file to w as it arrives.
+func (c *TaskClient) ExportBoard(ctx context.Context, boardID int64, format pb.DataFormat, w io.Writer) error {
+	stream, err := c.client.ExportBoard(ctx, &pb.ExportBoardRequest{BoardId: boardID, Format: format})
+	if err != nil {
+		return fmt.Errorf("failed to export board: %w", err)
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to export board: %w", err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return fmt.Errorf("failed to write export: %w", err)
+		}
+	}
+}
+
+// ImportBoard streams the file in r to the task service. If r fails, the
+// import is cancelled and nothing is written.
+func (c *TaskClient) ImportBoard(ctx context.Context, header *pb.ImportBoardHeader, r io.Reader) (*pb.ImportBoardResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.ImportBoard(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import board: %w", err)
+	}
+
+	err = stream.Send(&pb.ImportBoardRequest{Payload: &pb.ImportBoardRequest_Header{Header: header}})
+	for err == nil {
+		buf := make([]byte, importChunkSize)
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			err = stream.Send(&pb.ImportBoardRequest{Payload: &pb.ImportBoardRequest_Data{Data: buf[:n]}})
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read import: %w", readErr)
+		}
+	}
+	// io.EOF from Send means the server has already answered; its status
+	// comes from CloseAndRecv.
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to import board: %w", err)
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to import board: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,24 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) ExportUserData(ctx context.Context, userID string) (*pb.ExportUserDataResponse, error) {
+	resp, err := c.client.ExportUserData(ctx, &pb.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user data: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) DeleteUserData(ctx context.Context, userID string) error {
+	_, err := c.client.DeleteUserData(ctx, &pb.DeleteUserDataRequest{UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete user data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	resp, err := c.client.CreateWebhook(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) GetWebhook(ctx context.Context, id int64, userID string) (*pb.Webhook, error) {
+	resp, err := c.client.GetWebhook(ctx, &pb.GetWebhookRequest{Id: id, UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return resp.Webhook, nil
+}
+
+func (c *TaskClient) ListWebhooks(ctx context.Context, userID string) ([]*pb.Webhook, error) {
+	resp, err := c.client.ListWebhooks(ctx, &pb.ListWebhooksRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return resp.Webhooks, nil
+}
+
+func (c *TaskClient) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	resp, err := c.client.UpdateWebhook(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) DeleteWebhook(ctx context.Context, id int64, userID string) error {
+	_, err := c.client.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{Id: id, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return nil
+}
+
+func (c *TaskClient) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	resp, err := c.client.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return resp, nil
+}
+
+func (c *TaskClient) RedeliverWebhookDelivery(ctx context.Context, req *pb.RedeliverWebhookDeliveryRequest) (*pb.WebhookDelivery, error) {
+	resp, err := c.client.RedeliverWebhookDelivery(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redeliver webhook delivery: %w", err)
+	}
+	return resp.Delivery, nil
+}
@@ -0,0 +1,56 @@
+package grpcclient
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func (c *TaskClient) CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.Workspace, error) {
+	resp, err := c.client.CreateWorkspace(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+	return resp.Workspace, nil
+}
+
+func (c *TaskClient) UpdateWorkspace(ctx context.Context, req *pb.UpdateWorkspaceRequest) (*pb.Workspace, error) {
+	resp, err := c.client.UpdateWorkspace(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workspace: %w", err)
+	}
+	return resp.Workspace, nil
+}
+
+func (c *TaskClient) ListWorkspaces(ctx context.Context) ([]*pb.Workspace, error) {
+	resp, err := c.client.ListWorkspaces(ctx, &pb.ListWorkspacesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	return resp.Workspaces, nil
+}
+
+func (c *TaskClient) ListWorkspaceMembers(ctx context.Context, workspaceID int64) ([]*pb.WorkspaceMember, error) {
+	resp, err := c.client.ListWorkspaceMembers(ctx, &pb.ListWorkspaceMembersRequest{WorkspaceId: workspaceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace members: %w", err)
+	}
+	return resp.Members, nil
+}
+
+func (c *TaskClient) AddWorkspaceMember(ctx context.Context, req *pb.AddWorkspaceMemberRequest) (*pb.WorkspaceMember, error) {
+	resp, err := c.client.AddWorkspaceMember(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add workspace member: %w", err)
+	}
+	return resp.Member, nil
+}
+
+func (c *TaskClient) RemoveWorkspaceMember(ctx context.Context, workspaceID int64, userID string) error {
+	_, err := c.client.RemoveWorkspaceMember(ctx, &pb.RemoveWorkspaceMemberRequest{WorkspaceId: workspaceID, UserId: userID})
+	if err != nil {
+		return fmt.Errorf("failed to remove workspace member: %w", err)
+	}
+	return nil
+}
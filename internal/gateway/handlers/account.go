@@ -0,0 +1,259 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/lockout"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/mail"
+)
+
+// How long emailed tokens work for.
+const (
+	passwordResetTTL     = time.Hour
+	emailVerificationTTL = 24 * time.Hour
+)
+
+// accountMailTimeout bounds sending an account email, which happens after
+// the request that triggered it has been answered.
+const accountMailTimeout = 30 * time.Second
+
+// AccountMail configures the emails that reset users' passwords and verify
+// their emails.
+type AccountMail struct {
+	Sender mail.Sender
+	From   string
+
+	// Links in the emails, with "{token}" replaced by the token. Without
+	// one, emails give the token to paste instead.
+	ResetURL  string
+	VerifyURL string
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type ConfirmPasswordResetRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type ConfirmEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// sendToken emails u a one-time token for a purpose, created in users, in
+// the background.
+func (m AccountMail) sendToken(users *user.Store, u user.User, purpose string) {
+	ctx, cancel := context.WithTimeout(context.Background(), accountMailTimeout)
+	defer cancel()
+
+	ttl, subject, action, link := passwordResetTTL, "Reset your Taskboard password", "reset your password", m.ResetURL
+	if purpose == user.PurposeEmailVerification {
+		ttl, subject, action, link = emailVerificationTTL, "Verify your Taskboard email", "verify your email", m.VerifyURL
+	}
+
+	token, err := users.CreateToken(ctx, u.ID(), purpose, ttl)
+	if err != nil {
+		log.Printf("Error creating %s token for %s: %v", purpose, u.ID(), err)
+		return
+	}
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Hi %s,\n\n", u.Username())
+	if link != "" {
+		fmt.Fprintf(&body, "To %s, open this link within %v:\n\n%s\n\n", action, ttl, strings.ReplaceAll(link, "{token}", token))
+	} else {
+		fmt.Fprintf(&body, "To %s, use this code within %v:\n\n%s\n\n", action, ttl, token)
+	}
+	body.WriteString("If you didn't ask for this, you can ignore this email.\n")
+
+	err = m.Sender.Send(ctx, mail.Message{
+		From:    m.From,
+		To:      (&netmail.Address{Name: u.Username(), Address: u.Email()}).String(),
+		Subject: subject,
+		Text:    body.String(),
+	})
+	if err != nil {
+		log.Printf("Error emailing %s token to %s: %v", purpose, u.ID(), err)
+		return
+	}
+	log.Printf("📧 Emailed %s token to %s", purpose, u.ID())
+}
+
+// RequestPasswordReset handles POST "/api/auth/password-reset" with
+// {"email": "..."}, emailing the user with that email a token to reset
+// their password. It answers the same whether or not there is one.
+func (h *AuthHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	// Looked up after answering, so the time taken doesn't tell either.
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), accountMailTimeout)
+		defer cancel()
+
+		u, err := h.users.QueryByEmail(ctx, req.Email)
+		if errors.Is(err, user.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			log.Printf("Error looking up user: %v", err)
+			return
+		}
+		h.mail.sendToken(h.users, u, user.PurposePasswordReset)
+	}()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ConfirmPasswordReset handles POST "/api/auth/password-reset/confirm"
+// with {"token": "...", "password": "..."}, setting the password of the
+// user the token was emailed to. All their sessions are logged out, and
+// their account unlocked: whoever reads their email may sign in.
+func (h *AuthHandler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, err := h.users.ResetPassword(r.Context(), req.Token, req.Password)
+	switch {
+	case errors.Is(err, user.ErrInvalidToken), errors.Is(err, user.ErrWeakPassword):
+		respondWithError(w, http.StatusBadRequest, "Failed to reset password", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error resetting password: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to reset password", "")
+		return
+	}
+
+	if err := h.sessions.RevokeAll(r.Context(), u.ID(), ""); err != nil {
+		log.Printf("Error revoking sessions: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log out sessions", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindPasswordReset, UserID: u.ID(), Email: u.Email()})
+	if _, err := h.guard.Lockout.Unlock(r.Context(), lockout.AccountKey(u.Email())); err != nil && !errors.Is(err, lockout.ErrNotFound) {
+		log.Printf("Error unlocking account: %v", err)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RequestEmailVerification handles POST "/api/auth/verify-email", emailing
+// the authenticated user a token to verify their email.
+func (h *AuthHandler) RequestEmailVerification(w http.ResponseWriter, r *http.Request) {
+	u, err := h.users.QueryById(r.Context(), userID(r))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to send verification email", "")
+		return
+	}
+	if u.EmailVerified() {
+		respondWithError(w, http.StatusConflict, "Email already verified", "")
+		return
+	}
+
+	go h.mail.sendToken(h.users, u, user.PurposeEmailVerification)
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// ConfirmEmail handles POST "/api/auth/verify-email/confirm" with
+// {"token": "..."}, verifying the email the token was sent to.
+func (h *AuthHandler) ConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	_, err := h.users.VerifyEmail(r.Context(), req.Token)
+	if errors.Is(err, user.ErrInvalidToken) {
+		respondWithError(w, http.StatusBadRequest, "Failed to verify email", err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error verifying email: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to verify email", "")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ChangePassword handles POST "/api/auth/change-password" with
+// {"current_password": "...", "new_password": "..."}. The user's other
+// sessions are logged out; the request's stays.
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	claims, _ := UserFromContext(r.Context())
+
+	u, err := h.users.QueryById(r.Context(), claims.UserId)
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to change password", "")
+		return
+	}
+	// Users without a password set one with a password reset.
+	if !h.guard.checkPassword(w, r, u, req.CurrentPassword, "Failed to change password") {
+		return
+	}
+
+	if err := u.SetPassword(req.NewPassword); err != nil {
+		if errors.Is(err, user.ErrWeakPassword) {
+			respondWithError(w, http.StatusBadRequest, "Failed to change password", err.Error())
+			return
+		}
+		log.Printf("Error hashing password: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to change password", "")
+		return
+	}
+	if err := h.users.UpdatePassword(r.Context(), u); err != nil {
+		log.Printf("Error updating password: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to change password", "")
+		return
+	}
+
+	if err := h.sessions.RevokeAll(r.Context(), u.ID(), claims.SessionID); err != nil {
+		log.Printf("Error revoking sessions: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log out other sessions", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindPasswordChanged, UserID: u.ID(), Email: u.Email()})
+	w.WriteHeader(http.StatusNoContent)
+}
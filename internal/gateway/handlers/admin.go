@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/lockout"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+)
+
+// AdminHandler lets Taskboard's admins, users flagged is_admin, see who is
+// locked out and let them back in, and read the auth audit log. Only
+// admins who signed in with two-factor authentication may.
+type AdminHandler struct {
+	users *user.Store
+	guard LoginGuard
+}
+
+func NewAdminHandler(users *user.Store, guard LoginGuard) *AdminHandler {
+	return &AdminHandler{
+		users: users,
+		guard: guard,
+	}
+}
+
+type LockoutResponse struct {
+	// "account:<email>" or "ip:<address>".
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	FirstFailedAt time.Time  `json:"first_failed_at"`
+	LastFailedAt  time.Time  `json:"last_failed_at"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LockedUntil   *time.Time `json:"locked_until,omitempty"`
+}
+
+type ListLockoutsResponse struct {
+	Lockouts []LockoutResponse `json:"lockouts"`
+}
+
+func newLockoutResponse(e lockout.Entry) LockoutResponse {
+	resp := LockoutResponse{
+		Key:           e.Key,
+		Failures:      e.Failures,
+		FirstFailedAt: e.FirstFailedAt.UTC(),
+		LastFailedAt:  e.LastFailedAt.UTC(),
+		NextAttemptAt: e.NextAttemptAt.UTC(),
+	}
+	if !e.LockedUntil.IsZero() {
+		lockedUntil := e.LockedUntil.UTC()
+		resp.LockedUntil = &lockedUntil
+	}
+	return resp
+}
+
+// requireAdmin returns the authenticated user if they're an admin signed
+// in with a second factor, and otherwise responds so and returns false.
+func (h *AdminHandler) requireAdmin(w http.ResponseWriter, r *http.Request) (user.User, bool) {
+	u, err := h.users.QueryById(r.Context(), userID(r))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return user.User{}, false
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to check permissions", "")
+		return user.User{}, false
+	}
+	if !u.Admin() {
+		respondWithError(w, http.StatusForbidden, "Forbidden", "only admins may do this")
+		return user.User{}, false
+	}
+	if claims, _ := UserFromContext(r.Context()); !claims.MFA {
+		respondWithError(w, http.StatusForbidden, "Forbidden", "admins must sign in with two-factor authentication")
+		return user.User{}, false
+	}
+	return u, true
+}
+
+// ListLockouts handles GET "/api/admin/lockouts", returning the accounts
+// and addresses delayed or locked out now, most recently failed first.
+func (h *AdminHandler) ListLockouts(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.requireAdmin(w, r); !ok {
+		return
+	}
+
+	entries, err := h.guard.Lockout.ListBlocked(r.Context())
+	if err != nil {
+		log.Printf("Error listing lockouts: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to list lockouts", "")
+		return
+	}
+
+	resp := ListLockoutsResponse{Lockouts: make([]LockoutResponse, 0, len(entries))}
+	for _, e := range entries {
+		resp.Lockouts = append(resp.Lockouts, newLockoutResponse(e))
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// Unlock handles DELETE "/api/admin/lockouts/{key}", forgetting the failed
+// attempts of an account or address, as listed, ending any delay or
+// lockout.
+func (h *AdminHandler) Unlock(w http.ResponseWriter, r *http.Request) {
+	admin, ok := h.requireAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.PathValue("key")
+	e, err := h.guard.Lockout.Unlock(r.Context(), key)
+	if errors.Is(err, lockout.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, "Lockout not found", err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error unlocking %s: %v", key, err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to unlock", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindUnlocked, ActorID: admin.ID(), Detail: e.Key})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnlockUser handles POST "/api/admin/users/{id}/unlock", ending any delay
+// or lockout of a user's account. Unlocking an account that isn't locked
+// does nothing.
+func (h *AdminHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	admin, ok := h.requireAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	u, err := h.users.QueryById(r.Context(), r.PathValue("id"))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, "User not found", "")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to unlock user", "")
+		return
+	}
+
+	key := lockout.AccountKey(u.Email())
+	_, err = h.guard.Lockout.Unlock(r.Context(), key)
+	if errors.Is(err, lockout.ErrNotFound) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		log.Printf("Error unlocking %s: %v", key, err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to unlock user", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{
+		Kind:    audit.KindUnlocked,
+		UserID:  u.ID(),
+		Email:   u.Email(),
+		ActorID: admin.ID(),
+		Detail:  key,
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListAuthEvents handles GET "/api/admin/auth-events", returning sign-ins,
+// failed and blocked attempts, lockouts and account changes, newest first.
+// The "user_id", "email", "ip" and "kind" query parameters narrow them;
+// "limit" caps them, at 50 by default, and "before", the ID of the last
+// event of a page, pages back.
+func (h *AdminHandler) ListAuthEvents(w http.ResponseWriter, r *http.Request) {
+	if _, ok := h.requireAdmin(w, r); !ok {
+		return
+	}
+
+	filter, err := parseAuthEventFilter(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid query", err.Error())
+		return
+	}
+
+	events, err := h.guard.Events.List(r.Context(), filter)
+	if err != nil {
+		log.Printf("Error listing auth events: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to list auth events", "")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, newListAuthEventsResponse(events))
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/apitoken"
+)
+
+type CreateAPITokenRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+
+	// Omitted for tokens that never expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Scopes the token to a workspace. Without one, its requests choose
+	// theirs with X-Workspace-ID.
+	WorkspaceID int64 `json:"workspace_id,omitempty"`
+}
+
+type APITokenResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	Scopes      []string   `json:"scopes"`
+	WorkspaceID int64      `json:"workspace_id,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+
+	// The token itself, only in the response to creating it.
+	Token string `json:"token,omitempty"`
+}
+
+// optionalTime returns t in UTC, or nil if it's zero.
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+func newAPITokenResponse(t apitoken.Token) APITokenResponse {
+	return APITokenResponse{
+		ID:          t.ID,
+		Name:        t.Name,
+		Prefix:      t.Prefix,
+		Scopes:      t.Scopes,
+		WorkspaceID: t.WorkspaceID,
+		CreatedAt:   t.CreatedAt.UTC(),
+		LastUsedAt:  optionalTime(t.LastUsedAt),
+		ExpiresAt:   optionalTime(t.ExpiresAt),
+	}
+}
+
+// CreateAPIToken handles POST "/api/tokens" with
+// {"name": "...", "scopes": ["tasks:read", ...], "expires_at": "..."},
+// creating an API token for the authenticated user. The response is the
+// only time the token is shown.
+func (h *AuthHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req CreateAPITokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	if req.WorkspaceID < 0 {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", "")
+		return
+	}
+
+	claims, _ := UserFromContext(r.Context())
+	tok := apitoken.Token{
+		UserID:      claims.UserId,
+		Name:        req.Name,
+		Scopes:      req.Scopes,
+		WorkspaceID: req.WorkspaceID,
+	}
+	if req.ExpiresAt != nil {
+		tok.ExpiresAt = *req.ExpiresAt
+	}
+	if err := tok.Validate(time.Now()); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid API token", err.Error())
+		return
+	}
+
+	token, err := h.tokens.Create(r.Context(), &tok)
+	if err != nil {
+		log.Printf("Error creating API token: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to create API token", "")
+		return
+	}
+
+	resp := newAPITokenResponse(tok)
+	resp.Token = token
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+// ListAPITokens handles GET "/api/tokens", returning the authenticated
+// user's API tokens, without the tokens themselves.
+func (h *AuthHandler) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.tokens.List(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error listing API tokens: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to list API tokens", "")
+		return
+	}
+
+	resp := make([]APITokenResponse, 0, len(tokens))
+	for _, t := range tokens {
+		resp = append(resp, newAPITokenResponse(t))
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// RevokeAPIToken handles DELETE "/api/tokens/{id}", revoking one of the
+// authenticated user's API tokens.
+func (h *AuthHandler) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	err := h.tokens.Revoke(r.Context(), userID(r), r.PathValue("id"))
+	if errors.Is(err, apitoken.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, "API token not found", "")
+		return
+	}
+	if err != nil {
+		log.Printf("Error revoking API token: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to revoke API token", "")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
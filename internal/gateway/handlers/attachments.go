@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/sys/blob"
+	"github.com/zaouldyeck/taskboard/internal/gateway/grpcclient"
+)
+
+const (
+	// sniffLen is how many bytes http.DetectContentType looks at.
+	sniffLen = 512
+
+	// multipartOverhead is extra body allowance for multipart boundaries and headers.
+	multipartOverhead = 64 << 10
+
+	// transferTimeout replaces the server's read/write timeouts for uploads and downloads.
+	transferTimeout = 10 * time.Minute
+)
+
+// AttachmentHandler serves attachment uploads and downloads. Contents are
+// streamed to and from the blob store; metadata lives in the task service.
+type AttachmentHandler struct {
+	taskClient     *grpcclient.TaskClient
+	blobs          blob.Store
+	maxUploadBytes int64
+}
+
+type ListAttachmentsResponse struct {
+	Attachments []*pb.Attachment `json:"attachments"`
+}
+
+func NewAttachmentHandler(taskClient *grpcclient.TaskClient, blobs blob.Store,
+	maxUploadBytes int64,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		taskClient:     taskClient,
+		blobs:          blobs,
+		maxUploadBytes: maxUploadBytes,
+	}
+}
+
+// limitedReader fails once more than max bytes have been read.
+type limitedReader struct {
+	r   io.Reader
+	n   int64
+	max int64
+}
+
+var errFileTooLarge = errors.New("file too large")
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.max {
+		return n, errFileTooLarge
+	}
+	return n, err
+}
+
+// sniffContentType peeks at the start of r and picks a content type. The
+// sniffed type wins unless it is generic, in which case the declared type
+// or the file extension is used. The returned reader replays the peeked bytes.
+func sniffContentType(r io.Reader, declared, filename string) (string, io.Reader, error) {
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	buf = buf[:n]
+
+	contentType := http.DetectContentType(buf)
+	generic := contentType == "application/octet-stream" || strings.HasPrefix(contentType, "text/plain")
+	if generic {
+		if mt, _, err := mime.ParseMediaType(declared); err == nil && mt != "multipart/form-data" {
+			contentType = declared
+		} else if byExt := mime.TypeByExtension(filepath.Ext(filename)); byExt != "" {
+			contentType = byExt
+		}
+	}
+
+	return contentType, io.MultiReader(bytes.NewReader(buf), r), nil
+}
+
+// cleanFilename strips any client supplied directories and control characters.
+func cleanFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "." || name == "/" || name == "" {
+		return "upload"
+	}
+	return name
+}
+
+// Upload handles POST "/api/tasks/{id}/attachments".
+// Accepts either multipart/form-data with a "file" part, or a raw request
+// body with the name in the "filename" query parameter. Both are streamed
+// straight to the blob store.
+func (h *AttachmentHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	// Uploads may take longer than the server-wide timeouts.
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Now().Add(transferTimeout))
+	rc.SetWriteDeadline(time.Now().Add(transferTimeout))
+
+	if r.ContentLength > h.maxUploadBytes+multipartOverhead {
+		respondWithError(w, http.StatusRequestEntityTooLarge, "File too large",
+			fmt.Sprintf("max upload size is %d bytes", h.maxUploadBytes))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadBytes+multipartOverhead)
+
+	// Refuse callers who can't attach to the task before storing anything.
+	if _, err := h.taskClient.CreateAttachment(r.Context(), &pb.CreateAttachmentRequest{
+		TaskId:     taskID,
+		UploadedBy: userID(r),
+		DryRun:     true,
+	}); err != nil {
+		log.Printf("Error authorizing attachment: %v", err)
+		respondWithGRPCError(w, "Failed to create attachment", err)
+		return
+	}
+
+	var (
+		body     io.Reader
+		filename string
+		declared string
+		size     int64 = -1
+	)
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "multipart/form-data" {
+		mr, err := r.MultipartReader()
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid multipart body", err.Error())
+			return
+		}
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				respondWithError(w, http.StatusBadRequest, "Missing file part", `expected a form field named "file"`)
+				return
+			}
+			if err != nil {
+				respondWithError(w, http.StatusBadRequest, "Invalid multipart body", err.Error())
+				return
+			}
+			if part.FormName() == "file" {
+				body = part
+				filename = part.FileName()
+				declared = part.Header.Get("Content-Type")
+				break
+			}
+			part.Close()
+		}
+	} else {
+		body = r.Body
+		filename = r.URL.Query().Get("filename")
+		declared = r.Header.Get("Content-Type")
+		size = r.ContentLength
+		if filename == "" {
+			respondWithError(w, http.StatusBadRequest, "Filename is required", "pass ?filename= for raw uploads")
+			return
+		}
+	}
+	filename = cleanFilename(filename)
+
+	counted := &limitedReader{r: body, max: h.maxUploadBytes}
+	contentType, content, err := sniffContentType(counted, declared, filename)
+	if err != nil {
+		respondUploadError(w, err)
+		return
+	}
+
+	key := fmt.Sprintf("tasks/%d/%s", taskID, uuid.New().String())
+	if err := h.blobs.Put(r.Context(), key, content, size, contentType); err != nil {
+		log.Printf("Error storing attachment blob: %v", err)
+		respondUploadError(w, err)
+		return
+	}
+
+	attachment, err := h.taskClient.CreateAttachment(r.Context(), &pb.CreateAttachmentRequest{
+		TaskId:      taskID,
+		Filename:    filename,
+		ContentType: contentType,
+		SizeBytes:   counted.n,
+		StorageKey:  key,
+		UploadedBy:  userID(r),
+	})
+	if err != nil {
+		log.Printf("Error creating attachment: %v", err)
+
+		// Don't leave an unreferenced blob behind.
+		if delErr := h.blobs.Delete(context.WithoutCancel(r.Context()), key); delErr != nil {
+			log.Printf("Error removing orphaned blob %s: %v", key, delErr)
+		}
+		respondWithGRPCError(w, "Failed to create attachment", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, attachment)
+}
+
+// respondUploadError reports size limit violations as 413 and anything else as 500.
+func respondUploadError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.Is(err, errFileTooLarge) || errors.As(err, &maxBytesErr) {
+		respondWithError(w, http.StatusRequestEntityTooLarge, "File too large", err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, "Failed to store attachment", err.Error())
+}
+
+// List handles GET "/api/tasks/{id}/attachments".
+func (h *AttachmentHandler) List(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	attachments, err := h.taskClient.ListAttachments(r.Context(), taskID)
+	if err != nil {
+		log.Printf("Error listing attachments: %v", err)
+		respondWithGRPCError(w, "Failed to list attachments", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, ListAttachmentsResponse{Attachments: attachments})
+}
+
+// Download handles GET "/api/attachments/{id}".
+func (h *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid attachment ID", err.Error())
+		return
+	}
+
+	attachment, err := h.taskClient.GetAttachment(r.Context(), id)
+	if err != nil {
+		log.Printf("Error getting attachment: %v", err)
+		respondWithGRPCError(w, "Failed to get attachment", err)
+		return
+	}
+
+	content, err := h.blobs.Get(r.Context(), attachment.StorageKey)
+	if err != nil {
+		log.Printf("Error reading attachment blob: %v", err)
+		if errors.Is(err, blob.ErrNotFound) {
+			respondWithError(w, http.StatusNotFound, "Attachment contents not found", "")
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, "Failed to read attachment", err.Error())
+		return
+	}
+	defer content.Close()
+
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(transferTimeout))
+
+	// Always download, never render, so uploaded HTML can't run in our origin.
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content); err != nil {
+		log.Printf("Error streaming attachment %d: %v", id, err)
+	}
+}
+
+// Delete handles DELETE "/api/attachments/{id}". The task service removes the blob.
+func (h *AttachmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid attachment ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteAttachment(r.Context(), id); err != nil {
+		log.Printf("Error deleting attachment: %v", err)
+		respondWithGRPCError(w, "Failed to delete attachment", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,425 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/apitoken"
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/session"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+	"github.com/zaouldyeck/taskboard/business/sys/oidc"
+)
+
+// AuthHandler registers and logs in users, and manages their sessions:
+// short-lived access tokens, and the rotating refresh tokens that renew
+// them, and the API tokens they automate with. Users sign in with a
+// password, which they can reset by email, or through an identity
+// provider, then, if they enrolled an authenticator, with a code from it.
+// Password guessing is throttled, and sign-ins are logged, by a
+// LoginGuard.
+type AuthHandler struct {
+	users      *user.Store
+	sessions   *session.Store
+	tokens     *apitoken.Store
+	providers  map[string]*oidc.Provider
+	mail       AccountMail
+	guard      LoginGuard
+	mfa        SecondFactor
+	auth       *auth.Auth
+	tokenTTL   time.Duration
+	refreshTTL time.Duration
+}
+
+func NewAuthHandler(users *user.Store, sessions *session.Store, tokens *apitoken.Store, providers []*oidc.Provider,
+	mail AccountMail, guard LoginGuard, factors SecondFactor, auth *auth.Auth, tokenTTL, refreshTTL time.Duration,
+) *AuthHandler {
+	byName := make(map[string]*oidc.Provider, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+	return &AuthHandler{
+		users:      users,
+		sessions:   sessions,
+		tokens:     tokens,
+		providers:  byName,
+		mail:       mail,
+		guard:      guard,
+		mfa:        factors,
+		auth:       auth,
+		tokenTTL:   tokenTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// JWKSMaxAge is how long clients may cache the JWKS.
+const JWKSMaxAge = 5 * time.Minute
+
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+
+	// Scopes the token to a workspace. Without one, requests choose theirs
+	// with X-Workspace-ID.
+	WorkspaceID int64 `json:"workspace_id,omitempty"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+
+	// As for LoginRequest.
+	WorkspaceID int64 `json:"workspace_id,omitempty"`
+}
+
+type UserResponse struct {
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Username      string `json:"username"`
+	DisplayName   string `json:"display_name"`
+	TimeZone      string `json:"time_zone"`
+
+	// "" without an avatar.
+	AvatarURL string `json:"avatar_url"`
+}
+
+type TokenResponse struct {
+	Token            string       `json:"token"`
+	TokenType        string       `json:"token_type"`
+	ExpiresAt        time.Time    `json:"expires_at"`
+	RefreshToken     string       `json:"refresh_token"`
+	RefreshExpiresAt time.Time    `json:"refresh_expires_at"`
+	User             UserResponse `json:"user"`
+}
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+
+	// Whether the session signed in with a second factor.
+	MFA bool `json:"mfa"`
+
+	// Whether the request was made in this session.
+	Current bool `json:"current"`
+}
+
+func newUserResponse(u user.User) UserResponse {
+	return UserResponse{
+		ID:            u.ID(),
+		Email:         u.Email(),
+		EmailVerified: u.EmailVerified(),
+		Username:      u.Username(),
+		DisplayName:   u.DisplayName(),
+		TimeZone:      u.TimeZone(),
+		AvatarURL:     avatarURL(u),
+	}
+}
+
+// newSessionResponse describes s, which is current if it is the session
+// with ID current.
+func newSessionResponse(s session.Session, current string) SessionResponse {
+	return SessionResponse{
+		ID:         s.ID,
+		UserAgent:  s.UserAgent,
+		IP:         s.IP,
+		CreatedAt:  s.CreatedAt.UTC(),
+		LastUsedAt: s.LastUsedAt.UTC(),
+		ExpiresAt:  s.ExpiresAt.UTC(),
+		MFA:        s.MFA,
+		Current:    s.ID == current,
+	}
+}
+
+type userKey struct{}
+
+// WithUser returns a context for a request authenticated as the user the
+// token claims describe.
+func WithUser(ctx context.Context, claims *auth.TokenClaims) context.Context {
+	return context.WithValue(ctx, userKey{}, claims)
+}
+
+// UserFromContext returns the claims of the authenticated user, if any.
+func UserFromContext(ctx context.Context) (*auth.TokenClaims, bool) {
+	claims, ok := ctx.Value(userKey{}).(*auth.TokenClaims)
+	return claims, ok
+}
+
+// userID returns the ID of the user a request is authenticated as, or ""
+// on public routes.
+func userID(r *http.Request) string {
+	if claims, ok := UserFromContext(r.Context()); ok {
+		return claims.UserId
+	}
+	return ""
+}
+
+// remoteIP returns the IP address a request came from.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// startSession starts a session for u on the device making the request,
+// and responds with its tokens. Sessions remember whether u signed in with
+// a second factor.
+func (h *AuthHandler) startSession(w http.ResponseWriter, r *http.Request, code int, u user.User, workspaceID int64, mfa bool) {
+	sess := session.Session{
+		UserID:    u.ID(),
+		UserAgent: r.UserAgent(),
+		IP:        remoteIP(r),
+		MFA:       mfa,
+	}
+	refreshToken, err := h.sessions.Create(r.Context(), &sess, h.refreshTTL)
+	if err != nil {
+		log.Printf("Error creating session: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to start session", "")
+		return
+	}
+
+	h.issueTokens(w, code, u, sess, refreshToken, workspaceID)
+}
+
+// issueTokens responds with a new access token for u in sess, and the
+// session's refresh token.
+func (h *AuthHandler) issueTokens(w http.ResponseWriter, code int, u user.User, sess session.Session, refreshToken string, workspaceID int64) {
+	expiresAt := time.Now().Add(h.tokenTTL)
+	token, err := h.auth.GenerateToken(u.ID(), u.Email(), sess.ID, workspaceID, sess.MFA, h.tokenTTL)
+	if err != nil {
+		log.Printf("Error generating token: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to issue token", "")
+		return
+	}
+
+	respondWithJSON(w, code, TokenResponse{
+		Token:            token,
+		TokenType:        "Bearer",
+		ExpiresAt:        expiresAt.UTC(),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: sess.ExpiresAt.UTC(),
+		User:             newUserResponse(u),
+	})
+}
+
+// Register handles POST "/api/auth/register" with
+// {"email": "...", "username": "...", "password": "..."}, logs the new
+// user in, and emails them a token to verify their email.
+func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, err := user.New(req.Email, req.Username, req.Password)
+	switch {
+	case errors.Is(err, user.ErrInvalidEmail), errors.Is(err, user.ErrEmptyUsername), errors.Is(err, user.ErrWeakPassword):
+		respondWithError(w, http.StatusBadRequest, "Invalid registration", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error creating user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to register", "")
+		return
+	}
+
+	if err := h.users.Create(r.Context(), u); err != nil {
+		if errors.Is(err, user.ErrEmailTaken) || errors.Is(err, user.ErrUsernameTaken) {
+			respondWithError(w, http.StatusConflict, "Failed to register", err.Error())
+			return
+		}
+		log.Printf("Error storing user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to register", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindRegistered, UserID: u.ID(), Email: u.Email()})
+	go h.mail.sendToken(h.users, u, user.PurposeEmailVerification)
+	h.startSession(w, r, http.StatusCreated, u, 0, false)
+}
+
+// Login handles POST "/api/auth/login" with
+// {"email": "...", "password": "..."}. After a few failures, on the account
+// or from the address, each attempt must wait longer than the last, and
+// after too many they're locked out for a while: those attempts get a 429
+// with Retry-After. Users with two-factor authentication on get an
+// MFAChallengeResponse rather than tokens, to finish with CompleteLogin.
+func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	if req.WorkspaceID < 0 {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", "")
+		return
+	}
+
+	keys, locked, ok := h.guard.attempt(w, r, req.Email, "Failed to log in")
+	if !ok {
+		return
+	}
+
+	u, err := h.users.QueryByEmail(r.Context(), req.Email)
+	if err != nil && !errors.Is(err, user.ErrNotFound) {
+		log.Printf("Error looking up user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+	// Unknown emails and wrong passwords look the same, and take as long:
+	// the zero User's Authenticate hashes the password all the same.
+	if !u.Authenticate(req.Password) {
+		detail := "wrong password"
+		if err != nil {
+			detail = "unknown email"
+		}
+		h.guard.recordFailure(r, u.ID(), req.Email, detail, locked)
+		respondWithError(w, http.StatusUnauthorized, "Failed to log in", user.ErrInvalidCredentials.Error())
+		return
+	}
+
+	h.guard.succeeded(r, keys)
+	h.signIn(w, r, u, req.WorkspaceID, audit.Event{Kind: audit.KindLoginSucceeded, UserID: u.ID(), Email: u.Email()})
+}
+
+// Refresh handles POST "/api/auth/refresh" with {"refresh_token": "..."},
+// exchanging a refresh token for a new access token and a new refresh
+// token. Each refresh token works once: presenting one again logs its
+// session out.
+func (h *AuthHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	if req.WorkspaceID < 0 {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", "")
+		return
+	}
+
+	sess, refreshToken, err := h.sessions.Refresh(r.Context(), req.RefreshToken, h.refreshTTL)
+	switch {
+	case errors.Is(err, session.ErrInvalidToken), errors.Is(err, session.ErrTokenReused):
+		respondWithError(w, http.StatusUnauthorized, "Failed to refresh", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error refreshing session: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to refresh", "")
+		return
+	}
+
+	u, err := h.users.QueryById(r.Context(), sess.UserID)
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "Failed to refresh", "the session's user no longer exists")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to refresh", "")
+		return
+	}
+
+	h.issueTokens(w, http.StatusOK, u, sess, refreshToken, req.WorkspaceID)
+}
+
+// Logout handles POST "/api/auth/logout", revoking the request's access
+// token and ending its session.
+func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	claims, _ := UserFromContext(r.Context())
+
+	if err := h.sessions.RevokeToken(r.Context(), claims.ID, claims.ExpiresAt.Time); err != nil {
+		log.Printf("Error revoking token: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log out", "")
+		return
+	}
+	if claims.SessionID != "" {
+		err := h.sessions.Revoke(r.Context(), claims.UserId, claims.SessionID)
+		if err != nil && !errors.Is(err, session.ErrNotFound) {
+			log.Printf("Error revoking session: %v", err)
+			respondWithError(w, http.StatusInternalServerError, "Failed to log out", "")
+			return
+		}
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindLogout, UserID: claims.UserId, Email: claims.Email})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListSessions handles GET "/api/sessions", returning the authenticated
+// user's active sessions.
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	claims, _ := UserFromContext(r.Context())
+
+	sessions, err := h.sessions.List(r.Context(), claims.UserId)
+	if err != nil {
+		log.Printf("Error listing sessions: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to list sessions", "")
+		return
+	}
+
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, newSessionResponse(s, claims.SessionID))
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// RevokeSession handles DELETE "/api/sessions/{id}", logging one of the
+// authenticated user's sessions out. Its access tokens stop working at
+// once.
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	err := h.sessions.Revoke(r.Context(), userID(r), r.PathValue("id"))
+	if errors.Is(err, session.ErrNotFound) {
+		respondWithError(w, http.StatusNotFound, "Session not found", "")
+		return
+	}
+	if err != nil {
+		log.Printf("Error revoking session: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to revoke session", "")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// JWKS handles GET "/.well-known/jwks.json", returning the public keys
+// access tokens are verified with. Keys are published before they sign
+// anything, well ahead of the cache expiring.
+func (h *AuthHandler) JWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(JWKSMaxAge.Seconds())))
+	respondWithJSON(w, http.StatusOK, h.auth.JWKS())
+}
+
+// Me handles GET "/api/me", returning the authenticated user.
+func (h *AuthHandler) Me(w http.ResponseWriter, r *http.Request) {
+	u, err := h.users.QueryById(r.Context(), userID(r))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to get user", "")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, newUserResponse(u))
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strings"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// calendarPath is where a feed is served, followed by its token and ".ics".
+const calendarPath = "/api/calendar/"
+
+// ListCalendarFeeds handles GET "/api/calendar/feeds".
+func (h *TaskHandler) ListCalendarFeeds(w http.ResponseWriter, r *http.Request) {
+	feeds, err := h.taskClient.ListCalendarFeeds(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error listing calendar feeds: %v", err)
+		respondWithGRPCError(w, "Failed to list calendar feeds", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListCalendarFeedsResponse{Feeds: feeds})
+}
+
+// CreateCalendarFeed handles POST "/api/calendar/feeds". The response
+// holds the feed's token, which can't be retrieved later, and its URL is
+// in the Location header.
+func (h *TaskHandler) CreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateCalendarFeedRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.OwnerId = userID(r)
+
+	resp, err := h.taskClient.CreateCalendarFeed(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating calendar feed: %v", err)
+		respondWithGRPCError(w, "Failed to create calendar feed", err)
+		return
+	}
+
+	w.Header().Set("Location", calendarPath+resp.Token+".ics")
+	respondWithProto(w, http.StatusCreated, resp)
+}
+
+// DeleteCalendarFeed handles DELETE "/api/calendar/feeds/{id}".
+// The feed's URL stops working at once.
+func (h *TaskHandler) DeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid feed ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteCalendarFeed(r.Context(), id, userID(r)); err != nil {
+		log.Printf("Error deleting calendar feed: %v", err)
+		respondWithGRPCError(w, "Failed to delete calendar feed", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetCalendar handles GET "/api/calendar/{token}.ics". The token in the
+// URL is the only credential, as calendar apps can't send others.
+func (h *TaskHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	token, ok := strings.CutSuffix(r.PathValue("file"), ".ics")
+	if !ok || token == "" {
+		respondWithError(w, http.StatusNotFound, "Calendar not found", "calendar URLs end in .ics")
+		return
+	}
+
+	resp, err := h.taskClient.GetCalendar(r.Context(), token, r.Header.Get("If-None-Match"))
+	if err != nil {
+		log.Printf("Error getting calendar: %v", err)
+		respondWithGRPCError(w, "Failed to get calendar", err)
+		return
+	}
+
+	w.Header().Set("ETag", resp.Etag)
+	// Clients must revalidate, so changes and revocations show up on the
+	// next poll; the token must not end up in shared caches.
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if resp.NotModified {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="taskboard.ics"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp.Data)
+}
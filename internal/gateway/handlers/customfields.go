@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// CustomFieldValue is the REST form of a task's custom field value. Value
+// is a string, a number, a list of strings for multi select fields, or
+// null to clear the field on update.
+type CustomFieldValue struct {
+	FieldID   int64           `json:"field_id"`
+	FieldName string          `json:"field_name,omitempty"`
+	FieldType string          `json:"field_type,omitempty"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// taskResponse renders a task with its custom fields in REST form, and the
+// users it names. The outer fields take precedence over the embedded proto
+// fields.
+type taskResponse struct {
+	*pb.Task
+	CustomFields []CustomFieldValue `json:"custom_fields,omitempty"`
+	Creator      *TaskUser          `json:"creator"`
+	Assignees    []TaskUser         `json:"assignees"`
+}
+
+// TaskUser names a user a task refers to: its creator or an assignee.
+type TaskUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// fieldTypeName turns CUSTOM_FIELD_TYPE_SINGLE_SELECT into "single_select".
+func fieldTypeName(t pb.CustomFieldType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "CUSTOM_FIELD_TYPE_"))
+}
+
+func newTaskResponse(task *pb.Task) taskResponse {
+	resp := taskResponse{Task: task, Assignees: []TaskUser{}}
+	if c := task.GetCreator(); c != nil {
+		resp.Creator = &TaskUser{ID: c.Id, Username: c.Username}
+	}
+	for _, a := range task.GetAssignees() {
+		resp.Assignees = append(resp.Assignees, TaskUser{ID: a.Id, Username: a.Username})
+	}
+	for _, v := range task.GetCustomFields() {
+		var value any
+		switch val := v.Value.(type) {
+		case *pb.CustomFieldValue_Text:
+			value = val.Text
+		case *pb.CustomFieldValue_Number:
+			value = val.Number
+		case *pb.CustomFieldValue_Date:
+			value = val.Date
+		case *pb.CustomFieldValue_Options:
+			value = val.Options.GetValues()
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			log.Printf("Error encoding custom field %d: %v", v.FieldId, err)
+			continue
+		}
+		resp.CustomFields = append(resp.CustomFields, CustomFieldValue{
+			FieldID:   v.FieldId,
+			FieldName: v.FieldName,
+			FieldType: fieldTypeName(v.FieldType),
+			Value:     raw,
+		})
+	}
+	return resp
+}
+
+// fieldValuesToProto converts REST values. The task service checks them
+// against the field types.
+func fieldValuesToProto(values []CustomFieldValue) ([]*pb.CustomFieldValue, error) {
+	pbValues := make([]*pb.CustomFieldValue, 0, len(values))
+	for _, v := range values {
+		if v.FieldID == 0 {
+			return nil, fmt.Errorf("custom field values need a field_id")
+		}
+		pbValue := &pb.CustomFieldValue{FieldId: v.FieldID}
+
+		var value any
+		if err := json.Unmarshal(v.Value, &value); err != nil && len(v.Value) > 0 {
+			return nil, fmt.Errorf("field %d: %w", v.FieldID, err)
+		}
+		switch val := value.(type) {
+		case nil:
+			// Clears the field.
+		case string:
+			pbValue.Value = &pb.CustomFieldValue_Text{Text: val}
+		case float64:
+			pbValue.Value = &pb.CustomFieldValue_Number{Number: val}
+		case []any:
+			opts := make([]string, len(val))
+			for i, o := range val {
+				s, ok := o.(string)
+				if !ok {
+					return nil, fmt.Errorf("field %d: options must be strings", v.FieldID)
+				}
+				opts[i] = s
+			}
+			pbValue.Value = &pb.CustomFieldValue_Options{Options: &pb.StringList{Values: opts}}
+		default:
+			return nil, fmt.Errorf("field %d: unsupported value", v.FieldID)
+		}
+		pbValues = append(pbValues, pbValue)
+	}
+	return pbValues, nil
+}
+
+var filterOpNames = map[string]pb.CustomFieldFilter_Op{
+	"eq":       pb.CustomFieldFilter_OP_EQ,
+	"ne":       pb.CustomFieldFilter_OP_NE,
+	"lt":       pb.CustomFieldFilter_OP_LT,
+	"lte":      pb.CustomFieldFilter_OP_LTE,
+	"gt":       pb.CustomFieldFilter_OP_GT,
+	"gte":      pb.CustomFieldFilter_OP_GTE,
+	"contains": pb.CustomFieldFilter_OP_CONTAINS,
+	"set":      pb.CustomFieldFilter_OP_SET,
+	"unset":    pb.CustomFieldFilter_OP_UNSET,
+}
+
+// parseFieldQuery reads custom field filters from the query:
+//
+//	cf.<id>=<value>        equal to value
+//	cf.<id>=<op>:<value>   op is eq, ne, lt, lte, gt, gte or contains
+//	cf.<id>=set|unset      has a value or not
+func parseFieldQuery(r *http.Request, req *pb.ListTasksRequest) error {
+	query := r.URL.Query()
+
+	for key, values := range query {
+		idStr, ok := strings.CutPrefix(key, "cf.")
+		if !ok {
+			continue
+		}
+		fieldID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid custom field %q", key)
+		}
+		for _, v := range values {
+			filter := &pb.CustomFieldFilter{FieldId: fieldID, Op: pb.CustomFieldFilter_OP_EQ, Value: v}
+			if op, ok := filterOpNames[v]; ok && (op == pb.CustomFieldFilter_OP_SET || op == pb.CustomFieldFilter_OP_UNSET) {
+				filter.Op, filter.Value = op, ""
+			} else if name, rest, found := strings.Cut(v, ":"); found {
+				if op, ok := filterOpNames[name]; ok {
+					filter.Op, filter.Value = op, rest
+				}
+			}
+			req.CustomFieldFilters = append(req.CustomFieldFilters, filter)
+		}
+	}
+
+	return nil
+}
+
+// CreateCustomField handles POST "/api/boards/{id}/custom-fields".
+func (h *TaskHandler) CreateCustomField(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	var req pb.CreateCustomFieldRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.BoardId = boardID
+
+	field, err := h.taskClient.CreateCustomField(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating custom field: %v", err)
+		respondWithGRPCError(w, "Failed to create custom field", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, field)
+}
+
+// ListCustomFields handles GET "/api/boards/{id}/custom-fields".
+func (h *TaskHandler) ListCustomFields(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	fields, err := h.taskClient.ListCustomFields(r.Context(), boardID)
+	if err != nil {
+		log.Printf("Error listing custom fields: %v", err)
+		respondWithGRPCError(w, "Failed to list custom fields", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListCustomFieldsResponse{Fields: fields})
+}
+
+// UpdateCustomField handles PUT "/api/custom-fields/{id}".
+func (h *TaskHandler) UpdateCustomField(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid custom field ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateCustomFieldRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.Id = id
+
+	field, err := h.taskClient.UpdateCustomField(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating custom field: %v", err)
+		respondWithGRPCError(w, "Failed to update custom field", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, field)
+}
+
+// DeleteCustomField handles DELETE "/api/custom-fields/{id}".
+func (h *TaskHandler) DeleteCustomField(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid custom field ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteCustomField(r.Context(), id); err != nil {
+		log.Printf("Error deleting custom field: %v", err)
+		respondWithGRPCError(w, "Failed to delete custom field", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/lockout"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+)
+
+// LoginGuard slows password guessing down and locks accounts and addresses
+// out after too many failures, and logs authentication events.
+type LoginGuard struct {
+	Lockout *lockout.Store
+	Events  *audit.Store
+}
+
+type AuthEventResponse struct {
+	ID        int64     `json:"id"`
+	Kind      string    `json:"kind"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	ActorID   string    `json:"actor_id"`
+	Detail    string    `json:"detail"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ListAuthEventsResponse struct {
+	Events []AuthEventResponse `json:"events"`
+}
+
+func newListAuthEventsResponse(events []audit.Event) ListAuthEventsResponse {
+	resp := ListAuthEventsResponse{Events: make([]AuthEventResponse, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, AuthEventResponse{
+			ID:        e.ID,
+			Kind:      e.Kind,
+			UserID:    e.UserID,
+			Email:     e.Email,
+			IP:        e.IP,
+			UserAgent: e.UserAgent,
+			ActorID:   e.ActorID,
+			Detail:    e.Detail,
+			CreatedAt: e.CreatedAt.UTC(),
+		})
+	}
+	return resp
+}
+
+// parseAuthEventFilter parses the query parameters listing auth events:
+// "user_id", "email", "ip" and "kind" narrow them, and "before", the ID of
+// the last event of the previous page, and "limit" page through them.
+func parseAuthEventFilter(r *http.Request) (audit.Filter, error) {
+	q := r.URL.Query()
+	filter := audit.Filter{
+		UserID: q.Get("user_id"),
+		Email:  q.Get("email"),
+		IP:     q.Get("ip"),
+		Kind:   q.Get("kind"),
+	}
+	if v := q.Get("before"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || id <= 0 {
+			return audit.Filter{}, fmt.Errorf("invalid before %q", v)
+		}
+		filter.BeforeID = id
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 || limit > audit.MaxLimit {
+			return audit.Filter{}, fmt.Errorf("limit must be between 1 and %d", audit.MaxLimit)
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
+
+// record logs an event of a request. Failing to is logged, but doesn't
+// fail the request.
+func (g LoginGuard) record(r *http.Request, e audit.Event) {
+	e.IP = remoteIP(r)
+	e.UserAgent = r.UserAgent()
+	if err := g.Events.Record(context.WithoutCancel(r.Context()), &e); err != nil {
+		log.Printf("Error recording %s event: %v", e.Kind, err)
+	}
+}
+
+// recordFailure logs a failed password attempt on an account, and the
+// lockouts it caused.
+func (g LoginGuard) recordFailure(r *http.Request, userID, email, detail string, locked []string) {
+	g.record(r, audit.Event{Kind: audit.KindLoginFailed, UserID: userID, Email: email, Detail: detail})
+	for _, key := range locked {
+		g.record(r, audit.Event{Kind: audit.KindLocked, UserID: userID, Email: email, Detail: key})
+	}
+}
+
+// attempt starts a password attempt on the account with an email, from the
+// request's address. If they're delayed or locked out, it responds so and
+// returns false.
+func (g LoginGuard) attempt(w http.ResponseWriter, r *http.Request, email, message string) ([]string, []string, bool) {
+	keys := []string{lockout.AccountKey(email), lockout.IPKey(remoteIP(r))}
+	locked, err := g.Lockout.Attempt(r.Context(), keys...)
+
+	var blocked *lockout.BlockedError
+	if errors.As(err, &blocked) {
+		g.record(r, audit.Event{Kind: audit.KindLoginBlocked, Email: email, Detail: blocked.Error()})
+		retryAfter := math.Ceil(time.Until(blocked.Until).Seconds())
+		w.Header().Set("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+		respondWithError(w, http.StatusTooManyRequests, message, blocked.Error())
+		return nil, nil, false
+	}
+	if err != nil {
+		log.Printf("Error starting password attempt: %v", err)
+		respondWithError(w, http.StatusInternalServerError, message, "")
+		return nil, nil, false
+	}
+	return keys, locked, true
+}
+
+// succeeded records that an attempt on keys succeeded. Failing to only
+// leaves the attempt counted as failed, so it is just logged.
+func (g LoginGuard) succeeded(r *http.Request, keys []string) {
+	if err := g.Lockout.Succeeded(context.WithoutCancel(r.Context()), keys...); err != nil {
+		log.Printf("Error recording successful password attempt: %v", err)
+	}
+}
+
+// checkPassword checks the password of a logged-in user confirming a
+// change, counting failures like logins', since a stolen access token
+// shouldn't allow guessing it either. It responds and returns false unless
+// the password is right.
+func (g LoginGuard) checkPassword(w http.ResponseWriter, r *http.Request, u user.User, password, message string) bool {
+	keys, locked, ok := g.attempt(w, r, u.Email(), message)
+	if !ok {
+		return false
+	}
+	if !u.Authenticate(password) {
+		g.recordFailure(r, u.ID(), u.Email(), "wrong password confirming "+r.Method+" "+r.URL.Path, locked)
+		respondWithError(w, http.StatusForbidden, message, "current password is incorrect")
+		return false
+	}
+	g.succeeded(r, keys)
+	return true
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// ListInboxNotifications handles
+// GET "/api/inbox?unread=true&page_size=...&page=...".
+func (h *TaskHandler) ListInboxNotifications(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ListInboxNotificationsRequest{
+		UserId:     userID(r),
+		PageSize:   parseInt32Query(r, "page_size", 50),
+		PageNumber: parseInt32Query(r, "page", 1),
+	}
+	if unread := parseBoolQuery(r, "unread"); unread != nil {
+		req.UnreadOnly = *unread
+	}
+
+	resp, err := h.taskClient.ListInboxNotifications(r.Context(), req)
+	if err != nil {
+		log.Printf("Error listing inbox notifications: %v", err)
+		respondWithGRPCError(w, "Failed to list notifications", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
+
+// GetInboxUnreadCount handles GET "/api/inbox/unread-count".
+func (h *TaskHandler) GetInboxUnreadCount(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.taskClient.GetInboxUnreadCount(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error counting unread inbox notifications: %v", err)
+		respondWithGRPCError(w, "Failed to count unread notifications", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
+
+// MarkInboxNotificationRead handles POST "/api/inbox/{id}/read".
+func (h *TaskHandler) MarkInboxNotificationRead(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid notification ID", err.Error())
+		return
+	}
+
+	resp, err := h.taskClient.MarkInboxNotificationRead(r.Context(), id, userID(r))
+	if err != nil {
+		log.Printf("Error marking inbox notification read: %v", err)
+		respondWithGRPCError(w, "Failed to mark notification read", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
+
+// MarkAllInboxNotificationsRead handles POST "/api/inbox/read-all".
+func (h *TaskHandler) MarkAllInboxNotificationsRead(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.taskClient.MarkAllInboxNotificationsRead(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error marking inbox notifications read: %v", err)
+		respondWithGRPCError(w, "Failed to mark notifications read", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
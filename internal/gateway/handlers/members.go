@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// ListBoardMembers handles GET "/api/boards/{id}/members".
+func (h *TaskHandler) ListBoardMembers(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	members, err := h.taskClient.ListBoardMembers(r.Context(), boardID)
+	if err != nil {
+		log.Printf("Error listing board members: %v", err)
+		respondWithGRPCError(w, "Failed to list board members", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListBoardMembersResponse{Members: members})
+}
+
+// AddBoardMember handles POST "/api/boards/{id}/members" with
+// {"user_id": "...", "role": "BOARD_ROLE_MEMBER"}.
+func (h *TaskHandler) AddBoardMember(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	var req pb.AddBoardMemberRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.BoardId = boardID
+
+	member, err := h.taskClient.AddBoardMember(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error adding board member: %v", err)
+		respondWithGRPCError(w, "Failed to add board member", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, member)
+}
+
+// UpdateBoardMember handles PUT "/api/boards/{id}/members/{user_id}" with
+// {"role": "BOARD_ROLE_ADMIN"}.
+func (h *TaskHandler) UpdateBoardMember(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateBoardMemberRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.BoardId = boardID
+	req.UserId = r.PathValue("user_id")
+
+	member, err := h.taskClient.UpdateBoardMember(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating board member: %v", err)
+		respondWithGRPCError(w, "Failed to update board member", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, member)
+}
+
+// RemoveBoardMember handles DELETE "/api/boards/{id}/members/{user_id}".
+func (h *TaskHandler) RemoveBoardMember(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.RemoveBoardMember(r.Context(), boardID, r.PathValue("user_id")); err != nil {
+		log.Printf("Error removing board member: %v", err)
+		respondWithGRPCError(w, "Failed to remove board member", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
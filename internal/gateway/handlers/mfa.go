@@ -0,0 +1,396 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/mfa"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/totp"
+)
+
+// SecondFactor configures two-factor authentication: users' TOTP
+// authenticators and recovery codes, and the challenges that finish their
+// logins.
+type SecondFactor struct {
+	Store *mfa.Store
+
+	// Issuer names Taskboard in users' authenticator apps.
+	Issuer string
+}
+
+// MFAChallengeResponse answers a login with a correct password by a user
+// with two-factor authentication on. They finish it with CompleteLogin.
+type MFAChallengeResponse struct {
+	MFARequired bool      `json:"mfa_required"`
+	MFAToken    string    `json:"mfa_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type CompleteLoginRequest struct {
+	MFAToken string `json:"mfa_token"`
+
+	// From the authenticator, or a recovery code.
+	Code string `json:"code"`
+}
+
+type MFAStatusResponse struct {
+	Enabled           bool       `json:"enabled"`
+	EnabledAt         *time.Time `json:"enabled_at,omitempty"`
+	RecoveryCodesLeft int        `json:"recovery_codes_left"`
+
+	// Whether the request's session signed in with a second factor.
+	SessionMFA bool `json:"session_mfa"`
+}
+
+type EnrollTOTPRequest struct {
+	// Required of users with a password.
+	Password string `json:"password"`
+}
+
+// EnrollTOTPResponse is the secret to add to an authenticator app, as is
+// or by scanning a QR code of the provisioning URI.
+type EnrollTOTPResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code"`
+}
+
+type DisableMFARequest struct {
+	// Required of users with a password.
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+// RecoveryCodesResponse holds recovery codes. They are shown once, and
+// only their hashes are kept.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// signIn finishes signing u in once they proved who they are, recording
+// e: it starts a session, or, if they have two-factor authentication on,
+// challenges them for a code first.
+func (h *AuthHandler) signIn(w http.ResponseWriter, r *http.Request, u user.User, workspaceID int64, e audit.Event) {
+	status, err := h.mfa.Store.Status(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error getting MFA status: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+	if !status.Enabled {
+		h.guard.record(r, e)
+		h.startSession(w, r, http.StatusOK, u, workspaceID, false)
+		return
+	}
+
+	token, ch, err := h.mfa.Store.CreateChallenge(r.Context(), u.ID(), workspaceID)
+	if err != nil {
+		log.Printf("Error creating MFA challenge: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+
+	e.Kind, e.Detail = audit.KindMFAChallenged, e.Kind
+	h.guard.record(r, e)
+	respondWithJSON(w, http.StatusOK, MFAChallengeResponse{
+		MFARequired: true,
+		MFAToken:    token,
+		ExpiresAt:   ch.ExpiresAt.UTC(),
+	})
+}
+
+// CompleteLogin handles POST "/api/auth/login/mfa" with
+// {"mfa_token": "...", "code": "..."}, finishing a login challenged for a
+// second factor. The code is from the user's authenticator, or one of
+// their recovery codes, which then stops working. Wrong codes count
+// towards lockouts like wrong passwords, and leave the challenge to try
+// again until it expires.
+func (h *AuthHandler) CompleteLogin(w http.ResponseWriter, r *http.Request) {
+	var req CompleteLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	ch, err := h.mfa.Store.Challenge(r.Context(), req.MFAToken)
+	if errors.Is(err, mfa.ErrInvalidChallenge) {
+		respondWithError(w, http.StatusUnauthorized, "Failed to log in", err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting MFA challenge: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+	u, err := h.users.QueryById(r.Context(), ch.UserID)
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "Failed to log in", mfa.ErrInvalidChallenge.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+
+	keys, locked, ok := h.guard.attempt(w, r, u.Email(), "Failed to log in")
+	if !ok {
+		return
+	}
+	ch, v, err := h.mfa.Store.CompleteChallenge(r.Context(), req.MFAToken, req.Code)
+	switch {
+	case errors.Is(err, mfa.ErrInvalidCode):
+		h.recordCodeFailure(r, u, locked)
+		respondWithError(w, http.StatusUnauthorized, "Failed to log in", err.Error())
+		return
+	case errors.Is(err, mfa.ErrInvalidChallenge):
+		respondWithError(w, http.StatusUnauthorized, "Failed to log in", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error completing MFA challenge: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log in", "")
+		return
+	}
+
+	h.guard.succeeded(r, keys)
+	h.recordVerification(r, u, v)
+	h.guard.record(r, audit.Event{Kind: audit.KindLoginSucceeded, UserID: u.ID(), Email: u.Email(), Detail: "mfa"})
+	h.startSession(w, r, http.StatusOK, u, ch.WorkspaceID, true)
+}
+
+// recordCodeFailure logs a wrong code from u, and the lockouts it caused.
+func (h *AuthHandler) recordCodeFailure(r *http.Request, u user.User, locked []string) {
+	h.guard.record(r, audit.Event{Kind: audit.KindMFAFailed, UserID: u.ID(), Email: u.Email()})
+	for _, key := range locked {
+		h.guard.record(r, audit.Event{Kind: audit.KindLocked, UserID: u.ID(), Email: u.Email(), Detail: key})
+	}
+}
+
+// recordVerification logs u using up a recovery code, if they did.
+func (h *AuthHandler) recordVerification(r *http.Request, u user.User, v mfa.Verification) {
+	if v.Recovery {
+		h.guard.record(r, audit.Event{
+			Kind:   audit.KindRecoveryCodeUsed,
+			UserID: u.ID(),
+			Email:  u.Email(),
+			Detail: fmt.Sprintf("%d left", v.RecoveryCodesLeft),
+		})
+	}
+}
+
+// checkCode checks a code from a logged-in user confirming a change to
+// their second factor, counting failures like logins'. It responds and
+// returns false unless the code is right.
+func (h *AuthHandler) checkCode(w http.ResponseWriter, r *http.Request, u user.User, code, message string) bool {
+	keys, locked, ok := h.guard.attempt(w, r, u.Email(), message)
+	if !ok {
+		return false
+	}
+	v, err := h.mfa.Store.Verify(r.Context(), u.ID(), code)
+	switch {
+	case errors.Is(err, mfa.ErrInvalidCode):
+		h.recordCodeFailure(r, u, locked)
+		respondWithError(w, http.StatusForbidden, message, err.Error())
+		return false
+	case errors.Is(err, mfa.ErrNotEnabled):
+		respondWithError(w, http.StatusConflict, message, err.Error())
+		return false
+	case err != nil:
+		log.Printf("Error verifying MFA code: %v", err)
+		respondWithError(w, http.StatusInternalServerError, message, "")
+		return false
+	}
+	h.guard.succeeded(r, keys)
+	h.recordVerification(r, u, v)
+	return true
+}
+
+// currentUser returns the user a request is authenticated as, or responds
+// so and returns false.
+func (h *AuthHandler) currentUser(w http.ResponseWriter, r *http.Request, action string) (user.User, bool) {
+	u, err := h.users.QueryById(r.Context(), userID(r))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return user.User{}, false
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to "+action, "")
+		return user.User{}, false
+	}
+	return u, true
+}
+
+// MFAStatus handles GET "/api/auth/mfa", returning whether the
+// authenticated user has two-factor authentication on.
+func (h *AuthHandler) MFAStatus(w http.ResponseWriter, r *http.Request) {
+	claims, _ := UserFromContext(r.Context())
+
+	status, err := h.mfa.Store.Status(r.Context(), claims.UserId)
+	if err != nil {
+		log.Printf("Error getting MFA status: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to get two-factor status", "")
+		return
+	}
+
+	resp := MFAStatusResponse{
+		Enabled:           status.Enabled,
+		RecoveryCodesLeft: status.RecoveryCodesLeft,
+		SessionMFA:        claims.MFA,
+	}
+	if status.Enabled {
+		enabledAt := status.EnabledAt.UTC()
+		resp.EnabledAt = &enabledAt
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// EnrollTOTP handles POST "/api/auth/mfa/totp", with {"password": "..."}
+// for users with a password, starting to enrol an authenticator. It
+// returns the secret to add to it; enrolling again replaces one not yet
+// confirmed with ConfirmTOTP.
+func (h *AuthHandler) EnrollTOTP(w http.ResponseWriter, r *http.Request) {
+	var req EnrollTOTPRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "enrol authenticator")
+	if !ok {
+		return
+	}
+	if u.HasPassword() && !h.guard.checkPassword(w, r, u, req.Password, "Failed to enrol authenticator") {
+		return
+	}
+
+	secret, err := h.mfa.Store.Enroll(r.Context(), u.ID())
+	if errors.Is(err, mfa.ErrAlreadyEnabled) {
+		respondWithError(w, http.StatusConflict, "Failed to enrol authenticator", err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error enrolling authenticator: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to enrol authenticator", "")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, EnrollTOTPResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(h.mfa.Issuer, u.Email(), secret),
+	})
+}
+
+// ConfirmTOTP handles POST "/api/auth/mfa/totp/confirm" with
+// {"code": "..."}, a code from the authenticator being enrolled, turning
+// two-factor authentication on. It returns the user's recovery codes.
+// Sessions carry on without a second factor until they sign in again.
+func (h *AuthHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	var req MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "confirm authenticator")
+	if !ok {
+		return
+	}
+
+	codes, err := h.mfa.Store.Confirm(r.Context(), u.ID(), req.Code)
+	switch {
+	case errors.Is(err, mfa.ErrInvalidCode):
+		respondWithError(w, http.StatusBadRequest, "Failed to confirm authenticator", err.Error())
+		return
+	case errors.Is(err, mfa.ErrNotEnrolled), errors.Is(err, mfa.ErrAlreadyEnabled):
+		respondWithError(w, http.StatusConflict, "Failed to confirm authenticator", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error confirming authenticator: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to confirm authenticator", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindMFAEnabled, UserID: u.ID(), Email: u.Email()})
+	respondWithJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// RegenerateRecoveryCodes handles POST "/api/auth/mfa/recovery-codes"
+// with {"code": "..."}, replacing the authenticated user's recovery codes
+// with new ones.
+func (h *AuthHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var req MFACodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "regenerate recovery codes")
+	if !ok {
+		return
+	}
+	if !h.checkCode(w, r, u, req.Code, "Failed to regenerate recovery codes") {
+		return
+	}
+
+	codes, err := h.mfa.Store.RegenerateRecoveryCodes(r.Context(), u.ID())
+	if errors.Is(err, mfa.ErrNotEnabled) {
+		respondWithError(w, http.StatusConflict, "Failed to regenerate recovery codes", err.Error())
+		return
+	}
+	if err != nil {
+		log.Printf("Error regenerating recovery codes: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to regenerate recovery codes", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindRecoveryCodesRegenerated, UserID: u.ID(), Email: u.Email()})
+	respondWithJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// DisableMFA handles DELETE "/api/auth/mfa" with {"code": "..."}, and
+// "password" for users with a password, turning two-factor authentication
+// off. Sessions lose their second factor, so can no longer use what
+// requires one.
+func (h *AuthHandler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	var req DisableMFARequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "disable two-factor authentication")
+	if !ok {
+		return
+	}
+	if u.HasPassword() && !h.guard.checkPassword(w, r, u, req.Password, "Failed to disable two-factor authentication") {
+		return
+	}
+	if !h.checkCode(w, r, u, req.Code, "Failed to disable two-factor authentication") {
+		return
+	}
+
+	if err := h.mfa.Store.Disable(r.Context(), u.ID()); err != nil {
+		log.Printf("Error disabling MFA: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to disable two-factor authentication", "")
+		return
+	}
+	if err := h.sessions.ClearMFA(r.Context(), u.ID()); err != nil {
+		log.Printf("Error clearing sessions' MFA: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to update sessions", "")
+		return
+	}
+
+	h.guard.record(r, audit.Event{Kind: audit.KindMFADisabled, UserID: u.ID(), Email: u.Email()})
+	w.WriteHeader(http.StatusNoContent)
+}
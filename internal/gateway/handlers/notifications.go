@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// ListTaskWatchers handles GET "/api/tasks/{id}/watchers".
+func (h *TaskHandler) ListTaskWatchers(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	userIDs, err := h.taskClient.ListTaskWatchers(r.Context(), id)
+	if err != nil {
+		log.Printf("Error listing task watchers: %v", err)
+		respondWithGRPCError(w, "Failed to list task watchers", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListTaskWatchersResponse{UserIds: userIDs})
+}
+
+// WatchTask handles PUT "/api/tasks/{id}/watchers/{user_id}".
+func (h *TaskHandler) WatchTask(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.WatchTask(r.Context(), id, r.PathValue("user_id")); err != nil {
+		log.Printf("Error watching task: %v", err)
+		respondWithGRPCError(w, "Failed to watch task", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UnwatchTask handles DELETE "/api/tasks/{id}/watchers/{user_id}".
+func (h *TaskHandler) UnwatchTask(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.UnwatchTask(r.Context(), id, r.PathValue("user_id")); err != nil {
+		log.Printf("Error unwatching task: %v", err)
+		respondWithGRPCError(w, "Failed to unwatch task", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetNotificationPreferences handles
+// GET "/api/notifications/preferences".
+func (h *TaskHandler) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	prefs, err := h.taskClient.GetNotificationPreferences(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error getting notification preferences: %v", err)
+		respondWithGRPCError(w, "Failed to get notification preferences", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, prefs)
+}
+
+// UpdateNotificationPreferences handles PUT "/api/notifications/preferences".
+func (h *TaskHandler) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	var req pb.UpdateNotificationPreferencesRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.UserId = userID(r)
+
+	prefs, err := h.taskClient.UpdateNotificationPreferences(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating notification preferences: %v", err)
+		respondWithGRPCError(w, "Failed to update notification preferences", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, prefs)
+}
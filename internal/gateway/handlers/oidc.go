@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/oidc"
+)
+
+// oidcCookie holds the state, nonce and PKCE verifier of a sign-in in
+// progress, binding it to the browser that started it.
+const oidcCookie = "oidc_login"
+
+// oidcLoginTTL is how long users have to sign in at their provider.
+const oidcLoginTTL = 10 * time.Minute
+
+type ProviderResponse struct {
+	Name     string `json:"name"`
+	LoginURL string `json:"login_url"`
+}
+
+// oidcCookiePath scopes the sign-in cookie to a provider's endpoints.
+func oidcCookiePath(provider string) string {
+	return "/api/auth/oidc/" + provider + "/"
+}
+
+// ListProviders handles GET "/api/auth/oidc/providers", returning the
+// identity providers users can sign in through.
+func (h *AuthHandler) ListProviders(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(h.providers))
+	for name := range h.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	resp := make([]ProviderResponse, 0, len(names))
+	for _, name := range names {
+		resp = append(resp, ProviderResponse{Name: name, LoginURL: oidcCookiePath(name) + "login"})
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// OIDCLogin handles GET "/api/auth/oidc/{provider}/login", redirecting the
+// browser to sign in at the provider, which sends it back to OIDCCallback.
+func (h *AuthHandler) OIDCLogin(w http.ResponseWriter, r *http.Request) {
+	p, ok := h.providers[r.PathValue("provider")]
+	if !ok {
+		respondWithError(w, http.StatusNotFound, "Identity provider not found", "")
+		return
+	}
+
+	var values [3]string // State, nonce and verifier.
+	for i := range values {
+		v, err := oidc.RandomString()
+		if err != nil {
+			log.Printf("Error generating OIDC state: %v", err)
+			respondWithError(w, http.StatusInternalServerError, "Failed to start sign-in", "")
+			return
+		}
+		values[i] = v
+	}
+	state, nonce, verifier := values[0], values[1], values[2]
+
+	authURL, err := p.AuthCodeURL(r.Context(), state, nonce, verifier)
+	if err != nil {
+		log.Printf("Error discovering identity provider %s: %v", p.Name(), err)
+		respondWithError(w, http.StatusBadGateway, "Identity provider unavailable", "")
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcCookie,
+		Value:    strings.Join(values[:], "."),
+		Path:     oidcCookiePath(p.Name()),
+		MaxAge:   int(oidcLoginTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		// Lax, so the cookie comes back with the provider's redirect.
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// OIDCCallback handles GET "/api/auth/oidc/{provider}/callback", where the
+// provider sends the browser back with an authorization code. It signs in
+// the user linked to the provider's identity, linking or provisioning one
+// by email the first time, and responds like Login.
+func (h *AuthHandler) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	p, ok := h.providers[r.PathValue("provider")]
+	if !ok {
+		respondWithError(w, http.StatusNotFound, "Identity provider not found", "")
+		return
+	}
+
+	// The sign-in is over either way.
+	http.SetCookie(w, &http.Cookie{Name: oidcCookie, Path: oidcCookiePath(p.Name()), MaxAge: -1})
+
+	q := r.URL.Query()
+	if e := q.Get("error"); e != "" {
+		respondWithError(w, http.StatusUnauthorized, "Failed to sign in", e+": "+q.Get("error_description"))
+		return
+	}
+
+	cookie, err := r.Cookie(oidcCookie)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Failed to sign in", "no sign-in in progress")
+		return
+	}
+	values := strings.Split(cookie.Value, ".")
+	if len(values) != 3 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(q.Get("state"))) != 1 {
+		respondWithError(w, http.StatusBadRequest, "Failed to sign in", "state mismatch")
+		return
+	}
+	nonce, verifier := values[1], values[2]
+
+	claims, err := p.Exchange(r.Context(), q.Get("code"), verifier, nonce)
+	switch {
+	case errors.Is(err, oidc.ErrExchange), errors.Is(err, oidc.ErrInvalidIDToken), errors.Is(err, oidc.ErrNonceMismatch):
+		respondWithError(w, http.StatusUnauthorized, "Failed to sign in", err.Error())
+		return
+	case err != nil:
+		log.Printf("Error exchanging code with identity provider %s: %v", p.Name(), err)
+		respondWithError(w, http.StatusBadGateway, "Identity provider unavailable", "")
+		return
+	}
+
+	u, err := h.users.SignInWithIdentity(r.Context(), user.Identity{
+		Provider:          p.Name(),
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     p.EmailVerified(claims),
+		PreferredUsername: claims.PreferredUsername,
+	})
+	switch {
+	case errors.Is(err, user.ErrEmailNotVerified), errors.Is(err, user.ErrInvalidEmail):
+		respondWithError(w, http.StatusForbidden, "Failed to sign in", err.Error())
+		return
+	case errors.Is(err, user.ErrEmailTaken), errors.Is(err, user.ErrUsernameTaken):
+		respondWithError(w, http.StatusConflict, "Failed to sign in", "signing in concurrently; try again")
+		return
+	case err != nil:
+		log.Printf("Error signing in with identity: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to sign in", "")
+		return
+	}
+
+	h.signIn(w, r, u, 0, audit.Event{Kind: audit.KindIdentitySignIn, UserID: u.ID(), Email: u.Email(), Detail: p.Name()})
+}
@@ -3,10 +3,18 @@ package handlers
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
 	"github.com/zaouldyeck/taskboard/internal/gateway/grpcclient"
@@ -20,13 +28,27 @@ type CreateTaskRequest struct {
 	BoardID     int64  `json:"board_id"`
 	Title       string `json:"title"`
 	Description string `json:"description"`
-	CreatedBy   int64  `json:"created_by"`
+
+	CustomFields []CustomFieldValue `json:"custom_fields,omitempty"`
+	DueAt        *time.Time         `json:"due_at,omitempty"`
+	Labels       []string           `json:"labels,omitempty"`
+	AssigneeIDs  []string           `json:"assignee_ids,omitempty"`
 }
 
 type UpdateTaskRequest struct {
 	Title       *string `json:"title,omitempty"`
 	Description *string `json:"description,omitempty"`
 	Completed   *bool   `json:"completed,omitempty"`
+
+	// Only the listed fields change; a null value clears one.
+	CustomFields []CustomFieldValue `json:"custom_fields,omitempty"`
+
+	DueAt      *time.Time `json:"due_at,omitempty"`
+	ClearDueAt bool       `json:"clear_due_at,omitempty"`
+
+	// Replace the task's labels or assignees when present.
+	Labels      *[]string `json:"labels,omitempty"`
+	AssigneeIDs *[]string `json:"assignee_ids,omitempty"`
 }
 
 type ErrorResponse struct {
@@ -35,10 +57,10 @@ type ErrorResponse struct {
 }
 
 type ListTasksResponse struct {
-	Tasks      []*pb.Task `json:"tasks"`
-	TotalCount int32      `json:"total_count"`
-	Page       int32      `json:"page"`
-	PageSize   int32      `json:"page_size"`
+	Tasks      []taskResponse `json:"tasks"`
+	TotalCount int32          `json:"total_count"`
+	Page       int32          `json:"page"`
+	PageSize   int32          `json:"page_size"`
 }
 
 func NewTaskHandler(taskClient *grpcclient.TaskClient) *TaskHandler {
@@ -62,6 +84,62 @@ func respondWithError(w http.ResponseWriter, code int, message, details string)
 	})
 }
 
+// protoMarshaler renders proto messages with their snake_case field names,
+// matching the json tags used by respondWithJSON.
+var protoMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// respondWithProto writes a proto message as JSON. Unlike respondWithJSON it
+// understands oneofs, enums and well-known types.
+func respondWithProto(w http.ResponseWriter, code int, msg proto.Message) {
+	data, err := protoMarshaler.Marshal(msg)
+	if err != nil {
+		log.Printf("Error encoding proto response: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to encode response", "")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// decodeProto decodes a JSON request body into a proto message.
+func decodeProto(r *http.Request, msg proto.Message) error {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(data, msg)
+}
+
+// httpStatusFromGRPC maps the gRPC status code of err onto an HTTP status.
+func httpStatusFromGRPC(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// respondWithGRPCError writes err from the task service using its mapped HTTP status.
+func respondWithGRPCError(w http.ResponseWriter, message string, err error) {
+	respondWithError(w, httpStatusFromGRPC(err), message, status.Convert(err).Message())
+}
+
 // parseInt64Query parses int64 query parameter and returns value.
 func parseInt64Query(r *http.Request, key string, defaultValue int64) int64 {
 	valStr := r.URL.Query().Get(key)
@@ -100,6 +178,30 @@ func parseBoolQuery(r *http.Request, key string) *bool {
 	return &val
 }
 
+// parseTimeQuery parses an RFC 3339 timestamp or a YYYY-MM-DD date query parameter.
+// A missing parameter returns nil.
+func parseTimeQuery(r *http.Request, key string) (*time.Time, error) {
+	valStr := r.URL.Query().Get(key)
+	if valStr == "" {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if val, err := time.Parse(layout, valStr); err == nil {
+			return &val, nil
+		}
+	}
+	return nil, fmt.Errorf("%s must be an RFC 3339 timestamp or YYYY-MM-DD date", key)
+}
+
+// pathInt64 parses an int64 path wildcard such as {id}.
+func pathInt64(r *http.Request, name string) (int64, error) {
+	val, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+	return val, nil
+}
+
 // extractTask extracts task ID from URL.
 func extractTaskID(r *http.Request) (int64, error) {
 	path := r.URL.Path
@@ -133,19 +235,33 @@ func (h *TaskHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	task, err := h.taskClient.CreateTask(r.Context(), &pb.CreateTaskRequest{
-		BoardId:     req.BoardID,
-		Title:       req.Title,
-		Description: req.Description,
-		CreatedBy:   req.CreatedBy,
-	})
+	customFields, err := fieldValuesToProto(req.CustomFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid custom fields", err.Error())
+		return
+	}
+
+	grpcReq := &pb.CreateTaskRequest{
+		BoardId:      req.BoardID,
+		Title:        req.Title,
+		Description:  req.Description,
+		CreatedBy:    userID(r),
+		CustomFields: customFields,
+		Labels:       req.Labels,
+		AssigneeIds:  req.AssigneeIDs,
+	}
+	if req.DueAt != nil {
+		grpcReq.DueAt = timestamppb.New(*req.DueAt)
+	}
+
+	task, err := h.taskClient.CreateTask(r.Context(), grpcReq)
 	if err != nil {
 		log.Printf("Error creating task: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Failed to create task", err.Error())
+		respondWithGRPCError(w, "Failed to create task", err)
 		return
 	}
 
-	respondWithJSON(w, http.StatusCreated, task)
+	respondWithJSON(w, http.StatusCreated, newTaskResponse(task))
 }
 
 // GetTask handler for GET "/api/tasks/:id".
@@ -159,17 +275,33 @@ func (h *TaskHandler) GetTask(w http.ResponseWriter, r *http.Request) {
 	task, err := h.taskClient.GetTask(r.Context(), taskId)
 	if err != nil {
 		log.Printf("Error getting task: %v", err)
-		respondWithError(w, http.StatusNotFound, "Task not found", err.Error())
+		respondWithGRPCError(w, "Failed to get task", err)
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, task)
+	respondWithJSON(w, http.StatusOK, newTaskResponse(task))
 }
 
 // ListTasks handles GET "/api/tasks".
+//
+// Besides board_id, completed and paging it takes q, a filter query such as
+// `status:open assignee:me label:bug due<7d`, where "me" is the
+// authenticated user, sort (for example -due) and cf.<id> custom field
+// filters. Without board_id, tasks from board 1 are listed, or from all
+// boards if q is given.
 func (h *TaskHandler) ListTasks(w http.ResponseWriter, r *http.Request) {
+	h.listTasks(w, r, 0)
+}
+
+// listTasks serves ListTasks, applying a saved view if viewID is set.
+func (h *TaskHandler) listTasks(w http.ResponseWriter, r *http.Request, viewID int64) {
 	// Store query params.
-	boardId := parseInt64Query(r, "board_id", 1)
+	query := r.URL.Query().Get("q")
+	defaultBoard := int64(1)
+	if query != "" || viewID != 0 {
+		defaultBoard = 0
+	}
+	boardId := parseInt64Query(r, "board_id", defaultBoard)
 	pageSize := parseInt32Query(r, "page_size", 10)
 	pageNumber := parseInt32Query(r, "page", 1)
 	completed := parseBoolQuery(r, "completed")
@@ -181,15 +313,35 @@ func (h *TaskHandler) ListTasks(w http.ResponseWriter, r *http.Request) {
 		pageSize = 10
 	}
 
-	tasks, totalCount, err := h.taskClient.ListTasks(r.Context(), boardId, completed, pageSize, pageNumber)
+	req := &pb.ListTasksRequest{
+		BoardId:    boardId,
+		Completed:  completed,
+		PageSize:   pageSize,
+		PageNumber: pageNumber,
+		Query:      query,
+		UserId:     userID(r),
+		Sort:       r.URL.Query().Get("sort"),
+		ViewId:     viewID,
+	}
+	if err := parseFieldQuery(r, req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid custom field filter", err.Error())
+		return
+	}
+
+	tasks, totalCount, err := h.taskClient.ListTasks(r.Context(), req)
 	if err != nil {
 		log.Printf("Error listing tasks: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Failed to list tasks", err.Error())
+		respondWithGRPCError(w, "Failed to list tasks", err)
 		return
 	}
 
+	taskResponses := make([]taskResponse, len(tasks))
+	for i, task := range tasks {
+		taskResponses[i] = newTaskResponse(task)
+	}
+
 	response := ListTasksResponse{
-		Tasks:      tasks,
+		Tasks:      taskResponses,
 		TotalCount: totalCount,
 		Page:       pageNumber,
 		PageSize:   pageSize,
@@ -223,15 +375,30 @@ func (h *TaskHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
 	if req.Completed != nil {
 		grpcReq.Completed = req.Completed
 	}
+	if req.DueAt != nil {
+		grpcReq.DueAt = timestamppb.New(*req.DueAt)
+	}
+	grpcReq.ClearDueAt = req.ClearDueAt
+	if req.Labels != nil {
+		grpcReq.Labels = &pb.StringList{Values: *req.Labels}
+	}
+	if req.AssigneeIDs != nil {
+		grpcReq.AssigneeIds = &pb.StringList{Values: *req.AssigneeIDs}
+	}
+	grpcReq.CustomFields, err = fieldValuesToProto(req.CustomFields)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid custom fields", err.Error())
+		return
+	}
 
 	task, err := h.taskClient.UpdateTask(r.Context(), grpcReq)
 	if err != nil {
 		log.Printf("Error updating task: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Failed to update task", err.Error())
+		respondWithGRPCError(w, "Failed to update task", err)
 		return
 	}
 
-	respondWithJSON(w, http.StatusOK, task)
+	respondWithJSON(w, http.StatusOK, newTaskResponse(task))
 }
 
 // DeleteTask handles DELETE "/api/tasks/:id".
@@ -245,7 +412,7 @@ func (h *TaskHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
 	success, err := h.taskClient.DeleteTask(r.Context(), taskId)
 	if err != nil {
 		log.Printf("Error deleting task: %v", err)
-		respondWithError(w, http.StatusInternalServerError, "Failed to delete task", err.Error())
+		respondWithGRPCError(w, "Failed to delete task", err)
 		return
 	}
 
@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// templateKinds maps the kind query parameter to the proto enum.
+var templateKinds = map[string]pb.TemplateKind{
+	"":      pb.TemplateKind_TEMPLATE_KIND_UNSPECIFIED,
+	"board": pb.TemplateKind_TEMPLATE_KIND_BOARD,
+	"task":  pb.TemplateKind_TEMPLATE_KIND_TASK,
+}
+
+// CreateBoard handles POST "/api/boards".
+func (h *TaskHandler) CreateBoard(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateBoardRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	board, err := h.taskClient.CreateBoard(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating board: %v", err)
+		respondWithGRPCError(w, "Failed to create board", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, board)
+}
+
+// GetBoard handles GET "/api/boards/{id}".
+func (h *TaskHandler) GetBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	board, err := h.taskClient.GetBoard(r.Context(), boardID)
+	if err != nil {
+		log.Printf("Error getting board: %v", err)
+		respondWithGRPCError(w, "Failed to get board", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, board)
+}
+
+// UpdateBoard handles PUT "/api/boards/{id}" with {"require_mfa": true},
+// changing a board's settings. Only admins and owners update boards.
+func (h *TaskHandler) UpdateBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateBoardRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.Id = boardID
+
+	board, err := h.taskClient.UpdateBoard(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating board: %v", err)
+		respondWithGRPCError(w, "Failed to update board", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, board)
+}
+
+// SaveBoardAsTemplate handles POST "/api/boards/{id}/template".
+func (h *TaskHandler) SaveBoardAsTemplate(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	var req pb.SaveBoardAsTemplateRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.BoardId = boardID
+
+	tmpl, err := h.taskClient.SaveBoardAsTemplate(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error saving board as template: %v", err)
+		respondWithGRPCError(w, "Failed to save board as template", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, tmpl)
+}
+
+// CreateTemplate handles POST "/api/templates".
+// The body holds either a "board" or a "task" template.
+func (h *TaskHandler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateTemplateRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	tmpl, err := h.taskClient.CreateTemplate(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating template: %v", err)
+		respondWithGRPCError(w, "Failed to create template", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, tmpl)
+}
+
+// ListTemplates handles GET "/api/templates?kind=board|task".
+func (h *TaskHandler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	kind, ok := templateKinds[r.URL.Query().Get("kind")]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "Invalid kind", "kind must be board or task")
+		return
+	}
+
+	templates, err := h.taskClient.ListTemplates(r.Context(), kind)
+	if err != nil {
+		log.Printf("Error listing templates: %v", err)
+		respondWithGRPCError(w, "Failed to list templates", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListTemplatesResponse{Templates: templates})
+}
+
+// GetTemplate handles GET "/api/templates/{id}".
+func (h *TaskHandler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	tmpl, err := h.taskClient.GetTemplate(r.Context(), id)
+	if err != nil {
+		log.Printf("Error getting template: %v", err)
+		respondWithGRPCError(w, "Failed to get template", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, tmpl)
+}
+
+// DeleteTemplate handles DELETE "/api/templates/{id}".
+func (h *TaskHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteTemplate(r.Context(), id); err != nil {
+		log.Printf("Error deleting template: %v", err)
+		respondWithGRPCError(w, "Failed to delete template", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateBoardFromTemplate handles POST "/api/templates/{id}/boards".
+func (h *TaskHandler) CreateBoardFromTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	var req pb.CreateBoardFromTemplateRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.TemplateId = id
+	req.CreatedBy = userID(r)
+
+	resp, err := h.taskClient.CreateBoardFromTemplate(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating board from template: %v", err)
+		respondWithGRPCError(w, "Failed to create board from template", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, resp)
+}
+
+// CreateTaskFromTemplate handles POST "/api/templates/{id}/tasks".
+func (h *TaskHandler) CreateTaskFromTemplate(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid template ID", err.Error())
+		return
+	}
+
+	var req pb.CreateTaskFromTemplateRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.TemplateId = id
+	req.CreatedBy = userID(r)
+
+	task, err := h.taskClient.CreateTaskFromTemplate(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating task from template: %v", err)
+		respondWithGRPCError(w, "Failed to create task from template", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, newTaskResponse(task))
+}
@@ -0,0 +1,302 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+type StartTimerRequest struct {
+	Note string `json:"note"`
+}
+
+type CreateTimeEntryRequest struct {
+	Note      string    `json:"note"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at"`
+}
+
+type UpdateTimeEntryRequest struct {
+	Note      *string    `json:"note,omitempty"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+type ListTimeEntriesResponse struct {
+	Entries []*pb.TimeEntry `json:"entries"`
+}
+
+// reportGroupings maps the group_by query parameter to the proto enum.
+var reportGroupings = map[string]pb.TimeReportGrouping{
+	"":      pb.TimeReportGrouping_TIME_REPORT_GROUPING_DAY,
+	"day":   pb.TimeReportGrouping_TIME_REPORT_GROUPING_DAY,
+	"week":  pb.TimeReportGrouping_TIME_REPORT_GROUPING_WEEK,
+	"month": pb.TimeReportGrouping_TIME_REPORT_GROUPING_MONTH,
+}
+
+// optionalTimestamp converts an optional time to a proto timestamp.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// parseTimeRange reads the "from" and "to" query parameters.
+func parseTimeRange(r *http.Request) (from, to *timestamppb.Timestamp, err error) {
+	fromTime, err := parseTimeQuery(r, "from")
+	if err != nil {
+		return nil, nil, err
+	}
+	toTime, err := parseTimeQuery(r, "to")
+	if err != nil {
+		return nil, nil, err
+	}
+	return optionalTimestamp(fromTime), optionalTimestamp(toTime), nil
+}
+
+// StartTimer handles POST "/api/tasks/{id}/timer/start".
+func (h *TaskHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	var req StartTimerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.taskClient.StartTimer(r.Context(), &pb.StartTimerRequest{
+		TaskId: taskID,
+		UserId: userID(r),
+		Note:   req.Note,
+	})
+	if err != nil {
+		log.Printf("Error starting timer: %v", err)
+		respondWithGRPCError(w, "Failed to start timer", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, entry)
+}
+
+// StopTimer handles POST "/api/timer/stop", stopping the authenticated
+// user's running timer.
+func (h *TaskHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	entry, err := h.taskClient.StopTimer(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error stopping timer: %v", err)
+		respondWithGRPCError(w, "Failed to stop timer", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, entry)
+}
+
+// CreateTimeEntry handles POST "/api/tasks/{id}/time-entries".
+func (h *TaskHandler) CreateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	var req CreateTimeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.taskClient.CreateTimeEntry(r.Context(), &pb.CreateTimeEntryRequest{
+		TaskId:    taskID,
+		UserId:    userID(r),
+		Note:      req.Note,
+		StartedAt: timestamppb.New(req.StartedAt),
+		EndedAt:   timestamppb.New(req.EndedAt),
+	})
+	if err != nil {
+		log.Printf("Error creating time entry: %v", err)
+		respondWithGRPCError(w, "Failed to create time entry", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, entry)
+}
+
+// ListTaskTimeEntries handles GET "/api/tasks/{id}/time-entries".
+func (h *TaskHandler) ListTaskTimeEntries(w http.ResponseWriter, r *http.Request) {
+	taskID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID", err.Error())
+		return
+	}
+
+	entries, err := h.taskClient.ListTimeEntries(r.Context(), &pb.ListTimeEntriesRequest{TaskId: taskID})
+	if err != nil {
+		log.Printf("Error listing time entries: %v", err)
+		respondWithGRPCError(w, "Failed to list time entries", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, ListTimeEntriesResponse{Entries: entries})
+}
+
+// UpdateTimeEntry handles PUT "/api/time-entries/{id}".
+func (h *TaskHandler) UpdateTimeEntry(w http.ResponseWriter, r *http.Request) {
+	entryID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid time entry ID", err.Error())
+		return
+	}
+
+	var req UpdateTimeEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	entry, err := h.taskClient.UpdateTimeEntry(r.Context(), &pb.UpdateTimeEntryRequest{
+		Id:        entryID,
+		Note:      req.Note,
+		StartedAt: optionalTimestamp(req.StartedAt),
+		EndedAt:   optionalTimestamp(req.EndedAt),
+	})
+	if err != nil {
+		log.Printf("Error updating time entry: %v", err)
+		respondWithGRPCError(w, "Failed to update time entry", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, entry)
+}
+
+// DeleteTimeEntry handles DELETE "/api/time-entries/{id}".
+func (h *TaskHandler) DeleteTimeEntry(w http.ResponseWriter, r *http.Request) {
+	entryID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid time entry ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteTimeEntry(r.Context(), entryID); err != nil {
+		log.Printf("Error deleting time entry: %v", err)
+		respondWithGRPCError(w, "Failed to delete time entry", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTimeEntries handles GET "/api/time-entries".
+// Filters: board_id, user_id, from, to. With format=csv the entries are
+// exported as a CSV download instead of JSON.
+func (h *TaskHandler) ListTimeEntries(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid time range", err.Error())
+		return
+	}
+
+	entries, err := h.taskClient.ListTimeEntries(r.Context(), &pb.ListTimeEntriesRequest{
+		BoardId: parseInt64Query(r, "board_id", 0),
+		UserId:  r.URL.Query().Get("user_id"),
+		From:    from,
+		To:      to,
+	})
+	if err != nil {
+		log.Printf("Error listing time entries: %v", err)
+		respondWithGRPCError(w, "Failed to list time entries", err)
+		return
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		writeTimeEntriesCSV(w, entries)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, ListTimeEntriesResponse{Entries: entries})
+}
+
+// writeTimeEntriesCSV streams entries as a CSV attachment.
+func writeTimeEntriesCSV(w http.ResponseWriter, entries []*pb.TimeEntry) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="time-entries.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"id", "task_id", "user_id", "started_at", "ended_at", "duration_seconds", "note"})
+	for _, e := range entries {
+		endedAt := ""
+		if e.EndedAt != nil {
+			endedAt = e.EndedAt.AsTime().Format(time.RFC3339)
+		}
+		cw.Write([]string{
+			strconv.FormatInt(e.Id, 10),
+			strconv.FormatInt(e.TaskId, 10),
+			csvCell(e.UserId),
+			e.StartedAt.AsTime().Format(time.RFC3339),
+			endedAt,
+			strconv.FormatInt(e.DurationSeconds, 10),
+			csvCell(e.Note),
+		})
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		log.Printf("Error writing time entries CSV: %v", err)
+	}
+}
+
+// csvCell quotes text that spreadsheets would otherwise run as a formula.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// GetTimeReport handles GET "/api/time-report".
+// Filters: board_id, user_id, from, to; group_by is day (default), week or month.
+func (h *TaskHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseTimeRange(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid time range", err.Error())
+		return
+	}
+
+	groupBy, ok := reportGroupings[r.URL.Query().Get("group_by")]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "Invalid group_by",
+			fmt.Sprintf("unsupported grouping %q", r.URL.Query().Get("group_by")))
+		return
+	}
+
+	report, err := h.taskClient.GetTimeReport(r.Context(), &pb.GetTimeReportRequest{
+		BoardId: parseInt64Query(r, "board_id", 0),
+		UserId:  r.URL.Query().Get("user_id"),
+		From:    from,
+		To:      to,
+		GroupBy: groupBy,
+	})
+	if err != nil {
+		log.Printf("Error getting time report: %v", err)
+		respondWithGRPCError(w, "Failed to get time report", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+var dataFormats = map[string]pb.DataFormat{
+	"csv":  pb.DataFormat_DATA_FORMAT_CSV,
+	"json": pb.DataFormat_DATA_FORMAT_JSON,
+}
+
+// importFormats are the formats that can be imported: dataFormats and
+// exports from other systems.
+var importFormats = map[string]pb.DataFormat{
+	"csv":    pb.DataFormat_DATA_FORMAT_CSV,
+	"json":   pb.DataFormat_DATA_FORMAT_JSON,
+	"trello": pb.DataFormat_DATA_FORMAT_TRELLO,
+	"github": pb.DataFormat_DATA_FORMAT_GITHUB,
+}
+
+// exportWriter sets the response headers on the first write, so an export
+// that fails before sending anything can still get an error response.
+type exportWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportWriter) Write(p []byte) (int, error) {
+	if !e.started {
+		e.started = true
+		e.w.Header().Set("Content-Type", e.contentType)
+		e.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+			map[string]string{"filename": e.filename}))
+		e.w.WriteHeader(http.StatusOK)
+	}
+	return e.w.Write(p)
+}
+
+// ExportBoard handles GET "/api/boards/{id}/export?format=csv|json". The
+// file is streamed as the task service produces it; JSON is the default.
+func (h *TaskHandler) ExportBoard(w http.ResponseWriter, r *http.Request) {
+	boardID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+		return
+	}
+
+	name := strings.ToLower(r.URL.Query().Get("format"))
+	if name == "" {
+		name = "json"
+	}
+	format, ok := dataFormats[name]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "Invalid format", "format must be csv or json")
+		return
+	}
+
+	contentType := "application/json"
+	if format == pb.DataFormat_DATA_FORMAT_CSV {
+		contentType = "text/csv; charset=utf-8"
+	}
+	out := &exportWriter{
+		w:           w,
+		contentType: contentType,
+		filename:    fmt.Sprintf("board-%d.%s", boardID, name),
+	}
+
+	// Large boards may take longer than the server-wide timeouts.
+	http.NewResponseController(w).SetWriteDeadline(time.Now().Add(transferTimeout))
+
+	if err := h.taskClient.ExportBoard(r.Context(), boardID, format, out); err != nil {
+		log.Printf("Error exporting board: %v", err)
+		if !out.started {
+			respondWithGRPCError(w, "Failed to export board", err)
+			return
+		}
+		// Too late for an error response; drop the connection so the
+		// client doesn't take a partial file for a whole one.
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// ImportBoard handles POST "/api/boards/{id}/import" and, for files that
+// describe their board, POST "/api/boards/import", which creates the board
+// or, for Trello and GitHub, reuses the one an earlier import created. The
+// request body is the file, streamed to the task service. Query parameters:
+//
+//	format=csv|json|trello|github  defaults from the Content-Type, else json
+//	dry_run=true                   validate only
+//	map.<column>=<field>           CSV column mapping; an empty field ignores the column
+//	user.<source>:<name>=<id>      Trello and GitHub assignee mapping
+//
+// Rows that don't name a creator are created by the caller. The response
+// reports per-row errors. CSV and JSON imports with errors
+// import nothing; Trello and GitHub imports write the other rows. The
+// status is 422 if there are errors and nothing was imported.
+func (h *TaskHandler) ImportBoard(w http.ResponseWriter, r *http.Request) {
+	var boardID int64
+	if r.PathValue("id") != "" {
+		var err error
+		boardID, err = pathInt64(r, "id")
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid board ID", err.Error())
+			return
+		}
+	}
+
+	query := r.URL.Query()
+
+	name := strings.ToLower(query.Get("format"))
+	if name == "" {
+		name = "json"
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "text/csv" {
+			name = "csv"
+		}
+	}
+	format, ok := importFormats[name]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "Invalid format", "format must be csv, json, trello or github")
+		return
+	}
+
+	header := &pb.ImportBoardHeader{
+		BoardId:   boardID,
+		Format:    format,
+		CreatedBy: userID(r),
+	}
+	if dryRun := parseBoolQuery(r, "dry_run"); dryRun != nil {
+		header.DryRun = *dryRun
+	}
+	for key, values := range query {
+		if column, ok := strings.CutPrefix(key, "map."); ok {
+			if header.ColumnMapping == nil {
+				header.ColumnMapping = map[string]string{}
+			}
+			header.ColumnMapping[column] = values[0]
+		}
+		if user, ok := strings.CutPrefix(key, "user."); ok {
+			if header.UserMap == nil {
+				header.UserMap = map[string]string{}
+			}
+			header.UserMap[user] = values[0]
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetReadDeadline(time.Now().Add(transferTimeout))
+	rc.SetWriteDeadline(time.Now().Add(transferTimeout))
+
+	resp, err := h.taskClient.ImportBoard(r.Context(), header, r.Body)
+	if err != nil {
+		log.Printf("Error importing board: %v", err)
+		respondWithGRPCError(w, "Failed to import board", err)
+		return
+	}
+
+	code := http.StatusOK
+	if len(resp.Errors) > 0 && resp.RowsImported == 0 {
+		code = http.StatusUnprocessableEntity
+	} else if resp.BoardCreated {
+		code = http.StatusCreated
+	}
+	respondWithProto(w, code, resp)
+}
@@ -0,0 +1,647 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/apitoken"
+	"github.com/zaouldyeck/taskboard/business/core/audit"
+	"github.com/zaouldyeck/taskboard/business/core/mfa"
+	"github.com/zaouldyeck/taskboard/business/core/session"
+	"github.com/zaouldyeck/taskboard/business/core/user"
+	"github.com/zaouldyeck/taskboard/business/sys/blob"
+	"github.com/zaouldyeck/taskboard/internal/gateway/grpcclient"
+)
+
+// maxAvatarBytes bounds avatar uploads.
+const maxAvatarBytes = 1 << 20
+
+// How recently users without a password must have signed in to delete
+// their account, having no password to confirm it with.
+const recentSignIn = 10 * time.Minute
+
+// avatarTypes are the image types avatars may be, which browsers render
+// inline without running anything.
+var avatarTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// UserHandler manages users' profiles and preferences, finds users to
+// assign and mention, and exports and deletes accounts. Deleted accounts
+// are anonymised, not removed, so what their users created stays
+// credited to someone.
+type UserHandler struct {
+	users      *user.Store
+	sessions   *session.Store
+	tokens     *apitoken.Store
+	mfa        *mfa.Store
+	taskClient *grpcclient.TaskClient
+	blobs      blob.Store
+	mail       AccountMail
+	guard      LoginGuard
+}
+
+func NewUserHandler(users *user.Store, sessions *session.Store, tokens *apitoken.Store, factors *mfa.Store,
+	taskClient *grpcclient.TaskClient, blobs blob.Store, mail AccountMail, guard LoginGuard,
+) *UserHandler {
+	return &UserHandler{
+		users:      users,
+		sessions:   sessions,
+		tokens:     tokens,
+		mfa:        factors,
+		taskClient: taskClient,
+		blobs:      blobs,
+		mail:       mail,
+		guard:      guard,
+	}
+}
+
+// UpdateProfileRequest changes the fields it sets. Changing the email of
+// a user with a password needs it as CurrentPassword.
+type UpdateProfileRequest struct {
+	Username        *string `json:"username"`
+	Email           *string `json:"email"`
+	DisplayName     *string `json:"display_name"`
+	CurrentPassword string  `json:"current_password"`
+}
+
+type PreferencesResponse struct {
+	TimeZone string `json:"time_zone"`
+
+	// An EmailDelivery name, such as "EMAIL_DELIVERY_DAILY".
+	EmailDelivery string `json:"email_delivery"`
+}
+
+// UpdatePreferencesRequest changes the preferences it sets.
+type UpdatePreferencesRequest struct {
+	TimeZone      *string `json:"time_zone"`
+	EmailDelivery *string `json:"email_delivery"`
+}
+
+type DeleteAccountRequest struct {
+	// Required of users with a password.
+	Password string `json:"password"`
+}
+
+// UserSummary is what other users see of a user.
+type UserSummary struct {
+	ID          string `json:"id"`
+	Username    string `json:"username"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+}
+
+type ListUsersResponse struct {
+	Users []UserSummary `json:"users"`
+}
+
+type IdentityResponse struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportResponse is everything Taskboard keeps about a user.
+type ExportResponse struct {
+	ExportedAt time.Time          `json:"exported_at"`
+	User       UserResponse       `json:"user"`
+	Identities []IdentityResponse `json:"identities"`
+	Sessions   []SessionResponse  `json:"sessions"`
+	APITokens  []APITokenResponse `json:"api_tokens"`
+
+	// Their tasks, time, memberships and settings in each workspace, as
+	// an ExportUserDataResponse.
+	Tasks json.RawMessage `json:"tasks"`
+}
+
+// avatarURL returns where u's avatar is served, or "" if they have none.
+// The URL changes with the avatar, so it can be cached for long.
+func avatarURL(u user.User) string {
+	if u.AvatarKey() == "" {
+		return ""
+	}
+	return "/api/users/" + u.ID() + "/avatar?v=" + path.Base(u.AvatarKey())
+}
+
+func newUserSummary(u user.User) UserSummary {
+	return UserSummary{ID: u.ID(), Username: u.Username(), DisplayName: u.DisplayName(), AvatarURL: avatarURL(u)}
+}
+
+// currentUser returns the user a request is authenticated as, or responds
+// with an error and returns false.
+func (h *UserHandler) currentUser(w http.ResponseWriter, r *http.Request, action string) (user.User, bool) {
+	u, err := h.users.QueryById(r.Context(), userID(r))
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return user.User{}, false
+	}
+	if err != nil {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to "+action, "")
+		return user.User{}, false
+	}
+	return u, true
+}
+
+// UpdateProfile handles PUT "/api/me" with any of {"username": "...",
+// "email": "...", "display_name": "..."}. A changed email needs verifying
+// again, so a verification email is sent to it.
+func (h *UserHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	var req UpdateProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "update profile")
+	if !ok {
+		return
+	}
+	oldEmail := u.Email()
+
+	var err error
+	if req.Username != nil {
+		err = errors.Join(err, u.SetUsername(*req.Username))
+	}
+	if req.Email != nil {
+		err = errors.Join(err, u.SetEmail(*req.Email))
+	}
+	if req.DisplayName != nil {
+		err = errors.Join(err, u.SetDisplayName(*req.DisplayName))
+	}
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Failed to update profile", err.Error())
+		return
+	}
+
+	// Otherwise a stolen access token could take the account over with a
+	// password reset to a new email.
+	emailChanged := u.Email() != oldEmail
+	if emailChanged && u.HasPassword() && !h.guard.checkPassword(w, r, u, req.CurrentPassword, "Failed to update profile") {
+		return
+	}
+
+	err = h.users.UpdateProfile(r.Context(), u)
+	switch {
+	case errors.Is(err, user.ErrEmailTaken), errors.Is(err, user.ErrUsernameTaken):
+		respondWithError(w, http.StatusConflict, "Failed to update profile", err.Error())
+		return
+	case errors.Is(err, user.ErrNotFound):
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return
+	case err != nil:
+		log.Printf("Error updating profile: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to update profile", "")
+		return
+	}
+
+	if emailChanged {
+		h.guard.record(r, audit.Event{Kind: audit.KindEmailChanged, UserID: u.ID(), Email: u.Email(), Detail: "from " + oldEmail})
+		go h.mail.sendToken(h.users, u, user.PurposeEmailVerification)
+	}
+
+	respondWithJSON(w, http.StatusOK, newUserResponse(u))
+}
+
+// GetPreferences handles GET "/api/me/preferences".
+func (h *UserHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	u, ok := h.currentUser(w, r, "get preferences")
+	if !ok {
+		return
+	}
+
+	prefs, err := h.taskClient.GetNotificationPreferences(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error getting notification preferences: %v", err)
+		respondWithGRPCError(w, "Failed to get preferences", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, PreferencesResponse{
+		TimeZone:      u.TimeZone(),
+		EmailDelivery: prefs.EmailDelivery.String(),
+	})
+}
+
+// UpdatePreferences handles PUT "/api/me/preferences" with either of
+// {"time_zone": "Europe/Paris", "email_delivery": "EMAIL_DELIVERY_DAILY"}.
+func (h *UserHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	var req UpdatePreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "update preferences")
+	if !ok {
+		return
+	}
+
+	// Both are checked before either is stored.
+	if req.TimeZone != nil {
+		if err := u.SetTimeZone(*req.TimeZone); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Failed to update preferences", err.Error())
+			return
+		}
+	}
+	var delivery pb.EmailDelivery
+	if req.EmailDelivery != nil {
+		value, ok := pb.EmailDelivery_value[*req.EmailDelivery]
+		if !ok || value == int32(pb.EmailDelivery_EMAIL_DELIVERY_UNSPECIFIED) {
+			respondWithError(w, http.StatusBadRequest, "Failed to update preferences",
+				fmt.Sprintf("unknown email_delivery %q", *req.EmailDelivery))
+			return
+		}
+		delivery = pb.EmailDelivery(value)
+	}
+
+	if req.TimeZone != nil {
+		if err := h.users.UpdateProfile(r.Context(), u); err != nil {
+			log.Printf("Error updating time zone: %v", err)
+			respondWithError(w, http.StatusInternalServerError, "Failed to update preferences", "")
+			return
+		}
+	}
+
+	var prefs *pb.NotificationPreferences
+	var err error
+	if req.EmailDelivery != nil {
+		prefs, err = h.taskClient.UpdateNotificationPreferences(r.Context(), &pb.UpdateNotificationPreferencesRequest{
+			UserId:        u.ID(),
+			EmailDelivery: delivery,
+		})
+	} else {
+		prefs, err = h.taskClient.GetNotificationPreferences(r.Context(), u.ID())
+	}
+	if err != nil {
+		log.Printf("Error updating notification preferences: %v", err)
+		respondWithGRPCError(w, "Failed to update preferences", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, PreferencesResponse{
+		TimeZone:      u.TimeZone(),
+		EmailDelivery: prefs.EmailDelivery.String(),
+	})
+}
+
+// UploadAvatar handles PUT "/api/me/avatar" with a PNG, JPEG, GIF or WebP
+// image as the body, of at most 1 MiB.
+func (h *UserHandler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
+	if r.ContentLength > maxAvatarBytes {
+		respondWithError(w, http.StatusRequestEntityTooLarge, "Avatar too large",
+			fmt.Sprintf("max avatar size is %d bytes", maxAvatarBytes))
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxAvatarBytes)
+
+	// Only the sniffed type counts, whatever the request declares.
+	contentType, content, err := sniffContentType(r.Body, "", "")
+	if err != nil {
+		respondUploadError(w, err)
+		return
+	}
+	if !avatarTypes[contentType] {
+		respondWithError(w, http.StatusUnsupportedMediaType, "Unsupported avatar type",
+			"avatars must be PNG, JPEG, GIF or WebP images")
+		return
+	}
+
+	id := userID(r)
+	key := fmt.Sprintf("avatars/%s/%s", id, uuid.New().String())
+	if err := h.blobs.Put(r.Context(), key, content, r.ContentLength, contentType); err != nil {
+		log.Printf("Error storing avatar blob: %v", err)
+		respondUploadError(w, err)
+		return
+	}
+
+	previous, err := h.users.SetAvatar(r.Context(), id, key, contentType)
+	if err != nil {
+		h.deleteAvatar(r.Context(), key)
+		if errors.Is(err, user.ErrNotFound) {
+			respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+			return
+		}
+		log.Printf("Error setting avatar: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to set avatar", "")
+		return
+	}
+	h.deleteAvatar(r.Context(), previous)
+
+	u, ok := h.currentUser(w, r, "set avatar")
+	if !ok {
+		return
+	}
+	respondWithJSON(w, http.StatusOK, newUserResponse(u))
+}
+
+// DeleteAvatar handles DELETE "/api/me/avatar".
+func (h *UserHandler) DeleteAvatar(w http.ResponseWriter, r *http.Request) {
+	previous, err := h.users.SetAvatar(r.Context(), userID(r), "", "")
+	if errors.Is(err, user.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, "User not found", "the token's user no longer exists")
+		return
+	}
+	if err != nil {
+		log.Printf("Error removing avatar: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to remove avatar", "")
+		return
+	}
+	h.deleteAvatar(r.Context(), previous)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteAvatar deletes an avatar's blob, if any, once nothing refers to
+// it. Failing only leaves an orphaned blob, so it is just logged.
+func (h *UserHandler) deleteAvatar(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+	if err := h.blobs.Delete(context.WithoutCancel(ctx), key); err != nil {
+		log.Printf("Error removing avatar blob %s: %v", key, err)
+	}
+}
+
+// GetAvatar handles GET "/api/users/{id}/avatar". It is public, so pages
+// can show avatars with <img>.
+func (h *UserHandler) GetAvatar(w http.ResponseWriter, r *http.Request) {
+	u, err := h.users.QueryById(r.Context(), r.PathValue("id"))
+	if err != nil && !errors.Is(err, user.ErrNotFound) {
+		log.Printf("Error getting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to get avatar", "")
+		return
+	}
+	if err != nil || u.AvatarKey() == "" {
+		respondWithError(w, http.StatusNotFound, "Avatar not found", "")
+		return
+	}
+
+	content, err := h.blobs.Get(r.Context(), u.AvatarKey())
+	if err != nil {
+		log.Printf("Error reading avatar blob: %v", err)
+		if errors.Is(err, blob.ErrNotFound) {
+			respondWithError(w, http.StatusNotFound, "Avatar not found", "")
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, "Failed to read avatar", "")
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", u.AvatarType())
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'")
+	// Avatar URLs change with the avatar.
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content); err != nil {
+		log.Printf("Error streaming avatar of %s: %v", u.ID(), err)
+	}
+}
+
+// SearchUsers handles GET "/api/users?q=...&limit=...", finding users by
+// the start of their username or display name, or their whole email, for
+// assignee pickers and @mentions. In workspaces with members, only
+// members are found.
+func (h *UserHandler) SearchUsers(w http.ResponseWriter, r *http.Request) {
+	filter := user.SearchFilter{Query: r.URL.Query().Get("q")}
+	if s := r.URL.Query().Get("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			respondWithError(w, http.StatusBadRequest, "Invalid limit", "limit must be a positive integer")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	if workspaceID, ok := grpcclient.WorkspaceFromContext(r.Context()); ok {
+		members, err := h.taskClient.ListWorkspaceMembers(r.Context(), workspaceID)
+		if err != nil {
+			log.Printf("Error listing workspace members: %v", err)
+			respondWithGRPCError(w, "Failed to search users", err)
+			return
+		}
+		// Workspaces without members are open to everyone.
+		if len(members) > 0 {
+			filter.IDs = make([]string, 0, len(members))
+			for _, m := range members {
+				filter.IDs = append(filter.IDs, m.UserId)
+			}
+		}
+	}
+
+	users, err := h.users.Search(r.Context(), filter)
+	if err != nil {
+		log.Printf("Error searching users: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to search users", "")
+		return
+	}
+
+	resp := ListUsersResponse{Users: make([]UserSummary, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, newUserSummary(u))
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// ExportData handles GET "/api/me/export", returning everything kept about
+// the authenticated user as a JSON download: their profile, how they sign
+// in, and their tasks, time and settings in every workspace.
+func (h *UserHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	claims, _ := UserFromContext(r.Context())
+	u, ok := h.currentUser(w, r, "export data")
+	if !ok {
+		return
+	}
+
+	identities, err := h.users.Identities(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error listing identities: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to export data", "")
+		return
+	}
+	sessions, err := h.sessions.List(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error listing sessions: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to export data", "")
+		return
+	}
+	tokens, err := h.tokens.List(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error listing API tokens: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to export data", "")
+		return
+	}
+
+	data, err := h.taskClient.ExportUserData(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error exporting user data: %v", err)
+		respondWithGRPCError(w, "Failed to export data", err)
+		return
+	}
+	tasks, err := protoMarshaler.Marshal(data)
+	if err != nil {
+		log.Printf("Error encoding user data: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to export data", "")
+		return
+	}
+
+	resp := ExportResponse{
+		ExportedAt: time.Now().UTC(),
+		User:       newUserResponse(u),
+		Identities: make([]IdentityResponse, 0, len(identities)),
+		Sessions:   make([]SessionResponse, 0, len(sessions)),
+		APITokens:  make([]APITokenResponse, 0, len(tokens)),
+		Tasks:      tasks,
+	}
+	for _, id := range identities {
+		resp.Identities = append(resp.Identities, IdentityResponse{
+			Provider:  id.Provider,
+			Subject:   id.Subject,
+			CreatedAt: id.CreatedAt.UTC(),
+		})
+	}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, newSessionResponse(s, claims.SessionID))
+	}
+	for _, t := range tokens {
+		resp.APITokens = append(resp.APITokens, newAPITokenResponse(t))
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="taskboard-export.json"`)
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// DeleteAccount handles DELETE "/api/me", with {"password": "..."} for
+// users with a password; users without one must have signed in within
+// recentSignIn. The user leaves every board and workspace, handing
+// those they own on to their most senior other member, and is anonymised:
+// tasks and time they created stay, credited to "Deleted user". All their
+// sessions and API tokens stop working.
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	var req DeleteAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	u, ok := h.currentUser(w, r, "delete account")
+	if !ok {
+		return
+	}
+	if u.HasPassword() {
+		if !h.guard.checkPassword(w, r, u, req.Password, "Failed to delete account") {
+			return
+		}
+	} else if !h.checkRecentSignIn(w, r, "Failed to delete account") {
+		return
+	}
+
+	// Task data goes first: if anything after fails, the user can still
+	// sign in and try again.
+	if err := h.taskClient.DeleteUserData(r.Context(), u.ID()); err != nil {
+		log.Printf("Error deleting user data: %v", err)
+		respondWithGRPCError(w, "Failed to delete account", err)
+		return
+	}
+
+	avatar, err := h.users.Delete(r.Context(), u.ID())
+	if err != nil {
+		log.Printf("Error deleting user: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to delete account", "")
+		return
+	}
+	h.deleteAvatar(r.Context(), avatar)
+
+	if err := h.sessions.RevokeAll(r.Context(), u.ID(), ""); err != nil {
+		log.Printf("Error revoking sessions: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to log out sessions", "")
+		return
+	}
+	if err := h.tokens.RevokeAll(r.Context(), u.ID()); err != nil {
+		log.Printf("Error revoking API tokens: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to revoke API tokens", "")
+		return
+	}
+	if err := h.mfa.Disable(r.Context(), u.ID()); err != nil {
+		log.Printf("Error disabling MFA: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to remove authenticator", "")
+		return
+	}
+
+	// The event keeps the email the account had, so admins can tell who
+	// it was.
+	h.guard.record(r, audit.Event{Kind: audit.KindAccountDeleted, UserID: u.ID(), Email: u.Email()})
+	log.Printf("Deleted account of user %s", u.ID())
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// checkRecentSignIn checks that the session the request's access token was
+// issued for signed in within recentSignIn, so a token lifted from a
+// password-less user's browser can't do what a password would guard. It
+// responds with msg and returns false if not.
+func (h *UserHandler) checkRecentSignIn(w http.ResponseWriter, r *http.Request, msg string) bool {
+	claims, _ := UserFromContext(r.Context())
+	if claims.SessionID == "" {
+		respondWithError(w, http.StatusForbidden, msg, "sign in again first")
+		return false
+	}
+
+	sess, err := h.sessions.Get(r.Context(), claims.UserId, claims.SessionID)
+	if errors.Is(err, session.ErrNotFound) {
+		respondWithError(w, http.StatusUnauthorized, msg, "the token's session has ended")
+		return false
+	}
+	if err != nil {
+		log.Printf("Error getting session: %v", err)
+		respondWithError(w, http.StatusInternalServerError, msg, "")
+		return false
+	}
+	if time.Since(sess.CreatedAt) > recentSignIn {
+		respondWithError(w, http.StatusForbidden, msg, "sign in again first")
+		return false
+	}
+	return true
+}
+
+// ListAuthEvents handles GET "/api/me/auth-events", returning the
+// authenticated user's sign-ins, failed attempts and account changes,
+// newest first, so they can spot ones that weren't them. It takes the
+// "before" and "limit" query parameters GET "/api/admin/auth-events" does.
+func (h *UserHandler) ListAuthEvents(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseAuthEventFilter(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid query", err.Error())
+		return
+	}
+
+	events, err := h.guard.Events.List(r.Context(), audit.Filter{
+		UserID:   userID(r),
+		BeforeID: filter.BeforeID,
+		Limit:    filter.Limit,
+	})
+	if err != nil {
+		log.Printf("Error listing auth events: %v", err)
+		respondWithError(w, http.StatusInternalServerError, "Failed to list auth events", "")
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, newListAuthEventsResponse(events))
+}
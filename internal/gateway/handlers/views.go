@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// ListSavedViews handles GET "/api/views?board_id=...".
+// It lists the user's own views followed by views others have shared.
+func (h *TaskHandler) ListSavedViews(w http.ResponseWriter, r *http.Request) {
+	userID := userID(r)
+	boardID := parseInt64Query(r, "board_id", 0)
+
+	views, err := h.taskClient.ListSavedViews(r.Context(), userID, boardID)
+	if err != nil {
+		log.Printf("Error listing saved views: %v", err)
+		respondWithGRPCError(w, "Failed to list saved views", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListSavedViewsResponse{Views: views})
+}
+
+// CreateSavedView handles POST "/api/views".
+func (h *TaskHandler) CreateSavedView(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateSavedViewRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.OwnerId = userID(r)
+
+	view, err := h.taskClient.CreateSavedView(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating saved view: %v", err)
+		respondWithGRPCError(w, "Failed to create saved view", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, view)
+}
+
+// GetSavedView handles GET "/api/views/{id}".
+func (h *TaskHandler) GetSavedView(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid view ID", err.Error())
+		return
+	}
+
+	view, err := h.taskClient.GetSavedView(r.Context(), id, userID(r))
+	if err != nil {
+		log.Printf("Error getting saved view: %v", err)
+		respondWithGRPCError(w, "Failed to get saved view", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, view)
+}
+
+// UpdateSavedView handles PUT "/api/views/{id}".
+func (h *TaskHandler) UpdateSavedView(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid view ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateSavedViewRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.Id = id
+	req.UserId = userID(r)
+
+	view, err := h.taskClient.UpdateSavedView(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating saved view: %v", err)
+		respondWithGRPCError(w, "Failed to update saved view", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, view)
+}
+
+// DeleteSavedView handles DELETE "/api/views/{id}".
+func (h *TaskHandler) DeleteSavedView(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid view ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteSavedView(r.Context(), id, userID(r)); err != nil {
+		log.Printf("Error deleting saved view: %v", err)
+		respondWithGRPCError(w, "Failed to delete saved view", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListViewTasks handles GET "/api/views/{id}/tasks". It takes the same
+// query parameters as ListTasks; q narrows the view further.
+func (h *TaskHandler) ListViewTasks(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid view ID", err.Error())
+		return
+	}
+
+	h.listTasks(w, r, id)
+}
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// webhookDeliveryStatuses are the values of the deliveries "status" filter.
+var webhookDeliveryStatuses = map[string]pb.WebhookDeliveryStatus{
+	"pending":   pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+	"succeeded": pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+	"dead":      pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+}
+
+// ListWebhooks handles GET "/api/webhooks".
+func (h *TaskHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.taskClient.ListWebhooks(r.Context(), userID(r))
+	if err != nil {
+		log.Printf("Error listing webhooks: %v", err)
+		respondWithGRPCError(w, "Failed to list webhooks", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListWebhooksResponse{Webhooks: webhooks})
+}
+
+// CreateWebhook handles POST "/api/webhooks". The response holds the
+// signing secret, which can't be retrieved later.
+func (h *TaskHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateWebhookRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.OwnerId = userID(r)
+
+	resp, err := h.taskClient.CreateWebhook(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating webhook: %v", err)
+		respondWithGRPCError(w, "Failed to create webhook", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, resp)
+}
+
+// GetWebhook handles GET "/api/webhooks/{id}".
+func (h *TaskHandler) GetWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid webhook ID", err.Error())
+		return
+	}
+
+	webhook, err := h.taskClient.GetWebhook(r.Context(), id, userID(r))
+	if err != nil {
+		log.Printf("Error getting webhook: %v", err)
+		respondWithGRPCError(w, "Failed to get webhook", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, webhook)
+}
+
+// UpdateWebhook handles PUT "/api/webhooks/{id}".
+func (h *TaskHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid webhook ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateWebhookRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.Id = id
+	req.UserId = userID(r)
+
+	resp, err := h.taskClient.UpdateWebhook(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating webhook: %v", err)
+		respondWithGRPCError(w, "Failed to update webhook", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
+
+// DeleteWebhook handles DELETE "/api/webhooks/{id}".
+func (h *TaskHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid webhook ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.DeleteWebhook(r.Context(), id, userID(r)); err != nil {
+		log.Printf("Error deleting webhook: %v", err)
+		respondWithGRPCError(w, "Failed to delete webhook", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries handles
+// GET "/api/webhooks/{id}/deliveries?status=...&page=...&page_size=...".
+func (h *TaskHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid webhook ID", err.Error())
+		return
+	}
+
+	req := &pb.ListWebhookDeliveriesRequest{
+		WebhookId:  id,
+		UserId:     userID(r),
+		PageSize:   parseInt32Query(r, "page_size", 50),
+		PageNumber: parseInt32Query(r, "page", 1),
+	}
+	if s := r.URL.Query().Get("status"); s != "" {
+		status, ok := webhookDeliveryStatuses[s]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "Invalid status",
+				fmt.Sprintf("status must be pending, succeeded or dead, got %q", s))
+			return
+		}
+		req.Status = status
+	}
+
+	resp, err := h.taskClient.ListWebhookDeliveries(r.Context(), req)
+	if err != nil {
+		log.Printf("Error listing webhook deliveries: %v", err)
+		respondWithGRPCError(w, "Failed to list webhook deliveries", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, resp)
+}
+
+// RedeliverWebhookDelivery handles
+// POST "/api/webhooks/{id}/deliveries/{delivery_id}/redeliver".
+func (h *TaskHandler) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	id, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid webhook ID", err.Error())
+		return
+	}
+	deliveryID, err := pathInt64(r, "delivery_id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid delivery ID", err.Error())
+		return
+	}
+
+	delivery, err := h.taskClient.RedeliverWebhookDelivery(r.Context(), &pb.RedeliverWebhookDeliveryRequest{
+		WebhookId:  id,
+		DeliveryId: deliveryID,
+		UserId:     userID(r),
+	})
+	if err != nil {
+		log.Printf("Error redelivering webhook delivery: %v", err)
+		respondWithGRPCError(w, "Failed to redeliver webhook delivery", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusAccepted, delivery)
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// ListWorkspaces handles GET "/api/workspaces".
+func (h *TaskHandler) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	workspaces, err := h.taskClient.ListWorkspaces(r.Context())
+	if err != nil {
+		log.Printf("Error listing workspaces: %v", err)
+		respondWithGRPCError(w, "Failed to list workspaces", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListWorkspacesResponse{Workspaces: workspaces})
+}
+
+// CreateWorkspace handles POST "/api/workspaces" with
+// {"name": "...", "slug": "..."}. The caller becomes its owner.
+func (h *TaskHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var req pb.CreateWorkspaceRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+
+	workspace, err := h.taskClient.CreateWorkspace(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error creating workspace: %v", err)
+		respondWithGRPCError(w, "Failed to create workspace", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, workspace)
+}
+
+// UpdateWorkspace handles PUT "/api/workspaces/{id}" with
+// {"require_mfa": true}, changing a workspace's settings. Only owners
+// update workspaces.
+func (h *TaskHandler) UpdateWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", err.Error())
+		return
+	}
+
+	var req pb.UpdateWorkspaceRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.WorkspaceId = workspaceID
+
+	workspace, err := h.taskClient.UpdateWorkspace(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error updating workspace: %v", err)
+		respondWithGRPCError(w, "Failed to update workspace", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, workspace)
+}
+
+// ListWorkspaceMembers handles GET "/api/workspaces/{id}/members".
+func (h *TaskHandler) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", err.Error())
+		return
+	}
+
+	members, err := h.taskClient.ListWorkspaceMembers(r.Context(), workspaceID)
+	if err != nil {
+		log.Printf("Error listing workspace members: %v", err)
+		respondWithGRPCError(w, "Failed to list workspace members", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusOK, &pb.ListWorkspaceMembersResponse{Members: members})
+}
+
+// AddWorkspaceMember handles POST "/api/workspaces/{id}/members" with
+// {"user_id": "...", "role": "WORKSPACE_ROLE_MEMBER"}.
+func (h *TaskHandler) AddWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", err.Error())
+		return
+	}
+
+	var req pb.AddWorkspaceMemberRequest
+	if err := decodeProto(r, &req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body", err.Error())
+		return
+	}
+	req.WorkspaceId = workspaceID
+
+	member, err := h.taskClient.AddWorkspaceMember(r.Context(), &req)
+	if err != nil {
+		log.Printf("Error adding workspace member: %v", err)
+		respondWithGRPCError(w, "Failed to add workspace member", err)
+		return
+	}
+
+	respondWithProto(w, http.StatusCreated, member)
+}
+
+// RemoveWorkspaceMember handles DELETE "/api/workspaces/{id}/members/{user_id}".
+func (h *TaskHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := pathInt64(r, "id")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid workspace ID", err.Error())
+		return
+	}
+
+	if err := h.taskClient.RemoveWorkspaceMember(r.Context(), workspaceID, r.PathValue("user_id")); err != nil {
+		log.Printf("Error removing workspace member: %v", err)
+		respondWithGRPCError(w, "Failed to remove workspace member", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -28,6 +29,29 @@ type Client struct {
 
 	// Buffered channel for outbound messages.
 	Send chan []byte
+
+	// The user the client acts for, if any. It gets their inbox
+	// notifications, and events of the boards and tasks they can see and
+	// it subscribes to.
+	UserID string
+
+	// Whether the user signed in with a second factor, which some boards'
+	// events require.
+	MFA bool
+
+	// The workspace the client gets events of.
+	WorkspaceID int64
+
+	// Active reports whether the session or API token the client connected
+	// with is still valid. The hub closes clients whose credentials were
+	// revoked since. May be nil.
+	Active func(ctx context.Context) (bool, error)
+
+	// Boards, tasks and saved views the client subscribed to, by ID.
+	// Guarded by Hub.mu.
+	boards map[int64]bool
+	tasks  map[int64]bool
+	views  map[int64]*viewSubscription
 }
 
 // ReadMsgFromWebSocket reads messages from websocket and sends them to hub.
@@ -54,8 +78,7 @@ func (c *Client) ReadMsgFromWebSocket() {
 			break
 		}
 
-		// For now, just log client messages.
-		log.Printf("Received from client: %s", message)
+		c.Hub.handleMessage(c, message)
 	}
 }
 
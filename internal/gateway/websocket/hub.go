@@ -1,20 +1,31 @@
 package websocket
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/nats-io/nats.go"
 )
 
+// revalidateInterval is how often the hub checks that clients'
+// credentials weren't revoked.
+const revalidateInterval = time.Minute
+
 // Hub represents internal state of active clients and what messages to broadcast to them.
 type Hub struct {
-	// Active connections.
+	// Active connections, and those of each user.
 	clients map[*Client]bool
+	users   map[string]map[*Client]bool
 
 	// Queue for messages to send.
 	broadcast chan []byte
 
+	// Queue for inbox events, sent only to their user's clients.
+	inbox chan []byte
+
 	// Queue for new clients.
 	Register chan *Client
 
@@ -23,59 +34,203 @@ type Hub struct {
 
 	nats *nats.Conn
 
-	// Protect concurrent access to clients map.
+	// Loads saved views for view subscriptions. May be nil.
+	views ViewSource
+
+	// Loads boards and tasks for board and task subscriptions. May be nil.
+	boards BoardSource
+
+	// Clients subscribed to each board and task, and those following saved
+	// views. Only they get task events.
+	boardClients map[int64]map[*Client]bool
+	taskClients  map[int64]map[*Client]bool
+	viewClients  map[*Client]bool
+
+	// Protect concurrent access to clients map and client subscriptions.
 	mu sync.RWMutex
 }
 
-func NewHub(nc *nats.Conn) *Hub {
+func NewHub(nc *nats.Conn, views ViewSource, boards BoardSource) *Hub {
 	return &Hub{
-		broadcast:  make(chan []byte, 256),
-		Register:   make(chan *Client),
-		unregister: make(chan *Client),
-		clients:    make(map[*Client]bool),
-		nats:       nc,
+		broadcast:    make(chan []byte, 256),
+		inbox:        make(chan []byte, 256),
+		Register:     make(chan *Client),
+		unregister:   make(chan *Client),
+		clients:      make(map[*Client]bool),
+		users:        make(map[string]map[*Client]bool),
+		nats:         nc,
+		views:        views,
+		boards:       boards,
+		boardClients: make(map[int64]map[*Client]bool),
+		taskClients:  make(map[int64]map[*Client]bool),
+		viewClients:  make(map[*Client]bool),
 	}
 }
 
 // Run starts the hub's main loop.
 func (h *Hub) Run() {
-	h.nats.Subscribe("tasks.>", func(msg *nats.Msg) {
-		log.Printf("📨 Received NATS event on %s, broadcasting to %d clients", msg.Subject, len(h.clients))
+	h.nats.Subscribe("workspaces.*.tasks.>", func(msg *nats.Msg) {
+		log.Printf("📨 Received NATS event on %s", msg.Subject)
 		h.broadcast <- msg.Data
 	})
 
-	log.Println("✅ Hub subscribed to tasks.* events")
+	log.Println("✅ Hub subscribed to workspaces.*.tasks.* events")
+
+	go func() {
+		for range time.Tick(revalidateInterval) {
+			h.revalidate(context.Background())
+		}
+	}()
+
+	h.nats.Subscribe("workspaces.*.inbox.notifications", func(msg *nats.Msg) {
+		h.inbox <- msg.Data
+	})
 
 	for {
 		select {
 		case client := <-h.Register:
-			h.mu.Lock()
-			h.clients[client] = true
-			h.mu.Unlock()
-			log.Printf("➕ Client connected. Total clients: %d", len(h.clients))
+			h.connect(client)
 
 		case client := <-h.unregister:
-			h.mu.Lock()
-			if _, ok := h.clients[client]; ok {
-				delete(h.clients, client)
-				close(client.Send)
-			}
-			h.mu.Unlock()
-			log.Printf("➖ Client disconnected. Total clients: %d", len(h.clients))
+			h.disconnect(client)
 
 		case message := <-h.broadcast:
-			h.mu.RLock()
-			for client := range h.clients {
+			h.deliver(message)
+
+		case message := <-h.inbox:
+			var event struct {
+				UserID      string `json:"user_id"`
+				WorkspaceID int64  `json:"workspace_id"`
+			}
+			if err := json.Unmarshal(message, &event); err != nil || event.UserID == "" {
+				log.Printf("Invalid inbox event: %s", message)
+				continue
+			}
+
+			h.mu.Lock()
+			for client := range h.users[event.UserID] {
+				if client.WorkspaceID != event.WorkspaceID {
+					continue
+				}
 				select {
 				case client.Send <- message:
-					// Message sent successfully.
 				default:
-					// Clients send buffer full. Closing.
-					close(client.Send)
-					delete(h.clients, client)
+					h.remove(client)
 				}
 			}
-			h.mu.RUnlock()
+			h.mu.Unlock()
+		}
+	}
+}
+
+// connect adds a client.
+func (h *Hub) connect(client *Client) {
+	h.mu.Lock()
+	h.clients[client] = true
+	if client.UserID != "" {
+		if h.users[client.UserID] == nil {
+			h.users[client.UserID] = map[*Client]bool{}
+		}
+		h.users[client.UserID][client] = true
+	}
+	total := len(h.clients)
+	h.mu.Unlock()
+	log.Printf("➕ Client connected. Total clients: %d", total)
+}
+
+// disconnect removes a client that left, unless it was already removed.
+func (h *Hub) disconnect(client *Client) {
+	h.mu.Lock()
+	if _, ok := h.clients[client]; ok {
+		h.remove(client)
+	}
+	total := len(h.clients)
+	h.mu.Unlock()
+	log.Printf("➖ Client disconnected. Total clients: %d", total)
+}
+
+// revalidate closes the clients whose credentials were revoked since they
+// connected. Clients whose credentials can't be checked are kept.
+func (h *Hub) revalidate(ctx context.Context) {
+	h.mu.RLock()
+	var clients []*Client
+	for client := range h.clients {
+		if client.Active != nil {
+			clients = append(clients, client)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, client := range clients {
+		active, err := client.Active(ctx)
+		if err != nil {
+			log.Printf("Failed to check websocket client credentials: %v", err)
+			continue
+		}
+		if !active {
+			h.disconnect(client)
+		}
+	}
+}
+
+// deliver sends a task event to the clients subscribed to its board or
+// task, and to those following saved views it belongs to.
+func (h *Hub) deliver(message []byte) {
+	// Decoded once, for its audience and saved views. Events that can't be
+	// decoded don't say who may see them, so are dropped. Only clients
+	// subscribed to the event's board or task, or following a saved view,
+	// are considered.
+	var event taskEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		log.Printf("Invalid task event: %v", err)
+		return
+	}
+	now := time.Now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for client := range h.audience(&event) {
+		if client.WorkspaceID != event.WorkspaceID || !event.visibleTo(client) {
+			continue
+		}
+
+		var messages [][]byte
+		if client.boards[event.BoardID] || client.tasks[event.TaskID] {
+			messages = append(messages, message)
+		}
+		for _, sub := range client.views {
+			if msg := sub.message(&event, message, now); msg != nil {
+				messages = append(messages, msg)
+			}
+		}
+
+		for _, msg := range messages {
+			select {
+			case client.Send <- msg:
+				// Message sent successfully.
+				continue
+			default:
+				// Clients send buffer full. Closing.
+				h.remove(client)
+			}
+			break
+		}
+	}
+	if event.Type == "deleted" {
+		h.forgetTask(event.TaskID)
+	}
+}
+
+// remove forgets a client and its subscriptions, and closes its send
+// channel. h.mu must be held.
+func (h *Hub) remove(client *Client) {
+	delete(h.clients, client)
+	h.unsubscribeAll(client)
+	if clients := h.users[client.UserID]; clients != nil {
+		delete(clients, client)
+		if len(clients) == 0 {
+			delete(h.users, client.UserID)
 		}
 	}
+	close(client.Send)
 }
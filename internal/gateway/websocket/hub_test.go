@@ -0,0 +1,269 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+// fakeBoards lets clients see every board and task except the hidden one.
+type fakeBoards struct {
+	hidden int64
+}
+
+func (f fakeBoards) GetBoard(ctx context.Context, id int64) (*pb.Board, error) {
+	if id == f.hidden {
+		return nil, status.Error(codes.NotFound, "board not found")
+	}
+	return &pb.Board{Id: id}, nil
+}
+
+func (f fakeBoards) GetTask(ctx context.Context, id int64) (*pb.Task, error) {
+	if id == f.hidden {
+		return nil, status.Error(codes.NotFound, "task not found")
+	}
+	return &pb.Task{Id: id}, nil
+}
+
+// connectClient connects a client of userID to h in workspace 1.
+func connectClient(h *Hub, userID string) *Client {
+	c := &Client{Hub: h, Send: make(chan []byte, 256), UserID: userID, WorkspaceID: 1}
+	h.connect(c)
+	return c
+}
+
+// nextReply returns the next message sent to c, failing if there is none.
+func nextReply(t *testing.T, c *Client) serverMessage {
+	t.Helper()
+
+	select {
+	case data := <-c.Send:
+		var msg serverMessage
+		if err := json.Unmarshal(data, &msg); err != nil {
+			t.Fatalf("failed to decode reply %s: %v", data, err)
+		}
+		return msg
+	default:
+		t.Fatal("expected a reply")
+		return serverMessage{}
+	}
+}
+
+// received returns how many messages are waiting for c.
+func received(c *Client) int {
+	n := 0
+	for {
+		select {
+		case <-c.Send:
+			n++
+		default:
+			return n
+		}
+	}
+}
+
+func taskEventMessage(t *testing.T, e taskEvent) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("failed to encode event: %v", err)
+	}
+	return data
+}
+
+func TestDeliverToSubscribers(t *testing.T) {
+	h := NewHub(nil, nil, fakeBoards{})
+	boardClient := connectClient(h, "alice")
+	taskClient := connectClient(h, "bob")
+	idle := connectClient(h, "carol")
+
+	h.subscribeBoard(boardClient, 1)
+	h.subscribeTask(taskClient, 7)
+	for _, c := range []*Client{boardClient, taskClient} {
+		if reply := nextReply(t, c); reply.Type != "subscribed" {
+			t.Fatalf("expected subscribed, got %+v", reply)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		event taskEvent
+		want  map[*Client]int
+	}{
+		{"board event", taskEvent{Type: "created", TaskID: 5, BoardID: 1, WorkspaceID: 1, OpenBoard: true},
+			map[*Client]int{boardClient: 1}},
+		{"task event", taskEvent{Type: "updated", TaskID: 7, BoardID: 2, WorkspaceID: 1, OpenBoard: true},
+			map[*Client]int{taskClient: 1}},
+		{"task event on subscribed board", taskEvent{Type: "updated", TaskID: 7, BoardID: 1, WorkspaceID: 1, OpenBoard: true},
+			map[*Client]int{boardClient: 1, taskClient: 1}},
+		{"other workspace", taskEvent{Type: "created", TaskID: 5, BoardID: 1, WorkspaceID: 2, OpenBoard: true},
+			map[*Client]int{}},
+		{"not a member", taskEvent{Type: "created", TaskID: 5, BoardID: 1, WorkspaceID: 1, MemberIDs: []string{"bob"}},
+			map[*Client]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h.deliver(taskEventMessage(t, tt.event))
+			for _, c := range []*Client{boardClient, taskClient, idle} {
+				if got := received(c); got != tt.want[c] {
+					t.Errorf("expected %s to get %d messages, got %d", c.UserID, tt.want[c], got)
+				}
+			}
+		})
+	}
+
+	t.Log("✅ Events reach only subscribed clients")
+}
+
+func TestSubscribeHidden(t *testing.T) {
+	h := NewHub(nil, nil, fakeBoards{hidden: 3})
+	c := connectClient(h, "alice")
+
+	h.subscribeBoard(c, 3)
+	if reply := nextReply(t, c); reply.Type != "error" || reply.Message != "board not found" {
+		t.Errorf("expected board not found, got %+v", reply)
+	}
+	if len(h.boardClients) != 0 {
+		t.Errorf("expected no board subscriptions, got %v", h.boardClients)
+	}
+
+	t.Log("✅ Correctly refused subscription to a board the user can't see")
+}
+
+func TestUnsubscribe(t *testing.T) {
+	h := NewHub(nil, nil, fakeBoards{})
+	c := connectClient(h, "alice")
+	other := connectClient(h, "bob")
+
+	h.subscribeBoard(c, 1)
+	h.subscribeBoard(other, 1)
+	h.subscribeTask(c, 7)
+	received(c)
+	received(other)
+
+	t.Run("unsubscribe", func(t *testing.T) {
+		h.unsubscribeBoard(c, 1)
+		h.unsubscribeTask(c, 7)
+
+		if clients := h.boardClients[1]; len(clients) != 1 || !clients[other] {
+			t.Errorf("expected only the other client on board 1, got %v", clients)
+		}
+		if _, ok := h.taskClients[7]; ok {
+			t.Errorf("expected task 7 dropped from the index, got %v", h.taskClients[7])
+		}
+		if len(c.boards) != 0 || len(c.tasks) != 0 {
+			t.Errorf("expected no subscriptions left, got boards %v and tasks %v", c.boards, c.tasks)
+		}
+
+		h.deliver(taskEventMessage(t, taskEvent{Type: "updated", TaskID: 7, BoardID: 1, WorkspaceID: 1, OpenBoard: true}))
+		if got := received(c) - 2; got != 0 { // The two unsubscribed replies.
+			t.Errorf("expected no events after unsubscribing, got %d", got)
+		}
+
+		t.Log("✅ Unsubscribing cleans the index")
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		h.disconnect(other)
+
+		if len(h.boardClients) != 0 || len(h.taskClients) != 0 {
+			t.Errorf("expected empty indexes, got boards %v and tasks %v", h.boardClients, h.taskClients)
+		}
+		if _, ok := h.clients[other]; ok {
+			t.Error("expected client removed")
+		}
+		if _, ok := h.users["bob"]; ok {
+			t.Error("expected user's clients removed")
+		}
+		// The event delivered above may still be buffered.
+		closed := false
+		for !closed {
+			select {
+			case _, open := <-other.Send:
+				closed = !open
+			default:
+				t.Fatal("expected send channel closed")
+			}
+		}
+
+		t.Log("✅ Disconnecting cleans the index")
+	})
+
+	t.Run("deleted task", func(t *testing.T) {
+		h.subscribeTask(c, 8)
+		received(c)
+
+		h.deliver(taskEventMessage(t, taskEvent{Type: "deleted", TaskID: 8, BoardID: 1, WorkspaceID: 1, OpenBoard: true}))
+		if _, ok := h.taskClients[8]; ok || c.tasks[8] {
+			t.Error("expected deleted task's subscriptions dropped")
+		}
+
+		t.Log("✅ Deleted tasks' subscriptions are dropped")
+	})
+}
+
+func TestSubscriptionLimit(t *testing.T) {
+	h := NewHub(nil, nil, fakeBoards{})
+	c := connectClient(h, "alice")
+
+	for id := int64(1); id <= maxSubscriptions; id++ {
+		h.subscribeBoard(c, id)
+		if reply := nextReply(t, c); reply.Type != "subscribed" {
+			t.Fatalf("expected subscribed to board %d, got %+v", id, reply)
+		}
+	}
+
+	h.subscribeTask(c, 1)
+	if reply := nextReply(t, c); reply.Type != "error" || reply.Message != "too many subscriptions" {
+		t.Errorf("expected too many subscriptions, got %+v", reply)
+	}
+
+	h.subscribeBoard(c, 1)
+	if reply := nextReply(t, c); reply.Type != "subscribed" {
+		t.Errorf("expected renewed subscription to succeed, got %+v", reply)
+	}
+
+	t.Log("✅ Subscriptions are limited, but renewing one isn't refused")
+}
+
+func TestRevalidate(t *testing.T) {
+	h := NewHub(nil, nil, fakeBoards{})
+	active := connectClient(h, "alice")
+	revoked := connectClient(h, "bob")
+	unchecked := connectClient(h, "carol")
+	anonymous := connectClient(h, "")
+
+	active.Active = func(ctx context.Context) (bool, error) { return true, nil }
+	revoked.Active = func(ctx context.Context) (bool, error) { return false, nil }
+	unchecked.Active = func(ctx context.Context) (bool, error) { return false, errors.New("db down") }
+
+	h.subscribeBoard(revoked, 1)
+	received(revoked)
+
+	h.revalidate(context.Background())
+
+	if _, ok := h.clients[revoked]; ok {
+		t.Error("expected revoked client removed")
+	}
+	if len(h.boardClients) != 0 {
+		t.Errorf("expected revoked client's subscriptions dropped, got %v", h.boardClients)
+	}
+	if _, open := <-revoked.Send; open {
+		t.Error("expected revoked client's send channel closed")
+	}
+	for _, c := range []*Client{active, unchecked, anonymous} {
+		if _, ok := h.clients[c]; !ok {
+			t.Errorf("expected client of %q kept", c.UserID)
+		}
+	}
+
+	t.Log("✅ Clients whose credentials were revoked are closed")
+}
@@ -0,0 +1,204 @@
+package websocket
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/gateway/grpcclient"
+)
+
+// maxSubscriptions caps the boards, tasks and saved views one client may
+// subscribe to.
+const maxSubscriptions = 100
+
+// BoardSource loads the boards and tasks clients subscribe to, as their
+// user, so clients only subscribe to what they can see.
+type BoardSource interface {
+	GetBoard(ctx context.Context, id int64) (*pb.Board, error)
+	GetTask(ctx context.Context, id int64) (*pb.Task, error)
+}
+
+// clientContext returns a context for calls to the task service on behalf
+// of c's user, and its cancel function.
+func clientContext(c *Client) (context.Context, context.CancelFunc) {
+	ctx := grpcclient.WithWorkspace(grpcclient.WithCaller(context.Background(), c.UserID), c.WorkspaceID)
+	if c.MFA {
+		ctx = grpcclient.WithMFA(ctx)
+	}
+	return context.WithTimeout(ctx, 5*time.Second)
+}
+
+// subscriptionError is the error reply to a subscription the task service
+// refused. Things the user can't see are not found, so their existence
+// doesn't leak.
+func subscriptionError(what string, err error) string {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return what + " not found"
+	case codes.PermissionDenied, codes.InvalidArgument:
+		return status.Convert(err).Message()
+	}
+	return "failed to subscribe to " + what
+}
+
+// subscriptions returns how many things c is subscribed to. h.mu must be
+// held.
+func (c *Client) subscriptions() int {
+	return len(c.boards) + len(c.tasks) + len(c.views)
+}
+
+// subscribeBoard subscribes c to the events of a board it can see.
+func (h *Hub) subscribeBoard(c *Client, boardID int64) {
+	reply := serverMessage{BoardID: boardID}
+	h.subscribe(c, "board", boardID, reply, func(ctx context.Context) error {
+		_, err := h.boards.GetBoard(ctx, boardID)
+		return err
+	}, func() bool {
+		return c.boards[boardID]
+	}, func() {
+		if c.boards == nil {
+			c.boards = map[int64]bool{}
+		}
+		c.boards[boardID] = true
+		addIndex(h.boardClients, boardID, c)
+	})
+}
+
+// subscribeTask subscribes c to the events of a task it can see.
+func (h *Hub) subscribeTask(c *Client, taskID int64) {
+	reply := serverMessage{TaskID: taskID}
+	h.subscribe(c, "task", taskID, reply, func(ctx context.Context) error {
+		_, err := h.boards.GetTask(ctx, taskID)
+		return err
+	}, func() bool {
+		return c.tasks[taskID]
+	}, func() {
+		if c.tasks == nil {
+			c.tasks = map[int64]bool{}
+		}
+		c.tasks[taskID] = true
+		addIndex(h.taskClients, taskID, c)
+	})
+}
+
+// subscribe checks with load that c may see the kind of thing with the
+// given ID, then adds the subscription with add, under h.mu, and replies.
+// Renewing a subscription, which subscribed reports under h.mu, doesn't
+// count towards maxSubscriptions. Clients that already left are ignored.
+func (h *Hub) subscribe(c *Client, kind string, id int64, reply serverMessage,
+	load func(context.Context) error, subscribed func() bool, add func(),
+) {
+	fail := func(msg string) {
+		reply.Type, reply.Message = "error", msg
+		h.reply(c, reply)
+	}
+	if id <= 0 {
+		fail(kind + "_id is required")
+		return
+	}
+	if h.boards == nil {
+		fail(kind + "s are not available")
+		return
+	}
+
+	ctx, cancel := clientContext(c)
+	err := load(ctx)
+	cancel()
+	if err != nil {
+		log.Printf("Error loading %s %d for subscription: %v", kind, id, err)
+		fail(subscriptionError(kind, err))
+		return
+	}
+
+	h.mu.Lock()
+	_, connected := h.clients[c]
+	full := !subscribed() && c.subscriptions() >= maxSubscriptions
+	if connected && !full {
+		add()
+	}
+	h.mu.Unlock()
+
+	switch {
+	case !connected:
+	case full:
+		fail("too many subscriptions")
+	default:
+		reply.Type = "subscribed"
+		h.reply(c, reply)
+	}
+}
+
+// unsubscribeBoard stops c getting a board's events.
+func (h *Hub) unsubscribeBoard(c *Client, boardID int64) {
+	h.mu.Lock()
+	delete(c.boards, boardID)
+	removeIndex(h.boardClients, boardID, c)
+	h.mu.Unlock()
+	h.reply(c, serverMessage{Type: "unsubscribed", BoardID: boardID})
+}
+
+// unsubscribeTask stops c getting a task's events.
+func (h *Hub) unsubscribeTask(c *Client, taskID int64) {
+	h.mu.Lock()
+	delete(c.tasks, taskID)
+	removeIndex(h.taskClients, taskID, c)
+	h.mu.Unlock()
+	h.reply(c, serverMessage{Type: "unsubscribed", TaskID: taskID})
+}
+
+// audience returns the clients subscribed to e's board or task, or
+// following a saved view. h.mu must be held.
+func (h *Hub) audience(e *taskEvent) map[*Client]bool {
+	clients := map[*Client]bool{}
+	for c := range h.boardClients[e.BoardID] {
+		clients[c] = true
+	}
+	for c := range h.taskClients[e.TaskID] {
+		clients[c] = true
+	}
+	for c := range h.viewClients {
+		clients[c] = true
+	}
+	return clients
+}
+
+// forgetTask drops subscriptions to a deleted task. h.mu must be held.
+func (h *Hub) forgetTask(taskID int64) {
+	for c := range h.taskClients[taskID] {
+		delete(c.tasks, taskID)
+	}
+	delete(h.taskClients, taskID)
+}
+
+// unsubscribeAll drops all of c's subscriptions from the hub's indexes.
+// h.mu must be held.
+func (h *Hub) unsubscribeAll(c *Client) {
+	for id := range c.boards {
+		removeIndex(h.boardClients, id, c)
+	}
+	for id := range c.tasks {
+		removeIndex(h.taskClients, id, c)
+	}
+	delete(h.viewClients, c)
+}
+
+func addIndex(index map[int64]map[*Client]bool, id int64, c *Client) {
+	if index[id] == nil {
+		index[id] = map[*Client]bool{}
+	}
+	index[id][c] = true
+}
+
+func removeIndex(index map[int64]map[*Client]bool, id int64, c *Client) {
+	if clients := index[id]; clients != nil {
+		delete(clients, c)
+		if len(clients) == 0 {
+			delete(index, id)
+		}
+	}
+}
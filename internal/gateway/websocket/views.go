@@ -0,0 +1,216 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+	"time"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
+)
+
+// ViewSource loads the saved views clients subscribe to.
+type ViewSource interface {
+	GetSavedView(ctx context.Context, id int64, userID string) (*pb.SavedView, error)
+}
+
+// clientMessage is a request sent by a client over the WebSocket. Clients
+// only get the task events of boards and tasks they subscribe to:
+//
+//	{"action": "subscribe_board", "board_id": 1}
+//	{"action": "unsubscribe_board", "board_id": 1}
+//	{"action": "subscribe_task", "task_id": 42}
+//	{"action": "unsubscribe_task", "task_id": 42}
+//	{"action": "subscribe_view", "view_id": 3}
+//	{"action": "unsubscribe_view", "view_id": 3}
+type clientMessage struct {
+	Action  string `json:"action"`
+	BoardID int64  `json:"board_id"`
+	TaskID  int64  `json:"task_id"`
+	ViewID  int64  `json:"view_id"`
+}
+
+// serverMessage answers a clientMessage, naming what it subscribed to.
+type serverMessage struct {
+	Type    string `json:"type"` // "subscribed", "unsubscribed" or "error".
+	BoardID int64  `json:"board_id,omitempty"`
+	TaskID  int64  `json:"task_id,omitempty"`
+	ViewID  int64  `json:"view_id,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// viewEvent tells a view subscriber that a task event concerns the view's
+// board. Match says whether the task is now in the view; clients drop tasks
+// they hold when it is false.
+type viewEvent struct {
+	Type   string          `json:"type"` // Always "view_event".
+	ViewID int64           `json:"view_id"`
+	Match  bool            `json:"match"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// viewSubscription is a client's subscription to one saved view. The query
+// is parsed per event so "me" and relative dates stay current.
+type viewSubscription struct {
+	view   *pb.SavedView
+	userID string
+}
+
+// taskEvent is the task service's NATS event, as far as the hub needs it.
+type taskEvent struct {
+	Type        string     `json:"type"`
+	TaskID      int64      `json:"task_id"`
+	BoardID     int64      `json:"board_id"`
+	Title       string     `json:"title"`
+	Completed   *bool      `json:"completed"`
+	Description string     `json:"description"`
+	Labels      []string   `json:"labels"`
+	AssigneeIDs []string   `json:"assignee_ids"`
+	DueAt       *time.Time `json:"due_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	WorkspaceID int64      `json:"workspace_id"`
+
+	OpenBoard  bool     `json:"open_board"`
+	MemberIDs  []string `json:"member_ids"`
+	RequireMFA bool     `json:"require_mfa"`
+}
+
+// visibleTo reports whether c may see e: everyone may see events of open
+// boards, and only members those of other boards. Events of boards that
+// require a second factor need clients that signed in with one.
+func (e *taskEvent) visibleTo(c *Client) bool {
+	if e.RequireMFA && !c.MFA {
+		return false
+	}
+	return e.OpenBoard || (c.UserID != "" && slices.Contains(e.MemberIDs, c.UserID))
+}
+
+func (e *taskEvent) task() taskquery.Task {
+	return taskquery.Task{
+		Title:       e.Title,
+		Description: e.Description,
+		Completed:   e.Completed != nil && *e.Completed,
+		Labels:      e.Labels,
+		AssigneeIDs: e.AssigneeIDs,
+		DueAt:       e.DueAt,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
+
+// message returns the view_event for e, or nil if e is outside the view.
+func (sub *viewSubscription) message(e *taskEvent, raw []byte, now time.Time) []byte {
+	if sub.view.BoardId != 0 && sub.view.BoardId != e.BoardID {
+		return nil
+	}
+
+	q, err := taskquery.Parse(sub.view.Query, taskquery.Env{Now: now, UserID: sub.userID})
+	if err != nil {
+		log.Printf("Saved view %d has an invalid query: %v", sub.view.Id, err)
+		return nil
+	}
+
+	data, err := json.Marshal(viewEvent{
+		Type:   "view_event",
+		ViewID: sub.view.Id,
+		Match:  e.Type != "deleted" && q.Match(e.task()),
+		Event:  raw,
+	})
+	if err != nil {
+		log.Printf("Error encoding view event: %v", err)
+		return nil
+	}
+	return data
+}
+
+// handleMessage acts on a message read from c.
+func (h *Hub) handleMessage(c *Client, data []byte) {
+	var msg clientMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		h.reply(c, serverMessage{Type: "error", Message: "invalid message: " + err.Error()})
+		return
+	}
+
+	switch msg.Action {
+	case "subscribe_board":
+		h.subscribeBoard(c, msg.BoardID)
+
+	case "unsubscribe_board":
+		h.unsubscribeBoard(c, msg.BoardID)
+
+	case "subscribe_task":
+		h.subscribeTask(c, msg.TaskID)
+
+	case "unsubscribe_task":
+		h.unsubscribeTask(c, msg.TaskID)
+
+	case "subscribe_view":
+		if h.views == nil {
+			h.reply(c, serverMessage{Type: "error", ViewID: msg.ViewID, Message: "views are not available"})
+			return
+		}
+
+		ctx, cancel := clientContext(c)
+		view, err := h.views.GetSavedView(ctx, msg.ViewID, c.UserID)
+		cancel()
+		if err != nil {
+			log.Printf("Error loading saved view %d for subscription: %v", msg.ViewID, err)
+			h.reply(c, serverMessage{Type: "error", ViewID: msg.ViewID, Message: "saved view not found"})
+			return
+		}
+
+		h.mu.Lock()
+		_, connected := h.clients[c]
+		full := c.views[view.Id] == nil && c.subscriptions() >= maxSubscriptions
+		if connected && !full {
+			if c.views == nil {
+				c.views = map[int64]*viewSubscription{}
+			}
+			c.views[view.Id] = &viewSubscription{view: view, userID: c.UserID}
+			h.viewClients[c] = true
+		}
+		h.mu.Unlock()
+		switch {
+		case !connected:
+		case full:
+			h.reply(c, serverMessage{Type: "error", ViewID: view.Id, Message: "too many subscriptions"})
+		default:
+			h.reply(c, serverMessage{Type: "subscribed", ViewID: view.Id})
+		}
+
+	case "unsubscribe_view":
+		h.mu.Lock()
+		delete(c.views, msg.ViewID)
+		if len(c.views) == 0 {
+			delete(h.viewClients, c)
+		}
+		h.mu.Unlock()
+		h.reply(c, serverMessage{Type: "unsubscribed", ViewID: msg.ViewID})
+
+	default:
+		h.reply(c, serverMessage{Type: "error", Message: "unknown action " + msg.Action})
+	}
+}
+
+// reply queues msg for c, unless c has already gone.
+func (h *Hub) reply(c *Client, msg serverMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Error encoding reply: %v", err)
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if _, ok := h.clients[c]; !ok {
+		return
+	}
+	select {
+	case c.Send <- data:
+	default:
+		// Buffer full; the client is too slow to keep up anyway.
+	}
+}
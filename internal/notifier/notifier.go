@@ -0,0 +1,242 @@
+// Package notifier emails users about task events: it turns events from
+// NATS into queued notifications, and sends them as single emails or
+// digests according to each user's preferences.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/business/core/notify"
+	"github.com/zaouldyeck/taskboard/business/sys/mail"
+)
+
+const (
+	// The outbox is checked every pollInterval, so immediate emails
+	// gather the notifications of a burst of changes.
+	pollInterval = 30 * time.Second
+	userBatch    = 100
+	sendTimeout  = time.Minute
+)
+
+// Config configures a Notifier.
+type Config struct {
+	// From is the sender of emails.
+	From string
+
+	// TaskURL links to a task when "{id}" is replaced by its ID.
+	TaskURL string
+
+	// PublicURL is where the notifier's HTTP server is reached, for
+	// unsubscribe links.
+	PublicURL string
+
+	// UnsubscribeSecret signs unsubscribe links.
+	UnsubscribeSecret []byte
+}
+
+// Notifier queues and sends email notifications.
+type Notifier struct {
+	cfg    Config
+	store  *Store
+	sender mail.Sender
+}
+
+func New(cfg Config, store *Store, sender mail.Sender) *Notifier {
+	cfg.PublicURL = strings.TrimSuffix(cfg.PublicURL, "/")
+	return &Notifier{cfg: cfg, store: store, sender: sender}
+}
+
+// HandleEvent queues notifications of a task event for the users who
+// want them.
+func (n *Notifier) HandleEvent(ctx context.Context, data []byte) error {
+	var event notify.Event
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal task event: %w", err)
+	}
+
+	watchers, err := n.store.Watchers(ctx, event.WorkspaceID, event.TaskID)
+	if err != nil {
+		return err
+	}
+	notifications := notify.Recipients(event, watchers)
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	userIDs := make([]string, len(notifications))
+	for i, notification := range notifications {
+		userIDs[i] = notification.UserID
+	}
+	recipients, err := n.store.Recipients(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	// Users without an account can't be emailed.
+	var queued []notify.Notification
+	for _, notification := range notifications {
+		if r, ok := recipients[notification.UserID]; ok && r.EmailDelivery != "off" {
+			queued = append(queued, notification)
+		}
+	}
+	return n.store.Enqueue(ctx, queued)
+}
+
+// Run sends queued notifications as they fall due, until ctx is
+// cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		n.sendDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (n *Notifier) sendDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		userIDs, err := n.store.DueUsers(ctx, userBatch)
+		if err != nil {
+			log.Printf("ERROR: Failed to list users with notifications: %v", err)
+			return
+		}
+
+		for _, userID := range userIDs {
+			if err := n.sendTo(ctx, userID); err != nil {
+				log.Printf("ERROR: Failed to email notifications to %s: %v", userID, err)
+			}
+		}
+
+		if len(userIDs) < userBatch {
+			return
+		}
+	}
+}
+
+// sendTo emails a user their queued notifications. Those of users who
+// turned emails off, or no longer exist, are dropped.
+func (n *Notifier) sendTo(ctx context.Context, userID string) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	recipients, err := n.store.Recipients(ctx, []string{userID})
+	if err != nil {
+		return err
+	}
+	recipient, ok := recipients[userID]
+
+	return n.store.Drain(ctx, userID, func(notifications []notify.Notification) error {
+		if !ok || recipient.EmailDelivery == "off" {
+			return nil
+		}
+
+		unsubscribeURL := n.unsubscribeURL(userID)
+		email, err := notify.Render(notify.Digest{
+			Name:           recipient.Name,
+			Items:          notifications,
+			TaskURL:        n.cfg.TaskURL,
+			UnsubscribeURL: unsubscribeURL,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = n.sender.Send(ctx, mail.Message{
+			From:    n.cfg.From,
+			To:      (&netmail.Address{Name: recipient.Name, Address: recipient.Email}).String(),
+			Subject: email.Subject,
+			Text:    email.Text,
+			HTML:    email.HTML,
+			Headers: map[string]string{
+				// One-click unsubscribe, RFC 8058.
+				"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+				"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("📧 Emailed %d notifications to %s", len(notifications), userID)
+		return nil
+	})
+}
+
+func (n *Notifier) unsubscribeURL(userID string) string {
+	query := url.Values{
+		"user":  {userID},
+		"token": {notify.UnsubscribeToken(n.cfg.UnsubscribeSecret, userID)},
+	}
+	return n.cfg.PublicURL + "/unsubscribe?" + query.Encode()
+}
+
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Taskboard emails</title></head>
+<body style="font-family: sans-serif;">
+{{if .Done}}
+<p>You will no longer get Taskboard emails. You can turn them back on in your notification settings.</p>
+{{else}}
+<form method="post">
+<p>Stop all Taskboard email notifications?</p>
+<button type="submit">Unsubscribe</button>
+</form>
+{{end}}
+</body>
+</html>
+`))
+
+// Handler serves unsubscribe links and health checks.
+func (n *Notifier) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("OK"))
+	})
+
+	// The link asks for confirmation, so link scanners don't unsubscribe
+	// anyone; mail clients' one-click unsubscribe POSTs directly.
+	mux.HandleFunc("GET /unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		if !n.validUnsubscribe(r) {
+			http.Error(w, "Invalid unsubscribe link", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		unsubscribePage.Execute(w, struct{ Done bool }{false})
+	})
+	mux.HandleFunc("POST /unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		if !n.validUnsubscribe(r) {
+			http.Error(w, "Invalid unsubscribe link", http.StatusForbidden)
+			return
+		}
+		if err := n.store.Unsubscribe(r.Context(), r.URL.Query().Get("user")); err != nil {
+			log.Printf("ERROR: %v", err)
+			http.Error(w, "Failed to unsubscribe", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		unsubscribePage.Execute(w, struct{ Done bool }{true})
+	})
+
+	return mux
+}
+
+func (n *Notifier) validUnsubscribe(r *http.Request) bool {
+	query := r.URL.Query()
+	user := query.Get("user")
+	return user != "" && notify.ValidUnsubscribeToken(n.cfg.UnsubscribeSecret, user, query.Get("token"))
+}
@@ -0,0 +1,237 @@
+package notifier
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/zaouldyeck/taskboard/business/core/notify"
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+// Recipient is a user who can be emailed.
+type Recipient struct {
+	ID            string
+	Email         string
+	Name          string
+	EmailDelivery string
+}
+
+// Store reads users and watchers from the task service's database, and
+// keeps notifications in its outbox until they are emailed.
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// Watchers returns the IDs of the watchers of a task in a workspace.
+func (s *Store) Watchers(ctx context.Context, workspaceID, taskID int64) ([]string, error) {
+	var userIDs []string
+	err := s.db.QueryRowContext(database.WithWorkspace(ctx, workspaceID), `
+		SELECT ARRAY(
+			SELECT user_id FROM task_watchers
+			WHERE task_id = $1 AND task_id IN (SELECT id FROM tasks WHERE workspace_id = $2)
+		)
+	`, taskID, workspaceID).Scan(pq.Array(&userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchers: %w", err)
+	}
+	return userIDs, nil
+}
+
+// Recipients returns the users with the given IDs, by ID. Unknown IDs, and
+// deleted users, are left out.
+func (s *Store) Recipients(ctx context.Context, userIDs []string) (map[string]Recipient, error) {
+	query := `
+		SELECT u.id, u.email, u.username, COALESCE(p.email_delivery, 'immediate')
+		FROM users u
+		LEFT JOIN notification_preferences p ON p.user_id = u.id
+		WHERE u.id = ANY($1) AND u.deleted_at IS NULL
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, pq.Array(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipients: %w", err)
+	}
+	defer rows.Close()
+
+	recipients := map[string]Recipient{}
+	for rows.Next() {
+		var r Recipient
+		if err := rows.Scan(&r.ID, &r.Email, &r.Name, &r.EmailDelivery); err != nil {
+			return nil, fmt.Errorf("failed to scan recipient: %w", err)
+		}
+		recipients[r.ID] = r
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating recipients: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// Enqueue adds notifications to the outbox.
+func (s *Store) Enqueue(ctx context.Context, notifications []notify.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	for _, n := range notifications {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO notification_outbox (user_id, kind, task_id, board_id, title, due_at, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		`, n.UserID, string(n.Kind), n.TaskID, n.BoardID, n.Title, n.DueAt)
+		if err != nil {
+			return fmt.Errorf("failed to enqueue notification: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit notifications: %w", err)
+	}
+
+	return nil
+}
+
+// DueUsers returns up to limit users with notifications to send: at once
+// for immediate delivery, and at most once an hour or a day for digests.
+// Users who turned emails off are included, so their outbox is emptied.
+func (s *Store) DueUsers(ctx context.Context, limit int) ([]string, error) {
+	query := `
+		SELECT o.user_id
+		FROM notification_outbox o
+		LEFT JOIN notification_preferences p ON p.user_id = o.user_id
+		GROUP BY o.user_id, p.email_delivery, p.last_email_at
+		HAVING COALESCE(p.email_delivery, 'immediate') IN ('immediate', 'off')
+			OR (p.email_delivery = 'hourly' AND COALESCE(p.last_email_at, '-infinity') <= NOW() - INTERVAL '1 hour')
+			OR (p.email_delivery = 'daily' AND COALESCE(p.last_email_at, '-infinity') <= NOW() - INTERVAL '1 day')
+		ORDER BY MIN(o.id)
+		LIMIT $1
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due users: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating due users: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// Drain passes a user's queued notifications to send, oldest first, and
+// removes them if it succeeds. The notifications are locked meanwhile, so
+// other notifier instances skip them.
+func (s *Store) Drain(ctx context.Context, userID string, send func([]notify.Notification) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, kind, task_id, board_id, title, due_at
+		FROM notification_outbox
+		WHERE user_id = $1
+		ORDER BY id
+		FOR UPDATE SKIP LOCKED
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to read outbox: %w", err)
+	}
+
+	var ids []int64
+	var notifications []notify.Notification
+	for rows.Next() {
+		var id int64
+		n := notify.Notification{UserID: userID}
+		if err := rows.Scan(&id, &n.Kind, &n.TaskID, &n.BoardID, &n.Title, &n.DueAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan notification: %w", err)
+		}
+		ids = append(ids, id)
+		notifications = append(notifications, n)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating outbox: %w", err)
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	if err := send(notifications); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM notification_outbox WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return fmt.Errorf("failed to clear outbox: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO notification_preferences (user_id, last_email_at, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET last_email_at = EXCLUDED.last_email_at
+	`, userID, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to record email: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit outbox: %w", err)
+	}
+
+	return nil
+}
+
+// Unsubscribe turns off a user's emails and drops their queued
+// notifications.
+func (s *Store) Unsubscribe(ctx context.Context, userID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO notification_preferences (user_id, email_delivery, updated_at)
+		VALUES ($1, 'off', NOW())
+		ON CONFLICT (user_id) DO UPDATE SET email_delivery = 'off', updated_at = NOW()
+	`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM notification_outbox WHERE user_id = $1`, userID); err != nil {
+		return fmt.Errorf("failed to clear outbox: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit unsubscribe: %w", err)
+	}
+
+	return nil
+}
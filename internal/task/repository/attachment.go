@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+// Attachment represents attachment metadata in DB. Contents live in the
+// blob store under StorageKey.
+type Attachment struct {
+	ID          int64
+	TaskID      int64
+	Filename    string
+	ContentType string
+	SizeBytes   int64
+	StorageKey  string
+	UploadedBy  string
+	CreatedAt   time.Time
+}
+
+// AttachmentRepository handles DB ops for attachments.
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment *Attachment) error
+	GetAttachment(ctx context.Context, id int64) (*Attachment, error)
+	ListAttachments(ctx context.Context, taskID int64) ([]*Attachment, error)
+	DeleteAttachment(ctx context.Context, id int64) (*Attachment, error)
+}
+
+const attachmentColumns = `id, task_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at`
+
+func scanAttachment(s scanner) (*Attachment, error) {
+	a := &Attachment{}
+	err := s.Scan(
+		&a.ID,
+		&a.TaskID,
+		&a.Filename,
+		&a.ContentType,
+		&a.SizeBytes,
+		&a.StorageKey,
+		&a.UploadedBy,
+		&a.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *postgresRepository) CreateAttachment(ctx context.Context, attachment *Attachment) error {
+	query := `
+		INSERT INTO attachments (task_id, filename, content_type, size_bytes, storage_key, uploaded_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		attachment.TaskID,
+		attachment.Filename,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.StorageKey,
+		attachment.UploadedBy,
+	).Scan(&attachment.ID, &attachment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetAttachment(ctx context.Context, id int64) (*Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE id = $1 AND ` +
+		workspaceTaskCondition("task_id", "$2")
+
+	a, err := scanAttachment(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	return a, nil
+}
+
+func (r *postgresRepository) ListAttachments(ctx context.Context, taskID int64) ([]*Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM attachments WHERE task_id = $1 AND ` +
+		workspaceTaskCondition("task_id", "$2") + ` ORDER BY created_at`
+
+	rows, err := r.db.QueryContext(ctx, query, taskID, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := []*Attachment{}
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// DeleteAttachment removes the metadata row and returns it, so the caller
+// can remove the blob.
+func (r *postgresRepository) DeleteAttachment(ctx context.Context, id int64) (*Attachment, error) {
+	query := `DELETE FROM attachments WHERE id = $1 AND ` + workspaceTaskCondition("task_id", "$2") +
+		` RETURNING ` + attachmentColumns
+
+	a, err := scanAttachment(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	return a, nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrBoardNotFound = errors.New("board not found")
+
+// Board represents a board in DB, with its columns and labels.
+type Board struct {
+	ID          int64
+	Name        string
+	Description string
+	Columns     []BoardColumn
+	Labels      []BoardLabel
+	CreatedAt   time.Time
+
+	// RequireMFA limits the board to callers who signed in with a second
+	// factor.
+	RequireMFA bool
+
+	// OwnerID, if set when the board is created, becomes its first
+	// owner and is recorded as CreatedBy. Boards created without one are
+	// open to everyone.
+	OwnerID string
+
+	// CreatedBy is the user who created the board, or empty if unknown.
+	CreatedBy string
+}
+
+type BoardColumn struct {
+	ID       int64
+	Name     string
+	Position int
+}
+
+type BoardLabel struct {
+	ID    int64
+	Name  string
+	Color string
+}
+
+// BoardRepository handles DB ops for boards.
+type BoardRepository interface {
+	// CreateBoard inserts the board, its columns and labels, and any seed
+	// tasks in a single transaction. Seed tasks get the new board's ID.
+	CreateBoard(ctx context.Context, board *Board, seedTasks []*Task) error
+	GetBoard(ctx context.Context, id int64) (*Board, error)
+
+	// SetBoardRequireMFA returns ErrBoardNotFound for boards that don't
+	// exist.
+	SetBoardRequireMFA(ctx context.Context, id int64, require bool) error
+}
+
+func (r *postgresRepository) CreateBoard(ctx context.Context, board *Board, seedTasks []*Task) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if err := insertBoard(ctx, tx, board); err != nil {
+		return err
+	}
+
+	for _, task := range seedTasks {
+		task.BoardID = board.ID
+		if err := insertTask(ctx, tx, task); err != nil {
+			return fmt.Errorf("failed to create seed task: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit board: %w", err)
+	}
+
+	return nil
+}
+
+// insertBoard adds board with its columns and labels within tx.
+func insertBoard(ctx context.Context, tx *sql.Tx, board *Board) error {
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO boards (workspace_id, name, description, created_by, created_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		RETURNING id, created_at
+	`, currentWorkspace(ctx), board.Name, board.Description, nullString(board.OwnerID)).Scan(&board.ID, &board.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create board: %w", err)
+	}
+	board.CreatedBy = board.OwnerID
+
+	for i := range board.Columns {
+		col := &board.Columns[i]
+		col.Position = i
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO board_columns (board_id, name, position)
+			VALUES ($1, $2, $3)
+			RETURNING id
+		`, board.ID, col.Name, col.Position).Scan(&col.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create board column: %w", err)
+		}
+	}
+
+	for i := range board.Labels {
+		label := &board.Labels[i]
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO board_labels (board_id, name, color)
+			VALUES ($1, $2, $3)
+			RETURNING id
+		`, board.ID, label.Name, label.Color).Scan(&label.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create board label: %w", err)
+		}
+	}
+
+	if board.OwnerID != "" {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO board_members (board_id, user_id, role, created_at)
+			VALUES ($1, $2, $3, NOW())
+		`, board.ID, board.OwnerID, RoleOwner)
+		if err != nil {
+			return fmt.Errorf("failed to add board owner: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetBoard(ctx context.Context, id int64) (*Board, error) {
+	board := &Board{}
+	var createdBy sql.NullString
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, name, description, created_at, require_mfa, created_by
+		FROM boards
+		WHERE id = $1 AND workspace_id = $2
+	`, id, currentWorkspace(ctx)).Scan(&board.ID, &board.Name, &board.Description, &board.CreatedAt, &board.RequireMFA, &createdBy)
+	if err == sql.ErrNoRows {
+		return nil, ErrBoardNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+	board.CreatedBy = createdBy.String
+
+	// Columns, in display order.
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, position FROM board_columns WHERE board_id = $1 ORDER BY position
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board columns: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var col BoardColumn
+		if err := rows.Scan(&col.ID, &col.Name, &col.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan board column: %w", err)
+		}
+		board.Columns = append(board.Columns, col)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating board columns: %w", err)
+	}
+
+	// Labels.
+	labelRows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, color FROM board_labels WHERE board_id = $1 ORDER BY name
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board labels: %w", err)
+	}
+	defer labelRows.Close()
+	for labelRows.Next() {
+		var label BoardLabel
+		if err := labelRows.Scan(&label.ID, &label.Name, &label.Color); err != nil {
+			return nil, fmt.Errorf("failed to scan board label: %w", err)
+		}
+		board.Labels = append(board.Labels, label)
+	}
+	if err := labelRows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating board labels: %w", err)
+	}
+
+	return board, nil
+}
+
+func (r *postgresRepository) SetBoardRequireMFA(ctx context.Context, id int64, require bool) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE boards SET require_mfa = $3 WHERE id = $1 AND workspace_id = $2
+	`, id, currentWorkspace(ctx), require)
+	if err != nil {
+		return fmt.Errorf("failed to update board: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return ErrBoardNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// CalendarFeed represents an iCalendar feed in DB. BoardID is 0 for feeds
+// of the owner's assigned tasks. Component is "event" or "todo".
+type CalendarFeed struct {
+	ID        int64
+	OwnerID   string
+	BoardID   int64
+	Component string
+	TimeZone  string
+	TokenHash []byte
+	CreatedAt time.Time
+}
+
+// CalendarFeedRepository handles DB ops for calendar feeds.
+type CalendarFeedRepository interface {
+	CreateCalendarFeed(ctx context.Context, feed *CalendarFeed) error
+	GetCalendarFeed(ctx context.Context, id int64) (*CalendarFeed, error)
+	GetCalendarFeedByToken(ctx context.Context, tokenHash []byte) (*CalendarFeed, error)
+	ListCalendarFeeds(ctx context.Context, ownerID string) ([]*CalendarFeed, error)
+	DeleteCalendarFeed(ctx context.Context, id int64) error
+}
+
+const calendarFeedColumns = `id, owner_id, COALESCE(board_id, 0), component, time_zone, token_hash, created_at`
+
+func scanCalendarFeed(s scanner) (*CalendarFeed, error) {
+	f := &CalendarFeed{}
+	err := s.Scan(&f.ID, &f.OwnerID, &f.BoardID, &f.Component, &f.TimeZone, &f.TokenHash, &f.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *postgresRepository) CreateCalendarFeed(ctx context.Context, feed *CalendarFeed) error {
+	query := `
+		INSERT INTO calendar_feeds (owner_id, board_id, component, time_zone, token_hash, workspace_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		feed.OwnerID,
+		nullBoardID(feed.BoardID),
+		feed.Component,
+		feed.TimeZone,
+		feed.TokenHash,
+		currentWorkspace(ctx),
+	).Scan(&feed.ID, &feed.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetCalendarFeed(ctx context.Context, id int64) (*CalendarFeed, error) {
+	query := `SELECT ` + calendarFeedColumns + ` FROM calendar_feeds WHERE id = $1 AND workspace_id = $2`
+
+	feed, err := scanCalendarFeed(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+func (r *postgresRepository) GetCalendarFeedByToken(ctx context.Context, tokenHash []byte) (*CalendarFeed, error) {
+	query := `SELECT ` + calendarFeedColumns + ` FROM calendar_feeds WHERE token_hash = $1 AND workspace_id = $2`
+
+	feed, err := scanCalendarFeed(r.db.QueryRowContext(ctx, query, tokenHash, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+func (r *postgresRepository) ListCalendarFeeds(ctx context.Context, ownerID string) ([]*CalendarFeed, error) {
+	query := `SELECT ` + calendarFeedColumns + ` FROM calendar_feeds WHERE owner_id = $1 AND workspace_id = $2 ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query, ownerID, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+	defer rows.Close()
+
+	feeds := []*CalendarFeed{}
+	for rows.Next() {
+		feed, err := scanCalendarFeed(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan calendar feed: %w", err)
+		}
+		feeds = append(feeds, feed)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating calendar feeds: %w", err)
+	}
+
+	return feeds, nil
+}
+
+func (r *postgresRepository) DeleteCalendarFeed(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM calendar_feeds WHERE id = $1 AND workspace_id = $2`, id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCalendarFeedNotFound
+	}
+
+	return nil
+}
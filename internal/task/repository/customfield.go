@@ -0,0 +1,409 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrCustomFieldNotFound = errors.New("custom field not found")
+	ErrCustomFieldExists   = errors.New("a custom field with this name already exists on the board")
+)
+
+// Custom field types.
+const (
+	FieldTypeText         = "text"
+	FieldTypeNumber       = "number"
+	FieldTypeSingleSelect = "single_select"
+	FieldTypeMultiSelect  = "multi_select"
+	FieldTypeDate         = "date"
+	FieldTypeUser         = "user"
+	FieldTypeURL          = "url"
+)
+
+// Custom field filter operators.
+const (
+	FilterOpEq       = "eq"
+	FilterOpNe       = "ne"
+	FilterOpLt       = "lt"
+	FilterOpLte      = "lte"
+	FilterOpGt       = "gt"
+	FilterOpGte      = "gte"
+	FilterOpContains = "contains"
+	FilterOpSet      = "set"
+	FilterOpUnset    = "unset"
+)
+
+// CustomField represents a board's custom field definition in DB.
+type CustomField struct {
+	ID        int64
+	BoardID   int64
+	Name      string
+	Type      string
+	Options   []string
+	Required  bool
+	Position  int
+	CreatedAt time.Time
+}
+
+// CustomFieldValue is one task's value for a field. Value holds JSON: a
+// string, number, or (for multi select) an array of strings.
+type CustomFieldValue struct {
+	FieldID int64
+	Name    string
+	Type    string
+	Value   json.RawMessage
+}
+
+// CustomFieldFilter matches tasks on a custom field. Type is the field's
+// type and decides how Value is compared.
+type CustomFieldFilter struct {
+	FieldID int64
+	Type    string
+	Op      string
+	Value   string
+}
+
+// CustomFieldRepository handles DB ops for custom fields and their values.
+type CustomFieldRepository interface {
+	CreateCustomField(ctx context.Context, field *CustomField) error
+	GetCustomField(ctx context.Context, id int64) (*CustomField, error)
+	UpdateCustomField(ctx context.Context, field *CustomField) error
+	DeleteCustomField(ctx context.Context, id int64) error
+	ListCustomFields(ctx context.Context, boardID int64) ([]*CustomField, error)
+
+	// SetTaskFieldValues upserts values and removes the fields in clear.
+	SetTaskFieldValues(ctx context.Context, taskID int64, values []CustomFieldValue, clear []int64) error
+}
+
+const customFieldColumns = `id, board_id, name, type, options, required, position, created_at`
+
+func scanCustomField(s scanner) (*CustomField, error) {
+	f := &CustomField{}
+	err := s.Scan(
+		&f.ID,
+		&f.BoardID,
+		&f.Name,
+		&f.Type,
+		pq.Array(&f.Options),
+		&f.Required,
+		&f.Position,
+		&f.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *postgresRepository) CreateCustomField(ctx context.Context, field *CustomField) error {
+	return insertCustomField(ctx, r.db, field)
+}
+
+// rowQuerier is satisfied by both *sql.DB and *sql.Tx.
+type rowQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func insertCustomField(ctx context.Context, q rowQuerier, field *CustomField) error {
+	query := `
+		INSERT INTO custom_fields (board_id, name, type, options, required, position, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING id, created_at
+	`
+
+	err := q.QueryRowContext(
+		ctx,
+		query,
+		field.BoardID,
+		field.Name,
+		field.Type,
+		pq.Array(nonNilStrings(field.Options)),
+		field.Required,
+		field.Position,
+	).Scan(&field.ID, &field.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrCustomFieldExists
+		}
+		return fmt.Errorf("failed to create custom field: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetCustomField(ctx context.Context, id int64) (*CustomField, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_fields WHERE id = $1 AND ` +
+		workspaceBoardCondition("board_id", "$2")
+
+	field, err := scanCustomField(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrCustomFieldNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field: %w", err)
+	}
+
+	return field, nil
+}
+
+func (r *postgresRepository) UpdateCustomField(ctx context.Context, field *CustomField) error {
+	query := `
+		UPDATE custom_fields
+		SET name = $1, options = $2, required = $3, position = $4
+		WHERE id = $5 AND ` + workspaceBoardCondition("board_id", "$6")
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		field.Name,
+		pq.Array(nonNilStrings(field.Options)),
+		field.Required,
+		field.Position,
+		field.ID,
+		currentWorkspace(ctx),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrCustomFieldExists
+		}
+		return fmt.Errorf("failed to update custom field: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+// DeleteCustomField removes a field definition. Its values cascade.
+func (r *postgresRepository) DeleteCustomField(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM custom_fields WHERE id = $1 AND `+workspaceBoardCondition("board_id", "$2"),
+		id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete custom field: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrCustomFieldNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListCustomFields(ctx context.Context, boardID int64) ([]*CustomField, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_fields WHERE board_id = $1 AND ` +
+		workspaceBoardCondition("board_id", "$2") + ` ORDER BY position, id`
+
+	rows, err := r.db.QueryContext(ctx, query, boardID, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom fields: %w", err)
+	}
+	defer rows.Close()
+
+	fields := []*CustomField{}
+	for rows.Next() {
+		field, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan custom field: %w", err)
+		}
+		fields = append(fields, field)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating custom fields: %w", err)
+	}
+
+	return fields, nil
+}
+
+func (r *postgresRepository) SetTaskFieldValues(ctx context.Context, taskID int64,
+	values []CustomFieldValue, clear []int64,
+) error {
+	if len(values) == 0 && len(clear) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if err := upsertFieldValues(ctx, tx, taskID, values); err != nil {
+		return err
+	}
+
+	if len(clear) > 0 {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM task_field_values WHERE task_id = $1 AND field_id = ANY($2)
+		`, taskID, pq.Array(clear))
+		if err != nil {
+			return fmt.Errorf("failed to clear custom field values: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit custom field values: %w", err)
+	}
+
+	return nil
+}
+
+func upsertFieldValues(ctx context.Context, tx *sql.Tx, taskID int64, values []CustomFieldValue) error {
+	for _, v := range values {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO task_field_values (task_id, field_id, value)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (task_id, field_id) DO UPDATE SET value = EXCLUDED.value
+		`, taskID, v.FieldID, []byte(v.Value))
+		if err != nil {
+			return fmt.Errorf("failed to set custom field value: %w", err)
+		}
+	}
+	return nil
+}
+
+// loadFieldValues fills CustomFields on tasks with one query.
+func (r *postgresRepository) loadFieldValues(ctx context.Context, tasks ...*Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	byID := make(map[int64]*Task, len(tasks))
+	ids := make([]int64, len(tasks))
+	for i, task := range tasks {
+		byID[task.ID] = task
+		ids[i] = task.ID
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT v.task_id, v.field_id, f.name, f.type, v.value
+		FROM task_field_values v
+		JOIN custom_fields f ON f.id = v.field_id
+		WHERE v.task_id = ANY($1)
+		ORDER BY f.position, f.id
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to load custom field values: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID int64
+		var v CustomFieldValue
+		var raw []byte
+		if err := rows.Scan(&taskID, &v.FieldID, &v.Name, &v.Type, &raw); err != nil {
+			return fmt.Errorf("failed to scan custom field value: %w", err)
+		}
+		v.Value = raw
+		byID[taskID].CustomFields = append(byID[taskID].CustomFields, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating custom field values: %w", err)
+	}
+
+	return nil
+}
+
+// fieldValueExpr is the typed SQL expression for a value column, so
+// numbers and dates compare by value rather than as text.
+func fieldValueExpr(col, fieldType string) string {
+	switch fieldType {
+	case FieldTypeNumber:
+		return "(" + col + " #>> '{}')::numeric"
+	case FieldTypeDate:
+		return "(" + col + " #>> '{}')::date"
+	default:
+		return "(" + col + " #>> '{}')"
+	}
+}
+
+// fieldParamCast casts a text query parameter to match fieldValueExpr.
+func fieldParamCast(fieldType string) string {
+	switch fieldType {
+	case FieldTypeNumber:
+		return "::numeric"
+	case FieldTypeDate:
+		return "::date"
+	default:
+		return "::text"
+	}
+}
+
+var filterComparisons = map[string]string{
+	FilterOpEq:  "=",
+	FilterOpLt:  "<",
+	FilterOpLte: "<=",
+	FilterOpGt:  ">",
+	FilterOpGte: ">=",
+}
+
+// customFieldCondition builds a WHERE condition on tasks for filter. addParam
+// appends a query parameter and returns its placeholder.
+func customFieldCondition(filter CustomFieldFilter, addParam func(any) string) (string, error) {
+	field := addParam(filter.FieldID)
+	exists := func(cond string) string {
+		if cond != "" {
+			cond = " AND " + cond
+		}
+		return "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = " +
+			field + cond + ")"
+	}
+
+	expr := fieldValueExpr("v.value", filter.Type)
+	cast := fieldParamCast(filter.Type)
+
+	switch filter.Op {
+	case FilterOpSet:
+		return exists(""), nil
+	case FilterOpUnset:
+		return "NOT " + exists(""), nil
+	}
+
+	value := addParam(filter.Value)
+
+	if filter.Type == FieldTypeMultiSelect {
+		contains := exists("v.value @> jsonb_build_array(" + value + "::text)")
+		switch filter.Op {
+		case FilterOpEq, FilterOpContains:
+			return contains, nil
+		case FilterOpNe:
+			return "NOT " + contains, nil
+		}
+		return "", fmt.Errorf("operator %s not supported on multi select fields", filter.Op)
+	}
+
+	switch filter.Op {
+	case FilterOpNe:
+		return "NOT " + exists(expr+" = "+value+cast), nil
+	case FilterOpContains:
+		if filter.Type == FieldTypeNumber || filter.Type == FieldTypeDate {
+			return "", fmt.Errorf("operator contains not supported on %s fields", filter.Type)
+		}
+		return exists("strpos(lower(" + expr + "), lower(" + value + ")) > 0"), nil
+	}
+
+	cmp, ok := filterComparisons[filter.Op]
+	if !ok {
+		return "", fmt.Errorf("unknown filter operator %q", filter.Op)
+	}
+	return exists(expr + " " + cmp + " " + value + cast), nil
+}
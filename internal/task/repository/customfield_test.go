@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// paramList collects query parameters the way List does.
+type paramList []any
+
+func (p *paramList) add(v any) string {
+	*p = append(*p, v)
+	return fmt.Sprintf("$%d", len(*p))
+}
+
+func TestCustomFieldCondition(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter CustomFieldFilter
+		want   string
+		params []any
+	}{
+		{
+			name:   "number comparison",
+			filter: CustomFieldFilter{FieldID: 4, Type: FieldTypeNumber, Op: FilterOpGte, Value: "3"},
+			want:   "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1 AND (v.value #>> '{}')::numeric >= $2::numeric)",
+			params: []any{int64(4), "3"},
+		},
+		{
+			name:   "date comparison",
+			filter: CustomFieldFilter{FieldID: 5, Type: FieldTypeDate, Op: FilterOpLt, Value: "2026-03-05"},
+			want:   "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1 AND (v.value #>> '{}')::date < $2::date)",
+			params: []any{int64(5), "2026-03-05"},
+		},
+		{
+			name:   "text not equal",
+			filter: CustomFieldFilter{FieldID: 6, Type: FieldTypeText, Op: FilterOpNe, Value: "x"},
+			want:   "NOT EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1 AND (v.value #>> '{}') = $2::text)",
+			params: []any{int64(6), "x"},
+		},
+		{
+			name:   "text contains",
+			filter: CustomFieldFilter{FieldID: 6, Type: FieldTypeText, Op: FilterOpContains, Value: "x"},
+			want:   "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1 AND strpos(lower((v.value #>> '{}')), lower($2)) > 0)",
+			params: []any{int64(6), "x"},
+		},
+		{
+			name:   "multi select contains",
+			filter: CustomFieldFilter{FieldID: 7, Type: FieldTypeMultiSelect, Op: FilterOpEq, Value: "ios"},
+			want:   "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1 AND v.value @> jsonb_build_array($2::text))",
+			params: []any{int64(7), "ios"},
+		},
+		{
+			name:   "set takes no value",
+			filter: CustomFieldFilter{FieldID: 8, Type: FieldTypeNumber, Op: FilterOpSet, Value: "ignored"},
+			want:   "EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1)",
+			params: []any{int64(8)},
+		},
+		{
+			name:   "unset",
+			filter: CustomFieldFilter{FieldID: 8, Type: FieldTypeDate, Op: FilterOpUnset},
+			want:   "NOT EXISTS (SELECT 1 FROM task_field_values v WHERE v.task_id = tasks.id AND v.field_id = $1)",
+			params: []any{int64(8)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var params paramList
+			got, err := customFieldCondition(tt.filter, params.add)
+			if err != nil {
+				t.Fatalf("failed to build condition: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+			if !reflect.DeepEqual([]any(params), tt.params) {
+				t.Errorf("expected params %v, got %v", tt.params, params)
+			}
+		})
+	}
+
+	t.Log("✅ Custom field filters build parameterised SQL")
+}
+
+func TestCustomFieldConditionUnsupported(t *testing.T) {
+	tests := []CustomFieldFilter{
+		{Type: FieldTypeNumber, Op: FilterOpContains, Value: "1"},
+		{Type: FieldTypeDate, Op: FilterOpContains, Value: "2026"},
+		{Type: FieldTypeMultiSelect, Op: FilterOpLt, Value: "ios"},
+		{Type: FieldTypeText, Op: "like", Value: "%"},
+	}
+
+	for _, filter := range tests {
+		var params paramList
+		if _, err := customFieldCondition(filter, params.add); err == nil {
+			t.Errorf("expected error for %s on %s", filter.Op, filter.Type)
+		}
+	}
+
+	t.Log("✅ Correctly rejected unsupported operators")
+}
+
+func TestFieldValueExpr(t *testing.T) {
+	tests := map[string]string{
+		FieldTypeNumber:       "(sv.value #>> '{}')::numeric",
+		FieldTypeDate:         "(sv.value #>> '{}')::date",
+		FieldTypeText:         "(sv.value #>> '{}')",
+		FieldTypeSingleSelect: "(sv.value #>> '{}')",
+	}
+
+	for fieldType, want := range tests {
+		if got := fieldValueExpr("sv.value", fieldType); got != want {
+			t.Errorf("%s: expected %s, got %s", fieldType, want, got)
+		}
+	}
+
+	t.Log("✅ Field values sort by their type")
+}
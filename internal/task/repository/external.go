@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrAlreadyImported is returned when importing a board or task that an
+// earlier import of the same source already wrote.
+var ErrAlreadyImported = errors.New("already imported")
+
+// ExternalImportRepository handles DB ops for imports from other systems,
+// such as Trello, which record what they wrote so they can be rerun.
+type ExternalImportRepository interface {
+	// GetImportedBoard returns the ID of the board an earlier import of
+	// the external board created.
+	GetImportedBoard(ctx context.Context, source, externalID string) (int64, error)
+
+	// CreateImportedBoard creates board and fields and records them as
+	// the import of the external board.
+	CreateImportedBoard(ctx context.Context, source, externalID string, board *Board, fields []*CustomField) error
+
+	IsTaskImported(ctx context.Context, boardID int64, source, externalID string) (bool, error)
+
+	// ImportExternalTask creates task, with its custom field values, and
+	// records it as the import of the external task into task.BoardID.
+	ImportExternalTask(ctx context.Context, source, externalID string, task *Task) error
+}
+
+func (r *postgresRepository) GetImportedBoard(ctx context.Context, source, externalID string) (int64, error) {
+	var boardID int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT board_id FROM imported_boards
+		WHERE workspace_id = $1 AND source = $2 AND external_id = $3
+	`, currentWorkspace(ctx), source, externalID).Scan(&boardID)
+	if err == sql.ErrNoRows {
+		return 0, ErrBoardNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get imported board: %w", err)
+	}
+	return boardID, nil
+}
+
+func (r *postgresRepository) CreateImportedBoard(ctx context.Context, source, externalID string,
+	board *Board, fields []*CustomField,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if err := insertBoard(ctx, tx, board); err != nil {
+		return err
+	}
+	for _, field := range fields {
+		field.BoardID = board.ID
+		if err := insertCustomField(ctx, tx, field); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO imported_boards (workspace_id, source, external_id, board_id)
+		VALUES ($1, $2, $3, $4)
+	`, currentWorkspace(ctx), source, externalID, board.ID)
+	if isUniqueViolation(err) {
+		return ErrAlreadyImported
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record imported board: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit board: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) IsTaskImported(ctx context.Context, boardID int64, source, externalID string) (bool, error) {
+	var imported bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM imported_tasks
+			WHERE board_id = $1 AND source = $2 AND external_id = $3
+				AND `+workspaceBoardCondition("board_id", "$4")+`
+		)
+	`, boardID, source, externalID, currentWorkspace(ctx)).Scan(&imported)
+	if err != nil {
+		return false, fmt.Errorf("failed to check imported task: %w", err)
+	}
+	return imported, nil
+}
+
+func (r *postgresRepository) ImportExternalTask(ctx context.Context, source, externalID string, task *Task) error {
+	imported, err := r.IsTaskImported(ctx, task.BoardID, source, externalID)
+	if err != nil {
+		return err
+	}
+	if imported {
+		return ErrAlreadyImported
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if err := insertTask(ctx, tx, task); err != nil {
+		return fmt.Errorf("failed to import task: %w", err)
+	}
+	if err := upsertFieldValues(ctx, tx, task.ID, task.CustomFields); err != nil {
+		return err
+	}
+
+	// A concurrent import of the same task may have won since the check.
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO imported_tasks (board_id, source, external_id, task_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT DO NOTHING
+	`, task.BoardID, source, externalID, task.ID)
+	if err != nil {
+		return fmt.Errorf("failed to record imported task: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to record imported task: %w", err)
+	} else if n == 0 {
+		return ErrAlreadyImported
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit task: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInboxNotificationNotFound = errors.New("inbox notification not found")
+
+// InboxNotification represents an in-app notification in DB.
+type InboxNotification struct {
+	ID        int64
+	UserID    string
+	Kind      string
+	TaskID    int64
+	BoardID   int64
+	Title     string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// InboxFilter selects a page of a user's inbox.
+type InboxFilter struct {
+	UserID     string
+	UnreadOnly bool
+	Limit      int
+	Offset     int
+}
+
+// InboxRepository handles DB ops for users' in-app notifications.
+type InboxRepository interface {
+	// CreateInboxNotifications stores notifications, setting their IDs
+	// and creation times.
+	CreateInboxNotifications(ctx context.Context, notifications []*InboxNotification) error
+
+	// ListInboxNotifications lists notifications newest first, with the
+	// total count matching the filter.
+	ListInboxNotifications(ctx context.Context, filter InboxFilter) ([]*InboxNotification, int, error)
+	CountUnreadInboxNotifications(ctx context.Context, userID string) (int, error)
+
+	// MarkInboxNotificationRead marks one of the user's notifications
+	// read, keeping the time it was first read.
+	MarkInboxNotificationRead(ctx context.Context, id int64, userID string) (*InboxNotification, error)
+
+	// MarkAllInboxNotificationsRead returns how many notifications were
+	// unread.
+	MarkAllInboxNotificationsRead(ctx context.Context, userID string) (int, error)
+}
+
+const inboxNotificationColumns = `id, user_id, kind, task_id, board_id, title, read_at, created_at`
+
+func scanInboxNotification(row scanner) (*InboxNotification, error) {
+	var n InboxNotification
+	var readAt sql.NullTime
+	err := row.Scan(&n.ID, &n.UserID, &n.Kind, &n.TaskID, &n.BoardID, &n.Title, &readAt, &n.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if readAt.Valid {
+		n.ReadAt = &readAt.Time
+	}
+	return &n, nil
+}
+
+func (r *postgresRepository) CreateInboxNotifications(ctx context.Context, notifications []*InboxNotification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	query := `
+		INSERT INTO inbox_notifications (user_id, kind, task_id, board_id, title, workspace_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		RETURNING id, created_at
+	`
+	for _, n := range notifications {
+		err := tx.QueryRowContext(ctx, query, n.UserID, n.Kind, n.TaskID, n.BoardID, n.Title, currentWorkspace(ctx)).
+			Scan(&n.ID, &n.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to create inbox notification: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit inbox notifications: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListInboxNotifications(ctx context.Context, filter InboxFilter) ([]*InboxNotification, int, error) {
+	where := ` WHERE user_id = $1 AND workspace_id = $2`
+	if filter.UnreadOnly {
+		where += ` AND read_at IS NULL`
+	}
+
+	var totalCount int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM inbox_notifications`+where, filter.UserID, currentWorkspace(ctx)).
+		Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count inbox notifications: %w", err)
+	}
+
+	query := `SELECT ` + inboxNotificationColumns + ` FROM inbox_notifications` + where +
+		` ORDER BY id DESC LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.UserID, currentWorkspace(ctx), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list inbox notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*InboxNotification{}
+	for rows.Next() {
+		n, err := scanInboxNotification(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan inbox notification: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating inbox notifications: %w", err)
+	}
+
+	return notifications, totalCount, nil
+}
+
+func (r *postgresRepository) CountUnreadInboxNotifications(ctx context.Context, userID string) (int, error) {
+	query := `SELECT COUNT(*) FROM inbox_notifications WHERE user_id = $1 AND workspace_id = $2 AND read_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID, currentWorkspace(ctx)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count unread inbox notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *postgresRepository) MarkInboxNotificationRead(ctx context.Context, id int64, userID string) (*InboxNotification, error) {
+	query := `
+		UPDATE inbox_notifications
+		SET read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND user_id = $2 AND workspace_id = $3
+		RETURNING ` + inboxNotificationColumns
+
+	n, err := scanInboxNotification(r.db.QueryRowContext(ctx, query, id, userID, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrInboxNotificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark inbox notification read: %w", err)
+	}
+
+	return n, nil
+}
+
+func (r *postgresRepository) MarkAllInboxNotificationsRead(ctx context.Context, userID string) (int, error) {
+	query := `UPDATE inbox_notifications SET read_at = NOW() WHERE user_id = $1 AND workspace_id = $2 AND read_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, userID, currentWorkspace(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark inbox notifications read: %w", err)
+	}
+
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(marked), nil
+}
@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Board roles, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+var (
+	ErrBoardMemberNotFound = errors.New("board member not found")
+	ErrBoardMemberExists   = errors.New("user is already a board member")
+	ErrLastBoardOwner      = errors.New("a board must keep at least one owner")
+)
+
+// BoardMember represents a user's role on a board in DB.
+type BoardMember struct {
+	BoardID   int64
+	UserID    string
+	Role      string
+	CreatedAt time.Time
+}
+
+// BoardAccess is what a user may see of a board.
+type BoardAccess struct {
+	// Open boards have no members, and are open to everyone. Boards in
+	// other workspaces are never open.
+	Open bool
+
+	// Role is the user's role, or empty if they aren't a member.
+	Role string
+
+	// RequireMFA is whether the board requires a second factor.
+	RequireMFA bool
+}
+
+// MembershipRepository handles DB ops for board members.
+type MembershipRepository interface {
+	ListBoardMembers(ctx context.Context, boardID int64) ([]*BoardMember, error)
+	GetBoardAccess(ctx context.Context, boardID int64, userID string) (*BoardAccess, error)
+
+	// AddBoardMember returns ErrBoardNotFound for boards that don't exist.
+	AddBoardMember(ctx context.Context, member *BoardMember) error
+
+	// SetBoardMemberRole and RemoveBoardMember refuse to leave a board
+	// without owners.
+	SetBoardMemberRole(ctx context.Context, boardID int64, userID, role string) (*BoardMember, error)
+	RemoveBoardMember(ctx context.Context, boardID int64, userID string) error
+}
+
+const boardMemberColumns = `board_id, user_id, role, created_at`
+
+func scanBoardMember(row scanner) (*BoardMember, error) {
+	var m BoardMember
+	if err := row.Scan(&m.BoardID, &m.UserID, &m.Role, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *postgresRepository) ListBoardMembers(ctx context.Context, boardID int64) ([]*BoardMember, error) {
+	query := `SELECT ` + boardMemberColumns + ` FROM board_members WHERE board_id = $1 AND ` +
+		workspaceBoardCondition("board_id", "$2") + ` ORDER BY created_at, user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, boardID, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*BoardMember{}
+	for rows.Next() {
+		m, err := scanBoardMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan board member: %w", err)
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating board members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *postgresRepository) GetBoardAccess(ctx context.Context, boardID int64, userID string) (*BoardAccess, error) {
+	query := `
+		SELECT
+			NOT EXISTS (SELECT 1 FROM board_members WHERE board_id = $1)
+				AND NOT EXISTS (SELECT 1 FROM boards WHERE id = $1 AND workspace_id <> $3),
+			COALESCE((
+				SELECT role FROM board_members
+				WHERE board_id = $1 AND user_id = $2 AND ` + workspaceBoardCondition("board_id", "$3") + `
+			), ''),
+			COALESCE((SELECT require_mfa FROM boards WHERE id = $1 AND workspace_id = $3), FALSE)
+	`
+
+	var access BoardAccess
+	err := r.db.QueryRowContext(ctx, query, boardID, userID, currentWorkspace(ctx)).
+		Scan(&access.Open, &access.Role, &access.RequireMFA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board access: %w", err)
+	}
+
+	return &access, nil
+}
+
+func (r *postgresRepository) AddBoardMember(ctx context.Context, member *BoardMember) error {
+	query := `
+		INSERT INTO board_members (board_id, user_id, role, created_at)
+		SELECT $1, $2, $3, NOW()
+		WHERE EXISTS (SELECT 1 FROM boards WHERE id = $1 AND workspace_id = $4)
+		RETURNING created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, member.BoardID, member.UserID, member.Role, currentWorkspace(ctx)).
+		Scan(&member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return ErrBoardNotFound
+	}
+	if isUniqueViolation(err) {
+		return ErrBoardMemberExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add board member: %w", err)
+	}
+
+	return nil
+}
+
+// lockBoardOwners locks the board's members for the rest of tx and
+// returns its owners, so concurrent changes can't remove the last one.
+func lockBoardOwners(ctx context.Context, tx *sql.Tx, boardID int64) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id, role FROM board_members WHERE board_id = $1 FOR UPDATE
+	`, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock board members: %w", err)
+	}
+	defer rows.Close()
+
+	var owners []string
+	for rows.Next() {
+		var userID, role string
+		if err := rows.Scan(&userID, &role); err != nil {
+			return nil, fmt.Errorf("failed to scan board member: %w", err)
+		}
+		if role == RoleOwner {
+			owners = append(owners, userID)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating board members: %w", err)
+	}
+
+	return owners, nil
+}
+
+func (r *postgresRepository) SetBoardMemberRole(ctx context.Context, boardID int64, userID, role string) (*BoardMember, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	owners, err := lockBoardOwners(ctx, tx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if role != RoleOwner && len(owners) == 1 && owners[0] == userID {
+		return nil, ErrLastBoardOwner
+	}
+
+	m, err := scanBoardMember(tx.QueryRowContext(ctx, `
+		UPDATE board_members SET role = $3
+		WHERE board_id = $1 AND user_id = $2
+		RETURNING `+boardMemberColumns,
+		boardID, userID, role))
+	if err == sql.ErrNoRows {
+		return nil, ErrBoardMemberNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set board member role: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit board member: %w", err)
+	}
+
+	return m, nil
+}
+
+func (r *postgresRepository) RemoveBoardMember(ctx context.Context, boardID int64, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	owners, err := lockBoardOwners(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+	if len(owners) == 1 && owners[0] == userID {
+		return ErrLastBoardOwner
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM board_members WHERE board_id = $1 AND user_id = $2`, boardID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove board member: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrBoardMemberNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit board member: %w", err)
+	}
+
+	return nil
+}
+
+// visibleBoardsCondition returns an SQL condition on the board ID column
+// col that holds for boards userID can see: open boards, and boards they
+// are a member of.
+func visibleBoardsCondition(col, userParam string) string {
+	return `(` + col + ` IN (SELECT board_id FROM board_members WHERE user_id = ` + userParam + `)` +
+		` OR NOT EXISTS (SELECT 1 FROM board_members WHERE board_id = ` + col + `))`
+}
+
+// mfaBoardsCondition returns an SQL condition on the board ID column col
+// that holds for boards that don't require a second factor.
+func mfaBoardsCondition(col string) string {
+	return col + ` NOT IN (SELECT id FROM boards WHERE require_mfa)`
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// Email delivery settings.
+const (
+	EmailImmediate = "immediate"
+	EmailHourly    = "hourly"
+	EmailDaily     = "daily"
+	EmailOff       = "off"
+)
+
+// NotificationPreferences represents a user's email settings in DB.
+type NotificationPreferences struct {
+	UserID        string
+	EmailDelivery string
+}
+
+// NotificationRepository handles DB ops for task watchers, overdue tasks
+// and notification settings.
+type NotificationRepository interface {
+	// AddWatcher and RemoveWatcher are idempotent.
+	AddWatcher(ctx context.Context, taskID int64, userID string) error
+	RemoveWatcher(ctx context.Context, taskID int64, userID string) error
+	ListWatchers(ctx context.Context, taskID int64) ([]string, error)
+
+	// ClaimOverdueTasks returns up to limit open tasks that are due and
+	// haven't been claimed for their current due date, and marks them
+	// claimed.
+	ClaimOverdueTasks(ctx context.Context, limit int) ([]*Task, error)
+
+	// GetNotificationPreferences returns the user's settings, or the
+	// defaults if they have none.
+	GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error)
+	SetNotificationPreferences(ctx context.Context, prefs *NotificationPreferences) error
+}
+
+func (r *postgresRepository) AddWatcher(ctx context.Context, taskID int64, userID string) error {
+	query := `
+		INSERT INTO task_watchers (task_id, user_id, created_at)
+		SELECT $1, $2, NOW()
+		WHERE ` + workspaceTaskCondition("$1::bigint", "$3") + `
+		ON CONFLICT DO NOTHING
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, taskID, userID, currentWorkspace(ctx)); err != nil {
+		return fmt.Errorf("failed to add watcher: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) RemoveWatcher(ctx context.Context, taskID int64, userID string) error {
+	query := `DELETE FROM task_watchers WHERE task_id = $1 AND user_id = $2 AND ` +
+		workspaceTaskCondition("task_id", "$3")
+
+	if _, err := r.db.ExecContext(ctx, query, taskID, userID, currentWorkspace(ctx)); err != nil {
+		return fmt.Errorf("failed to remove watcher: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListWatchers(ctx context.Context, taskID int64) ([]string, error) {
+	query := `SELECT user_id FROM task_watchers WHERE task_id = $1 AND ` +
+		workspaceTaskCondition("task_id", "$2") + ` ORDER BY user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, taskID, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchers: %w", err)
+	}
+	defer rows.Close()
+
+	userIDs := []string{}
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan watcher: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating watchers: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+func (r *postgresRepository) ClaimOverdueTasks(ctx context.Context, limit int) ([]*Task, error) {
+	query := `
+		UPDATE tasks
+		SET overdue_notified_due_at = tasks.due_at
+		FROM (
+			SELECT id
+			FROM tasks
+			WHERE workspace_id = $2 AND NOT completed AND due_at <= NOW()
+				AND overdue_notified_due_at IS DISTINCT FROM due_at
+			ORDER BY due_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		) due
+		WHERE tasks.id = due.id
+		RETURNING ` + taskColumns + `
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim overdue tasks: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := []*Task{}
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating overdue tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+func (r *postgresRepository) GetNotificationPreferences(ctx context.Context, userID string) (*NotificationPreferences, error) {
+	query := `
+		SELECT COALESCE((SELECT email_delivery FROM notification_preferences WHERE user_id = $1), $2)
+	`
+
+	prefs := &NotificationPreferences{UserID: userID}
+	if err := r.db.QueryRowContext(ctx, query, userID, EmailImmediate).Scan(&prefs.EmailDelivery); err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+
+	return prefs, nil
+}
+
+func (r *postgresRepository) SetNotificationPreferences(ctx context.Context, prefs *NotificationPreferences) error {
+	query := `
+		INSERT INTO notification_preferences (user_id, email_delivery, updated_at)
+		VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET email_delivery = EXCLUDED.email_delivery, updated_at = NOW()
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, prefs.UserID, prefs.EmailDelivery); err != nil {
+		return fmt.Errorf("failed to set notification preferences: %w", err)
+	}
+
+	return nil
+}
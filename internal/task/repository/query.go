@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
+)
+
+// sortColumns maps TaskFilter.SortColumn to SQL. Only these may appear in
+// ORDER BY.
+var sortColumns = map[string]string{
+	"created": "tasks.created_at",
+	"updated": "tasks.updated_at",
+	"due":     "tasks.due_at",
+	"title":   "lower(tasks.title)",
+}
+
+// queryTimeColumns maps time fields of the query language to columns.
+var queryTimeColumns = map[taskquery.Field]string{
+	taskquery.FieldDue:     "tasks.due_at",
+	taskquery.FieldCreated: "tasks.created_at",
+	taskquery.FieldUpdated: "tasks.updated_at",
+}
+
+// queryCondition builds a WHERE condition on tasks for one query term.
+// Values are always passed as parameters via addParam. Negated terms treat
+// NULL as not matching, so -due<7d includes tasks without a due date, as
+// taskquery's Match does.
+func queryCondition(term taskquery.Term, addParam func(any) string) string {
+	var cond string
+
+	switch term.Field {
+	case taskquery.FieldText:
+		p := addParam(term.Value)
+		cond = "(strpos(lower(tasks.title), lower(" + p + ")) > 0 OR strpos(lower(tasks.description), lower(" + p + ")) > 0)"
+
+	case taskquery.FieldStatus:
+		cond = "tasks.completed = " + addParam(term.Completed)
+
+	case taskquery.FieldAssignee:
+		if term.None {
+			cond = "NOT EXISTS (SELECT 1 FROM task_assignees ta WHERE ta.task_id = tasks.id)"
+		} else {
+			cond = "EXISTS (SELECT 1 FROM task_assignees ta WHERE ta.task_id = tasks.id AND ta.user_id = " +
+				addParam(term.Value) + ")"
+		}
+
+	case taskquery.FieldLabel:
+		if term.None {
+			cond = "cardinality(tasks.labels) = 0"
+		} else {
+			cond = addParam(term.Value) + "::text = ANY(tasks.labels)"
+		}
+
+	case taskquery.FieldDue, taskquery.FieldCreated, taskquery.FieldUpdated:
+		col := queryTimeColumns[term.Field]
+		if term.None {
+			cond = col + " IS NULL"
+			break
+		}
+		cond = col + " IS NOT NULL"
+		if term.After != nil {
+			cond += " AND " + col + " >= " + addParam(*term.After)
+		}
+		if term.Before != nil {
+			cond += " AND " + col + " < " + addParam(*term.Before)
+		}
+
+	default:
+		// Parse never produces other fields.
+		cond = "FALSE"
+	}
+
+	if term.Negate {
+		return "NOT COALESCE((" + cond + "), FALSE)"
+	}
+	return "(" + cond + ")"
+}
+
+// ValidSortColumn reports whether name can be used as TaskFilter.SortColumn.
+func ValidSortColumn(name string) bool {
+	_, ok := sortColumns[name]
+	return ok
+}
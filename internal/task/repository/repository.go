@@ -3,10 +3,17 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
 )
 
+var ErrTaskNotFound = errors.New("task not found")
+
 // Task represents a task object in DB.
 type Task struct {
 	ID          int64
@@ -14,18 +21,48 @@ type Task struct {
 	Title       string
 	Description string
 	Completed   bool
-	CreatedBy   int64
+
+	// CreatedBy is empty for tasks whose creator isn't a user.
+	CreatedBy   string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DueAt       *time.Time
+	Labels      []string
+	AssigneeIDs []string
+
+	// Read-only, computed by queries.
+	AttachmentCount int
+	CustomFields    []CustomFieldValue
+
+	// Read-only, the users CreatedBy and AssigneeIDs name.
+	Creator   *UserSummary
+	Assignees []UserSummary
 }
 
 // Repository handles DB ops for tasks.
 type Repository interface {
 	Create(ctx context.Context, task *Task) error
 	GetByID(ctx context.Context, id int64) (*Task, error)
-	List(ctx context.Context, boardID int64, completed *bool, limit, offset int) ([]*Task, int, error)
+	List(ctx context.Context, filter TaskFilter) ([]*Task, int, error)
 	Update(ctx context.Context, task *Task) error
 	Delete(ctx context.Context, id int64) error
+
+	TimeEntryRepository
+	AttachmentRepository
+	BoardRepository
+	TemplateRepository
+	CustomFieldRepository
+	SavedViewRepository
+	TransferRepository
+	ExternalImportRepository
+	CalendarFeedRepository
+	WebhookRepository
+	NotificationRepository
+	InboxRepository
+	MembershipRepository
+	WorkspaceRepository
+	UserDataRepository
+	UserRepository
 }
 
 type postgresRepository struct {
@@ -37,91 +74,249 @@ func NewPostgresRepository(db *sql.DB) Repository {
 }
 
 func (r *postgresRepository) Create(ctx context.Context, task *Task) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if err := insertTask(ctx, tx, task); err != nil {
+		return fmt.Errorf("failed to create task: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit task: %w", err)
+	}
+
+	return r.loadUsers(ctx, task)
+}
+
+// insertTask adds task and its assignees within tx. Timestamps already set
+// on task are kept, so imports preserve them; otherwise they are now.
+func insertTask(ctx context.Context, tx *sql.Tx, task *Task) error {
 	query := `
-		INSERT INTO tasks (board_id, title, description, completed, created_by, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+		INSERT INTO tasks (board_id, title, description, completed, created_by, due_at, labels, created_at, updated_at, workspace_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE($8, NOW()), COALESCE($9, $8, NOW()), $10)
 		RETURNING id, created_at, updated_at
 	`
 
 	// Validate that row could be added to table.
-	err := r.db.QueryRowContext(
+	err := tx.QueryRowContext(
 		ctx,
 		query,
 		task.BoardID,
 		task.Title,
 		task.Description,
 		task.Completed,
-		task.CreatedBy,
+		nullString(task.CreatedBy),
+		task.DueAt,
+		pq.Array(nonNilStrings(task.Labels)),
+		nullTime(task.CreatedAt),
+		nullTime(task.UpdatedAt),
+		currentWorkspace(ctx),
 	).Scan(&task.ID, &task.CreatedAt, &task.UpdatedAt)
 	if err != nil {
-		return fmt.Errorf("failed to create task: %w", err)
+		return err
 	}
 
+	return setAssignees(ctx, tx, task.ID, task.AssigneeIDs)
+}
+
+// setAssignees replaces the assignees of a task.
+func setAssignees(ctx context.Context, tx *sql.Tx, taskID int64, userIDs []string) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_assignees WHERE task_id = $1`, taskID); err != nil {
+		return fmt.Errorf("failed to clear assignees: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO task_assignees (task_id, user_id)
+		SELECT $1, unnest($2::text[])
+	`, taskID, pq.Array(userIDs))
+	if err != nil {
+		return fmt.Errorf("failed to set assignees: %w", err)
+	}
 	return nil
 }
 
-func (r *postgresRepository) GetByID(ctx context.Context, id int64) (*Task, error) {
-	query := `
-		SELECT id, board_id, title, description, completed, created_by, created_at, updated_at
-		FROM tasks
-		WHERE id = $1
-	`
+// nullTime returns t, or nil for the zero time.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// nullString returns s, or NULL for the empty string.
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// nonNilStrings returns s, or an empty slice for nil; pq sends a nil slice
+// as NULL.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// taskColumns are the columns scanTask reads, including computed ones.
+const taskColumns = `
+	tasks.id, tasks.board_id, tasks.title, tasks.description, tasks.completed, tasks.created_by,
+	tasks.created_at, tasks.updated_at, tasks.due_at, tasks.labels,
+	ARRAY(SELECT ta.user_id FROM task_assignees ta WHERE ta.task_id = tasks.id ORDER BY ta.user_id),
+	(SELECT COUNT(*) FROM attachments a WHERE a.task_id = tasks.id)`
 
+func scanTask(s scanner) (*Task, error) {
 	task := &Task{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	var createdBy sql.NullString
+	err := s.Scan(
 		&task.ID,
 		&task.BoardID,
 		&task.Title,
 		&task.Description,
 		&task.Completed,
-		&task.CreatedBy,
+		&createdBy,
 		&task.CreatedAt,
 		&task.UpdatedAt,
+		&task.DueAt,
+		pq.Array(&task.Labels),
+		pq.Array(&task.AssigneeIDs),
+		&task.AttachmentCount,
 	)
+	if err != nil {
+		return nil, err
+	}
+	task.CreatedBy = createdBy.String
+	return task, nil
+}
+
+func (r *postgresRepository) GetByID(ctx context.Context, id int64) (*Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND workspace_id = $2`
+
+	task, err := scanTask(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
 
 	if err == sql.ErrNoRows {
-		// Special case, for when no rows are found.
-		return nil, fmt.Errorf("failed to get task: %w", err)
+		return nil, ErrTaskNotFound
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task; %w", err)
 	}
 
+	if err := r.loadFieldValues(ctx, task); err != nil {
+		return nil, err
+	}
+	if err := r.loadUsers(ctx, task); err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
-// List lists tasks with the option to filter.
-func (r *postgresRepository) List(ctx context.Context, boardID int64,
-	completed *bool, limit, offset int,
-) ([]*Task, int, error) {
-	// Dynamic query based on filters.
-	query := `
-		SELECT id, board_id, title, description, completed, created_by, created_at, updated_at
-		FROM tasks
-		WHERE board_id = $1
-	`
+// TaskFilter selects and orders tasks for List.
+type TaskFilter struct {
+	// BoardID limits the list to one board; 0 lists all boards.
+	BoardID      int64
+	Completed    *bool
+	CustomFields []CustomFieldFilter
+
+	// Queries must all match.
+	Queries []*taskquery.Query
+
+	// VisibleTo limits the list to boards the user can see; nil doesn't
+	// limit it.
+	VisibleTo *string
+
+	// WithoutMFABoards leaves out boards that require a second factor.
+	WithoutMFABoards bool
+
+	// SortColumn is created, updated, due or title; empty sorts by
+	// creation time, newest first.
+	SortColumn string
 
+	// SortFieldID orders by a custom field instead of creation time.
+	// Tasks without a value sort last.
+	SortFieldID   int64
+	SortFieldType string
+	SortDesc      bool
+
+	Limit  int
+	Offset int
+}
+
+// List lists tasks with the option to filter.
+func (r *postgresRepository) List(ctx context.Context, filter TaskFilter) ([]*Task, int, error) {
 	// Track which parameter number we're on.
-	params := []interface{}{boardID}
-	paramCount := 1
+	params := []interface{}{}
+	addParam := func(v any) string {
+		params = append(params, v)
+		return fmt.Sprintf("$%d", len(params))
+	}
+
+	// Dynamic WHERE based on filters, shared by the list and count queries.
+	where := " WHERE tasks.workspace_id = " + addParam(currentWorkspace(ctx))
+	if filter.BoardID != 0 {
+		where += " AND tasks.board_id = " + addParam(filter.BoardID)
+	}
+
+	if filter.VisibleTo != nil {
+		where += " AND " + visibleBoardsCondition("tasks.board_id", addParam(*filter.VisibleTo))
+	}
+	if filter.WithoutMFABoards {
+		where += " AND " + mfaBoardsCondition("tasks.board_id")
+	}
 
 	// Optional completed filter.
-	if completed != nil {
-		paramCount++
-		query += fmt.Sprintf(" AND completed = $%d", paramCount)
-		params = append(params, *completed)
+	if filter.Completed != nil {
+		where += " AND tasks.completed = " + addParam(*filter.Completed)
 	}
 
-	query += " ORDER BY created_at DESC"
+	for _, f := range filter.CustomFields {
+		cond, err := customFieldCondition(f, addParam)
+		if err != nil {
+			return nil, 0, err
+		}
+		where += " AND " + cond
+	}
 
-	// Set pagination.
-	paramCount++
-	query += fmt.Sprintf(" LIMIT $%d", paramCount)
-	params = append(params, limit)
+	for _, q := range filter.Queries {
+		for _, term := range q.Terms {
+			where += " AND " + queryCondition(term, addParam)
+		}
+	}
+
+	// Get total count for pagination.
+	var totalCount int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM tasks`+where, params...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count tasks: %w", err)
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM tasks`
+	order := " ORDER BY tasks.created_at DESC"
+	dir := "ASC"
+	if filter.SortDesc {
+		dir = "DESC"
+	}
+	if col, ok := sortColumns[filter.SortColumn]; ok {
+		order = " ORDER BY " + col + " " + dir + " NULLS LAST, tasks.id DESC"
+	} else if filter.SortColumn != "" {
+		return nil, 0, fmt.Errorf("unknown sort column %q", filter.SortColumn)
+	}
+	if filter.SortFieldID != 0 {
+		query += " LEFT JOIN task_field_values sv ON sv.task_id = tasks.id AND sv.field_id = " +
+			addParam(filter.SortFieldID)
+		order = " ORDER BY " + fieldValueExpr("sv.value", filter.SortFieldType) + " " + dir +
+			" NULLS LAST, tasks.created_at DESC"
+	}
+	query += where + order
 
-	paramCount++
-	query += fmt.Sprintf(" OFFSET $%d", paramCount)
-	params = append(params, offset)
+	// Set pagination.
+	query += " LIMIT " + addParam(filter.Limit)
+	query += " OFFSET " + addParam(filter.Offset)
 	// --
 
 	rows, err := r.db.QueryContext(ctx, query, params...)
@@ -133,17 +328,7 @@ func (r *postgresRepository) List(ctx context.Context, boardID int64,
 	// Scan DB rows into slice of Task ptrs.
 	tasks := []*Task{}
 	for rows.Next() {
-		task := &Task{}
-		err := rows.Scan(
-			&task.ID,
-			&task.BoardID,
-			&task.Title,
-			&task.Description,
-			&task.Completed,
-			&task.CreatedBy,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan task: %w", err)
 		}
@@ -154,18 +339,11 @@ func (r *postgresRepository) List(ctx context.Context, boardID int64,
 		return nil, 0, fmt.Errorf("error iterating tasks: %w", err)
 	}
 
-	// Get total count for pagination.
-	countQuery := `SELECT COUNT(*) FROM tasks WHERE board_id = $1`
-	countParams := []interface{}{boardID}
-	if completed != nil {
-		countQuery += " AND completed = $2"
-		countParams = append(countParams, *completed)
+	if err := r.loadFieldValues(ctx, tasks...); err != nil {
+		return nil, 0, err
 	}
-
-	var totalCount int
-	err = r.db.QueryRowContext(ctx, countQuery, countParams...).Scan(&totalCount)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count tasks: %w", err)
+	if err := r.loadUsers(ctx, tasks...); err != nil {
+		return nil, 0, err
 	}
 
 	return tasks, totalCount, nil
@@ -174,35 +352,52 @@ func (r *postgresRepository) List(ctx context.Context, boardID int64,
 func (r *postgresRepository) Update(ctx context.Context, task *Task) error {
 	query := `
 		UPDATE tasks
-		SET title = $1, description = $2, completed = $3, updated_at = NOW()
-		WHERE id = $4
+		SET title = $1, description = $2, completed = $3, due_at = $4, labels = $5, updated_at = NOW()
+		WHERE id = $6 AND workspace_id = $7
 		RETURNING updated_at
 	`
 
-	err := r.db.QueryRowContext(
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	err = tx.QueryRowContext(
 		ctx,
 		query,
 		task.Title,
 		task.Description,
 		task.Completed,
+		task.DueAt,
+		pq.Array(nonNilStrings(task.Labels)),
 		task.ID,
+		currentWorkspace(ctx),
 	).Scan(&task.UpdatedAt)
 
 	if err == sql.ErrNoRows {
-		return fmt.Errorf("task not found")
+		return ErrTaskNotFound
 	}
 	if err != nil {
 		return fmt.Errorf("failed to update task: %w", err)
 	}
 
-	return nil
+	if err := setAssignees(ctx, tx, task.ID, task.AssigneeIDs); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit task: %w", err)
+	}
+
+	return r.loadUsers(ctx, task)
 }
 
 // Delete task.
 func (r *postgresRepository) Delete(ctx context.Context, id int64) error {
-	query := `DELETE FROM tasks WHERE id = $1`
+	query := `DELETE FROM tasks WHERE id = $1 AND workspace_id = $2`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := r.db.ExecContext(ctx, query, id, currentWorkspace(ctx))
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
@@ -213,7 +408,7 @@ func (r *postgresRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("task not found")
+		return ErrTaskNotFound
 	}
 
 	return nil
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+// Test DB settings. Assumes port-forward of 5432:5432 from localhost to postgres svc is in place.
+var testDBConfig = database.Config{
+	Host:     "localhost",
+	Port:     5432,
+	User:     "taskboard",
+	Password: "taskboard",
+	Database: "taskboard_test",
+	SSLMode:  "disable",
+}
+
+// dropTables removes every table of the test db, so each test starts from
+// an empty schema.
+const dropTables = `
+	DO $$ DECLARE r record; BEGIN
+		FOR r IN SELECT tablename FROM pg_tables WHERE schemaname = 'public' LOOP
+			EXECUTE format('DROP TABLE IF EXISTS %I CASCADE', r.tablename);
+		END LOOP;
+	END $$;
+`
+
+// setupTestDb creates the full task service schema for each test.
+func setupTestDb(t *testing.T) (*sql.DB, func()) {
+	t.Helper()
+
+	db, err := database.NewPostgresDB(testDBConfig)
+	if err != nil {
+		t.Skip("Skipping test: cannot connect to test db")
+		return nil, nil
+	}
+
+	if _, err := db.Exec(dropTables); err != nil {
+		t.Fatalf("failed to drop tables: %v", err)
+	}
+	if err := database.InitSchema(db); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	cleanup := func() {
+		db.Exec(dropTables)
+		db.Close()
+	}
+
+	return db, cleanup
+}
+
+// createTestUser inserts a user with the given ID, which is also its
+// username.
+func createTestUser(t *testing.T, db *sql.DB, id string) {
+	t.Helper()
+
+	_, err := db.Exec(`
+		INSERT INTO users (id, email, username, password_hash)
+		VALUES ($1, $1::text || '@example.com', $1, 'x')
+	`, id)
+	if err != nil {
+		t.Fatalf("failed to create user %s: %v", id, err)
+	}
+}
+
+// createTestTask creates a task on a board of ctx's workspace.
+func createTestTask(t *testing.T, ctx context.Context, repo Repository, boardID int64, createdBy string, assignees ...string) *Task {
+	t.Helper()
+
+	task := &Task{BoardID: boardID, Title: "Test task", CreatedBy: createdBy, AssigneeIDs: assignees}
+	if err := repo.Create(ctx, task); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	return task
+}
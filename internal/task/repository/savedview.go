@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrSavedViewNotFound = errors.New("saved view not found")
+	ErrSavedViewExists   = errors.New("a saved view with this name already exists")
+)
+
+// SavedView represents a user's named task query in DB. BoardID is 0 for
+// views across all boards.
+type SavedView struct {
+	ID        int64
+	OwnerID   string
+	Name      string
+	Query     string
+	Sort      string
+	BoardID   int64
+	Shared    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SavedViewRepository handles DB ops for saved views.
+type SavedViewRepository interface {
+	CreateSavedView(ctx context.Context, view *SavedView) error
+	GetSavedView(ctx context.Context, id int64) (*SavedView, error)
+
+	// ListSavedViews lists views owned by ownerID plus shared views. A
+	// non-zero boardID keeps views for that board and for all boards.
+	ListSavedViews(ctx context.Context, ownerID string, boardID int64) ([]*SavedView, error)
+	UpdateSavedView(ctx context.Context, view *SavedView) error
+	DeleteSavedView(ctx context.Context, id int64) error
+}
+
+const savedViewColumns = `id, owner_id, name, query, sort, COALESCE(board_id, 0), shared, created_at, updated_at`
+
+func scanSavedView(s scanner) (*SavedView, error) {
+	v := &SavedView{}
+	err := s.Scan(&v.ID, &v.OwnerID, &v.Name, &v.Query, &v.Sort, &v.BoardID, &v.Shared, &v.CreatedAt, &v.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// nullBoardID stores board 0 as NULL.
+func nullBoardID(id int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: id, Valid: id != 0}
+}
+
+func (r *postgresRepository) CreateSavedView(ctx context.Context, view *SavedView) error {
+	query := `
+		INSERT INTO saved_views (owner_id, name, query, sort, board_id, shared, workspace_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		view.OwnerID,
+		view.Name,
+		view.Query,
+		view.Sort,
+		nullBoardID(view.BoardID),
+		view.Shared,
+		currentWorkspace(ctx),
+	).Scan(&view.ID, &view.CreatedAt, &view.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrSavedViewExists
+		}
+		return fmt.Errorf("failed to create saved view: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetSavedView(ctx context.Context, id int64) (*SavedView, error) {
+	query := `SELECT ` + savedViewColumns + ` FROM saved_views WHERE id = $1 AND workspace_id = $2`
+
+	view, err := scanSavedView(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrSavedViewNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved view: %w", err)
+	}
+
+	return view, nil
+}
+
+func (r *postgresRepository) ListSavedViews(ctx context.Context, ownerID string, boardID int64) ([]*SavedView, error) {
+	query := `SELECT ` + savedViewColumns + ` FROM saved_views WHERE (owner_id = $1 OR shared) AND workspace_id = $2`
+	params := []any{ownerID, currentWorkspace(ctx)}
+	if boardID != 0 {
+		query += ` AND (board_id = $3 OR board_id IS NULL)`
+		params = append(params, boardID)
+	}
+	query += ` ORDER BY owner_id <> $1, name`
+
+	rows, err := r.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved views: %w", err)
+	}
+	defer rows.Close()
+
+	views := []*SavedView{}
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved view: %w", err)
+		}
+		views = append(views, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saved views: %w", err)
+	}
+
+	return views, nil
+}
+
+func (r *postgresRepository) UpdateSavedView(ctx context.Context, view *SavedView) error {
+	query := `
+		UPDATE saved_views
+		SET name = $1, query = $2, sort = $3, board_id = $4, shared = $5, updated_at = NOW()
+		WHERE id = $6 AND workspace_id = $7
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		view.Name,
+		view.Query,
+		view.Sort,
+		nullBoardID(view.BoardID),
+		view.Shared,
+		view.ID,
+		currentWorkspace(ctx),
+	).Scan(&view.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrSavedViewNotFound
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrSavedViewExists
+		}
+		return fmt.Errorf("failed to update saved view: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteSavedView(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1 AND workspace_id = $2`, id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrSavedViewNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrTemplateNotFound = errors.New("template not found")
+
+// Template kinds.
+const (
+	TemplateKindBoard = "board"
+	TemplateKindTask  = "task"
+)
+
+// Template represents a saved board or task template in DB. Content is
+// the JSON encoded layout; its shape depends on Kind.
+type Template struct {
+	ID          int64
+	Kind        string
+	Name        string
+	Description string
+	Content     []byte
+	CreatedAt   time.Time
+
+	// CreatedBy is the user who created the template, or empty if unknown.
+	CreatedBy string
+
+	// SourceBoardID is the board the template was saved from, or 0.
+	SourceBoardID int64
+}
+
+// TemplateRepository handles DB ops for templates.
+type TemplateRepository interface {
+	CreateTemplate(ctx context.Context, tmpl *Template) error
+	GetTemplate(ctx context.Context, id int64) (*Template, error)
+	ListTemplates(ctx context.Context, kind string) ([]*Template, error)
+	DeleteTemplate(ctx context.Context, id int64) error
+}
+
+const templateColumns = `id, kind, name, description, content, created_at, created_by, source_board_id`
+
+func scanTemplate(s scanner) (*Template, error) {
+	t := &Template{}
+	var createdBy sql.NullString
+	var sourceBoardID sql.NullInt64
+	if err := s.Scan(&t.ID, &t.Kind, &t.Name, &t.Description, &t.Content, &t.CreatedAt, &createdBy, &sourceBoardID); err != nil {
+		return nil, err
+	}
+	t.CreatedBy, t.SourceBoardID = createdBy.String, sourceBoardID.Int64
+	return t, nil
+}
+
+func (r *postgresRepository) CreateTemplate(ctx context.Context, tmpl *Template) error {
+	query := `
+		INSERT INTO templates (workspace_id, kind, name, description, content, created_by, source_board_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, currentWorkspace(ctx), tmpl.Kind, tmpl.Name, tmpl.Description, tmpl.Content,
+		nullString(tmpl.CreatedBy), nullBoardID(tmpl.SourceBoardID)).
+		Scan(&tmpl.ID, &tmpl.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create template: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetTemplate(ctx context.Context, id int64) (*Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM templates WHERE id = $1 AND workspace_id = $2`
+
+	tmpl, err := scanTemplate(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// ListTemplates lists templates, optionally filtered by kind.
+func (r *postgresRepository) ListTemplates(ctx context.Context, kind string) ([]*Template, error) {
+	query := `SELECT ` + templateColumns + ` FROM templates WHERE workspace_id = $1`
+	params := []any{currentWorkspace(ctx)}
+	if kind != "" {
+		query += ` AND kind = $2`
+		params = append(params, kind)
+	}
+	query += ` ORDER BY name`
+
+	rows, err := r.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*Template{}
+	for rows.Next() {
+		tmpl, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan template: %w", err)
+		}
+		templates = append(templates, tmpl)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+func (r *postgresRepository) DeleteTemplate(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM templates WHERE id = $1 AND workspace_id = $2`, id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrTemplateNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrTimeEntryNotFound = errors.New("time entry not found")
+	ErrTimerRunning      = errors.New("user already has a running timer")
+	ErrNoRunningTimer    = errors.New("user has no running timer")
+)
+
+// TimeEntry represents a time_entries row in DB.
+// EndedAt is nil while the timer is still running.
+type TimeEntry struct {
+	ID        int64
+	TaskID    int64
+	UserID    string
+	Note      string
+	StartedAt time.Time
+	EndedAt   *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Duration returns time spent so far. Running entries count up to now.
+func (e *TimeEntry) Duration() time.Duration {
+	if e.EndedAt == nil {
+		return time.Since(e.StartedAt)
+	}
+	return e.EndedAt.Sub(e.StartedAt)
+}
+
+// TimeEntryFilter narrows ListTimeEntries and TimeReport queries.
+// Zero values are ignored.
+type TimeEntryFilter struct {
+	TaskID  int64
+	BoardID int64
+	UserID  string
+	From    time.Time
+	To      time.Time
+}
+
+// TimeReportRow is the summed time of one user on one board in one period.
+type TimeReportRow struct {
+	PeriodStart  time.Time
+	BoardID      int64
+	UserID       string
+	TotalSeconds int64
+	EntryCount   int
+}
+
+// TimeEntryRepository handles DB ops for time entries.
+type TimeEntryRepository interface {
+	StartTimer(ctx context.Context, entry *TimeEntry) error
+	StopTimer(ctx context.Context, userID string) (*TimeEntry, error)
+	CreateTimeEntry(ctx context.Context, entry *TimeEntry) error
+	GetTimeEntry(ctx context.Context, id int64) (*TimeEntry, error)
+	UpdateTimeEntry(ctx context.Context, entry *TimeEntry) error
+	DeleteTimeEntry(ctx context.Context, id int64) error
+	ListTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]*TimeEntry, error)
+	TotalTime(ctx context.Context, taskID int64) (time.Duration, error)
+	TimeReport(ctx context.Context, filter TimeEntryFilter, period string) ([]*TimeReportRow, error)
+}
+
+const timeEntryColumns = `id, task_id, user_id, note, started_at, ended_at, created_at, updated_at`
+
+// scanner is satisfied by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTimeEntry(s scanner) (*TimeEntry, error) {
+	entry := &TimeEntry{}
+	var endedAt sql.NullTime
+	err := s.Scan(
+		&entry.ID,
+		&entry.TaskID,
+		&entry.UserID,
+		&entry.Note,
+		&entry.StartedAt,
+		&endedAt,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if endedAt.Valid {
+		entry.EndedAt = &endedAt.Time
+	}
+	return entry, nil
+}
+
+// StartTimer inserts a running entry. The partial unique index on
+// (workspace_id, user_id) WHERE ended_at IS NULL guarantees one running
+// timer per user in each workspace.
+func (r *postgresRepository) StartTimer(ctx context.Context, entry *TimeEntry) error {
+	query := `
+		INSERT INTO time_entries (task_id, user_id, note, workspace_id, started_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, NOW(), NOW(), NOW())
+		RETURNING ` + timeEntryColumns
+
+	created, err := scanTimeEntry(r.db.QueryRowContext(ctx, query, entry.TaskID, entry.UserID, entry.Note, currentWorkspace(ctx)))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrTimerRunning
+		}
+		return fmt.Errorf("failed to start timer: %w", err)
+	}
+
+	*entry = *created
+	return nil
+}
+
+// StopTimer ends the running timer of a user.
+func (r *postgresRepository) StopTimer(ctx context.Context, userID string) (*TimeEntry, error) {
+	query := `
+		UPDATE time_entries
+		SET ended_at = NOW(), updated_at = NOW()
+		WHERE user_id = $1 AND workspace_id = $2 AND ended_at IS NULL
+		RETURNING ` + timeEntryColumns
+
+	entry, err := scanTimeEntry(r.db.QueryRowContext(ctx, query, userID, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrNoRunningTimer
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop timer: %w", err)
+	}
+
+	return entry, nil
+}
+
+// CreateTimeEntry inserts a manual, already finished, entry.
+func (r *postgresRepository) CreateTimeEntry(ctx context.Context, entry *TimeEntry) error {
+	query := `
+		INSERT INTO time_entries (task_id, user_id, note, started_at, ended_at, workspace_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		RETURNING ` + timeEntryColumns
+
+	created, err := scanTimeEntry(r.db.QueryRowContext(
+		ctx,
+		query,
+		entry.TaskID,
+		entry.UserID,
+		entry.Note,
+		entry.StartedAt,
+		entry.EndedAt,
+		currentWorkspace(ctx),
+	))
+	if err != nil {
+		return fmt.Errorf("failed to create time entry: %w", err)
+	}
+
+	*entry = *created
+	return nil
+}
+
+func (r *postgresRepository) GetTimeEntry(ctx context.Context, id int64) (*TimeEntry, error) {
+	query := `SELECT ` + timeEntryColumns + ` FROM time_entries WHERE id = $1 AND workspace_id = $2`
+
+	entry, err := scanTimeEntry(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrTimeEntryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get time entry: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (r *postgresRepository) UpdateTimeEntry(ctx context.Context, entry *TimeEntry) error {
+	query := `
+		UPDATE time_entries
+		SET note = $1, started_at = $2, ended_at = $3, updated_at = NOW()
+		WHERE id = $4 AND workspace_id = $5
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		entry.Note,
+		entry.StartedAt,
+		entry.EndedAt,
+		entry.ID,
+		currentWorkspace(ctx),
+	).Scan(&entry.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return ErrTimeEntryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update time entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteTimeEntry(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM time_entries WHERE id = $1 AND workspace_id = $2`, id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete time entry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrTimeEntryNotFound
+	}
+
+	return nil
+}
+
+// timeEntryWhere builds the shared WHERE clause for time entry queries.
+// Entries are joined with tasks ("t") so they can be filtered by board.
+func timeEntryWhere(ctx context.Context, filter TimeEntryFilter) (string, []any) {
+	conds := []string{"e.workspace_id = $1"}
+	params := []any{currentWorkspace(ctx)}
+
+	add := func(cond string, val any) {
+		params = append(params, val)
+		conds = append(conds, fmt.Sprintf(cond, len(params)))
+	}
+
+	if filter.TaskID != 0 {
+		add("e.task_id = $%d", filter.TaskID)
+	}
+	if filter.BoardID != 0 {
+		add("t.board_id = $%d", filter.BoardID)
+	}
+	if filter.UserID != "" {
+		add("e.user_id = $%d", filter.UserID)
+	}
+	if !filter.From.IsZero() {
+		add("e.started_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("e.started_at < $%d", filter.To)
+	}
+
+	return strings.Join(conds, " AND "), params
+}
+
+func (r *postgresRepository) ListTimeEntries(ctx context.Context, filter TimeEntryFilter) ([]*TimeEntry, error) {
+	where, params := timeEntryWhere(ctx, filter)
+	query := `
+		SELECT e.id, e.task_id, e.user_id, e.note, e.started_at, e.ended_at, e.created_at, e.updated_at
+		FROM time_entries e
+		JOIN tasks t ON t.id = e.task_id
+		WHERE ` + where + `
+		ORDER BY e.started_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list time entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*TimeEntry{}
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan time entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating time entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// TotalTime sums finished entries of a task.
+func (r *postgresRepository) TotalTime(ctx context.Context, taskID int64) (time.Duration, error) {
+	query := `
+		SELECT COALESCE(SUM(EXTRACT(EPOCH FROM (ended_at - started_at))), 0)::BIGINT
+		FROM time_entries
+		WHERE task_id = $1 AND workspace_id = $2 AND ended_at IS NOT NULL
+	`
+
+	var seconds int64
+	if err := r.db.QueryRowContext(ctx, query, taskID, currentWorkspace(ctx)).Scan(&seconds); err != nil {
+		return 0, fmt.Errorf("failed to sum time entries: %w", err)
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// TimeReport groups finished entries by period ("day", "week" or "month"),
+// board and user.
+func (r *postgresRepository) TimeReport(ctx context.Context, filter TimeEntryFilter,
+	period string,
+) ([]*TimeReportRow, error) {
+	switch period {
+	case "day", "week", "month":
+	default:
+		return nil, fmt.Errorf("invalid report period %q", period)
+	}
+
+	where, params := timeEntryWhere(ctx, filter)
+	// Period is whitelisted above, so it is safe to inline.
+	query := `
+		SELECT date_trunc('` + period + `', e.started_at) AS period_start,
+			t.board_id,
+			e.user_id,
+			SUM(EXTRACT(EPOCH FROM (e.ended_at - e.started_at)))::BIGINT,
+			COUNT(*)
+		FROM time_entries e
+		JOIN tasks t ON t.id = e.task_id
+		WHERE ` + where + ` AND e.ended_at IS NOT NULL
+		GROUP BY period_start, t.board_id, e.user_id
+		ORDER BY period_start, t.board_id, e.user_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build time report: %w", err)
+	}
+	defer rows.Close()
+
+	report := []*TimeReportRow{}
+	for rows.Next() {
+		row := &TimeReportRow{}
+		if err := rows.Scan(&row.PeriodStart, &row.BoardID, &row.UserID, &row.TotalSeconds, &row.EntryCount); err != nil {
+			return nil, fmt.Errorf("failed to scan time report row: %w", err)
+		}
+		report = append(report, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating time report: %w", err)
+	}
+
+	return report, nil
+}
+
+// isUniqueViolation checks for postgres unique constraint violation (23505).
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+func TestTimers(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return // Test was skipped.
+	}
+	defer cleanup()
+
+	repo := NewPostgresRepository(db)
+	ctx := WithWorkspace(context.Background(), database.DefaultWorkspaceID)
+	createTestUser(t, db, "alice")
+	createTestUser(t, db, "bob")
+	task := createTestTask(t, ctx, repo, 1, "alice")
+
+	t.Run("start timer", func(t *testing.T) {
+		entry := &TimeEntry{TaskID: task.ID, UserID: "alice"}
+		if err := repo.StartTimer(ctx, entry); err != nil {
+			t.Fatalf("failed to start timer: %v", err)
+		}
+		if entry.EndedAt != nil {
+			t.Error("expected running timer")
+		}
+
+		t.Logf("✅ Started timer %d", entry.ID)
+	})
+
+	t.Run("reject second running timer", func(t *testing.T) {
+		err := repo.StartTimer(ctx, &TimeEntry{TaskID: task.ID, UserID: "alice"})
+		if err != ErrTimerRunning {
+			t.Errorf("expected ErrTimerRunning, got: %v", err)
+		}
+
+		t.Log("✅ Correctly rejected second running timer")
+	})
+
+	t.Run("allow timers of other users", func(t *testing.T) {
+		if err := repo.StartTimer(ctx, &TimeEntry{TaskID: task.ID, UserID: "bob"}); err != nil {
+			t.Errorf("failed to start timer of another user: %v", err)
+		}
+
+		t.Log("✅ Users each have their own timer")
+	})
+
+	t.Run("stop timer", func(t *testing.T) {
+		entry, err := repo.StopTimer(ctx, "alice")
+		if err != nil {
+			t.Fatalf("failed to stop timer: %v", err)
+		}
+		if entry.EndedAt == nil {
+			t.Error("expected stopped timer to have ended")
+		}
+
+		if _, err := repo.StopTimer(ctx, "alice"); err != ErrNoRunningTimer {
+			t.Errorf("expected ErrNoRunningTimer, got: %v", err)
+		}
+
+		t.Log("✅ Stopped timer")
+	})
+
+	t.Run("restart stopped timer", func(t *testing.T) {
+		if err := repo.StartTimer(ctx, &TimeEntry{TaskID: task.ID, UserID: "alice"}); err != nil {
+			t.Errorf("failed to restart timer: %v", err)
+		}
+
+		t.Log("✅ Timer starts again once stopped")
+	})
+}
+
+func TestTimeReport(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return // Test was skipped.
+	}
+	defer cleanup()
+
+	repo := NewPostgresRepository(db)
+	ctx := WithWorkspace(context.Background(), database.DefaultWorkspaceID)
+	createTestUser(t, db, "alice")
+	createTestUser(t, db, "bob")
+	task1 := createTestTask(t, ctx, repo, 1, "alice")
+	task2 := createTestTask(t, ctx, repo, 2, "alice")
+
+	day1 := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	entries := []struct {
+		task   *Task
+		user   string
+		start  time.Time
+		length time.Duration
+	}{
+		{task1, "alice", day1, 30 * time.Minute},
+		{task1, "alice", day1.Add(time.Hour), 30 * time.Minute},
+		{task1, "bob", day1.Add(2 * time.Hour), 15 * time.Minute},
+		{task2, "alice", day1, 20 * time.Minute},
+		{task1, "alice", day2, time.Hour},
+	}
+	for _, e := range entries {
+		end := e.start.Add(e.length)
+		entry := &TimeEntry{TaskID: e.task.ID, UserID: e.user, StartedAt: e.start, EndedAt: &end}
+		if err := repo.CreateTimeEntry(ctx, entry); err != nil {
+			t.Fatalf("failed to create time entry: %v", err)
+		}
+	}
+	// Running timers aren't reported.
+	if err := repo.StartTimer(ctx, &TimeEntry{TaskID: task1.ID, UserID: "bob"}); err != nil {
+		t.Fatalf("failed to start timer: %v", err)
+	}
+
+	t.Run("group by day, board and user", func(t *testing.T) {
+		rows, err := repo.TimeReport(ctx, TimeEntryFilter{From: day1.AddDate(0, 0, -1)}, "day")
+		if err != nil {
+			t.Fatalf("failed to build report: %v", err)
+		}
+
+		want := []struct {
+			day     time.Time
+			boardID int64
+			userID  string
+			seconds int64
+			count   int
+		}{
+			{day1, 1, "alice", 3600, 2},
+			{day1, 1, "bob", 900, 1},
+			{day1, 2, "alice", 1200, 1},
+			{day2, 1, "alice", 3600, 1},
+		}
+		if len(rows) != len(want) {
+			t.Fatalf("expected %d rows, got %d", len(want), len(rows))
+		}
+		for i, w := range want {
+			row := rows[i]
+			if row.BoardID != w.boardID || row.UserID != w.userID || row.TotalSeconds != w.seconds || row.EntryCount != w.count {
+				t.Errorf("row %d: expected %+v, got %+v", i, w, row)
+			}
+			if y, m, d := row.PeriodStart.Date(); y != w.day.Year() || m != w.day.Month() || d != w.day.Day() {
+				t.Errorf("row %d: expected period of %s, got %s", i, w.day.Format(time.DateOnly), row.PeriodStart)
+			}
+		}
+
+		t.Log("✅ Report groups entries by day, board and user")
+	})
+
+	t.Run("group by month", func(t *testing.T) {
+		rows, err := repo.TimeReport(ctx, TimeEntryFilter{BoardID: 1, UserID: "alice"}, "month")
+		if err != nil {
+			t.Fatalf("failed to build report: %v", err)
+		}
+		if len(rows) != 1 || rows[0].TotalSeconds != 7200 || rows[0].EntryCount != 3 {
+			t.Errorf("expected one month of 7200s over 3 entries, got %+v", rows)
+		}
+
+		t.Log("✅ Report groups entries by month")
+	})
+
+	t.Run("reject invalid period", func(t *testing.T) {
+		if _, err := repo.TimeReport(ctx, TimeEntryFilter{}, "year; DROP TABLE tasks"); err == nil {
+			t.Error("expected error for invalid period")
+		}
+
+		t.Log("✅ Correctly rejected invalid period")
+	})
+}
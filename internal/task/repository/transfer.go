@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// TransferRepository handles DB ops for board export and import.
+type TransferRepository interface {
+	// ExportTasks calls fn for each task on the board, oldest first, as
+	// rows arrive rather than loading them all. CustomFields carry only
+	// FieldID and Value.
+	ExportTasks(ctx context.Context, boardID int64, fn func(*Task) error) error
+
+	// ImportTasks runs fn in one transaction, committing only if fn returns
+	// nil. fn adds tasks with insert, which also stores their custom field
+	// values. If board is not nil, it and fields are created first and the
+	// imported tasks go into it.
+	ImportTasks(ctx context.Context, board *Board, fields []*CustomField,
+		fn func(insert func(*Task) error) error) error
+}
+
+// withExtra scans extra columns after the ones s is asked for.
+type withExtra struct {
+	s     scanner
+	extra []any
+}
+
+func (w withExtra) Scan(dest ...any) error {
+	return w.s.Scan(append(dest, w.extra...)...)
+}
+
+func (r *postgresRepository) ExportTasks(ctx context.Context, boardID int64, fn func(*Task) error) error {
+	query := `
+		SELECT ` + taskColumns + `,
+			COALESCE((SELECT jsonb_object_agg(v.field_id, v.value)
+				FROM task_field_values v WHERE v.task_id = tasks.id), '{}')
+		FROM tasks
+		WHERE tasks.board_id = $1 AND tasks.workspace_id = $2
+		ORDER BY tasks.created_at, tasks.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, boardID, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to export tasks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rawValues []byte
+		task, err := scanTask(withExtra{s: rows, extra: []any{&rawValues}})
+		if err != nil {
+			return fmt.Errorf("failed to scan task: %w", err)
+		}
+
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(rawValues, &values); err != nil {
+			return fmt.Errorf("failed to decode custom field values: %w", err)
+		}
+		for key, value := range values {
+			fieldID, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid custom field ID %q: %w", key, err)
+			}
+			task.CustomFields = append(task.CustomFields, CustomFieldValue{FieldID: fieldID, Value: value})
+		}
+
+		if err := fn(task); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating tasks: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ImportTasks(ctx context.Context, board *Board, fields []*CustomField,
+	fn func(insert func(*Task) error) error,
+) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	if board != nil {
+		if err := insertBoard(ctx, tx, board); err != nil {
+			return err
+		}
+		for _, field := range fields {
+			field.BoardID = board.ID
+			if err := insertCustomField(ctx, tx, field); err != nil {
+				return err
+			}
+		}
+	}
+
+	insert := func(task *Task) error {
+		if board != nil {
+			task.BoardID = board.ID
+		}
+		if err := insertTask(ctx, tx, task); err != nil {
+			return fmt.Errorf("failed to import task: %w", err)
+		}
+		return upsertFieldValues(ctx, tx, task.ID, task.CustomFields)
+	}
+
+	if err := fn(insert); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+// UserSummary names a user tasks refer to.
+type UserSummary struct {
+	ID       string
+	Username string
+}
+
+// UserRepository handles DB ops for the users tasks refer to. The users
+// themselves are managed by the gateway, in the same database.
+type UserRepository interface {
+	// ListUserSummaries returns those of the users with the given IDs that
+	// exist, in no particular order.
+	ListUserSummaries(ctx context.Context, ids []string) ([]UserSummary, error)
+
+	// IsAdmin reports whether a user is one of Taskboard's admins. Users
+	// that don't exist aren't.
+	IsAdmin(ctx context.Context, userID string) (bool, error)
+}
+
+func (r *postgresRepository) ListUserSummaries(ctx context.Context, ids []string) ([]UserSummary, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `SELECT id, username FROM users WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	users, err := scanAll(rows, func(s scanner) (UserSummary, error) {
+		var u UserSummary
+		err := s.Scan(&u.ID, &u.Username)
+		return u, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan user: %w", err)
+	}
+	return users, nil
+}
+
+func (r *postgresRepository) IsAdmin(ctx context.Context, userID string) (bool, error) {
+	var admin bool
+	err := r.db.QueryRowContext(ctx, `SELECT is_admin FROM users WHERE id = $1`, userID).Scan(&admin)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	return admin, nil
+}
+
+// loadUsers fills Creator and Assignees on tasks with one query.
+func (r *postgresRepository) loadUsers(ctx context.Context, tasks ...*Task) error {
+	var ids []string
+	for _, task := range tasks {
+		if task.CreatedBy != "" {
+			ids = append(ids, task.CreatedBy)
+		}
+		ids = append(ids, task.AssigneeIDs...)
+	}
+
+	users, err := r.ListUserSummaries(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]UserSummary, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+
+	for _, task := range tasks {
+		task.Creator = nil
+		if u, ok := byID[task.CreatedBy]; ok {
+			task.Creator = &u
+		}
+		task.Assignees = make([]UserSummary, 0, len(task.AssigneeIDs))
+		for _, id := range task.AssigneeIDs {
+			if u, ok := byID[id]; ok {
+				task.Assignees = append(task.Assignees, u)
+			}
+		}
+	}
+	return nil
+}
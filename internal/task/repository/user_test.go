@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+func TestTaskUsers(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return // Test was skipped.
+	}
+	defer cleanup()
+
+	repo := NewPostgresRepository(db)
+	ctx := WithWorkspace(context.Background(), database.DefaultWorkspaceID)
+	createTestUser(t, db, "alice")
+	createTestUser(t, db, "bob")
+
+	t.Run("summarise creator and assignees", func(t *testing.T) {
+		task := createTestTask(t, ctx, repo, 1, "alice", "alice", "bob")
+
+		got, err := repo.GetByID(ctx, task.ID)
+		if err != nil {
+			t.Fatalf("failed to get task: %v", err)
+		}
+		if got.Creator == nil || got.Creator.ID != "alice" || got.Creator.Username != "alice" {
+			t.Errorf("expected creator alice, got %+v", got.Creator)
+		}
+		if len(got.Assignees) != 2 {
+			t.Fatalf("expected 2 assignees, got %+v", got.Assignees)
+		}
+		for _, a := range got.Assignees {
+			if a.Username != a.ID {
+				t.Errorf("expected username of %s, got %q", a.ID, a.Username)
+			}
+		}
+
+		t.Log("✅ Task summarises its creator and assignees")
+	})
+
+	t.Run("summarise tasks in lists", func(t *testing.T) {
+		tasks, _, err := repo.List(ctx, TaskFilter{BoardID: 1})
+		if err != nil {
+			t.Fatalf("failed to list tasks: %v", err)
+		}
+		for _, task := range tasks {
+			if task.Creator == nil {
+				t.Errorf("expected creator of task %d", task.ID)
+			}
+		}
+
+		t.Log("✅ Listed tasks summarise their users")
+	})
+
+	t.Run("reject unknown assignee", func(t *testing.T) {
+		task := &Task{BoardID: 1, Title: "Ghost", CreatedBy: "alice", AssigneeIDs: []string{"ghost"}}
+		if err := repo.Create(ctx, task); err == nil {
+			t.Error("expected foreign key violation for unknown assignee")
+		}
+
+		t.Log("✅ Correctly rejected unknown assignee")
+	})
+
+	t.Run("list user summaries", func(t *testing.T) {
+		users, err := repo.ListUserSummaries(ctx, []string{"bob", "ghost"})
+		if err != nil {
+			t.Fatalf("failed to list users: %v", err)
+		}
+		if len(users) != 1 || users[0].ID != "bob" {
+			t.Errorf("expected only bob, got %+v", users)
+		}
+
+		t.Log("✅ Unknown users are left out of summaries")
+	})
+}
@@ -0,0 +1,276 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// UserData is what a workspace keeps about a user: the tasks they created
+// or are assigned to, their time, and their settings.
+type UserData struct {
+	CreatedTasks       []*Task
+	AssignedTasks      []*Task
+	TimeEntries        []*TimeEntry
+	WatchedTaskIDs     []int64
+	BoardMemberships   []*BoardMember
+	SavedViews         []*SavedView
+	CalendarFeeds      []*CalendarFeed
+	InboxNotifications []*InboxNotification
+}
+
+// UserDataRepository handles DB ops for exporting and deleting all of a
+// user's data, for account export and deletion.
+type UserDataRepository interface {
+	// ExportUserData returns the user's data in the context's workspace.
+	ExportUserData(ctx context.Context, userID string) (*UserData, error)
+
+	// DeleteUserData removes the user from the context's workspace: their
+	// assignments, watches, views, feeds and inbox go, and their timer
+	// stops. Tasks and time entries they created stay, credited to their
+	// anonymised account. Boards they are the last owner of pass to their
+	// most senior other member; on boards without others, the membership
+	// stays, so the board doesn't open to everyone.
+	DeleteUserData(ctx context.Context, userID string) error
+
+	// ListUserWorkspaceMemberships and DeleteUserWorkspaceData handle the
+	// user's data outside any one workspace: their workspace memberships,
+	// passed on like board ones, and notification settings.
+	ListUserWorkspaceMemberships(ctx context.Context, userID string) ([]*WorkspaceMember, error)
+	DeleteUserWorkspaceData(ctx context.Context, userID string) error
+}
+
+// scanAll scans every row with scan.
+func scanAll[T any](rows *sql.Rows, scan func(scanner) (T, error)) ([]T, error) {
+	defer rows.Close()
+
+	items := []T{}
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+func scanID(row scanner) (int64, error) {
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+func (r *postgresRepository) ExportUserData(ctx context.Context, userID string) (*UserData, error) {
+	ws := currentWorkspace(ctx)
+	data := &UserData{}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+taskColumns+` FROM tasks
+		WHERE created_by = $1 AND workspace_id = $2
+		ORDER BY id
+	`, userID, ws)
+	if err == nil {
+		data.CreatedTasks, err = scanAll(rows, scanTask)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export created tasks: %w", err)
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT `+taskColumns+` FROM tasks
+		WHERE id IN (SELECT task_id FROM task_assignees WHERE user_id = $1) AND workspace_id = $2
+		ORDER BY id
+	`, userID, ws)
+	if err == nil {
+		data.AssignedTasks, err = scanAll(rows, scanTask)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export assigned tasks: %w", err)
+	}
+
+	tasks := append(data.CreatedTasks, data.AssignedTasks...)
+	if err := r.loadFieldValues(ctx, tasks...); err != nil {
+		return nil, err
+	}
+	if err := r.loadUsers(ctx, tasks...); err != nil {
+		return nil, err
+	}
+
+	if data.TimeEntries, err = r.ListTimeEntries(ctx, TimeEntryFilter{UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT task_id FROM task_watchers
+		WHERE user_id = $1 AND `+workspaceTaskCondition("task_id", "$2")+`
+		ORDER BY task_id
+	`, userID, ws)
+	if err == nil {
+		data.WatchedTaskIDs, err = scanAll(rows, scanID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export watched tasks: %w", err)
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT `+boardMemberColumns+` FROM board_members
+		WHERE user_id = $1 AND `+workspaceBoardCondition("board_id", "$2")+`
+		ORDER BY board_id
+	`, userID, ws)
+	if err == nil {
+		data.BoardMemberships, err = scanAll(rows, scanBoardMember)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export board memberships: %w", err)
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT `+savedViewColumns+` FROM saved_views
+		WHERE owner_id = $1 AND workspace_id = $2
+		ORDER BY id
+	`, userID, ws)
+	if err == nil {
+		data.SavedViews, err = scanAll(rows, scanSavedView)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export saved views: %w", err)
+	}
+
+	if data.CalendarFeeds, err = r.ListCalendarFeeds(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT `+inboxNotificationColumns+` FROM inbox_notifications
+		WHERE user_id = $1 AND workspace_id = $2
+		ORDER BY id
+	`, userID, ws)
+	if err == nil {
+		data.InboxNotifications, err = scanAll(rows, scanInboxNotification)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to export inbox notifications: %w", err)
+	}
+
+	return data, nil
+}
+
+// Statements that remove a user ($1) from a workspace ($2), run in order.
+var deleteUserDataStatements = []struct{ what, query string }{
+	{"assignments", `DELETE FROM task_assignees WHERE user_id = $1 AND ` + workspaceTaskCondition("task_id", "$2")},
+	{"watches", `DELETE FROM task_watchers WHERE user_id = $1 AND ` + workspaceTaskCondition("task_id", "$2")},
+	{"saved views", `DELETE FROM saved_views WHERE owner_id = $1 AND workspace_id = $2`},
+	{"calendar feeds", `DELETE FROM calendar_feeds WHERE owner_id = $1 AND workspace_id = $2`},
+	{"inbox notifications", `DELETE FROM inbox_notifications WHERE user_id = $1 AND workspace_id = $2`},
+	{"timer", `UPDATE time_entries SET ended_at = NOW(), updated_at = NOW() WHERE user_id = $1 AND workspace_id = $2 AND ended_at IS NULL`},
+	{"board members", `
+		SELECT 1 FROM board_members
+		WHERE board_id IN (SELECT board_id FROM board_members WHERE user_id = $1)
+			AND ` + workspaceBoardCondition("board_id", "$2") + `
+		FOR UPDATE`},
+	{"board owners", promoteSuccessorsQuery("board_members", "board_id", boardRoleRank,
+		workspaceBoardCondition("board_id", "$2"))},
+	{"board memberships", leaveQuery("board_members", "board_id", workspaceBoardCondition("board_id", "$2"))},
+}
+
+// boardRoleRank orders board roles by seniority.
+const boardRoleRank = `CASE role WHEN 'owner' THEN 0 WHEN 'admin' THEN 1 WHEN 'member' THEN 2 ELSE 3 END`
+
+// workspaceRoleRank orders workspace roles by seniority.
+const workspaceRoleRank = `CASE role WHEN 'owner' THEN 0 ELSE 1 END`
+
+// promoteSuccessorsQuery returns a statement making the most senior other
+// member of each group (a board or workspace, by the column col) that $1
+// is the last owner of an owner. cond limits the groups.
+func promoteSuccessorsQuery(table, col, rank, cond string) string {
+	return `
+		UPDATE ` + table + ` m SET role = 'owner'
+		FROM (
+			SELECT DISTINCT ON (` + col + `) ` + col + ` AS grp, user_id
+			FROM ` + table + `
+			WHERE user_id <> $1 AND ` + cond + ` AND ` + col + ` IN (
+				SELECT ` + col + ` FROM ` + table + ` WHERE user_id = $1 AND role = 'owner'
+				EXCEPT
+				SELECT ` + col + ` FROM ` + table + ` WHERE user_id <> $1 AND role = 'owner'
+			)
+			ORDER BY ` + col + `, ` + rank + `, created_at, user_id
+		) s
+		WHERE m.` + col + ` = s.grp AND m.user_id = s.user_id`
+}
+
+// leaveQuery returns a statement removing $1's memberships of the groups
+// cond allows that have other members.
+func leaveQuery(table, col, cond string) string {
+	return `
+		DELETE FROM ` + table + ` m
+		WHERE m.user_id = $1 AND ` + cond + `
+			AND EXISTS (SELECT 1 FROM ` + table + ` o WHERE o.` + col + ` = m.` + col + ` AND o.user_id <> $1)`
+}
+
+func (r *postgresRepository) DeleteUserData(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	for _, stmt := range deleteUserDataStatements {
+		if _, err := tx.ExecContext(ctx, stmt.query, userID, currentWorkspace(ctx)); err != nil {
+			return fmt.Errorf("failed to delete user's %s: %w", stmt.what, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user data deletion: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) ListUserWorkspaceMemberships(ctx context.Context, userID string) ([]*WorkspaceMember, error) {
+	query := `SELECT ` + workspaceMemberColumns + ` FROM workspace_members WHERE user_id = $1 ORDER BY workspace_id`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace memberships: %w", err)
+	}
+	members, err := scanAll(rows, scanWorkspaceMember)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan workspace memberships: %w", err)
+	}
+
+	return members, nil
+}
+
+// Statements that remove a user ($1) from every workspace, run in order.
+var deleteUserWorkspaceDataStatements = []struct{ what, query string }{
+	{"workspace members", `
+		SELECT 1 FROM workspace_members
+		WHERE workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = $1)
+		FOR UPDATE`},
+	{"workspace owners", promoteSuccessorsQuery("workspace_members", "workspace_id", workspaceRoleRank, "TRUE")},
+	{"workspace memberships", leaveQuery("workspace_members", "workspace_id", "TRUE")},
+	{"notification preferences", `DELETE FROM notification_preferences WHERE user_id = $1`},
+	{"notification outbox", `DELETE FROM notification_outbox WHERE user_id = $1`},
+}
+
+func (r *postgresRepository) DeleteUserWorkspaceData(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	for _, stmt := range deleteUserWorkspaceDataStatements {
+		if _, err := tx.ExecContext(ctx, stmt.query, userID); err != nil {
+			return fmt.Errorf("failed to delete user's %s: %w", stmt.what, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user data deletion: %w", err)
+	}
+
+	return nil
+}
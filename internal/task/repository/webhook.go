@@ -0,0 +1,526 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+// Webhook delivery statuses.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// Webhook represents an outbound webhook in DB. Empty EventTypes or
+// BoardIDs match every event or board.
+type Webhook struct {
+	ID             int64
+	OwnerID        string
+	URL            string
+	Secret         string
+	EventTypes     []string
+	BoardIDs       []int64
+	Active         bool
+	DisabledReason string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Read-only: the current run of failed attempts.
+	ConsecutiveFailures int
+	FailingSince        *time.Time
+}
+
+// WebhookDelivery represents an event sent, or to be sent, to a webhook.
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+	RedeliveryOf   int64
+	CreatedAt      time.Time
+	LastAttemptAt  *time.Time
+	NextAttemptAt  time.Time
+
+	// Set by ClaimWebhookDeliveries.
+	URL    string
+	Secret string
+}
+
+// WebhookDeliveryFilter selects a webhook's deliveries. An empty Status
+// matches all.
+type WebhookDeliveryFilter struct {
+	WebhookID int64
+	Status    string
+	Limit     int
+	Offset    int
+}
+
+// WebhookAttempt is the outcome of a delivery attempt.
+type WebhookAttempt struct {
+	DeliveryID     int64
+	WebhookID      int64
+	Succeeded      bool
+	ResponseStatus int
+	ResponseBody   string
+	Error          string
+
+	// NextAttemptAt schedules a retry after a failed attempt; nil marks
+	// the delivery dead.
+	NextAttemptAt *time.Time
+
+	// A webhook is deactivated once its attempts have failed for
+	// DisableAfter, at least DisableMinFailures times in a row.
+	DisableAfter       time.Duration
+	DisableMinFailures int
+}
+
+// WebhookRepository handles DB ops for webhooks and their deliveries.
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *Webhook) error
+	GetWebhook(ctx context.Context, id int64) (*Webhook, error)
+	ListWebhooks(ctx context.Context, ownerID string) ([]*Webhook, error)
+
+	// UpdateWebhook saves a webhook's settings. Activating it clears its
+	// failure run.
+	UpdateWebhook(ctx context.Context, webhook *Webhook) error
+	DeleteWebhook(ctx context.Context, id int64) error
+
+	// MatchWebhooks lists the active webhooks subscribed to eventType on
+	// boardID.
+	MatchWebhooks(ctx context.Context, eventType string, boardID int64) ([]*Webhook, error)
+
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error)
+
+	// ListWebhookDeliveries lists deliveries newest first, with the total
+	// count.
+	ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]*WebhookDelivery, int, error)
+
+	// ClaimWebhookDeliveries returns up to limit pending deliveries of
+	// active webhooks that are due, with URL and Secret set. Their next
+	// attempt is moved lease into the future, so no other worker claims
+	// them meanwhile and they are retried if this one dies.
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error)
+
+	// RecordWebhookAttempt stores an attempt and updates the webhook's
+	// failure run, reporting whether the webhook was deactivated.
+	RecordWebhookAttempt(ctx context.Context, attempt *WebhookAttempt) (bool, error)
+}
+
+const webhookColumns = `id, owner_id, url, secret, event_types, board_ids, active, disabled_reason,
+	consecutive_failures, failing_since, created_at, updated_at`
+
+func scanWebhook(s scanner) (*Webhook, error) {
+	w := &Webhook{}
+	err := s.Scan(
+		&w.ID,
+		&w.OwnerID,
+		&w.URL,
+		&w.Secret,
+		pq.Array(&w.EventTypes),
+		pq.Array(&w.BoardIDs),
+		&w.Active,
+		&w.DisabledReason,
+		&w.ConsecutiveFailures,
+		&w.FailingSince,
+		&w.CreatedAt,
+		&w.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// webhookDeliveryColumns select from webhook_deliveries aliased as d.
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.status, d.attempts,
+	d.response_status, d.response_body, d.error, COALESCE(d.redelivery_of, 0), d.created_at,
+	d.last_attempt_at, d.next_attempt_at`
+
+func scanWebhookDelivery(s scanner) (*WebhookDelivery, error) {
+	d := &WebhookDelivery{}
+	err := s.Scan(
+		&d.ID,
+		&d.WebhookID,
+		&d.EventID,
+		&d.EventType,
+		&d.Payload,
+		&d.Status,
+		&d.Attempts,
+		&d.ResponseStatus,
+		&d.ResponseBody,
+		&d.Error,
+		&d.RedeliveryOf,
+		&d.CreatedAt,
+		&d.LastAttemptAt,
+		&d.NextAttemptAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (r *postgresRepository) CreateWebhook(ctx context.Context, webhook *Webhook) error {
+	query := `
+		INSERT INTO webhooks (owner_id, url, secret, event_types, board_ids, active, workspace_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		webhook.OwnerID,
+		webhook.URL,
+		webhook.Secret,
+		pq.Array(nonNilStrings(webhook.EventTypes)),
+		pq.Array(nonNilInt64s(webhook.BoardIDs)),
+		webhook.Active,
+		currentWorkspace(ctx),
+	).Scan(&webhook.ID, &webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+// nonNilInt64s returns ids, or an empty slice for nil, so it is stored as
+// an empty array rather than NULL.
+func nonNilInt64s(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
+
+func (r *postgresRepository) GetWebhook(ctx context.Context, id int64) (*Webhook, error) {
+	query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1 AND workspace_id = $2`
+
+	webhook, err := scanWebhook(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *postgresRepository) ListWebhooks(ctx context.Context, ownerID string) ([]*Webhook, error) {
+	return r.queryWebhooks(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE owner_id = $1 AND workspace_id = $2 ORDER BY id`,
+		ownerID, currentWorkspace(ctx))
+}
+
+func (r *postgresRepository) MatchWebhooks(ctx context.Context, eventType string, boardID int64) ([]*Webhook, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhooks
+		WHERE active AND workspace_id = $3
+			AND (cardinality(event_types) = 0 OR $1 = ANY(event_types))
+			AND (cardinality(board_ids) = 0 OR $2 = ANY(board_ids))
+		ORDER BY id
+	`
+	return r.queryWebhooks(ctx, query, eventType, boardID, currentWorkspace(ctx))
+}
+
+func (r *postgresRepository) queryWebhooks(ctx context.Context, query string, args ...any) ([]*Webhook, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*Webhook{}
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *postgresRepository) UpdateWebhook(ctx context.Context, webhook *Webhook) error {
+	query := `
+		UPDATE webhooks
+		SET url = $1, secret = $2, event_types = $3, board_ids = $4, active = $5, disabled_reason = $6,
+			consecutive_failures = CASE WHEN $5 AND NOT active THEN 0 ELSE consecutive_failures END,
+			failing_since = CASE WHEN $5 AND NOT active THEN NULL ELSE failing_since END,
+			updated_at = NOW()
+		WHERE id = $7 AND workspace_id = $8
+		RETURNING consecutive_failures, failing_since, updated_at
+	`
+
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		webhook.URL,
+		webhook.Secret,
+		pq.Array(nonNilStrings(webhook.EventTypes)),
+		pq.Array(nonNilInt64s(webhook.BoardIDs)),
+		webhook.Active,
+		webhook.DisabledReason,
+		webhook.ID,
+		currentWorkspace(ctx),
+	).Scan(&webhook.ConsecutiveFailures, &webhook.FailingSince, &webhook.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) DeleteWebhook(ctx context.Context, id int64) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1 AND workspace_id = $2`, id, currentWorkspace(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	for _, d := range deliveries {
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status, redelivery_of,
+				created_at, next_attempt_at)
+			VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+			RETURNING id, status, created_at, next_attempt_at
+		`,
+			d.WebhookID,
+			d.EventID,
+			d.EventType,
+			d.Payload,
+			DeliveryPending,
+			sql.NullInt64{Int64: d.RedeliveryOf, Valid: d.RedeliveryOf != 0},
+		).Scan(&d.ID, &d.Status, &d.CreatedAt, &d.NextAttemptAt)
+		if err != nil {
+			return fmt.Errorf("failed to create webhook delivery: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetWebhookDelivery(ctx context.Context, id int64) (*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d WHERE d.id = $1 AND ` +
+		workspaceWebhookCondition("d.webhook_id", "$2")
+
+	delivery, err := scanWebhookDelivery(r.db.QueryRowContext(ctx, query, id, currentWorkspace(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+func (r *postgresRepository) ListWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]*WebhookDelivery, int, error) {
+	where := ` WHERE d.webhook_id = $1 AND ` + workspaceWebhookCondition("d.webhook_id", "$2")
+	params := []any{filter.WebhookID, currentWorkspace(ctx)}
+	if filter.Status != "" {
+		where += ` AND d.status = $3`
+		params = append(params, filter.Status)
+	}
+
+	var totalCount int
+	err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM webhook_deliveries d`+where, params...).Scan(&totalCount)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d` + where +
+		fmt.Sprintf(` ORDER BY d.id DESC LIMIT $%d OFFSET $%d`, len(params)+1, len(params)+2)
+	params = append(params, filter.Limit, filter.Offset)
+
+	rows, err := r.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*WebhookDelivery{}
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating webhook deliveries: %w", err)
+	}
+
+	return deliveries, totalCount, nil
+}
+
+func (r *postgresRepository) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $2)
+		FROM webhooks w
+		WHERE w.id = d.webhook_id AND d.id IN (
+			SELECT due.id
+			FROM webhook_deliveries due
+			JOIN webhooks dw ON dw.id = due.webhook_id
+			WHERE due.status = 'pending' AND due.next_attempt_at <= NOW() AND dw.active
+				AND dw.workspace_id = $3
+			ORDER BY due.next_attempt_at
+			LIMIT $1
+			FOR UPDATE OF due SKIP LOCKED
+		)
+		RETURNING ` + webhookDeliveryColumns + `, w.url, w.secret
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, limit, lease.Seconds(), currentWorkspace(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*WebhookDelivery{}
+	for rows.Next() {
+		var url, secret string
+		delivery, err := scanWebhookDelivery(withExtra{s: rows, extra: []any{&url, &secret}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		delivery.URL, delivery.Secret = url, secret
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r *postgresRepository) RecordWebhookAttempt(ctx context.Context, attempt *WebhookAttempt) (bool, error) {
+	status := DeliverySucceeded
+	if !attempt.Succeeded {
+		status = DeliveryPending
+		if attempt.NextAttemptAt == nil {
+			status = DeliveryDead
+		}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_attempt_at = NOW(),
+			next_attempt_at = COALESCE($3, next_attempt_at),
+			response_status = $4, response_body = $5, error = $6
+		WHERE id = $1 AND `+workspaceWebhookCondition("webhook_id", "$7")+`
+	`,
+		attempt.DeliveryID,
+		status,
+		attempt.NextAttemptAt,
+		attempt.ResponseStatus,
+		attempt.ResponseBody,
+		attempt.Error,
+		currentWorkspace(ctx),
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+
+	// A success ends the failure run; a failure extends it, and may end
+	// the webhook.
+	var failures int
+	var failingSince *time.Time
+	err = tx.QueryRowContext(ctx, `
+		UPDATE webhooks
+		SET consecutive_failures = CASE WHEN $2 THEN 0 ELSE consecutive_failures + 1 END,
+			failing_since = CASE WHEN $2 THEN NULL ELSE COALESCE(failing_since, NOW()) END
+		WHERE id = $1 AND workspace_id = $3
+		RETURNING consecutive_failures, failing_since
+	`, attempt.WebhookID, attempt.Succeeded, currentWorkspace(ctx)).Scan(&failures, &failingSince)
+	if err != nil && err != sql.ErrNoRows {
+		return false, fmt.Errorf("failed to update webhook failures: %w", err)
+	}
+
+	disabled := false
+	if !attempt.Succeeded && failingSince != nil && attempt.DisableMinFailures > 0 &&
+		failures >= attempt.DisableMinFailures && time.Since(*failingSince) >= attempt.DisableAfter {
+		reason := fmt.Sprintf("deactivated after %d failed attempts since %s; last error: %s",
+			failures, failingSince.UTC().Format(time.RFC3339), attempt.Error)
+		result, err := tx.ExecContext(ctx, `
+			UPDATE webhooks SET active = FALSE, disabled_reason = $2, updated_at = NOW()
+			WHERE id = $1 AND workspace_id = $3 AND active
+		`, attempt.WebhookID, reason, currentWorkspace(ctx))
+		if err != nil {
+			return false, fmt.Errorf("failed to deactivate webhook: %w", err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		disabled = n > 0
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit webhook attempt: %w", err)
+	}
+
+	return disabled, nil
+}
@@ -0,0 +1,399 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+// Workspace roles. Owners manage the workspace's members.
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleMember = "member"
+)
+
+// DefaultWorkspaceID is the workspace used when a caller doesn't pick one.
+const DefaultWorkspaceID = database.DefaultWorkspaceID
+
+var (
+	ErrWorkspaceNotFound       = errors.New("workspace not found")
+	ErrWorkspaceSlugTaken      = errors.New("workspace slug is already taken")
+	ErrWorkspaceMemberNotFound = errors.New("workspace member not found")
+	ErrWorkspaceMemberExists   = errors.New("user is already a workspace member")
+	ErrLastWorkspaceOwner      = errors.New("a workspace must keep at least one owner")
+)
+
+// WithWorkspace scopes repository calls made with ctx to a workspace.
+// Without one, they see nothing and can't create anything.
+func WithWorkspace(ctx context.Context, id int64) context.Context {
+	return database.WithWorkspace(ctx, id)
+}
+
+// WorkspaceFromContext returns the workspace ctx is scoped to.
+func WorkspaceFromContext(ctx context.Context) (int64, bool) {
+	return database.WorkspaceID(ctx)
+}
+
+// currentWorkspace returns the workspace ctx is scoped to, or 0, which no
+// rows belong to.
+func currentWorkspace(ctx context.Context) int64 {
+	id, _ := database.WorkspaceID(ctx)
+	return id
+}
+
+// Workspace represents a workspace in DB.
+type Workspace struct {
+	ID        int64
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+
+	// RequireMFA limits the workspace to callers who signed in with a
+	// second factor.
+	RequireMFA bool
+
+	// OwnerID, if set when the workspace is created, becomes its first
+	// owner.
+	OwnerID string
+}
+
+// WorkspaceMember represents a user's role in a workspace in DB.
+type WorkspaceMember struct {
+	WorkspaceID int64
+	UserID      string
+	Role        string
+	CreatedAt   time.Time
+}
+
+// WorkspaceAccess is what a user may do in a workspace.
+type WorkspaceAccess struct {
+	// Open workspaces have no members, and are open to everyone.
+	Open bool
+
+	// Role is the user's role, or empty if they aren't a member.
+	Role string
+
+	// RequireMFA is whether the workspace requires a second factor.
+	RequireMFA bool
+}
+
+// WorkspaceRepository handles DB ops for workspaces and their members.
+// Workspaces aren't scoped to one, so these ignore the context's.
+type WorkspaceRepository interface {
+	// CreateWorkspace returns ErrWorkspaceSlugTaken if the slug is in use.
+	CreateWorkspace(ctx context.Context, workspace *Workspace) error
+	GetWorkspace(ctx context.Context, id int64) (*Workspace, error)
+
+	// SetWorkspaceRequireMFA returns ErrWorkspaceNotFound for workspaces
+	// that don't exist.
+	SetWorkspaceRequireMFA(ctx context.Context, id int64, require bool) (*Workspace, error)
+
+	// ListWorkspaces returns the workspaces userID can use: open ones,
+	// and those they are a member of.
+	ListWorkspaces(ctx context.Context, userID string) ([]*Workspace, error)
+
+	// ListWorkspaceIDs returns every workspace, for background jobs that
+	// visit each in turn.
+	ListWorkspaceIDs(ctx context.Context) ([]int64, error)
+
+	// GetWorkspaceAccess returns ErrWorkspaceNotFound for workspaces that
+	// don't exist.
+	GetWorkspaceAccess(ctx context.Context, id int64, userID string) (*WorkspaceAccess, error)
+
+	ListWorkspaceMembers(ctx context.Context, id int64) ([]*WorkspaceMember, error)
+	AddWorkspaceMember(ctx context.Context, member *WorkspaceMember) error
+
+	// RemoveWorkspaceMember refuses to leave a workspace without owners.
+	RemoveWorkspaceMember(ctx context.Context, id int64, userID string) error
+}
+
+const workspaceColumns = `id, name, slug, created_at, require_mfa`
+
+func scanWorkspace(row scanner) (*Workspace, error) {
+	var w Workspace
+	if err := row.Scan(&w.ID, &w.Name, &w.Slug, &w.CreatedAt, &w.RequireMFA); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *postgresRepository) CreateWorkspace(ctx context.Context, workspace *Workspace) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO workspaces (name, slug, created_at)
+		VALUES ($1, $2, NOW())
+		RETURNING id, created_at
+	`, workspace.Name, workspace.Slug).Scan(&workspace.ID, &workspace.CreatedAt)
+	if isUniqueViolation(err) {
+		return ErrWorkspaceSlugTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	if workspace.OwnerID != "" {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+			VALUES ($1, $2, $3, NOW())
+		`, workspace.ID, workspace.OwnerID, WorkspaceRoleOwner)
+		if err != nil {
+			return fmt.Errorf("failed to add workspace owner: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit workspace: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) GetWorkspace(ctx context.Context, id int64) (*Workspace, error) {
+	query := `SELECT ` + workspaceColumns + ` FROM workspaces WHERE id = $1`
+
+	w, err := scanWorkspace(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	return w, nil
+}
+
+func (r *postgresRepository) SetWorkspaceRequireMFA(ctx context.Context, id int64, require bool) (*Workspace, error) {
+	query := `UPDATE workspaces SET require_mfa = $2 WHERE id = $1 RETURNING ` + workspaceColumns
+
+	w, err := scanWorkspace(r.db.QueryRowContext(ctx, query, id, require))
+	if err == sql.ErrNoRows {
+		return nil, ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workspace: %w", err)
+	}
+
+	return w, nil
+}
+
+func (r *postgresRepository) ListWorkspaces(ctx context.Context, userID string) ([]*Workspace, error) {
+	query := `
+		SELECT ` + workspaceColumns + ` FROM workspaces w
+		WHERE w.id IN (SELECT workspace_id FROM workspace_members WHERE user_id = $1)
+			OR NOT EXISTS (SELECT 1 FROM workspace_members m WHERE m.workspace_id = w.id)
+		ORDER BY w.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	defer rows.Close()
+
+	workspaces := []*Workspace{}
+	for rows.Next() {
+		w, err := scanWorkspace(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workspace: %w", err)
+		}
+		workspaces = append(workspaces, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspaces: %w", err)
+	}
+
+	return workspaces, nil
+}
+
+func (r *postgresRepository) ListWorkspaceIDs(ctx context.Context) ([]int64, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id FROM workspaces ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan workspace: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspaces: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *postgresRepository) GetWorkspaceAccess(ctx context.Context, id int64, userID string) (*WorkspaceAccess, error) {
+	query := `
+		SELECT
+			NOT EXISTS (SELECT 1 FROM workspace_members WHERE workspace_id = $1),
+			COALESCE((SELECT role FROM workspace_members WHERE workspace_id = $1 AND user_id = $2), ''),
+			require_mfa
+		FROM workspaces
+		WHERE id = $1
+	`
+
+	var access WorkspaceAccess
+	err := r.db.QueryRowContext(ctx, query, id, userID).Scan(&access.Open, &access.Role, &access.RequireMFA)
+	if err == sql.ErrNoRows {
+		return nil, ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace access: %w", err)
+	}
+
+	return &access, nil
+}
+
+const workspaceMemberColumns = `workspace_id, user_id, role, created_at`
+
+func scanWorkspaceMember(row scanner) (*WorkspaceMember, error) {
+	var m WorkspaceMember
+	if err := row.Scan(&m.WorkspaceID, &m.UserID, &m.Role, &m.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *postgresRepository) ListWorkspaceMembers(ctx context.Context, id int64) ([]*WorkspaceMember, error) {
+	query := `SELECT ` + workspaceMemberColumns + ` FROM workspace_members WHERE workspace_id = $1 ORDER BY created_at, user_id`
+
+	rows, err := r.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []*WorkspaceMember{}
+	for rows.Next() {
+		m, err := scanWorkspaceMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workspace member: %w", err)
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspace members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (r *postgresRepository) AddWorkspaceMember(ctx context.Context, member *WorkspaceMember) error {
+	query := `
+		INSERT INTO workspace_members (workspace_id, user_id, role, created_at)
+		SELECT $1, $2, $3, NOW()
+		WHERE EXISTS (SELECT 1 FROM workspaces WHERE id = $1)
+		RETURNING created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, member.WorkspaceID, member.UserID, member.Role).Scan(&member.CreatedAt)
+	if err == sql.ErrNoRows {
+		return ErrWorkspaceNotFound
+	}
+	if isUniqueViolation(err) {
+		return ErrWorkspaceMemberExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add workspace member: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRepository) RemoveWorkspaceMember(ctx context.Context, id int64, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op after commit.
+
+	owners, err := lockWorkspaceOwners(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if len(owners) == 1 && owners[0] == userID {
+		return ErrLastWorkspaceOwner
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM workspace_members WHERE workspace_id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove workspace member: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrWorkspaceMemberNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit workspace member: %w", err)
+	}
+
+	return nil
+}
+
+// lockWorkspaceOwners locks the workspace's members for the rest of tx and
+// returns its owners, so concurrent removals can't take the last one.
+func lockWorkspaceOwners(ctx context.Context, tx *sql.Tx, id int64) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT user_id, role FROM workspace_members WHERE workspace_id = $1 FOR UPDATE
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock workspace members: %w", err)
+	}
+	defer rows.Close()
+
+	var owners []string
+	for rows.Next() {
+		var userID, role string
+		if err := rows.Scan(&userID, &role); err != nil {
+			return nil, fmt.Errorf("failed to scan workspace member: %w", err)
+		}
+		if role == WorkspaceRoleOwner {
+			owners = append(owners, userID)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workspace members: %w", err)
+	}
+
+	return owners, nil
+}
+
+// workspaceBoardCondition returns an SQL condition on the board ID column
+// col that holds for boards in the workspace wsParam.
+func workspaceBoardCondition(col, wsParam string) string {
+	return col + ` IN (SELECT id FROM boards WHERE workspace_id = ` + wsParam + `)`
+}
+
+// workspaceTaskCondition returns an SQL condition on the task ID column
+// col that holds for tasks in the workspace wsParam.
+func workspaceTaskCondition(col, wsParam string) string {
+	return col + ` IN (SELECT id FROM tasks WHERE workspace_id = ` + wsParam + `)`
+}
+
+// workspaceWebhookCondition returns an SQL condition on the webhook ID
+// column col that holds for webhooks in the workspace wsParam.
+func workspaceWebhookCondition(col, wsParam string) string {
+	return col + ` IN (SELECT id FROM webhooks WHERE workspace_id = ` + wsParam + `)`
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/zaouldyeck/taskboard/internal/database"
+)
+
+func TestWorkspaceIsolation(t *testing.T) {
+	db, cleanup := setupTestDb(t)
+	if db == nil {
+		return // Test was skipped.
+	}
+	defer cleanup()
+
+	// Superusers and BYPASSRLS roles skip row-level security.
+	var bypass bool
+	err := db.QueryRow(`SELECT rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user`).Scan(&bypass)
+	if err != nil {
+		t.Fatalf("failed to check role: %v", err)
+	}
+	if bypass {
+		t.Skip("Skipping test: test db role bypasses row-level security")
+	}
+
+	repo := NewPostgresRepository(db)
+	createTestUser(t, db, "alice")
+
+	other := &Workspace{Name: "Other", Slug: "other"}
+	if err := repo.CreateWorkspace(context.Background(), other); err != nil {
+		t.Fatalf("failed to create workspace: %v", err)
+	}
+
+	defaultCtx := WithWorkspace(context.Background(), database.DefaultWorkspaceID)
+	otherCtx := WithWorkspace(context.Background(), other.ID)
+	task := createTestTask(t, otherCtx, repo, 1, "alice", "alice")
+
+	t.Run("repository hides other workspaces", func(t *testing.T) {
+		if _, err := repo.GetByID(defaultCtx, task.ID); !errors.Is(err, ErrTaskNotFound) {
+			t.Errorf("expected no rows, got: %v", err)
+		}
+
+		t.Log("✅ Tasks of other workspaces aren't found")
+	})
+
+	t.Run("row security hides other workspaces", func(t *testing.T) {
+		// Unlike the repository, these queries don't filter by workspace;
+		// only the policies keep other workspaces' rows out.
+		for _, table := range []string{"tasks", "task_assignees"} {
+			var count int
+			if err := db.QueryRowContext(defaultCtx, `SELECT COUNT(*) FROM `+table).Scan(&count); err != nil {
+				t.Fatalf("failed to count %s: %v", table, err)
+			}
+			if count != 0 {
+				t.Errorf("expected no %s of the other workspace, got %d", table, count)
+			}
+
+			if err := db.QueryRowContext(otherCtx, `SELECT COUNT(*) FROM `+table).Scan(&count); err != nil {
+				t.Fatalf("failed to count %s: %v", table, err)
+			}
+			if count != 1 {
+				t.Errorf("expected 1 row of %s in its workspace, got %d", table, count)
+			}
+		}
+
+		t.Log("✅ Row security scopes rows to app.workspace_id")
+	})
+
+	t.Run("row security hides rows without a workspace", func(t *testing.T) {
+		var count int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM tasks`).Scan(&count); err != nil {
+			t.Fatalf("failed to count tasks: %v", err)
+		}
+		if count != 0 {
+			t.Errorf("expected no tasks without a workspace, got %d", count)
+		}
+
+		t.Log("✅ Queries without a workspace see nothing")
+	})
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// Calls made on behalf of a user carry a call token for them, signed by
+// the gateway, under authorizationMetadataKey.
+const (
+	authorizationMetadataKey = "authorization"
+	taskServiceAudience      = "task-service"
+)
+
+// anonymousMethods may be called without a call token.
+var anonymousMethods = map[string]bool{
+	// Calendar feeds are authenticated by their token.
+	pb.TaskService_GetCalendar_FullMethodName: true,
+}
+
+type (
+	callerKey    struct{}
+	callerMFAKey struct{}
+)
+
+// roleRanks orders board roles; higher ranks can do everything lower
+// ranks can.
+var roleRanks = map[string]int{
+	repository.RoleViewer: 1,
+	repository.RoleMember: 2,
+	repository.RoleAdmin:  3,
+	repository.RoleOwner:  4,
+}
+
+// authenticate verifies a call's token, and returns ctx carrying the user
+// it's made on behalf of. Only anonymousMethods may be called without one.
+func (s *TaskService) authenticate(ctx context.Context, method string) (context.Context, error) {
+	value := incomingMetadata(ctx, authorizationMetadataKey)
+	if value == "" {
+		if anonymousMethods[method] {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "call token required")
+	}
+
+	token, ok := strings.CutPrefix(value, "Bearer ")
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "call token must be a bearer token")
+	}
+	claims, err := s.auth.ValidateCallToken(token, taskServiceAudience)
+	if err != nil {
+		log.Printf("Rejected call token for %s: %v", method, err)
+		return nil, status.Error(codes.Unauthenticated, "invalid call token")
+	}
+	ctx = context.WithValue(ctx, callerMFAKey{}, claims.MFA)
+	return context.WithValue(ctx, callerKey{}, claims.UserId), nil
+}
+
+// callerID returns the calling user's ID, or empty if the call is
+// anonymous.
+func callerID(ctx context.Context) string {
+	userID, _ := ctx.Value(callerKey{}).(string)
+	return userID
+}
+
+// callerMFA reports whether the calling user signed in with a second
+// factor.
+func callerMFA(ctx context.Context) bool {
+	mfa, _ := ctx.Value(callerMFAKey{}).(bool)
+	return mfa
+}
+
+// checkMFA refuses callers who didn't sign in with a second factor to
+// things that require one.
+func checkMFA(required, mfa bool, what string) error {
+	if required && !mfa {
+		return status.Errorf(codes.PermissionDenied, "%s requires signing in with two-factor authentication", what)
+	}
+	return nil
+}
+
+// actingUser returns the user a call acts as: its caller. Requests may
+// still name the user they act as, but only as the caller.
+func actingUser(ctx context.Context, requested string) (string, error) {
+	userID := callerID(ctx)
+	switch {
+	case userID == "":
+		return "", status.Error(codes.Unauthenticated, "call requires a user")
+	case requested != "" && requested != userID:
+		return "", status.Error(codes.PermissionDenied, "cannot act on behalf of another user")
+	}
+	return userID, nil
+}
+
+// incomingMetadata returns the first value of a call's metadata key, or
+// empty if it isn't set.
+func incomingMetadata(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// boardAccess returns what the caller may see of a board.
+func (s *TaskService) boardAccess(ctx context.Context, boardID int64, userID string) (*repository.BoardAccess, error) {
+	access, err := s.repo.GetBoardAccess(ctx, boardID, userID)
+	if err != nil {
+		log.Printf("Failed to get board access: %v", err)
+		return nil, status.Error(codes.Internal, "failed to check board access")
+	}
+	return access, nil
+}
+
+// authorizeBoard checks that the caller holds at least minRole on a board.
+// Open boards allow everything, though managing them takes a user. Boards the caller isn't a member of are
+// reported as not found, so their existence doesn't leak. Either way,
+// boards that require a second factor refuse callers without one.
+func (s *TaskService) authorizeBoard(ctx context.Context, boardID int64, minRole string) error {
+	userID := callerID(ctx)
+	access, err := s.boardAccess(ctx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	return checkAccess(access, userID, callerMFA(ctx), minRole)
+}
+
+func checkAccess(access *repository.BoardAccess, userID string, mfa bool, minRole string) error {
+	switch {
+	case access.Open && userID == "" && roleRanks[minRole] >= roleRanks[repository.RoleAdmin]:
+		return status.Error(codes.Unauthenticated, "board requires a user id")
+	case access.Open:
+		return checkMFA(access.RequireMFA, mfa, "board")
+	case userID == "":
+		return status.Error(codes.Unauthenticated, "board requires a user id")
+	case access.Role == "":
+		return status.Error(codes.NotFound, "board not found")
+	case roleRanks[access.Role] < roleRanks[minRole]:
+		return status.Errorf(codes.PermissionDenied, "requires the %s role on this board", minRole)
+	}
+	return checkMFA(access.RequireMFA, mfa, "board")
+}
+
+// authorizeTask loads a task and checks that the caller holds at least
+// minRole on its board. Tasks the caller can't see are reported as not
+// found.
+func (s *TaskService) authorizeTask(ctx context.Context, taskID int64, minRole string) (*repository.Task, error) {
+	task, err := s.requireTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeBoard(ctx, task.BoardID, minRole); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+// visibleTo returns the task filter restriction for the caller.
+func visibleTo(ctx context.Context) *string {
+	userID := callerID(ctx)
+	return &userID
+}
+
+// boardAudience returns who may receive a board's events: everyone if it
+// and its workspace are open, otherwise the members of both. Lookup
+// failures close the board, so events are withheld rather than leaked.
+func (s *TaskService) boardAudience(ctx context.Context, boardID int64) (open bool, memberIDs []string) {
+	members, err := s.repo.ListBoardMembers(ctx, boardID)
+	if err != nil {
+		log.Printf("ERROR: Failed to list members of board %d: %v", boardID, err)
+		return false, nil
+	}
+	workspaceMembers, err := s.repo.ListWorkspaceMembers(ctx, eventWorkspace(ctx))
+	if err != nil {
+		log.Printf("ERROR: Failed to list members of workspace %d: %v", eventWorkspace(ctx), err)
+		return false, nil
+	}
+
+	switch {
+	case len(members) == 0 && len(workspaceMembers) == 0:
+		return true, nil
+	case len(workspaceMembers) == 0:
+		for _, m := range members {
+			memberIDs = append(memberIDs, m.UserID)
+		}
+	case len(members) == 0:
+		for _, m := range workspaceMembers {
+			memberIDs = append(memberIDs, m.UserID)
+		}
+	default:
+		for _, m := range members {
+			if slices.ContainsFunc(workspaceMembers, func(w *repository.WorkspaceMember) bool {
+				return w.UserID == m.UserID
+			}) {
+				memberIDs = append(memberIDs, m.UserID)
+			}
+		}
+	}
+	return false, memberIDs
+}
+
+// requiresMFA reports whether a board, or its workspace, requires a second
+// factor of those receiving its events. Lookup failures require one, so
+// events are withheld rather than leaked.
+func (s *TaskService) requiresMFA(ctx context.Context, boardID int64) bool {
+	board, err := s.repo.GetBoardAccess(ctx, boardID, "")
+	if err != nil {
+		log.Printf("ERROR: Failed to get access to board %d: %v", boardID, err)
+		return true
+	}
+	workspace, err := s.repo.GetWorkspaceAccess(ctx, eventWorkspace(ctx), "")
+	if err != nil {
+		log.Printf("ERROR: Failed to get access to workspace %d: %v", eventWorkspace(ctx), err)
+		return true
+	}
+	return board.RequireMFA || workspace.RequireMFA
+}
+
+// canSee reports whether a user may receive an event for a board with the
+// given audience.
+func canSee(open bool, memberIDs []string, userID string) bool {
+	return open || slices.Contains(memberIDs, userID)
+}
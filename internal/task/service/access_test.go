@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// fakeRepo keeps just enough state in memory to check authorization.
+// Methods it doesn't override panic on the nil embedded Repository.
+type fakeRepo struct {
+	repository.Repository
+
+	boards    map[int64]*repository.Board
+	members   map[int64]map[string]string // Board ID to user ID to role.
+	workspace map[string]string           // User ID to workspace role.
+	admins    map[string]bool
+	users     []string
+	tasks     map[int64]*repository.Task
+	entries   map[int64]*repository.TimeEntry
+	added     []*repository.BoardMember
+}
+
+func (r *fakeRepo) GetBoard(ctx context.Context, id int64) (*repository.Board, error) {
+	board, ok := r.boards[id]
+	if !ok {
+		return nil, repository.ErrBoardNotFound
+	}
+	return board, nil
+}
+
+func (r *fakeRepo) GetBoardAccess(ctx context.Context, boardID int64, userID string) (*repository.BoardAccess, error) {
+	members := r.members[boardID]
+	return &repository.BoardAccess{Open: len(members) == 0, Role: members[userID]}, nil
+}
+
+func (r *fakeRepo) AddBoardMember(ctx context.Context, member *repository.BoardMember) error {
+	r.added = append(r.added, member)
+	return nil
+}
+
+func (r *fakeRepo) GetWorkspaceAccess(ctx context.Context, id int64, userID string) (*repository.WorkspaceAccess, error) {
+	return &repository.WorkspaceAccess{Open: len(r.workspace) == 0, Role: r.workspace[userID]}, nil
+}
+
+func (r *fakeRepo) AddWorkspaceMember(ctx context.Context, member *repository.WorkspaceMember) error {
+	r.workspace[member.UserID] = member.Role
+	return nil
+}
+
+func (r *fakeRepo) IsAdmin(ctx context.Context, userID string) (bool, error) {
+	return r.admins[userID], nil
+}
+
+func (r *fakeRepo) ListUserSummaries(ctx context.Context, ids []string) ([]repository.UserSummary, error) {
+	var users []repository.UserSummary
+	for _, id := range ids {
+		if slices.Contains(r.users, id) {
+			users = append(users, repository.UserSummary{ID: id, Username: id})
+		}
+	}
+	return users, nil
+}
+
+func (r *fakeRepo) GetByID(ctx context.Context, id int64) (*repository.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok {
+		return nil, repository.ErrTaskNotFound
+	}
+	return task, nil
+}
+
+func (r *fakeRepo) GetTimeEntry(ctx context.Context, id int64) (*repository.TimeEntry, error) {
+	entry, ok := r.entries[id]
+	if !ok {
+		return nil, repository.ErrTimeEntryNotFound
+	}
+	return entry, nil
+}
+
+func (r *fakeRepo) DeleteTimeEntry(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (r *fakeRepo) ListTimeEntries(ctx context.Context, filter repository.TimeEntryFilter) ([]*repository.TimeEntry, error) {
+	var entries []*repository.TimeEntry
+	for _, entry := range r.entries {
+		if filter.UserID == "" || entry.UserID == filter.UserID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (r *fakeRepo) TimeReport(ctx context.Context, filter repository.TimeEntryFilter, period string) ([]*repository.TimeReportRow, error) {
+	return nil, nil
+}
+
+// newFakeRepo returns a repo whose board 1 has an owner, admin, member and
+// viewer named after their role, and holds task 1. Board 2 is open. Both
+// were created by "creator".
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		boards: map[int64]*repository.Board{
+			1: {ID: 1, Name: "Closed", CreatedBy: "creator"},
+			2: {ID: 2, Name: "Open", CreatedBy: "creator"},
+		},
+		members: map[int64]map[string]string{
+			1: {
+				"owner":  repository.RoleOwner,
+				"admin":  repository.RoleAdmin,
+				"member": repository.RoleMember,
+				"viewer": repository.RoleViewer,
+			},
+		},
+		workspace: map[string]string{"boss": repository.WorkspaceRoleOwner},
+		admins:    map[string]bool{"root": true},
+		users:     []string{"owner", "admin", "member", "viewer", "creator", "boss", "root", "newcomer"},
+		tasks:     map[int64]*repository.Task{1: {ID: 1, BoardID: 1, Title: "Task"}},
+	}
+}
+
+// asUser returns ctx for a call made by userID.
+func asUser(userID string) context.Context {
+	return context.WithValue(context.Background(), callerKey{}, userID)
+}
+
+func TestCheckAccess(t *testing.T) {
+	tests := []struct {
+		name    string
+		access  repository.BoardAccess
+		userID  string
+		mfa     bool
+		minRole string
+		want    codes.Code
+	}{
+		{"open board", repository.BoardAccess{Open: true}, "", false, repository.RoleMember, codes.OK},
+		{"anonymous manages open board", repository.BoardAccess{Open: true}, "", false, repository.RoleAdmin, codes.Unauthenticated},
+		{"user manages open board", repository.BoardAccess{Open: true}, "u", false, repository.RoleOwner, codes.OK},
+		{"anonymous", repository.BoardAccess{}, "", false, repository.RoleViewer, codes.Unauthenticated},
+		{"not a member", repository.BoardAccess{}, "u", false, repository.RoleViewer, codes.NotFound},
+		{"viewer reads", repository.BoardAccess{Role: repository.RoleViewer}, "u", false, repository.RoleViewer, codes.OK},
+		{"viewer writes", repository.BoardAccess{Role: repository.RoleViewer}, "u", false, repository.RoleMember, codes.PermissionDenied},
+		{"member writes", repository.BoardAccess{Role: repository.RoleMember}, "u", false, repository.RoleMember, codes.OK},
+		{"member manages", repository.BoardAccess{Role: repository.RoleMember}, "u", false, repository.RoleAdmin, codes.PermissionDenied},
+		{"admin owns", repository.BoardAccess{Role: repository.RoleAdmin}, "u", false, repository.RoleOwner, codes.PermissionDenied},
+		{"owner owns", repository.BoardAccess{Role: repository.RoleOwner}, "u", false, repository.RoleOwner, codes.OK},
+		{"without second factor", repository.BoardAccess{Role: repository.RoleOwner, RequireMFA: true}, "u", false, repository.RoleViewer, codes.PermissionDenied},
+		{"with second factor", repository.BoardAccess{Role: repository.RoleOwner, RequireMFA: true}, "u", true, repository.RoleViewer, codes.OK},
+		{"open board without second factor", repository.BoardAccess{Open: true, RequireMFA: true}, "u", false, repository.RoleViewer, codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAccess(&tt.access, tt.userID, tt.mfa, tt.minRole)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Log("✅ Board roles are ranked")
+}
+
+func TestViewerCannotWrite(t *testing.T) {
+	s := &TaskService{repo: newFakeRepo()}
+	ctx := asUser("viewer")
+
+	t.Run("create task", func(t *testing.T) {
+		_, err := s.CreateTask(ctx, &pb.CreateTaskRequest{BoardId: 1, Title: "x"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got: %v", err)
+		}
+	})
+
+	t.Run("delete task", func(t *testing.T) {
+		_, err := s.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: 1})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got: %v", err)
+		}
+	})
+
+	t.Run("add member", func(t *testing.T) {
+		_, err := s.AddBoardMember(ctx, &pb.AddBoardMemberRequest{
+			BoardId: 1, UserId: "newcomer", Role: pb.BoardRole_BOARD_ROLE_VIEWER,
+		})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got: %v", err)
+		}
+	})
+
+	t.Run("outsiders don't see the board", func(t *testing.T) {
+		_, err := s.CreateTask(asUser("newcomer"), &pb.CreateTaskRequest{BoardId: 1, Title: "x"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got: %v", err)
+		}
+	})
+
+	t.Log("✅ Viewers can't write")
+}
+
+func TestGrantLimitedByRank(t *testing.T) {
+	tests := []struct {
+		name    string
+		granter string
+		role    pb.BoardRole
+		want    codes.Code
+	}{
+		{"member grants viewer", "member", pb.BoardRole_BOARD_ROLE_VIEWER, codes.PermissionDenied},
+		{"admin grants member", "admin", pb.BoardRole_BOARD_ROLE_MEMBER, codes.OK},
+		{"admin grants admin", "admin", pb.BoardRole_BOARD_ROLE_ADMIN, codes.OK},
+		{"admin grants owner", "admin", pb.BoardRole_BOARD_ROLE_OWNER, codes.PermissionDenied},
+		{"owner grants owner", "owner", pb.BoardRole_BOARD_ROLE_OWNER, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			s := &TaskService{repo: repo}
+
+			_, err := s.AddBoardMember(asUser(tt.granter), &pb.AddBoardMemberRequest{
+				BoardId: 1, UserId: "newcomer", Role: tt.role,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+			if granted := len(repo.added) > 0; granted != (tt.want == codes.OK) {
+				t.Errorf("expected member added %v, got %v", tt.want == codes.OK, granted)
+			}
+		})
+	}
+
+	t.Run("unknown user", func(t *testing.T) {
+		s := &TaskService{repo: newFakeRepo()}
+		_, err := s.AddBoardMember(asUser("owner"), &pb.AddBoardMemberRequest{
+			BoardId: 1, UserId: "ghost", Role: pb.BoardRole_BOARD_ROLE_VIEWER,
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got: %v", err)
+		}
+	})
+
+	t.Log("✅ Grants are limited by the granter's role")
+}
+
+func TestClaimOpenBoard(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller string
+		want   codes.Code
+	}{
+		{"creator", "creator", codes.OK},
+		{"workspace owner", "boss", codes.OK},
+		{"admin", "root", codes.OK},
+		{"anyone else", "newcomer", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &TaskService{repo: newFakeRepo()}
+			_, err := s.AddBoardMember(asUser(tt.caller), &pb.AddBoardMemberRequest{
+				BoardId: 2, UserId: tt.caller, Role: pb.BoardRole_BOARD_ROLE_OWNER,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Log("✅ Only the creator, workspace owners and admins claim open boards")
+}
+
+func TestTimeEntryOwnership(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller string
+		want   codes.Code
+	}{
+		{"entry's user", "member", codes.OK},
+		{"board admin", "admin", codes.OK},
+		{"another member", "viewer", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			repo.members[1]["viewer"] = repository.RoleMember
+			repo.entries = map[int64]*repository.TimeEntry{1: {ID: 1, TaskID: 1, UserID: "member"}}
+			s := &TaskService{repo: repo}
+
+			_, err := s.DeleteTimeEntry(asUser(tt.caller), &pb.DeleteTimeEntryRequest{Id: 1})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Log("✅ Only an entry's user and board admins change it")
+}
+
+func TestListOthersTimeEntries(t *testing.T) {
+	repo := newFakeRepo()
+	repo.entries = map[int64]*repository.TimeEntry{1: {ID: 1, TaskID: 1, UserID: "member"}}
+	s := &TaskService{repo: repo}
+
+	t.Run("list by user", func(t *testing.T) {
+		_, err := s.ListTimeEntries(asUser("newcomer"), &pb.ListTimeEntriesRequest{UserId: "member"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got: %v", err)
+		}
+
+		resp, err := s.ListTimeEntries(asUser("member"), &pb.ListTimeEntriesRequest{UserId: "member"})
+		if err != nil {
+			t.Fatalf("failed to list own entries: %v", err)
+		}
+		if len(resp.Entries) != 1 {
+			t.Errorf("expected own entry, got %d", len(resp.Entries))
+		}
+	})
+
+	t.Run("report by user", func(t *testing.T) {
+		_, err := s.GetTimeReport(asUser("newcomer"), &pb.GetTimeReportRequest{UserId: "member"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("expected PermissionDenied, got: %v", err)
+		}
+		if _, err := s.GetTimeReport(asUser("member"), &pb.GetTimeReportRequest{UserId: "member"}); err != nil {
+			t.Errorf("failed to report own time: %v", err)
+		}
+	})
+
+	t.Log("✅ Users only list their own time across boards")
+}
+
+func TestAttachmentDryRun(t *testing.T) {
+	s := &TaskService{repo: newFakeRepo()}
+
+	// The fake has no CreateAttachment, so a dry run that stored anything
+	// would panic.
+	_, err := s.CreateAttachment(asUser("viewer"), &pb.CreateAttachmentRequest{TaskId: 1, DryRun: true})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("expected PermissionDenied, got: %v", err)
+	}
+	_, err = s.CreateAttachment(asUser("newcomer"), &pb.CreateAttachmentRequest{TaskId: 1, DryRun: true})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got: %v", err)
+	}
+	if _, err := s.CreateAttachment(asUser("member"), &pb.CreateAttachmentRequest{TaskId: 1, DryRun: true}); err != nil {
+		t.Errorf("failed dry run as member: %v", err)
+	}
+
+	t.Log("✅ Dry runs only check the caller may attach files")
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// blobDeleteTimeout bounds deleting a single blob in the cleanup loop.
+const blobDeleteTimeout = 30 * time.Second
+
+func attachmentToProto(a *repository.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          a.ID,
+		TaskId:      a.TaskID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		SizeBytes:   a.SizeBytes,
+		StorageKey:  a.StorageKey,
+		UploadedBy:  a.UploadedBy,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
+
+// authorizeAttachment loads an attachment, checking that the caller holds
+// at least minRole on its task's board.
+func (s *TaskService) authorizeAttachment(ctx context.Context, id int64, minRole string) (*repository.Attachment, error) {
+	attachment, err := s.repo.GetAttachment(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrAttachmentNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to get attachment: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get attachment")
+	}
+	if _, err := s.authorizeTask(ctx, attachment.TaskID, minRole); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, repository.ErrAttachmentNotFound.Error())
+		}
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *TaskService) CreateAttachment(ctx context.Context, req *pb.CreateAttachmentRequest) (*pb.CreateAttachmentResponse, error) {
+	uploadedBy, err := actingUser(ctx, req.UploadedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	if !req.DryRun && (req.Filename == "" || req.StorageKey == "") {
+		return nil, status.Error(codes.InvalidArgument, "filename and storage_key are required")
+	}
+
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleMember); err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return &pb.CreateAttachmentResponse{}, nil
+	}
+
+	attachment := &repository.Attachment{
+		TaskID:      req.TaskId,
+		Filename:    req.Filename,
+		ContentType: req.ContentType,
+		SizeBytes:   req.SizeBytes,
+		StorageKey:  req.StorageKey,
+		UploadedBy:  uploadedBy,
+	}
+	if err := s.repo.CreateAttachment(ctx, attachment); err != nil {
+		log.Printf("Failed to create attachment: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create attachment")
+	}
+
+	return &pb.CreateAttachmentResponse{Attachment: attachmentToProto(attachment)}, nil
+}
+
+func (s *TaskService) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.GetAttachmentResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	attachment, err := s.authorizeAttachment(ctx, req.Id, repository.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetAttachmentResponse{Attachment: attachmentToProto(attachment)}, nil
+}
+
+func (s *TaskService) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.repo.ListAttachments(ctx, req.TaskId)
+	if err != nil {
+		log.Printf("Failed to list attachments: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list attachments")
+	}
+
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, a := range attachments {
+		pbAttachments[i] = attachmentToProto(a)
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: pbAttachments}, nil
+}
+
+func (s *TaskService) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.authorizeAttachment(ctx, req.Id, repository.RoleMember); err != nil {
+		return nil, err
+	}
+
+	attachment, err := s.repo.DeleteAttachment(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, repository.ErrAttachmentNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to delete attachment: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete attachment")
+	}
+
+	s.deleteBlobsAsync(attachment.StorageKey)
+
+	return &pb.DeleteAttachmentResponse{Attachment: attachmentToProto(attachment)}, nil
+}
+
+// deleteBlobsAsync queues blob keys for removal by RunBlobCleanup.
+func (s *TaskService) deleteBlobsAsync(keys ...string) {
+	if s.blobs == nil {
+		return
+	}
+	for _, key := range keys {
+		select {
+		case s.blobCleanup <- key:
+		default:
+			// Queue full. The blob is orphaned but the metadata is gone,
+			// so log it for manual cleanup rather than block the RPC.
+			log.Printf("ERROR: Blob cleanup queue full, orphaned blob %s", key)
+		}
+	}
+}
+
+// RunBlobCleanup deletes queued blobs until ctx is cancelled.
+func (s *TaskService) RunBlobCleanup(ctx context.Context) {
+	if s.blobs == nil {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case key := <-s.blobCleanup:
+			delCtx, cancel := context.WithTimeout(ctx, blobDeleteTimeout)
+			if err := s.blobs.Delete(delCtx, key); err != nil {
+				log.Printf("ERROR: Failed to delete blob %s: %v", key, err)
+			} else {
+				log.Printf("🗑️ Deleted blob %s", key)
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+func boardToProto(board *repository.Board) *pb.Board {
+	pbBoard := &pb.Board{
+		Id:          board.ID,
+		Name:        board.Name,
+		Description: board.Description,
+		CreatedAt:   timestamppb.New(board.CreatedAt),
+		RequireMfa:  board.RequireMFA,
+	}
+	for _, col := range board.Columns {
+		pbBoard.Columns = append(pbBoard.Columns, &pb.BoardColumn{
+			Id:       col.ID,
+			Name:     col.Name,
+			Position: int32(col.Position),
+		})
+	}
+	for _, label := range board.Labels {
+		pbBoard.Labels = append(pbBoard.Labels, &pb.BoardLabel{
+			Id:    label.ID,
+			Name:  label.Name,
+			Color: label.Color,
+		})
+	}
+	return pbBoard
+}
+
+// newBoard builds a domain board from names, validating them.
+func newBoard(name, description string, columns []string, labels []*pb.BoardLabel) (*repository.Board, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "board name is required")
+	}
+
+	board := &repository.Board{Name: name, Description: description}
+	for _, col := range columns {
+		if strings.TrimSpace(col) == "" {
+			return nil, status.Error(codes.InvalidArgument, "column names cannot be empty")
+		}
+		board.Columns = append(board.Columns, repository.BoardColumn{Name: col})
+	}
+
+	seen := map[string]bool{}
+	for _, label := range labels {
+		if strings.TrimSpace(label.Name) == "" {
+			return nil, status.Error(codes.InvalidArgument, "label names cannot be empty")
+		}
+		if seen[label.Name] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate label %q", label.Name)
+		}
+		seen[label.Name] = true
+		board.Labels = append(board.Labels, repository.BoardLabel{Name: label.Name, Color: label.Color})
+	}
+
+	return board, nil
+}
+
+func (s *TaskService) CreateBoard(ctx context.Context, req *pb.CreateBoardRequest) (*pb.CreateBoardResponse, error) {
+	board, err := newBoard(req.Name, req.Description, req.Columns, req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	// The creator owns the board; anonymous boards stay open.
+	board.OwnerID = callerID(ctx)
+
+	if err := s.repo.CreateBoard(ctx, board, nil); err != nil {
+		log.Printf("Failed to create board: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create board")
+	}
+
+	return &pb.CreateBoardResponse{Board: boardToProto(board)}, nil
+}
+
+// getBoard loads a board, returning a gRPC error if it can't.
+func (s *TaskService) getBoard(ctx context.Context, id int64) (*repository.Board, error) {
+	board, err := s.repo.GetBoard(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrBoardNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to get board: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get board")
+	}
+	return board, nil
+}
+
+func (s *TaskService) GetBoard(ctx context.Context, req *pb.GetBoardRequest) (*pb.GetBoardResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.authorizeBoard(ctx, req.Id, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+	board, err := s.getBoard(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBoardResponse{Board: boardToProto(board)}, nil
+}
+
+func (s *TaskService) UpdateBoard(ctx context.Context, req *pb.UpdateBoardRequest) (*pb.UpdateBoardResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if err := s.authorizeBoard(ctx, req.Id, repository.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if req.RequireMfa != nil {
+		// Admins can't require what they couldn't satisfy themselves.
+		if *req.RequireMfa && !callerMFA(ctx) {
+			return nil, status.Error(codes.FailedPrecondition, "sign in with two-factor authentication before requiring it")
+		}
+		if err := s.repo.SetBoardRequireMFA(ctx, req.Id, *req.RequireMfa); err != nil {
+			if errors.Is(err, repository.ErrBoardNotFound) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			log.Printf("Failed to update board: %v", err)
+			return nil, status.Error(codes.Internal, "failed to update board")
+		}
+	}
+
+	board, err := s.getBoard(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateBoardResponse{Board: boardToProto(board)}, nil
+}
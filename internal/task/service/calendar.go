@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/ical"
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// maxCalendarTasks bounds a calendar feed to the tasks due latest.
+const maxCalendarTasks = 1000
+
+// Calendar components, as stored and as iCalendar components.
+var (
+	calendarComponentsToRepo = map[pb.CalendarComponent]string{
+		pb.CalendarComponent_CALENDAR_COMPONENT_EVENT: "event",
+		pb.CalendarComponent_CALENDAR_COMPONENT_TODO:  "todo",
+	}
+	calendarComponentsToProto = map[string]pb.CalendarComponent{
+		"event": pb.CalendarComponent_CALENDAR_COMPONENT_EVENT,
+		"todo":  pb.CalendarComponent_CALENDAR_COMPONENT_TODO,
+	}
+	calendarComponentsToICal = map[string]ical.Component{
+		"event": ical.ComponentEvent,
+		"todo":  ical.ComponentTodo,
+	}
+)
+
+func calendarFeedToProto(feed *repository.CalendarFeed) *pb.CalendarFeed {
+	return &pb.CalendarFeed{
+		Id:        feed.ID,
+		OwnerId:   feed.OwnerID,
+		BoardId:   feed.BoardID,
+		Component: calendarComponentsToProto[feed.Component],
+		TimeZone:  feed.TimeZone,
+		CreatedAt: timestamppb.New(feed.CreatedAt),
+	}
+}
+
+// calendarFeedError maps repository errors to gRPC errors.
+func calendarFeedError(action string, err error) error {
+	if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	log.Printf("Failed to %s calendar feed: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s calendar feed", action)
+}
+
+// newFeedToken returns a random feed token and the hash that is stored.
+// The token starts with its workspace, since calendar clients can't send
+// one.
+func newFeedToken(workspaceID int64) (string, []byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	token := strconv.FormatInt(workspaceID, 10) + "." + base64.RawURLEncoding.EncodeToString(secret)
+	return token, hashFeedToken(token), nil
+}
+
+// feedTokenWorkspace returns the workspace a feed token belongs to. Tokens
+// issued before workspaces have no prefix and belong to the default one.
+func feedTokenWorkspace(token string) (int64, bool) {
+	prefix, _, found := strings.Cut(token, ".")
+	if !found {
+		return repository.DefaultWorkspaceID, true
+	}
+	id, err := strconv.ParseInt(prefix, 10, 64)
+	return id, err == nil && id > 0
+}
+
+func hashFeedToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+func (s *TaskService) CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CreateCalendarFeedResponse, error) {
+	userID, err := actingUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	component := "event"
+	if req.Component != pb.CalendarComponent_CALENDAR_COMPONENT_UNSPECIFIED {
+		var ok bool
+		if component, ok = calendarComponentsToRepo[req.Component]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown component")
+		}
+	}
+
+	timeZone := req.TimeZone
+	if timeZone != "" {
+		// "Local" would mean the server's zone, which callers can't know.
+		loc, err := time.LoadLocation(timeZone)
+		if err != nil || timeZone == "Local" {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown time zone %q", timeZone)
+		}
+		if loc == time.UTC {
+			timeZone = ""
+		}
+	}
+
+	if req.BoardId != 0 {
+		if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+		if _, err := s.getBoard(ctx, req.BoardId); err != nil {
+			return nil, err
+		}
+	}
+
+	token, hash, err := newFeedToken(eventWorkspace(ctx))
+	if err != nil {
+		log.Printf("Failed to generate calendar feed token: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create calendar feed")
+	}
+
+	feed := &repository.CalendarFeed{
+		OwnerID:   userID,
+		BoardID:   req.BoardId,
+		Component: component,
+		TimeZone:  timeZone,
+		TokenHash: hash,
+	}
+	if err := s.repo.CreateCalendarFeed(ctx, feed); err != nil {
+		return nil, calendarFeedError("create", err)
+	}
+
+	return &pb.CreateCalendarFeedResponse{Feed: calendarFeedToProto(feed), Token: token}, nil
+}
+
+func (s *TaskService) ListCalendarFeeds(ctx context.Context, req *pb.ListCalendarFeedsRequest) (*pb.ListCalendarFeedsResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	feeds, err := s.repo.ListCalendarFeeds(ctx, userID)
+	if err != nil {
+		return nil, calendarFeedError("list", err)
+	}
+
+	pbFeeds := make([]*pb.CalendarFeed, len(feeds))
+	for i, feed := range feeds {
+		pbFeeds[i] = calendarFeedToProto(feed)
+	}
+
+	return &pb.ListCalendarFeedsResponse{Feeds: pbFeeds}, nil
+}
+
+// DeleteCalendarFeed revokes a feed. Other users' feeds are reported as
+// not found.
+func (s *TaskService) DeleteCalendarFeed(ctx context.Context, req *pb.DeleteCalendarFeedRequest) (*pb.DeleteCalendarFeedResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	feed, err := s.repo.GetCalendarFeed(ctx, req.Id)
+	if err != nil {
+		return nil, calendarFeedError("get", err)
+	}
+	if feed.OwnerID != userID {
+		return nil, status.Error(codes.NotFound, repository.ErrCalendarFeedNotFound.Error())
+	}
+
+	if err := s.repo.DeleteCalendarFeed(ctx, req.Id); err != nil {
+		return nil, calendarFeedError("delete", err)
+	}
+
+	return &pb.DeleteCalendarFeedResponse{Success: true}, nil
+}
+
+// GetCalendar renders the feed a token belongs to. The ETag is a hash of
+// the calendar, which only changes when its tasks do, so clients polling
+// with If-None-Match get an empty not_modified response.
+func (s *TaskService) GetCalendar(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	workspaceID, ok := feedTokenWorkspace(req.Token)
+	if !ok {
+		return nil, status.Error(codes.NotFound, repository.ErrCalendarFeedNotFound.Error())
+	}
+	ctx = repository.WithWorkspace(ctx, workspaceID)
+
+	feed, err := s.repo.GetCalendarFeedByToken(ctx, hashFeedToken(req.Token))
+	if err != nil {
+		return nil, calendarFeedError("get", err)
+	}
+
+	cal := &ical.Calendar{Component: calendarComponentsToICal[feed.Component]}
+	if feed.TimeZone != "" {
+		loc, err := time.LoadLocation(feed.TimeZone)
+		if err != nil {
+			log.Printf("Calendar feed %d has unknown time zone %q, using UTC: %v", feed.ID, feed.TimeZone, err)
+		} else {
+			cal.Location = loc
+		}
+	}
+
+	// Only tasks with a due date have a place in a calendar.
+	filter := repository.TaskFilter{
+		BoardID:    feed.BoardID,
+		Queries:    []*taskquery.Query{{Terms: []taskquery.Term{{Field: taskquery.FieldDue}}}},
+		SortColumn: "due",
+		SortDesc:   true,
+		Limit:      maxCalendarTasks,
+	}
+	// The token stands in for the feed's owner, who may have lost access
+	// since creating it. Tokens are no second factor, so feeds leave out
+	// boards that require one.
+	if feed.BoardID != 0 {
+		access, err := s.boardAccess(ctx, feed.BoardID, feed.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkAccess(access, feed.OwnerID, false, repository.RoleViewer); err != nil {
+			return nil, status.Error(codes.NotFound, repository.ErrCalendarFeedNotFound.Error())
+		}
+		board, err := s.getBoard(ctx, feed.BoardID)
+		if err != nil {
+			return nil, err
+		}
+		cal.Name = board.Name
+	} else {
+		filter.VisibleTo = &feed.OwnerID
+		filter.WithoutMFABoards = true
+		filter.Queries[0].Terms = append(filter.Queries[0].Terms,
+			taskquery.Term{Field: taskquery.FieldAssignee, Value: feed.OwnerID})
+		cal.Name = "Tasks assigned to " + feed.OwnerID
+	}
+
+	tasks, _, err := s.repo.List(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list calendar tasks: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list tasks")
+	}
+
+	for _, task := range tasks {
+		cal.Items = append(cal.Items, ical.Item{
+			UID:         fmt.Sprintf("task-%d@taskboard", task.ID),
+			Summary:     task.Title,
+			Description: task.Description,
+			Categories:  task.Labels,
+			Due:         *task.DueAt,
+			Completed:   task.Completed,
+			Created:     task.CreatedAt,
+			Modified:    task.UpdatedAt,
+		})
+	}
+
+	data := cal.Marshal()
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	if etagMatches(req.IfNoneMatch, etag) {
+		return &pb.GetCalendarResponse{Etag: etag, NotModified: true}, nil
+	}
+	return &pb.GetCalendarResponse{Data: data, Etag: etag}, nil
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag,
+// using the weak comparison RFC 9110 asks for.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
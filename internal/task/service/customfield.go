@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// maxFieldTextLength bounds text values so fields stay fields.
+const maxFieldTextLength = 4096
+
+var fieldTypesToRepo = map[pb.CustomFieldType]string{
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_TEXT:          repository.FieldTypeText,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_NUMBER:        repository.FieldTypeNumber,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_SINGLE_SELECT: repository.FieldTypeSingleSelect,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_MULTI_SELECT:  repository.FieldTypeMultiSelect,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_DATE:          repository.FieldTypeDate,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_USER:          repository.FieldTypeUser,
+	pb.CustomFieldType_CUSTOM_FIELD_TYPE_URL:           repository.FieldTypeURL,
+}
+
+var fieldTypesToProto = func() map[string]pb.CustomFieldType {
+	m := make(map[string]pb.CustomFieldType, len(fieldTypesToRepo))
+	for k, v := range fieldTypesToRepo {
+		m[v] = k
+	}
+	return m
+}()
+
+var filterOps = map[pb.CustomFieldFilter_Op]string{
+	pb.CustomFieldFilter_OP_EQ:       repository.FilterOpEq,
+	pb.CustomFieldFilter_OP_NE:       repository.FilterOpNe,
+	pb.CustomFieldFilter_OP_LT:       repository.FilterOpLt,
+	pb.CustomFieldFilter_OP_LTE:      repository.FilterOpLte,
+	pb.CustomFieldFilter_OP_GT:       repository.FilterOpGt,
+	pb.CustomFieldFilter_OP_GTE:      repository.FilterOpGte,
+	pb.CustomFieldFilter_OP_CONTAINS: repository.FilterOpContains,
+	pb.CustomFieldFilter_OP_SET:      repository.FilterOpSet,
+	pb.CustomFieldFilter_OP_UNSET:    repository.FilterOpUnset,
+}
+
+func isSelectField(fieldType string) bool {
+	return fieldType == repository.FieldTypeSingleSelect || fieldType == repository.FieldTypeMultiSelect
+}
+
+func customFieldToProto(field *repository.CustomField) *pb.CustomField {
+	return &pb.CustomField{
+		Id:       field.ID,
+		BoardId:  field.BoardID,
+		Name:     field.Name,
+		Type:     fieldTypesToProto[field.Type],
+		Options:  field.Options,
+		Required: field.Required,
+		Position: int32(field.Position),
+	}
+}
+
+// fieldValueToProto decodes a stored value. Values that no longer decode
+// for the field's type come back without a value.
+func fieldValueToProto(v repository.CustomFieldValue) *pb.CustomFieldValue {
+	pbValue := &pb.CustomFieldValue{
+		FieldId:   v.FieldID,
+		FieldName: v.Name,
+		FieldType: fieldTypesToProto[v.Type],
+	}
+
+	switch v.Type {
+	case repository.FieldTypeNumber:
+		var n float64
+		if json.Unmarshal(v.Value, &n) == nil {
+			pbValue.Value = &pb.CustomFieldValue_Number{Number: n}
+		}
+	case repository.FieldTypeMultiSelect:
+		var opts []string
+		if json.Unmarshal(v.Value, &opts) == nil {
+			pbValue.Value = &pb.CustomFieldValue_Options{Options: &pb.StringList{Values: opts}}
+		}
+	case repository.FieldTypeDate:
+		var d string
+		if json.Unmarshal(v.Value, &d) == nil {
+			pbValue.Value = &pb.CustomFieldValue_Date{Date: d}
+		}
+	default:
+		var text string
+		if json.Unmarshal(v.Value, &text) == nil {
+			pbValue.Value = &pb.CustomFieldValue_Text{Text: text}
+		}
+	}
+
+	return pbValue
+}
+
+// validateOptions checks a select field's option list.
+func validateOptions(fieldType string, options []string) error {
+	if !isSelectField(fieldType) {
+		if len(options) > 0 {
+			return status.Error(codes.InvalidArgument, "options are only allowed on select fields")
+		}
+		return nil
+	}
+
+	if len(options) == 0 {
+		return status.Error(codes.InvalidArgument, "select fields need at least one option")
+	}
+	seen := map[string]bool{}
+	for _, opt := range options {
+		if strings.TrimSpace(opt) == "" {
+			return status.Error(codes.InvalidArgument, "options cannot be empty")
+		}
+		if seen[opt] {
+			return status.Errorf(codes.InvalidArgument, "duplicate option %q", opt)
+		}
+		seen[opt] = true
+	}
+	return nil
+}
+
+// customFieldError maps repository errors to gRPC errors.
+func customFieldError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrCustomFieldNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrCustomFieldExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	log.Printf("Failed to %s custom field: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s custom field", action)
+}
+
+// authorizeCustomField loads a field whose board the caller administers.
+func (s *TaskService) authorizeCustomField(ctx context.Context, id int64) (*repository.CustomField, error) {
+	field, err := s.repo.GetCustomField(ctx, id)
+	if err != nil {
+		return nil, customFieldError("get", err)
+	}
+	if err := s.authorizeBoard(ctx, field.BoardID, repository.RoleAdmin); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, repository.ErrCustomFieldNotFound.Error())
+		}
+		return nil, err
+	}
+	return field, nil
+}
+
+func (s *TaskService) CreateCustomField(ctx context.Context, req *pb.CreateCustomFieldRequest) (*pb.CreateCustomFieldResponse, error) {
+	if req.BoardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	fieldType, ok := fieldTypesToRepo[req.Type]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "type is required")
+	}
+	if err := validateOptions(fieldType, req.Options); err != nil {
+		return nil, err
+	}
+
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := s.getBoard(ctx, req.BoardId); err != nil {
+		return nil, err
+	}
+
+	field := &repository.CustomField{
+		BoardID:  req.BoardId,
+		Name:     req.Name,
+		Type:     fieldType,
+		Options:  req.Options,
+		Required: req.Required,
+		Position: int(req.Position),
+	}
+	if err := s.repo.CreateCustomField(ctx, field); err != nil {
+		return nil, customFieldError("create", err)
+	}
+
+	return &pb.CreateCustomFieldResponse{Field: customFieldToProto(field)}, nil
+}
+
+// UpdateCustomField changes a field's definition. Values already stored
+// are kept, even if an option they use is removed.
+func (s *TaskService) UpdateCustomField(ctx context.Context, req *pb.UpdateCustomFieldRequest) (*pb.UpdateCustomFieldResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	field, err := s.authorizeCustomField(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		if strings.TrimSpace(*req.Name) == "" {
+			return nil, status.Error(codes.InvalidArgument, "name cannot be empty")
+		}
+		field.Name = *req.Name
+	}
+	if req.ReplaceOptions {
+		if err := validateOptions(field.Type, req.Options); err != nil {
+			return nil, err
+		}
+		field.Options = req.Options
+	}
+	if req.Required != nil {
+		field.Required = *req.Required
+	}
+	if req.Position != nil {
+		field.Position = int(*req.Position)
+	}
+
+	if err := s.repo.UpdateCustomField(ctx, field); err != nil {
+		return nil, customFieldError("update", err)
+	}
+
+	return &pb.UpdateCustomFieldResponse{Field: customFieldToProto(field)}, nil
+}
+
+func (s *TaskService) DeleteCustomField(ctx context.Context, req *pb.DeleteCustomFieldRequest) (*pb.DeleteCustomFieldResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.authorizeCustomField(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteCustomField(ctx, req.Id); err != nil {
+		return nil, customFieldError("delete", err)
+	}
+
+	return &pb.DeleteCustomFieldResponse{Success: true}, nil
+}
+
+func (s *TaskService) ListCustomFields(ctx context.Context, req *pb.ListCustomFieldsRequest) (*pb.ListCustomFieldsResponse, error) {
+	if req.BoardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	fields, err := s.repo.ListCustomFields(ctx, req.BoardId)
+	if err != nil {
+		return nil, customFieldError("list", err)
+	}
+
+	pbFields := make([]*pb.CustomField, len(fields))
+	for i, field := range fields {
+		pbFields[i] = customFieldToProto(field)
+	}
+
+	return &pb.ListCustomFieldsResponse{Fields: pbFields}, nil
+}
+
+// encodeFieldValue validates v against field and returns its JSON form.
+// A nil result means v has no value and the field should be cleared.
+func encodeFieldValue(field *repository.CustomField, v *pb.CustomFieldValue) (json.RawMessage, error) {
+	if v.Value == nil {
+		return nil, nil
+	}
+
+	invalid := func(format string, args ...any) error {
+		return status.Errorf(codes.InvalidArgument, "field %q: "+format, append([]any{field.Name}, args...)...)
+	}
+
+	var value any
+	switch field.Type {
+	case repository.FieldTypeNumber:
+		n, ok := v.Value.(*pb.CustomFieldValue_Number)
+		if !ok {
+			return nil, invalid("expects a number")
+		}
+		if math.IsNaN(n.Number) || math.IsInf(n.Number, 0) {
+			return nil, invalid("number must be finite")
+		}
+		value = n.Number
+
+	case repository.FieldTypeDate:
+		// Dates are accepted as text too, for clients that don't know
+		// the field's type.
+		var d string
+		switch val := v.Value.(type) {
+		case *pb.CustomFieldValue_Date:
+			d = val.Date
+		case *pb.CustomFieldValue_Text:
+			d = val.Text
+		default:
+			return nil, invalid("expects a date")
+		}
+		if d == "" {
+			return nil, nil
+		}
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			return nil, invalid("date must be YYYY-MM-DD")
+		}
+		value = d
+
+	case repository.FieldTypeMultiSelect:
+		opts, ok := v.Value.(*pb.CustomFieldValue_Options)
+		if !ok {
+			return nil, invalid("expects a list of options")
+		}
+		values := opts.Options.GetValues()
+		seen := map[string]bool{}
+		for _, opt := range values {
+			if !containsString(field.Options, opt) {
+				return nil, invalid("unknown option %q", opt)
+			}
+			if seen[opt] {
+				return nil, invalid("duplicate option %q", opt)
+			}
+			seen[opt] = true
+		}
+		if len(values) == 0 {
+			return nil, nil
+		}
+		value = values
+
+	default:
+		t, ok := v.Value.(*pb.CustomFieldValue_Text)
+		if !ok {
+			return nil, invalid("expects text")
+		}
+		text := t.Text
+		if len(text) > maxFieldTextLength {
+			return nil, invalid("value is too long")
+		}
+		switch field.Type {
+		case repository.FieldTypeSingleSelect:
+			if !containsString(field.Options, text) {
+				return nil, invalid("unknown option %q", text)
+			}
+		case repository.FieldTypeURL:
+			u, err := url.Parse(text)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, invalid("must be an http or https URL")
+			}
+		case repository.FieldTypeUser:
+			if strings.TrimSpace(text) == "" {
+				return nil, invalid("user ID cannot be empty")
+			}
+		}
+		if text == "" {
+			return nil, nil
+		}
+		value = text
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, invalid("%v", err)
+	}
+	return raw, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldChanges holds validated custom field writes for one task.
+type fieldChanges struct {
+	fields []*repository.CustomField
+	set    []repository.CustomFieldValue
+	clear  []int64
+}
+
+// resolveFieldValues validates values against the board's fields. current
+// is nil when creating a task, in which case every required field must be
+// given; on update, required fields cannot be cleared.
+func (s *TaskService) resolveFieldValues(ctx context.Context, boardID int64,
+	values []*pb.CustomFieldValue, current []repository.CustomFieldValue, creating bool,
+) (*fieldChanges, error) {
+	if len(values) == 0 && !creating {
+		return &fieldChanges{}, nil
+	}
+
+	fields, err := s.repo.ListCustomFields(ctx, boardID)
+	if err != nil {
+		return nil, customFieldError("list", err)
+	}
+	byID := make(map[int64]*repository.CustomField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	given := map[int64]json.RawMessage{}
+	for _, v := range values {
+		field, ok := byID[v.FieldId]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "custom field %d does not belong to board %d", v.FieldId, boardID)
+		}
+		if _, dup := given[v.FieldId]; dup {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q given more than once", field.Name)
+		}
+		raw, err := encodeFieldValue(field, v)
+		if err != nil {
+			return nil, err
+		}
+		given[v.FieldId] = raw
+	}
+
+	has := map[int64]bool{}
+	for _, v := range current {
+		has[v.FieldID] = true
+	}
+
+	changes := &fieldChanges{fields: fields}
+	for _, f := range fields {
+		raw, ok := given[f.ID]
+		switch {
+		case ok && raw != nil:
+			changes.set = append(changes.set, repository.CustomFieldValue{
+				FieldID: f.ID, Name: f.Name, Type: f.Type, Value: raw,
+			})
+		case f.Required && (creating || ok):
+			return nil, status.Errorf(codes.InvalidArgument, "field %q is required", f.Name)
+		case ok && has[f.ID]:
+			changes.clear = append(changes.clear, f.ID)
+		}
+	}
+
+	return changes, nil
+}
+
+// apply returns current with the changes applied, in field order.
+func (c *fieldChanges) apply(current []repository.CustomFieldValue) []repository.CustomFieldValue {
+	if len(c.set) == 0 && len(c.clear) == 0 {
+		return current
+	}
+
+	values := map[int64]repository.CustomFieldValue{}
+	for _, v := range current {
+		values[v.FieldID] = v
+	}
+	for _, v := range c.set {
+		values[v.FieldID] = v
+	}
+	for _, id := range c.clear {
+		delete(values, id)
+	}
+
+	result := []repository.CustomFieldValue{}
+	for _, f := range c.fields {
+		if v, ok := values[f.ID]; ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// validateFilter rejects operators and values the field's type can't
+// compare, so they fail here rather than as SQL cast errors.
+func validateFilter(field *repository.CustomField, op, value string) error {
+	if op == repository.FilterOpSet || op == repository.FilterOpUnset {
+		return nil
+	}
+
+	invalid := func(msg string) error {
+		return status.Errorf(codes.InvalidArgument, "filter on %q: %s", field.Name, msg)
+	}
+
+	ordered := op == repository.FilterOpLt || op == repository.FilterOpLte ||
+		op == repository.FilterOpGt || op == repository.FilterOpGte
+
+	switch field.Type {
+	case repository.FieldTypeNumber:
+		if op == repository.FilterOpContains {
+			return invalid("contains is not supported on numbers")
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return invalid("value must be a number")
+		}
+	case repository.FieldTypeDate:
+		if op == repository.FilterOpContains {
+			return invalid("contains is not supported on dates")
+		}
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			return invalid("value must be YYYY-MM-DD")
+		}
+	case repository.FieldTypeMultiSelect:
+		if ordered {
+			return invalid("multi select fields only support eq, ne and contains")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+func textValue(s string) *pb.CustomFieldValue {
+	return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Text{Text: s}}
+}
+
+func numberValue(n float64) *pb.CustomFieldValue {
+	return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Number{Number: n}}
+}
+
+func dateValue(d string) *pb.CustomFieldValue {
+	return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Date{Date: d}}
+}
+
+func optionsValue(opts ...string) *pb.CustomFieldValue {
+	return &pb.CustomFieldValue{Value: &pb.CustomFieldValue_Options{Options: &pb.StringList{Values: opts}}}
+}
+
+func TestValidateOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldType string
+		options   []string
+		wantErr   bool
+	}{
+		{"select with options", repository.FieldTypeSingleSelect, []string{"low", "high"}, false},
+		{"multi select with options", repository.FieldTypeMultiSelect, []string{"ios"}, false},
+		{"select without options", repository.FieldTypeSingleSelect, nil, true},
+		{"empty option", repository.FieldTypeMultiSelect, []string{"ios", " "}, true},
+		{"duplicate option", repository.FieldTypeSingleSelect, []string{"low", "low"}, true},
+		{"text without options", repository.FieldTypeText, nil, false},
+		{"options on text", repository.FieldTypeText, []string{"a"}, true},
+		{"options on number", repository.FieldTypeNumber, []string{"1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateOptions(tt.fieldType, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got: %v", tt.wantErr, err)
+			}
+			if err != nil && status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected InvalidArgument, got: %v", err)
+			}
+		})
+	}
+
+	t.Log("✅ Select options are validated")
+}
+
+func TestEncodeFieldValue(t *testing.T) {
+	field := func(fieldType string, options ...string) *repository.CustomField {
+		return &repository.CustomField{Name: "F", Type: fieldType, Options: options}
+	}
+
+	tests := []struct {
+		name    string
+		field   *repository.CustomField
+		value   *pb.CustomFieldValue
+		want    string // JSON; empty clears the field.
+		wantErr bool
+	}{
+		{"no value", field(repository.FieldTypeText), &pb.CustomFieldValue{}, "", false},
+
+		{"text", field(repository.FieldTypeText), textValue("hi"), `"hi"`, false},
+		{"empty text clears", field(repository.FieldTypeText), textValue(""), "", false},
+		{"text too long", field(repository.FieldTypeText), textValue(strings.Repeat("x", maxFieldTextLength+1)), "", true},
+		{"number as text", field(repository.FieldTypeText), numberValue(1), "", true},
+
+		{"number", field(repository.FieldTypeNumber), numberValue(2.5), `2.5`, false},
+		{"number not finite", field(repository.FieldTypeNumber), numberValue(math.Inf(1)), "", true},
+		{"number NaN", field(repository.FieldTypeNumber), numberValue(math.NaN()), "", true},
+		{"text as number", field(repository.FieldTypeNumber), textValue("2"), "", true},
+
+		{"date", field(repository.FieldTypeDate), dateValue("2026-03-05"), `"2026-03-05"`, false},
+		{"date as text", field(repository.FieldTypeDate), textValue("2026-03-05"), `"2026-03-05"`, false},
+		{"empty date clears", field(repository.FieldTypeDate), dateValue(""), "", false},
+		{"invalid date", field(repository.FieldTypeDate), dateValue("05/03/2026"), "", true},
+		{"number as date", field(repository.FieldTypeDate), numberValue(1), "", true},
+
+		{"single select", field(repository.FieldTypeSingleSelect, "low", "high"), textValue("high"), `"high"`, false},
+		{"unknown option", field(repository.FieldTypeSingleSelect, "low"), textValue("mid"), "", true},
+
+		{"multi select", field(repository.FieldTypeMultiSelect, "ios", "web"), optionsValue("web", "ios"), `["web","ios"]`, false},
+		{"multi select none clears", field(repository.FieldTypeMultiSelect, "ios"), optionsValue(), "", false},
+		{"multi select unknown", field(repository.FieldTypeMultiSelect, "ios"), optionsValue("android"), "", true},
+		{"multi select duplicate", field(repository.FieldTypeMultiSelect, "ios"), optionsValue("ios", "ios"), "", true},
+		{"text as multi select", field(repository.FieldTypeMultiSelect, "ios"), textValue("ios"), "", true},
+
+		{"url", field(repository.FieldTypeURL), textValue("https://example.com/x"), `"https://example.com/x"`, false},
+		{"url without host", field(repository.FieldTypeURL), textValue("https://"), "", true},
+		{"url scheme", field(repository.FieldTypeURL), textValue("javascript:alert(1)"), "", true},
+
+		{"user", field(repository.FieldTypeUser), textValue("user-1"), `"user-1"`, false},
+		{"blank user", field(repository.FieldTypeUser), textValue("  "), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := encodeFieldValue(tt.field, tt.value)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("expected InvalidArgument, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to encode value: %v", err)
+			}
+			if string(raw) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, raw)
+			}
+		})
+	}
+
+	t.Log("✅ Field values are validated by type")
+}
+
+func TestValidateFilter(t *testing.T) {
+	number := &repository.CustomField{Name: "Points", Type: repository.FieldTypeNumber}
+	date := &repository.CustomField{Name: "Launch", Type: repository.FieldTypeDate}
+	multi := &repository.CustomField{Name: "Platform", Type: repository.FieldTypeMultiSelect}
+	text := &repository.CustomField{Name: "Notes", Type: repository.FieldTypeText}
+
+	tests := []struct {
+		name    string
+		field   *repository.CustomField
+		op      string
+		value   string
+		wantErr bool
+	}{
+		{"number compare", number, repository.FilterOpGte, "3", false},
+		{"number not a number", number, repository.FilterOpEq, "three", true},
+		{"number contains", number, repository.FilterOpContains, "3", true},
+		{"date compare", date, repository.FilterOpLt, "2026-03-05", false},
+		{"date invalid", date, repository.FilterOpLt, "soon", true},
+		{"date contains", date, repository.FilterOpContains, "2026", true},
+		{"multi select contains", multi, repository.FilterOpContains, "ios", false},
+		{"multi select ordered", multi, repository.FilterOpGt, "ios", true},
+		{"text contains", text, repository.FilterOpContains, "x", false},
+		{"set ignores value", number, repository.FilterOpSet, "", false},
+		{"unset ignores value", date, repository.FilterOpUnset, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFilter(tt.field, tt.op, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Log("✅ Filters are validated by field type")
+}
+
+func TestFieldChangesApply(t *testing.T) {
+	fields := []*repository.CustomField{{ID: 1}, {ID: 2}, {ID: 3}}
+	current := []repository.CustomFieldValue{
+		{FieldID: 1, Value: []byte(`"a"`)},
+		{FieldID: 3, Value: []byte(`"c"`)},
+	}
+	changes := &fieldChanges{
+		fields: fields,
+		set:    []repository.CustomFieldValue{{FieldID: 2, Value: []byte(`"b"`)}},
+		clear:  []int64{1},
+	}
+
+	got := changes.apply(current)
+	if len(got) != 2 || got[0].FieldID != 2 || got[1].FieldID != 3 {
+		t.Errorf("expected fields 2 and 3 in field order, got %+v", got)
+	}
+
+	t.Log("✅ Field changes apply in field order")
+}
@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/boardio"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// externalReader reads a board exported from another system.
+type externalReader interface {
+	boardio.Reader
+
+	// Board returns the exported board, or nil if the file doesn't say.
+	Board() *boardio.Board
+}
+
+// importExternal imports a Trello or GitHub export. Unlike CSV and JSON
+// imports, each task is written on its own and recorded by its ID in the
+// source, so a failed import can be rerun to pick up where it stopped and
+// a repeated one skips what it already wrote. Rows with errors are
+// reported without stopping the others.
+func (s *TaskService) importExternal(stream grpc.ClientStreamingServer[pb.ImportBoardRequest, pb.ImportBoardResponse],
+	imp *boardImport, in io.Reader,
+) error {
+	ctx := stream.Context()
+	header := imp.header
+
+	if len(header.ColumnMapping) > 0 {
+		return status.Error(codes.InvalidArgument, "column_mapping only applies to CSV imports")
+	}
+
+	var source string
+	var reader externalReader
+	switch header.Format {
+	case pb.DataFormat_DATA_FORMAT_TRELLO:
+		r, err := boardio.NewTrelloReader(in)
+		if err != nil {
+			return importError(err)
+		}
+		source, reader = boardio.SourceTrello, r
+	default:
+		r, err := boardio.NewGitHubReader(in)
+		if err != nil {
+			return importError(err)
+		}
+		source, reader = boardio.SourceGitHub, r
+	}
+
+	file := reader.Board()
+	var board *repository.Board
+	var fields []*repository.CustomField
+	if file != nil {
+		var err error
+		board, fields, err = importBoard(file)
+		if err != nil {
+			return err
+		}
+		board.OwnerID = callerID(ctx)
+	}
+
+	// The board is the one asked for, the one an earlier import of the
+	// same source created, or a new one.
+	boardID := header.BoardId
+	if boardID == 0 {
+		if file == nil {
+			return status.Error(codes.InvalidArgument, "board_id is required: the file doesn't name a board")
+		}
+		id, err := s.repo.GetImportedBoard(ctx, source, file.ExternalID)
+		if err != nil && !errors.Is(err, repository.ErrBoardNotFound) {
+			return importError(err)
+		}
+		boardID = id
+	}
+
+	if boardID == 0 {
+		if !header.DryRun {
+			err := s.repo.CreateImportedBoard(ctx, source, file.ExternalID, board, fields)
+			if errors.Is(err, repository.ErrAlreadyImported) {
+				return status.Error(codes.Aborted, "another import created the board; retry to import into it")
+			}
+			if err != nil {
+				return importError(err)
+			}
+			boardID = board.ID
+			imp.resp.BoardCreated = true
+		}
+		for _, f := range fields {
+			imp.fields[f.Name] = f
+		}
+	} else {
+		if err := s.authorizeBoard(ctx, boardID, repository.RoleAdmin); err != nil {
+			return err
+		}
+		if _, err := s.getBoard(ctx, boardID); err != nil {
+			return err
+		}
+		existing, err := s.repo.ListCustomFields(ctx, boardID)
+		if err != nil {
+			return customFieldError("list", err)
+		}
+		for _, f := range existing {
+			imp.fields[f.Name] = f
+		}
+		if err := s.mergeImportFields(ctx, imp, boardID, fields); err != nil {
+			return err
+		}
+	}
+	imp.resp.BoardId = boardID
+
+	for {
+		t, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *boardio.RowError
+		if errors.As(err, &rowErr) {
+			imp.resp.RowsTotal++
+			imp.fail(rowErr.Row, rowErr.Column, rowErr.Msg)
+			continue
+		}
+		if err != nil {
+			return importError(err)
+		}
+
+		imp.resp.RowsTotal++
+		if t.ExternalID == "" {
+			imp.fail(reader.Row(), "", "the task has no ID in its source")
+			continue
+		}
+		t.AssigneeIDs = mapUsers(header.UserMap, t.AssigneeIDs)
+		task, ok, err := imp.task(ctx, reader.Row(), t)
+		if err != nil {
+			return importError(err)
+		}
+		if !ok {
+			continue
+		}
+		task.BoardID = boardID
+
+		var imported bool
+		switch {
+		case header.DryRun && boardID == 0:
+			imported = true
+		case header.DryRun:
+			done, err := s.repo.IsTaskImported(ctx, boardID, source, t.ExternalID)
+			if err != nil {
+				return importError(err)
+			}
+			imported = !done
+		default:
+			err := s.repo.ImportExternalTask(ctx, source, t.ExternalID, task)
+			if err != nil && !errors.Is(err, repository.ErrAlreadyImported) {
+				return importError(err)
+			}
+			imported = err == nil
+		}
+		if imported {
+			imp.resp.RowsImported++
+		} else {
+			imp.resp.RowsSkipped++
+		}
+	}
+
+	return stream.SendAndClose(imp.resp)
+}
+
+// mergeImportFields makes sure an existing board has the custom fields an
+// import needs, creating missing ones and adding missing select options.
+// On dry runs only imp.fields is changed.
+func (s *TaskService) mergeImportFields(ctx context.Context, imp *boardImport, boardID int64,
+	fields []*repository.CustomField,
+) error {
+	for _, want := range fields {
+		field, ok := imp.fields[want.Name]
+		if !ok {
+			want.BoardID = boardID
+			if !imp.header.DryRun {
+				if err := s.repo.CreateCustomField(ctx, want); err != nil {
+					return customFieldError("create", err)
+				}
+			}
+			imp.fields[want.Name] = want
+			continue
+		}
+
+		if field.Type != want.Type {
+			return status.Errorf(codes.FailedPrecondition,
+				"custom field %q is a %s field; the import needs a %s field", field.Name, field.Type, want.Type)
+		}
+		added := false
+		for _, opt := range want.Options {
+			if !slices.Contains(field.Options, opt) {
+				field.Options = append(field.Options, opt)
+				added = true
+			}
+		}
+		if added && !imp.header.DryRun {
+			if err := s.repo.UpdateCustomField(ctx, field); err != nil {
+				return customFieldError("update", err)
+			}
+		}
+	}
+	return nil
+}
+
+// mapUsers maps assignees through userMap, leaving out unmapped ones, as
+// assignees must be users.
+func mapUsers(userMap map[string]string, assignees []string) []string {
+	mapped := []string{}
+	for _, a := range assignees {
+		if id, ok := userMap[a]; ok {
+			mapped = append(mapped, id)
+		}
+	}
+	return mapped
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/notify"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// inboxSubject returns the NATS subject of a workspace's InboxEvents.
+func inboxSubject(workspaceID int64) string {
+	return fmt.Sprintf("workspaces.%d.inbox.notifications", workspaceID)
+}
+
+// InboxEvent tells a user's WebSocket connections that their inbox
+// changed: a notification arrived, or notifications were read.
+type InboxEvent struct {
+	Type        string `json:"type"` // "notification" or "notifications_read".
+	WorkspaceID int64  `json:"workspace_id"`
+	UserID      string `json:"user_id"`
+	UnreadCount int    `json:"unread_count"`
+
+	// The new notification, as the REST API returns it.
+	Notification json.RawMessage `json:"notification,omitempty"`
+}
+
+var (
+	inboxKindsToProto = map[string]pb.InboxNotificationKind{
+		string(notify.KindMentioned): pb.InboxNotificationKind_INBOX_NOTIFICATION_KIND_MENTIONED,
+		string(notify.KindAssigned):  pb.InboxNotificationKind_INBOX_NOTIFICATION_KIND_ASSIGNED,
+		string(notify.KindUpdated):   pb.InboxNotificationKind_INBOX_NOTIFICATION_KIND_UPDATED,
+		string(notify.KindCompleted): pb.InboxNotificationKind_INBOX_NOTIFICATION_KIND_COMPLETED,
+		string(notify.KindDeleted):   pb.InboxNotificationKind_INBOX_NOTIFICATION_KIND_DELETED,
+	}
+
+	// Matches the gateway's JSON responses.
+	inboxMarshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+func inboxNotificationToProto(n *repository.InboxNotification) *pb.InboxNotification {
+	pbNotification := &pb.InboxNotification{
+		Id:        n.ID,
+		UserId:    n.UserID,
+		Kind:      inboxKindsToProto[n.Kind],
+		TaskId:    n.TaskID,
+		BoardId:   n.BoardID,
+		Title:     n.Title,
+		Read:      n.ReadAt != nil,
+		CreatedAt: timestamppb.New(n.CreatedAt),
+	}
+	if n.ReadAt != nil {
+		pbNotification.ReadAt = timestamppb.New(*n.ReadAt)
+	}
+	return pbNotification
+}
+
+func (s *TaskService) ListInboxNotifications(ctx context.Context, req *pb.ListInboxNotificationsRequest) (*pb.ListInboxNotificationsResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 50 // Default page size.
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max page size.
+	}
+	pageNumber := req.PageNumber
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+
+	notifications, totalCount, err := s.repo.ListInboxNotifications(ctx, repository.InboxFilter{
+		UserID:     userID,
+		UnreadOnly: req.UnreadOnly,
+		Limit:      int(pageSize),
+		Offset:     int((pageNumber - 1) * pageSize),
+	})
+	if err != nil {
+		log.Printf("Failed to list inbox notifications: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list notifications")
+	}
+
+	unreadCount, err := s.repo.CountUnreadInboxNotifications(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count unread inbox notifications: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list notifications")
+	}
+
+	pbNotifications := make([]*pb.InboxNotification, len(notifications))
+	for i, n := range notifications {
+		pbNotifications[i] = inboxNotificationToProto(n)
+	}
+
+	return &pb.ListInboxNotificationsResponse{
+		Notifications: pbNotifications,
+		TotalCount:    int32(totalCount),
+		UnreadCount:   int32(unreadCount),
+	}, nil
+}
+
+func (s *TaskService) GetInboxUnreadCount(ctx context.Context, req *pb.GetInboxUnreadCountRequest) (*pb.GetInboxUnreadCountResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	unreadCount, err := s.repo.CountUnreadInboxNotifications(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count unread inbox notifications: %v", err)
+		return nil, status.Error(codes.Internal, "failed to count unread notifications")
+	}
+
+	return &pb.GetInboxUnreadCountResponse{UnreadCount: int32(unreadCount)}, nil
+}
+
+func (s *TaskService) MarkInboxNotificationRead(ctx context.Context, req *pb.MarkInboxNotificationReadRequest) (*pb.MarkInboxNotificationReadResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other users' notifications are reported as not found.
+	n, err := s.repo.MarkInboxNotificationRead(ctx, req.Id, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrInboxNotificationNotFound) {
+			return nil, status.Error(codes.NotFound, "notification not found")
+		}
+		log.Printf("Failed to mark inbox notification read: %v", err)
+		return nil, status.Error(codes.Internal, "failed to mark notification read")
+	}
+
+	unreadCount, err := s.repo.CountUnreadInboxNotifications(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to count unread inbox notifications: %v", err)
+		return nil, status.Error(codes.Internal, "failed to mark notification read")
+	}
+	s.publishInboxEvent(ctx, InboxEvent{Type: "notifications_read", UserID: userID, UnreadCount: unreadCount})
+
+	return &pb.MarkInboxNotificationReadResponse{
+		Notification: inboxNotificationToProto(n),
+		UnreadCount:  int32(unreadCount),
+	}, nil
+}
+
+func (s *TaskService) MarkAllInboxNotificationsRead(ctx context.Context, req *pb.MarkAllInboxNotificationsReadRequest) (*pb.MarkAllInboxNotificationsReadResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	marked, err := s.repo.MarkAllInboxNotificationsRead(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to mark inbox notifications read: %v", err)
+		return nil, status.Error(codes.Internal, "failed to mark notifications read")
+	}
+	if marked > 0 {
+		s.publishInboxEvent(ctx, InboxEvent{Type: "notifications_read", UserID: userID})
+	}
+
+	return &pb.MarkAllInboxNotificationsReadResponse{MarkedCount: int32(marked)}, nil
+}
+
+// taskWatchers returns a task's watchers, or none if they can't be read;
+// a failure only costs inbox notifications.
+func (s *TaskService) taskWatchers(ctx context.Context, taskID int64) []string {
+	watchers, err := s.repo.ListWatchers(ctx, taskID)
+	if err != nil {
+		log.Printf("ERROR: Failed to list watchers of task %d: %v", taskID, err)
+		return nil
+	}
+	return watchers
+}
+
+// notifyInbox stores the inbox notifications for event and pushes each to
+// its user. previousDescription is the task's description before an
+// update, so only new mentions count. Failures are logged, as the task
+// has already changed.
+func (s *TaskService) notifyInbox(ctx context.Context, event TaskEvent, previousDescription string, watchers []string) {
+	recipients := notify.Inbox(notify.Event{
+		Type:             event.Type,
+		WorkspaceID:      event.WorkspaceID,
+		TaskID:           event.TaskId,
+		BoardID:          event.BoardId,
+		Title:            event.Title,
+		Completed:        event.Completed,
+		CreatedBy:        event.CreatedBy,
+		AssigneeIDs:      event.AssigneeIDs,
+		AddedAssigneeIDs: event.AddedAssigneeIDs,
+		OpenBoard:        event.OpenBoard,
+		MemberIDs:        event.MemberIDs,
+	}, notify.NewMentions(previousDescription, event.Description), watchers)
+	if len(recipients) == 0 {
+		return
+	}
+
+	// The caller's request may be over, but the notifications are still
+	// due.
+	ctx = context.WithoutCancel(ctx)
+
+	notifications := make([]*repository.InboxNotification, len(recipients))
+	for i, r := range recipients {
+		notifications[i] = &repository.InboxNotification{
+			UserID:  r.UserID,
+			Kind:    string(r.Kind),
+			TaskID:  r.TaskID,
+			BoardID: r.BoardID,
+			Title:   r.Title,
+		}
+	}
+	if err := s.repo.CreateInboxNotifications(ctx, notifications); err != nil {
+		log.Printf("ERROR: Failed to create inbox notifications for task %d: %v", event.TaskId, err)
+		return
+	}
+
+	for _, n := range notifications {
+		unreadCount, err := s.repo.CountUnreadInboxNotifications(ctx, n.UserID)
+		if err != nil {
+			log.Printf("ERROR: Failed to count unread inbox notifications: %v", err)
+			continue
+		}
+		data, err := inboxMarshaler.Marshal(inboxNotificationToProto(n))
+		if err != nil {
+			log.Printf("ERROR: Failed to marshal inbox notification: %v", err)
+			continue
+		}
+		s.publishInboxEvent(ctx, InboxEvent{
+			Type:         "notification",
+			UserID:       n.UserID,
+			UnreadCount:  unreadCount,
+			Notification: data,
+		})
+	}
+}
+
+func (s *TaskService) publishInboxEvent(ctx context.Context, event InboxEvent) {
+	if s.nats == nil {
+		return
+	}
+	event.WorkspaceID = eventWorkspace(ctx)
+
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("ERROR: Failed to marshal inbox event: %v", err)
+		return
+	}
+
+	if err := s.nats.Publish(inboxSubject(event.WorkspaceID), eventJSON); err != nil {
+		log.Printf("ERROR: Failed to publish inbox event: %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+var (
+	boardRolesToProto = map[string]pb.BoardRole{
+		repository.RoleViewer: pb.BoardRole_BOARD_ROLE_VIEWER,
+		repository.RoleMember: pb.BoardRole_BOARD_ROLE_MEMBER,
+		repository.RoleAdmin:  pb.BoardRole_BOARD_ROLE_ADMIN,
+		repository.RoleOwner:  pb.BoardRole_BOARD_ROLE_OWNER,
+	}
+	boardRolesFromProto = map[pb.BoardRole]string{
+		pb.BoardRole_BOARD_ROLE_VIEWER: repository.RoleViewer,
+		pb.BoardRole_BOARD_ROLE_MEMBER: repository.RoleMember,
+		pb.BoardRole_BOARD_ROLE_ADMIN:  repository.RoleAdmin,
+		pb.BoardRole_BOARD_ROLE_OWNER:  repository.RoleOwner,
+	}
+)
+
+func boardMemberToProto(m *repository.BoardMember) *pb.BoardMember {
+	return &pb.BoardMember{
+		BoardId:   m.BoardID,
+		UserId:    m.UserID,
+		Role:      boardRolesToProto[m.Role],
+		CreatedAt: timestamppb.New(m.CreatedAt),
+	}
+}
+
+// memberRequest validates the fields shared by the membership RPCs.
+func memberRequest(boardID int64, userID string) error {
+	if boardID == 0 {
+		return status.Error(codes.InvalidArgument, "board_id is required")
+	}
+	if userID == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return nil
+}
+
+// authorizeGrant checks that the caller may manage a member holding role:
+// admins manage roles up to their own, and only owners manage owners.
+func (s *TaskService) authorizeGrant(ctx context.Context, boardID int64, role string) error {
+	userID := callerID(ctx)
+	access, err := s.boardAccess(ctx, boardID, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkAccess(access, userID, callerMFA(ctx), repository.RoleAdmin); err != nil {
+		return err
+	}
+	if !access.Open && roleRanks[role] > roleRanks[access.Role] {
+		return status.Errorf(codes.PermissionDenied, "only owners can manage %s members", role)
+	}
+	return nil
+}
+
+// authorizeBoardClaim checks that the caller may give an open board its
+// first owner: only its creator, owners of its workspace and admins may,
+// so nobody else can take the board over.
+func (s *TaskService) authorizeBoardClaim(ctx context.Context, boardID int64) error {
+	userID := callerID(ctx)
+	if userID == "" {
+		return status.Error(codes.Unauthenticated, "adding members requires a user id")
+	}
+
+	board, err := s.getBoard(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if board.CreatedBy == userID {
+		return nil
+	}
+
+	return s.authorizeWorkspaceAdmin(ctx, userID, "only the board's creator, workspace owners and admins can add its first owner")
+}
+
+// authorizeWorkspaceAdmin checks that userID owns the current workspace or
+// is one of Taskboard's admins, refusing others with msg.
+func (s *TaskService) authorizeWorkspaceAdmin(ctx context.Context, userID, msg string) error {
+	workspace, err := s.repo.GetWorkspaceAccess(ctx, eventWorkspace(ctx), userID)
+	if err != nil {
+		return workspaceError(err, "get workspace access")
+	}
+	if workspace.Role == repository.WorkspaceRoleOwner {
+		return nil
+	}
+	return s.requireAdmin(ctx, userID, msg)
+}
+
+// requireAdmin checks that userID is one of Taskboard's admins, refusing
+// others with msg.
+func (s *TaskService) requireAdmin(ctx context.Context, userID, msg string) error {
+	admin, err := s.repo.IsAdmin(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to check admin: %v", err)
+		return status.Error(codes.Internal, "failed to check permissions")
+	}
+	if !admin {
+		return status.Error(codes.PermissionDenied, msg)
+	}
+	return nil
+}
+
+func membershipError(err error, action string) error {
+	switch {
+	case errors.Is(err, repository.ErrBoardNotFound), errors.Is(err, repository.ErrBoardMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrBoardMemberExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrLastBoardOwner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	log.Printf("Failed to %s: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s", action)
+}
+
+func (s *TaskService) ListBoardMembers(ctx context.Context, req *pb.ListBoardMembersRequest) (*pb.ListBoardMembersResponse, error) {
+	if req.BoardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListBoardMembers(ctx, req.BoardId)
+	if err != nil {
+		return nil, membershipError(err, "list board members")
+	}
+
+	pbMembers := make([]*pb.BoardMember, len(members))
+	for i, m := range members {
+		pbMembers[i] = boardMemberToProto(m)
+	}
+
+	return &pb.ListBoardMembersResponse{Members: pbMembers}, nil
+}
+
+func (s *TaskService) AddBoardMember(ctx context.Context, req *pb.AddBoardMemberRequest) (*pb.AddBoardMemberResponse, error) {
+	if err := memberRequest(req.BoardId, req.UserId); err != nil {
+		return nil, err
+	}
+	role, ok := boardRolesFromProto[req.Role]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	access, err := s.boardAccess(ctx, req.BoardId, callerID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	// An open board is closed by giving it an owner.
+	if access.Open {
+		if err := s.authorizeBoardClaim(ctx, req.BoardId); err != nil {
+			return nil, err
+		}
+		if role != repository.RoleOwner {
+			return nil, status.Error(codes.FailedPrecondition, "a board's first member must be an owner")
+		}
+	} else if err := s.authorizeGrant(ctx, req.BoardId, role); err != nil {
+		return nil, err
+	}
+	if err := s.checkUsers(ctx, "user_id", []string{req.UserId}); err != nil {
+		return nil, err
+	}
+
+	member := &repository.BoardMember{BoardID: req.BoardId, UserID: req.UserId, Role: role}
+	if err := s.repo.AddBoardMember(ctx, member); err != nil {
+		return nil, membershipError(err, "add board member")
+	}
+
+	return &pb.AddBoardMemberResponse{Member: boardMemberToProto(member)}, nil
+}
+
+func (s *TaskService) UpdateBoardMember(ctx context.Context, req *pb.UpdateBoardMemberRequest) (*pb.UpdateBoardMemberResponse, error) {
+	if err := memberRequest(req.BoardId, req.UserId); err != nil {
+		return nil, err
+	}
+	role, ok := boardRolesFromProto[req.Role]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	target, err := s.boardAccess(ctx, req.BoardId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if target.Role == "" {
+		if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+		return nil, status.Error(codes.NotFound, repository.ErrBoardMemberNotFound.Error())
+	}
+	// Both the member's current and new role must be the caller's to grant.
+	if err := s.authorizeGrant(ctx, req.BoardId, target.Role); err != nil {
+		return nil, err
+	}
+	if err := s.authorizeGrant(ctx, req.BoardId, role); err != nil {
+		return nil, err
+	}
+
+	member, err := s.repo.SetBoardMemberRole(ctx, req.BoardId, req.UserId, role)
+	if err != nil {
+		return nil, membershipError(err, "update board member")
+	}
+
+	return &pb.UpdateBoardMemberResponse{Member: boardMemberToProto(member)}, nil
+}
+
+func (s *TaskService) RemoveBoardMember(ctx context.Context, req *pb.RemoveBoardMemberRequest) (*pb.RemoveBoardMemberResponse, error) {
+	if err := memberRequest(req.BoardId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// Members can leave on their own.
+	if req.UserId != callerID(ctx) {
+		target, err := s.boardAccess(ctx, req.BoardId, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if target.Role == "" {
+			if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+				return nil, err
+			}
+			return nil, status.Error(codes.NotFound, repository.ErrBoardMemberNotFound.Error())
+		}
+		if err := s.authorizeGrant(ctx, req.BoardId, target.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.RemoveBoardMember(ctx, req.BoardId, req.UserId); err != nil {
+		return nil, membershipError(err, "remove board member")
+	}
+
+	return &pb.RemoveBoardMemberResponse{Success: true}, nil
+}
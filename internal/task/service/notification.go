@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+const (
+	// Tasks are checked every overdueScanInterval for having fallen due,
+	// and each due date gets one overdue event. Tasks overdue for longer
+	// than overdueWindow, e.g. while the service was down, get none.
+	overdueScanInterval = time.Minute
+	overdueWindow       = 24 * time.Hour
+	overdueBatch        = 100
+)
+
+// Email delivery settings, as stored and in the API.
+var (
+	emailDeliveriesToRepo = map[pb.EmailDelivery]string{
+		pb.EmailDelivery_EMAIL_DELIVERY_IMMEDIATE: repository.EmailImmediate,
+		pb.EmailDelivery_EMAIL_DELIVERY_HOURLY:    repository.EmailHourly,
+		pb.EmailDelivery_EMAIL_DELIVERY_DAILY:     repository.EmailDaily,
+		pb.EmailDelivery_EMAIL_DELIVERY_OFF:       repository.EmailOff,
+	}
+	emailDeliveriesToProto = map[string]pb.EmailDelivery{
+		repository.EmailImmediate: pb.EmailDelivery_EMAIL_DELIVERY_IMMEDIATE,
+		repository.EmailHourly:    pb.EmailDelivery_EMAIL_DELIVERY_HOURLY,
+		repository.EmailDaily:     pb.EmailDelivery_EMAIL_DELIVERY_DAILY,
+		repository.EmailOff:       pb.EmailDelivery_EMAIL_DELIVERY_OFF,
+	}
+)
+
+func notificationPreferencesToProto(prefs *repository.NotificationPreferences) *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		UserId:        prefs.UserID,
+		EmailDelivery: emailDeliveriesToProto[prefs.EmailDelivery],
+	}
+}
+
+func (s *TaskService) WatchTask(ctx context.Context, req *pb.WatchTaskRequest) (*pb.WatchTaskResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AddWatcher(ctx, req.TaskId, userID); err != nil {
+		log.Printf("Failed to add watcher: %v", err)
+		return nil, status.Error(codes.Internal, "failed to watch task")
+	}
+
+	return &pb.WatchTaskResponse{Success: true}, nil
+}
+
+func (s *TaskService) UnwatchTask(ctx context.Context, req *pb.UnwatchTaskRequest) (*pb.UnwatchTaskResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.RemoveWatcher(ctx, req.TaskId, userID); err != nil {
+		log.Printf("Failed to remove watcher: %v", err)
+		return nil, status.Error(codes.Internal, "failed to unwatch task")
+	}
+
+	return &pb.UnwatchTaskResponse{Success: true}, nil
+}
+
+func (s *TaskService) ListTaskWatchers(ctx context.Context, req *pb.ListTaskWatchersRequest) (*pb.ListTaskWatchersResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.repo.ListWatchers(ctx, req.TaskId)
+	if err != nil {
+		log.Printf("Failed to list watchers: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list watchers")
+	}
+
+	return &pb.ListTaskWatchersResponse{UserIds: userIDs}, nil
+}
+
+func (s *TaskService) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.GetNotificationPreferencesResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.repo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to get notification preferences: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get notification preferences")
+	}
+
+	return &pb.GetNotificationPreferencesResponse{Preferences: notificationPreferencesToProto(prefs)}, nil
+}
+
+func (s *TaskService) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.UpdateNotificationPreferencesResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, ok := emailDeliveriesToRepo[req.EmailDelivery]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "email_delivery is required")
+	}
+
+	prefs := &repository.NotificationPreferences{UserID: userID, EmailDelivery: delivery}
+	if err := s.repo.SetNotificationPreferences(ctx, prefs); err != nil {
+		log.Printf("Failed to set notification preferences: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update notification preferences")
+	}
+
+	return &pb.UpdateNotificationPreferencesResponse{Preferences: notificationPreferencesToProto(prefs)}, nil
+}
+
+// RunOverdueScan publishes an "overdue" event for each open task as it
+// falls due, until ctx is cancelled.
+func (s *TaskService) RunOverdueScan(ctx context.Context) {
+	if s.nats == nil {
+		return
+	}
+
+	ticker := time.NewTicker(overdueScanInterval)
+	defer ticker.Stop()
+
+	for {
+		s.forEachWorkspace(ctx, s.publishOverdueTasks)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishOverdueTasks claims the tasks of ctx's workspace that fell due
+// and publishes an "overdue" event for each.
+func (s *TaskService) publishOverdueTasks(ctx context.Context) {
+	for ctx.Err() == nil {
+		tasks, err := s.repo.ClaimOverdueTasks(ctx, overdueBatch)
+		if err != nil {
+			log.Printf("ERROR: Failed to claim overdue tasks: %v", err)
+			return
+		}
+		cutoff := time.Now().Add(-overdueWindow)
+		for _, task := range tasks {
+			if task.DueAt.After(cutoff) {
+				s.publishEvent(ctx, "overdue", task)
+			}
+		}
+		if len(tasks) < overdueBatch {
+			return
+		}
+	}
+}
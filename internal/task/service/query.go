@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// parseQuery parses a filter query, returning parse errors as
+// InvalidArgument so callers see where the query went wrong.
+func parseQuery(query string, env taskquery.Env) (*taskquery.Query, error) {
+	q, err := taskquery.Parse(query, env)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return q, nil
+}
+
+// parseSort splits a sort key such as "-due" or "cf.3" into a column or a
+// custom field ID, and the direction.
+func parseSort(sort string) (column string, fieldID int64, desc bool, err error) {
+	key, desc := strings.CutPrefix(sort, "-")
+	if key == "" {
+		return "", 0, false, nil
+	}
+
+	if idStr, ok := strings.CutPrefix(key, "cf."); ok {
+		fieldID, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil || fieldID <= 0 {
+			return "", 0, false, status.Errorf(codes.InvalidArgument, "invalid sort field %q", sort)
+		}
+		return "", fieldID, desc, nil
+	}
+
+	if !repository.ValidSortColumn(key) {
+		return "", 0, false, status.Errorf(codes.InvalidArgument,
+			"invalid sort %q, expected created, updated, due, title or cf.<field id>", sort)
+	}
+	return key, 0, desc, nil
+}
+
+// taskFilter builds the repository filter for a list request: completion,
+// custom field filters, the query language, a saved view and sorting. It
+// also limits the list to boards the caller can see.
+func (s *TaskService) taskFilter(ctx context.Context, req *pb.ListTasksRequest) (repository.TaskFilter, error) {
+	filter := repository.TaskFilter{
+		BoardID:   req.BoardId,
+		Completed: req.Completed,
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return filter, err
+	}
+	env := taskquery.Env{Now: time.Now(), UserID: userID}
+	sort := req.Sort
+
+	if req.ViewId != 0 {
+		view, err := s.visibleView(ctx, req.ViewId, userID)
+		if err != nil {
+			return filter, err
+		}
+		q, err := taskquery.Parse(view.Query, env)
+		if err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "saved view %q: %v", view.Name, err)
+		}
+		filter.Queries = append(filter.Queries, q)
+
+		if view.BoardID != 0 {
+			if filter.BoardID != 0 && filter.BoardID != view.BoardID {
+				return filter, status.Errorf(codes.InvalidArgument, "saved view %q is for board %d", view.Name, view.BoardID)
+			}
+			filter.BoardID = view.BoardID
+		}
+		if sort == "" && req.SortCustomFieldId == 0 {
+			sort = view.Sort
+		}
+	}
+
+	if req.Query != "" {
+		q, err := parseQuery(req.Query, env)
+		if err != nil {
+			return filter, err
+		}
+		filter.Queries = append(filter.Queries, q)
+	}
+
+	if filter.BoardID == 0 && len(filter.Queries) == 0 {
+		return filter, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+
+	// Lists across boards only see the caller's, and without a second
+	// factor, not those that require one.
+	if filter.BoardID == 0 {
+		filter.VisibleTo = visibleTo(ctx)
+		filter.WithoutMFABoards = !callerMFA(ctx)
+	} else if err := s.authorizeBoard(ctx, filter.BoardID, repository.RoleViewer); err != nil {
+		return filter, err
+	}
+
+	column, sortFieldID, desc, err := parseSort(sort)
+	if err != nil {
+		return filter, err
+	}
+	if req.SortCustomFieldId != 0 {
+		if req.Sort != "" {
+			return filter, status.Error(codes.InvalidArgument, "set either sort or sort_custom_field_id")
+		}
+		sortFieldID, desc = req.SortCustomFieldId, req.SortDescending
+	}
+	filter.SortColumn = column
+	filter.SortDesc = desc
+
+	if len(req.CustomFieldFilters) == 0 && sortFieldID == 0 {
+		return filter, nil
+	}
+
+	// Custom fields belong to a board, so they need one.
+	if filter.BoardID == 0 {
+		return filter, status.Error(codes.InvalidArgument, "custom field filters and sorting need a board_id")
+	}
+
+	fields, err := s.repo.ListCustomFields(ctx, filter.BoardID)
+	if err != nil {
+		return filter, customFieldError("list", err)
+	}
+	byID := make(map[int64]*repository.CustomField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	for _, f := range req.CustomFieldFilters {
+		field, ok := byID[f.FieldId]
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "custom field %d does not belong to board %d", f.FieldId, filter.BoardID)
+		}
+		op, ok := filterOps[f.Op]
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "filter on %q needs an operator", field.Name)
+		}
+		if err := validateFilter(field, op, f.Value); err != nil {
+			return filter, err
+		}
+		filter.CustomFields = append(filter.CustomFields, repository.CustomFieldFilter{
+			FieldID: field.ID,
+			Type:    field.Type,
+			Op:      op,
+			Value:   f.Value,
+		})
+	}
+
+	if sortFieldID != 0 {
+		field, ok := byID[sortFieldID]
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "custom field %d does not belong to board %d", sortFieldID, filter.BoardID)
+		}
+		if field.Type == repository.FieldTypeMultiSelect {
+			return filter, status.Errorf(codes.InvalidArgument, "cannot sort by multi select field %q", field.Name)
+		}
+		filter.SortFieldID = field.ID
+		filter.SortFieldType = field.Type
+	}
+
+	return filter, nil
+}
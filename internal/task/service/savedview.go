@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/taskquery"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+func savedViewToProto(view *repository.SavedView) *pb.SavedView {
+	return &pb.SavedView{
+		Id:        view.ID,
+		OwnerId:   view.OwnerID,
+		Name:      view.Name,
+		Query:     view.Query,
+		Sort:      view.Sort,
+		BoardId:   view.BoardID,
+		Shared:    view.Shared,
+		CreatedAt: timestamppb.New(view.CreatedAt),
+		UpdatedAt: timestamppb.New(view.UpdatedAt),
+	}
+}
+
+// savedViewError maps repository errors to gRPC errors.
+func savedViewError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrSavedViewNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrSavedViewExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	log.Printf("Failed to %s saved view: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s saved view", action)
+}
+
+// validateView checks a view's name, query and sort before saving it.
+// The query is parsed for errors only: "me" and relative dates are
+// resolved again each time the view is used.
+func (s *TaskService) validateView(ctx context.Context, view *repository.SavedView) error {
+	if strings.TrimSpace(view.Name) == "" {
+		return status.Error(codes.InvalidArgument, "name is required")
+	}
+	env := taskquery.Env{Now: time.Now(), UserID: view.OwnerID}
+	if _, err := parseQuery(view.Query, env); err != nil {
+		return err
+	}
+	if _, _, _, err := parseSort(view.Sort); err != nil {
+		return err
+	}
+	if view.BoardID != 0 {
+		if err := s.authorizeBoard(ctx, view.BoardID, repository.RoleViewer); err != nil {
+			return err
+		}
+		if _, err := s.getBoard(ctx, view.BoardID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// visibleView loads a view that userID owns or that is shared. Views the
+// user can't see are reported as not found.
+func (s *TaskService) visibleView(ctx context.Context, id int64, userID string) (*repository.SavedView, error) {
+	view, err := s.repo.GetSavedView(ctx, id)
+	if err != nil {
+		return nil, savedViewError("get", err)
+	}
+	if view.OwnerID != userID && !view.Shared {
+		return nil, status.Error(codes.NotFound, repository.ErrSavedViewNotFound.Error())
+	}
+	return view, nil
+}
+
+// ownedView loads a view for changes, which only its owner may make.
+func (s *TaskService) ownedView(ctx context.Context, id int64, userID string) (*repository.SavedView, error) {
+	view, err := s.visibleView(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if view.OwnerID != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the owner can change a saved view")
+	}
+	return view, nil
+}
+
+func (s *TaskService) CreateSavedView(ctx context.Context, req *pb.CreateSavedViewRequest) (*pb.CreateSavedViewResponse, error) {
+	userID, err := actingUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &repository.SavedView{
+		OwnerID: userID,
+		Name:    req.Name,
+		Query:   req.Query,
+		Sort:    req.Sort,
+		BoardID: req.BoardId,
+		Shared:  req.Shared,
+	}
+	if err := s.validateView(ctx, view); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateSavedView(ctx, view); err != nil {
+		return nil, savedViewError("create", err)
+	}
+
+	return &pb.CreateSavedViewResponse{View: savedViewToProto(view)}, nil
+}
+
+func (s *TaskService) GetSavedView(ctx context.Context, req *pb.GetSavedViewRequest) (*pb.GetSavedViewResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	view, err := s.visibleView(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSavedViewResponse{View: savedViewToProto(view)}, nil
+}
+
+func (s *TaskService) ListSavedViews(ctx context.Context, req *pb.ListSavedViewsRequest) (*pb.ListSavedViewsResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := s.repo.ListSavedViews(ctx, userID, req.BoardId)
+	if err != nil {
+		return nil, savedViewError("list", err)
+	}
+
+	pbViews := make([]*pb.SavedView, len(views))
+	for i, view := range views {
+		pbViews[i] = savedViewToProto(view)
+	}
+
+	return &pb.ListSavedViewsResponse{Views: pbViews}, nil
+}
+
+func (s *TaskService) UpdateSavedView(ctx context.Context, req *pb.UpdateSavedViewRequest) (*pb.UpdateSavedViewResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	view, err := s.ownedView(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		view.Name = *req.Name
+	}
+	if req.Query != nil {
+		view.Query = *req.Query
+	}
+	if req.Sort != nil {
+		view.Sort = *req.Sort
+	}
+	if req.BoardId != nil {
+		view.BoardID = *req.BoardId
+	}
+	if req.Shared != nil {
+		view.Shared = *req.Shared
+	}
+	if err := s.validateView(ctx, view); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateSavedView(ctx, view); err != nil {
+		return nil, savedViewError("update", err)
+	}
+
+	return &pb.UpdateSavedViewResponse{View: savedViewToProto(view)}, nil
+}
+
+func (s *TaskService) DeleteSavedView(ctx context.Context, req *pb.DeleteSavedViewRequest) (*pb.DeleteSavedViewResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.ownedView(ctx, req.Id, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteSavedView(ctx, req.Id); err != nil {
+		return nil, savedViewError("delete", err)
+	}
+
+	return &pb.DeleteSavedViewResponse{Success: true}, nil
+}
@@ -3,8 +3,12 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/netip"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -13,6 +17,8 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/sys/auth"
+	"github.com/zaouldyeck/taskboard/business/sys/blob"
 	"github.com/zaouldyeck/taskboard/internal/task/repository"
 )
 
@@ -24,32 +30,91 @@ type TaskService struct {
 
 	repo repository.Repository
 	nats *nats.Conn
+
+	// Blob store for attachment contents. Blobs of deleted attachments are
+	// queued on blobCleanup and removed by RunBlobCleanup.
+	blobs       blob.Store
+	blobCleanup chan string
+
+	// Signalled when webhook deliveries are queued, so RunWebhooks sends
+	// them without waiting for its next poll.
+	webhookWake chan struct{}
+
+	// Internal networks webhooks may still be delivered to.
+	webhookNetworks []netip.Prefix
+
+	// Verifies the call tokens calls are made on behalf of users with.
+	auth *auth.Auth
 }
 
-func NewTaskService(repo repository.Repository, nc *nats.Conn) *TaskService {
+func NewTaskService(repo repository.Repository, nc *nats.Conn, blobs blob.Store, authn *auth.Auth,
+	webhookNetworks []netip.Prefix,
+) *TaskService {
 	return &TaskService{
-		repo: repo,
-		nats: nc,
+		repo:            repo,
+		nats:            nc,
+		blobs:           blobs,
+		auth:            authn,
+		blobCleanup:     make(chan string, 1024),
+		webhookWake:     make(chan struct{}, 1),
+		webhookNetworks: webhookNetworks,
 	}
 }
 
-// TaskEvent represents an event published to NATS message broker.
+// TaskEvent represents an event published to NATS message broker, on the
+// subject workspaces.<workspace_id>.tasks.<type>.
 type TaskEvent struct {
-	Type      string `json:"type"` // Kind of action: "created", "updated", "deleted".
-	TaskId    int64  `json:"task_id"`
-	BoardId   int64  `json:"board_id"`
-	Title     string `json:"title"`
-	Completed *bool  `json:"completed"`
-	Timestamp int64  `json:"timestamp"`
+	Type        string `json:"type"` // Kind of action: "created", "updated", "deleted", "overdue".
+	WorkspaceID int64  `json:"workspace_id"`
+	TaskId      int64  `json:"task_id"`
+	BoardId     int64  `json:"board_id"`
+	Title       string `json:"title"`
+	Completed   *bool  `json:"completed"`
+	Timestamp   int64  `json:"timestamp"`
+
+	// Snapshot of the task, so subscribers can match it against queries.
+	Description string     `json:"description"`
+	Labels      []string   `json:"labels"`
+	AssigneeIDs []string   `json:"assignee_ids"`
+	DueAt       *time.Time `json:"due_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CreatedBy   string     `json:"created_by"`
+
+	// Assignees the task gained with this event; all of them when it was
+	// created.
+	AddedAssigneeIDs []string `json:"added_assignee_ids,omitempty"`
+
+	// Who may receive the event: everyone if the board is open, otherwise
+	// its members.
+	OpenBoard bool     `json:"open_board"`
+	MemberIDs []string `json:"member_ids,omitempty"`
+
+	// Whether the board or its workspace requires receivers to have signed
+	// in with a second factor.
+	RequireMFA bool `json:"require_mfa,omitempty"`
+}
+
+func (s *TaskService) publishEvent(ctx context.Context, eventType string, task *repository.Task) {
+	s.publishTaskEvent(s.newTaskEvent(ctx, eventType, task))
 }
 
-func (s *TaskService) publishEvent(eventType string, task *repository.Task) {
+func (s *TaskService) newTaskEvent(ctx context.Context, eventType string, task *repository.Task) TaskEvent {
 	event := TaskEvent{
-		Type:      eventType,
-		TaskId:    task.ID,
-		BoardId:   task.BoardID,
-		Title:     task.Title,
-		Timestamp: time.Now().Unix(),
+		Type:        eventType,
+		WorkspaceID: eventWorkspace(ctx),
+		TaskId:      task.ID,
+		BoardId:     task.BoardID,
+		Title:       task.Title,
+		Timestamp:   time.Now().Unix(),
+
+		Description: task.Description,
+		Labels:      task.Labels,
+		AssigneeIDs: task.AssigneeIDs,
+		DueAt:       task.DueAt,
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+		CreatedBy:   task.CreatedBy,
 	}
 
 	// Allows for setting of optional Completed status of task in event.
@@ -57,23 +122,37 @@ func (s *TaskService) publishEvent(eventType string, task *repository.Task) {
 		completed := true
 		event.Completed = &completed
 	}
+	if eventType == "created" {
+		event.AddedAssigneeIDs = task.AssigneeIDs
+	}
+	event.OpenBoard, event.MemberIDs = s.boardAudience(ctx, task.BoardID)
+	event.RequireMFA = s.requiresMFA(ctx, task.BoardID)
 
+	return event
+}
+
+func (s *TaskService) publishTaskEvent(event TaskEvent) {
 	eventJSON, err := json.Marshal(event)
 	if err != nil {
 		log.Printf("ERROR: Failed to marshal event: %v", err)
 		return
 	}
 
-	subject := fmt.Sprintf("tasks.%s", eventType)
+	subject := fmt.Sprintf("workspaces.%d.tasks.%s", event.WorkspaceID, event.Type)
 	if err := s.nats.Publish(subject, eventJSON); err != nil {
 		log.Printf("ERROR: Failed to publish event to %s: %v", subject, err)
 		return
 	}
 
-	log.Printf("📤 Published event: %s (task_id=%d, board_id=%d)", subject, task.ID, task.BoardID)
+	log.Printf("📤 Published event: %s (task_id=%d, board_id=%d)", subject, event.TaskId, event.BoardId)
 }
 
 func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
+	createdBy, err := actingUser(ctx, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
 	if req.Title == "" {
 		// Return gRPC error for a bad request.
 		return nil, status.Error(codes.InvalidArgument, "title is required")
@@ -81,6 +160,21 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 	if req.BoardId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "board_id is required")
 	}
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleMember); err != nil {
+		return nil, err
+	}
+
+	labels, err := cleanList("label", req.Labels)
+	if err != nil {
+		return nil, err
+	}
+	assignees, err := cleanList("assignee", req.AssigneeIds)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUsers(ctx, "assignee", assignees); err != nil {
+		return nil, err
+	}
 
 	// Convert protobuf to domain code, for domain/API separation.
 	domainTask := &repository.Task{
@@ -88,11 +182,20 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		Title:       req.Title,
 		Description: req.Description,
 		Completed:   false,
-		CreatedBy:   req.CreatedBy,
+		CreatedBy:   createdBy,
+		DueAt:       timeFromProto(req.DueAt),
+		Labels:      labels,
+		AssigneeIDs: assignees,
+	}
+
+	// Validate custom fields before anything is written.
+	fieldChanges, err := s.resolveFieldValues(ctx, req.BoardId, req.CustomFields, nil, true)
+	if err != nil {
+		return nil, err
 	}
 
 	// Persist do DB.
-	err := s.repo.Create(ctx, domainTask)
+	err = s.repo.Create(ctx, domainTask)
 	if err != nil {
 		log.Printf("Failed to create task: %v\n", err)
 
@@ -100,8 +203,19 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		return nil, status.Error(codes.Internal, "failed to create task")
 	}
 
+	if err := s.repo.SetTaskFieldValues(ctx, domainTask.ID, fieldChanges.set, nil); err != nil {
+		log.Printf("Failed to set custom fields: %v\n", err)
+		if err := s.repo.Delete(ctx, domainTask.ID); err != nil {
+			log.Printf("Failed to remove partially created task %d: %v\n", domainTask.ID, err)
+		}
+		return nil, status.Error(codes.Internal, "failed to create task")
+	}
+	domainTask.CustomFields = fieldChanges.set
+
 	// Publish "created" event to NATS for message queuing.
-	s.publishEvent("created", domainTask)
+	event := s.newTaskEvent(ctx, "created", domainTask)
+	s.publishTaskEvent(event)
+	s.notifyInbox(ctx, event, "", nil)
 
 	// Return protobuf response for gRPC API.
 	pbTask := domainToProto(domainTask)
@@ -109,8 +223,63 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 	return &pb.CreateTaskResponse{Task: pbTask}, nil
 }
 
+// cleanList trims values and drops duplicates, rejecting empty ones. kind
+// names the values in errors.
+func cleanList(kind string, values []string) ([]string, error) {
+	seen := map[string]bool{}
+	cleaned := []string{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "%s cannot be empty", kind)
+		}
+		if !seen[v] {
+			seen[v] = true
+			cleaned = append(cleaned, v)
+		}
+	}
+	return cleaned, nil
+}
+
+// unknownUsers returns those of ids that aren't users.
+func (s *TaskService) unknownUsers(ctx context.Context, ids []string) ([]string, error) {
+	users, err := s.repo.ListUserSummaries(ctx, ids)
+	if err != nil {
+		log.Printf("Failed to look up users: %v", err)
+		return nil, status.Error(codes.Internal, "failed to look up users")
+	}
+	var unknown []string
+	for _, id := range ids {
+		if !slices.ContainsFunc(users, func(u repository.UserSummary) bool { return u.ID == id }) {
+			unknown = append(unknown, id)
+		}
+	}
+	return unknown, nil
+}
+
+// checkUsers rejects ids that aren't users. kind names them in errors.
+func (s *TaskService) checkUsers(ctx context.Context, kind string, ids []string) error {
+	unknown, err := s.unknownUsers(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(unknown) > 0 {
+		return status.Errorf(codes.InvalidArgument, "%s %q is not a user", kind, unknown[0])
+	}
+	return nil
+}
+
+// timeFromProto converts an optional timestamp.
+func timeFromProto(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
 func domainToProto(task *repository.Task) *pb.Task {
-	return &pb.Task{
+	pbTask := &pb.Task{
 		Id:          task.ID,
 		BoardId:     task.BoardID,
 		Title:       task.Title,
@@ -119,7 +288,29 @@ func domainToProto(task *repository.Task) *pb.Task {
 		CreatedBy:   task.CreatedBy,
 		CreatedAt:   timestamppb.New(task.CreatedAt),
 		UpdatedAt:   timestamppb.New(task.UpdatedAt),
+
+		AttachmentCount: int32(task.AttachmentCount),
+
+		Labels:      task.Labels,
+		AssigneeIds: task.AssigneeIDs,
+	}
+	if task.DueAt != nil {
+		pbTask.DueAt = timestamppb.New(*task.DueAt)
 	}
+	if task.Creator != nil {
+		pbTask.Creator = userSummaryToProto(*task.Creator)
+	}
+	for _, u := range task.Assignees {
+		pbTask.Assignees = append(pbTask.Assignees, userSummaryToProto(u))
+	}
+	for _, v := range task.CustomFields {
+		pbTask.CustomFields = append(pbTask.CustomFields, fieldValueToProto(v))
+	}
+	return pbTask
+}
+
+func userSummaryToProto(u repository.UserSummary) *pb.UserSummary {
+	return &pb.UserSummary{Id: u.ID, Username: u.Username}
 }
 
 func (s *TaskService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
@@ -128,25 +319,20 @@ func (s *TaskService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.
 	}
 
 	// Fetch from DB.
-	task, err := s.repo.GetByID(ctx, req.Id)
+	task, err := s.authorizeTask(ctx, req.Id, repository.RoleViewer)
 	if err != nil {
-		if err.Error() == "task not found" {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-		fmt.Printf("Failed to get task: %v\n", err)
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, err
 	}
 
+	pbTask := domainToProto(task)
+	pbTask.TotalTimeSeconds = int64(s.taskTotalTime(ctx, task.ID).Seconds())
+
 	return &pb.GetTaskResponse{
-		Task: domainToProto(task),
+		Task: pbTask,
 	}, nil
 }
 
 func (s *TaskService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
-	if req.BoardId == 0 {
-		return nil, status.Error(codes.InvalidArgument, "board_id is required")
-	}
-
 	// Setup of default pagination of the taskboard.
 	pageSize := req.PageSize
 	if pageSize == 0 {
@@ -162,14 +348,16 @@ func (s *TaskService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (
 	}
 	offset := (pageNumber - 1) * pageSize
 
-	// Optional "completed" filter.
-	var completedFilter *bool
-	if req.Completed != nil {
-		completedFilter = req.Completed
+	// Optional "completed", custom field and query filters, and sorting.
+	filter, err := s.taskFilter(ctx, req)
+	if err != nil {
+		return nil, err
 	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int(offset)
 
 	// Fetch from DB.
-	tasks, totalCount, err := s.repo.List(ctx, req.BoardId, completedFilter, int(pageSize), int(offset))
+	tasks, totalCount, err := s.repo.List(ctx, filter)
 	if err != nil {
 		fmt.Printf("Failed to list tasks: %v\n", err)
 		return nil, status.Error(codes.Internal, "failed to list tasks")
@@ -192,15 +380,14 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	existingTask, err := s.repo.GetByID(ctx, req.Id)
+	existingTask, err := s.authorizeTask(ctx, req.Id, repository.RoleMember)
 	if err != nil {
-		if err.Error() == "task not found" {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-		fmt.Printf("Failed to get task: %v\n", err)
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, err
 	}
 
+	previousAssignees := existingTask.AssigneeIDs
+	previousDescription := existingTask.Description
+
 	// Update any of the optional fields.
 	if req.Title != nil {
 		existingTask.Title = *req.Title
@@ -211,6 +398,30 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 	if req.Completed != nil {
 		existingTask.Completed = *req.Completed
 	}
+	if req.DueAt != nil {
+		existingTask.DueAt = timeFromProto(req.DueAt)
+	}
+	if req.ClearDueAt {
+		existingTask.DueAt = nil
+	}
+	if req.Labels != nil {
+		if existingTask.Labels, err = cleanList("label", req.Labels.Values); err != nil {
+			return nil, err
+		}
+	}
+	if req.AssigneeIds != nil {
+		if existingTask.AssigneeIDs, err = cleanList("assignee", req.AssigneeIds.Values); err != nil {
+			return nil, err
+		}
+		if err := s.checkUsers(ctx, "assignee", existingTask.AssigneeIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	fieldChanges, err := s.resolveFieldValues(ctx, existingTask.BoardID, req.CustomFields, existingTask.CustomFields, false)
+	if err != nil {
+		return nil, err
+	}
 
 	// Save changes to task to DB.
 	err = s.repo.Update(ctx, existingTask)
@@ -219,8 +430,21 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 		return nil, status.Error(codes.Internal, "failed to update task")
 	}
 
+	if err := s.repo.SetTaskFieldValues(ctx, existingTask.ID, fieldChanges.set, fieldChanges.clear); err != nil {
+		fmt.Printf("Failed to update custom fields: %v\n", err)
+		return nil, status.Error(codes.Internal, "failed to update task")
+	}
+	existingTask.CustomFields = fieldChanges.apply(existingTask.CustomFields)
+
 	// Publish "update" event to NATS for message queuing.
-	s.publishEvent("updated", existingTask)
+	event := s.newTaskEvent(ctx, "updated", existingTask)
+	for _, id := range existingTask.AssigneeIDs {
+		if !slices.Contains(previousAssignees, id) {
+			event.AddedAssigneeIDs = append(event.AddedAssigneeIDs, id)
+		}
+	}
+	s.publishTaskEvent(event)
+	s.notifyInbox(ctx, event, previousDescription, s.taskWatchers(ctx, existingTask.ID))
 
 	return &pb.UpdateTaskResponse{
 		Task: domainToProto(existingTask),
@@ -233,26 +457,38 @@ func (s *TaskService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest)
 	}
 
 	// Capture task info for use with "deleted" event in NATS message broker.
-	task, err := s.repo.GetByID(ctx, req.Id)
+	task, err := s.authorizeTask(ctx, req.Id, repository.RoleMember)
 	if err != nil {
-		if err.Error() == "task not found" {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-		fmt.Printf("Failed to get task: %v\n", err)
-		return nil, status.Error(codes.Internal, "failed to get task")
+		return nil, err
 	}
 
+	// Attachment rows cascade with the task, so collect their blobs first.
+	attachments, err := s.repo.ListAttachments(ctx, req.Id)
+	if err != nil {
+		fmt.Printf("Failed to list attachments: %v\n", err)
+		return nil, status.Error(codes.Internal, "failed to delete task")
+	}
+	// As do watchers.
+	watchers := s.taskWatchers(ctx, req.Id)
+
 	err = s.repo.Delete(ctx, req.Id)
 	if err != nil {
-		if err.Error() == "task not found" {
+		if errors.Is(err, repository.ErrTaskNotFound) {
 			return nil, status.Error(codes.NotFound, "task not found")
 		}
 		fmt.Printf("Failed to delete task: %v\n", err)
 		return nil, status.Error(codes.Internal, "failed to delete task")
 	}
 
+	// Remove attachment blobs in the background.
+	for _, a := range attachments {
+		s.deleteBlobsAsync(a.StorageKey)
+	}
+
 	// Publish "deleted" event to NATS for message queuing.
-	s.publishEvent("deleted", task)
+	event := s.newTaskEvent(ctx, "deleted", task)
+	s.publishTaskEvent(event)
+	s.notifyInbox(ctx, event, "", watchers)
 
 	return &pb.DeleteTaskResponse{
 		Success: true,
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func TestCreateTaskUnknownAssignee(t *testing.T) {
+	s := &TaskService{repo: newFakeRepo()}
+
+	_, err := s.CreateTask(asUser("member"), &pb.CreateTaskRequest{
+		BoardId: 1, Title: "x", AssigneeIds: []string{"viewer", "ghost"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got: %v", err)
+	}
+	if want := `assignee "ghost" is not a user`; status.Convert(err).Message() != want {
+		t.Errorf("expected %q, got %q", want, status.Convert(err).Message())
+	}
+
+	t.Log("✅ Correctly rejected unknown assignee")
+}
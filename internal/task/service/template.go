@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// maxSeedTasks caps how many tasks SaveBoardAsTemplate copies.
+const maxSeedTasks = 500
+
+// templateVar matches {{name}} placeholders, allowing inner spaces.
+var templateVar = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// expander substitutes template variables, collecting any it can't resolve.
+type expander struct {
+	vars    map[string]string
+	unknown map[string]bool
+}
+
+// newExpander merges caller variables over the built-in ones.
+func newExpander(now time.Time, vars map[string]string) *expander {
+	merged := map[string]string{
+		"date":     now.Format(time.DateOnly),
+		"datetime": now.Format(time.RFC3339),
+		"year":     now.Format("2006"),
+		"month":    now.Format("01"),
+	}
+	for k, v := range vars {
+		merged[k] = v
+	}
+	return &expander{vars: merged, unknown: map[string]bool{}}
+}
+
+func (e *expander) expand(text string) string {
+	return templateVar.ReplaceAllStringFunc(text, func(match string) string {
+		name := templateVar.FindStringSubmatch(match)[1]
+		val, ok := e.vars[name]
+		if !ok {
+			e.unknown[name] = true
+			return match
+		}
+		return val
+	})
+}
+
+// err reports unresolved variables as an InvalidArgument error.
+func (e *expander) err() error {
+	if len(e.unknown) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(e.unknown))
+	for name := range e.unknown {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return status.Errorf(codes.InvalidArgument, "missing template variables: %s", strings.Join(names, ", "))
+}
+
+// renderTask expands a task template into a task title and description.
+// The checklist is appended to the description as markdown checkboxes.
+func (e *expander) renderTask(tt *pb.TaskTemplate) (title, description string) {
+	title = e.expand(tt.Title)
+	description = e.expand(tt.Description)
+
+	if len(tt.Checklist) > 0 {
+		var b strings.Builder
+		b.WriteString(description)
+		if description != "" {
+			b.WriteString("\n\n")
+		}
+		for _, item := range tt.Checklist {
+			fmt.Fprintf(&b, "- [ ] %s\n", e.expand(item))
+		}
+		description = strings.TrimRight(b.String(), "\n")
+	}
+
+	return title, description
+}
+
+// templateToProto decodes the stored JSON content of a template.
+func templateToProto(tmpl *repository.Template) (*pb.Template, error) {
+	pbTmpl := &pb.Template{
+		Id:            tmpl.ID,
+		Name:          tmpl.Name,
+		Description:   tmpl.Description,
+		CreatedAt:     timestamppb.New(tmpl.CreatedAt),
+		CreatedBy:     tmpl.CreatedBy,
+		SourceBoardId: tmpl.SourceBoardID,
+	}
+
+	switch tmpl.Kind {
+	case repository.TemplateKindBoard:
+		content := &pb.BoardTemplate{}
+		if err := protojson.Unmarshal(tmpl.Content, content); err != nil {
+			return nil, fmt.Errorf("decoding board template %d: %w", tmpl.ID, err)
+		}
+		pbTmpl.Kind = pb.TemplateKind_TEMPLATE_KIND_BOARD
+		pbTmpl.Content = &pb.Template_Board{Board: content}
+	case repository.TemplateKindTask:
+		content := &pb.TaskTemplate{}
+		if err := protojson.Unmarshal(tmpl.Content, content); err != nil {
+			return nil, fmt.Errorf("decoding task template %d: %w", tmpl.ID, err)
+		}
+		pbTmpl.Kind = pb.TemplateKind_TEMPLATE_KIND_TASK
+		pbTmpl.Content = &pb.Template_Task{Task: content}
+	default:
+		return nil, fmt.Errorf("unknown template kind %q", tmpl.Kind)
+	}
+
+	return pbTmpl, nil
+}
+
+// saveTemplate validates and stores template content, created by the
+// caller. sourceBoardID is the board the content was taken from, or 0.
+func (s *TaskService) saveTemplate(ctx context.Context, name, description string,
+	board *pb.BoardTemplate, task *pb.TaskTemplate, sourceBoardID int64,
+) (*pb.Template, error) {
+	userID, err := actingUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	tmpl := &repository.Template{
+		Name:          name,
+		Description:   description,
+		CreatedBy:     userID,
+		SourceBoardID: sourceBoardID,
+	}
+	var content proto.Message
+	switch {
+	case board != nil:
+		if board.Name == "" {
+			return nil, status.Error(codes.InvalidArgument, "board template needs a board name")
+		}
+		for _, tt := range board.Tasks {
+			if tt.Title == "" {
+				return nil, status.Error(codes.InvalidArgument, "seed tasks need a title")
+			}
+		}
+		tmpl.Kind = repository.TemplateKindBoard
+		content = board
+	case task != nil:
+		if task.Title == "" {
+			return nil, status.Error(codes.InvalidArgument, "task template needs a title")
+		}
+		tmpl.Kind = repository.TemplateKindTask
+		content = task
+	default:
+		return nil, status.Error(codes.InvalidArgument, "template content is required")
+	}
+
+	tmpl.Content, err = protojson.Marshal(content)
+	if err != nil {
+		log.Printf("Failed to encode template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to encode template")
+	}
+
+	if err := s.repo.CreateTemplate(ctx, tmpl); err != nil {
+		log.Printf("Failed to create template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create template")
+	}
+
+	return templateToProto(tmpl)
+}
+
+// canReadTemplate reports whether the caller may read a template.
+// Templates saved from a board hold its layout and tasks, so only their
+// creator and those who can see the board may; once the board is gone,
+// only their creator. Other templates are shared with the workspace.
+func (s *TaskService) canReadTemplate(ctx context.Context, tmpl *repository.Template) (bool, error) {
+	if tmpl.SourceBoardID == 0 || (tmpl.CreatedBy != "" && tmpl.CreatedBy == callerID(ctx)) {
+		return true, nil
+	}
+
+	if _, err := s.getBoard(ctx, tmpl.SourceBoardID); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	err := s.authorizeBoard(ctx, tmpl.SourceBoardID, repository.RoleViewer)
+	switch status.Code(err) {
+	case codes.OK:
+		return true, nil
+	case codes.NotFound, codes.PermissionDenied, codes.Unauthenticated:
+		return false, nil
+	}
+	return false, err
+}
+
+// loadTemplate loads a template the caller may read, returning a gRPC
+// error if it can't. Templates they can't read are reported as not found.
+func (s *TaskService) loadTemplate(ctx context.Context, id int64) (*repository.Template, error) {
+	if id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "template_id is required")
+	}
+
+	tmpl, err := s.repo.GetTemplate(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTemplateNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to get template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get template")
+	}
+
+	ok, err := s.canReadTemplate(ctx, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, repository.ErrTemplateNotFound.Error())
+	}
+	return tmpl, nil
+}
+
+// getTemplate loads and decodes a template the caller may read, returning
+// a gRPC error if it can't.
+func (s *TaskService) getTemplate(ctx context.Context, id int64) (*pb.Template, error) {
+	tmpl, err := s.loadTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTmpl, err := templateToProto(tmpl)
+	if err != nil {
+		log.Printf("Failed to decode template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to decode template")
+	}
+	return pbTmpl, nil
+}
+
+func (s *TaskService) CreateTemplate(ctx context.Context, req *pb.CreateTemplateRequest) (*pb.CreateTemplateResponse, error) {
+	tmpl, err := s.saveTemplate(ctx, req.Name, req.Description, req.GetBoard(), req.GetTask(), 0)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateTemplateResponse{Template: tmpl}, nil
+}
+
+func (s *TaskService) GetTemplate(ctx context.Context, req *pb.GetTemplateRequest) (*pb.GetTemplateResponse, error) {
+	tmpl, err := s.getTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTemplateResponse{Template: tmpl}, nil
+}
+
+func (s *TaskService) ListTemplates(ctx context.Context, req *pb.ListTemplatesRequest) (*pb.ListTemplatesResponse, error) {
+	var kind string
+	switch req.Kind {
+	case pb.TemplateKind_TEMPLATE_KIND_BOARD:
+		kind = repository.TemplateKindBoard
+	case pb.TemplateKind_TEMPLATE_KIND_TASK:
+		kind = repository.TemplateKindTask
+	}
+
+	templates, err := s.repo.ListTemplates(ctx, kind)
+	if err != nil {
+		log.Printf("Failed to list templates: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list templates")
+	}
+
+	resp := &pb.ListTemplatesResponse{}
+	for _, tmpl := range templates {
+		ok, err := s.canReadTemplate(ctx, tmpl)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		pbTmpl, err := templateToProto(tmpl)
+		if err != nil {
+			// Skip, rather than fail the whole listing on one bad row.
+			log.Printf("Failed to decode template: %v", err)
+			continue
+		}
+		resp.Templates = append(resp.Templates, pbTmpl)
+	}
+
+	return resp, nil
+}
+
+// DeleteTemplate deletes a template. Only its creator, workspace owners
+// and admins may.
+func (s *TaskService) DeleteTemplate(ctx context.Context, req *pb.DeleteTemplateRequest) (*pb.DeleteTemplateResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	userID, err := actingUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := s.loadTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if tmpl.CreatedBy != userID {
+		if err := s.authorizeWorkspaceAdmin(ctx, userID, "only a template's creator, workspace owners and admins can delete it"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.DeleteTemplate(ctx, req.Id); err != nil {
+		if errors.Is(err, repository.ErrTemplateNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to delete template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete template")
+	}
+
+	return &pb.DeleteTemplateResponse{Success: true}, nil
+}
+
+// SaveBoardAsTemplate captures an existing board's columns, labels and,
+// optionally, its tasks as a new board template.
+func (s *TaskService) SaveBoardAsTemplate(ctx context.Context, req *pb.SaveBoardAsTemplateRequest) (*pb.SaveBoardAsTemplateResponse, error) {
+	if req.BoardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+
+	// The template copies the board's tasks for the whole workspace to
+	// use, so it takes a board admin.
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleAdmin); err != nil {
+		return nil, err
+	}
+	board, err := s.getBoard(ctx, req.BoardId)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &pb.BoardTemplate{
+		Name:        board.Name,
+		Description: board.Description,
+	}
+	for _, col := range board.Columns {
+		content.Columns = append(content.Columns, col.Name)
+	}
+	for _, label := range board.Labels {
+		content.Labels = append(content.Labels, &pb.BoardLabel{Name: label.Name, Color: label.Color})
+	}
+
+	if req.IncludeTasks {
+		tasks, _, err := s.repo.List(ctx, repository.TaskFilter{BoardID: board.ID, Limit: maxSeedTasks})
+		if err != nil {
+			log.Printf("Failed to list board tasks: %v", err)
+			return nil, status.Error(codes.Internal, "failed to list board tasks")
+		}
+		// List is newest first; seed in creation order.
+		for i := len(tasks) - 1; i >= 0; i-- {
+			content.Tasks = append(content.Tasks, &pb.TaskTemplate{
+				Title:       tasks[i].Title,
+				Description: tasks[i].Description,
+			})
+		}
+	}
+
+	name := req.Name
+	if name == "" {
+		name = board.Name
+	}
+
+	tmpl, err := s.saveTemplate(ctx, name, req.Description, content, nil, board.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SaveBoardAsTemplateResponse{Template: tmpl}, nil
+}
+
+func (s *TaskService) CreateBoardFromTemplate(ctx context.Context, req *pb.CreateBoardFromTemplateRequest) (*pb.CreateBoardFromTemplateResponse, error) {
+	createdBy, err := actingUser(ctx, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := s.getTemplate(ctx, req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	content := tmpl.GetBoard()
+	if content == nil {
+		return nil, status.Error(codes.FailedPrecondition, "template is not a board template")
+	}
+
+	e := newExpander(time.Now().UTC(), req.Variables)
+
+	name := content.Name
+	if req.Name != "" {
+		name = req.Name
+	}
+
+	columns := make([]string, len(content.Columns))
+	for i, col := range content.Columns {
+		columns[i] = e.expand(col)
+	}
+	labels := make([]*pb.BoardLabel, len(content.Labels))
+	for i, label := range content.Labels {
+		labels[i] = &pb.BoardLabel{Name: e.expand(label.Name), Color: label.Color}
+	}
+
+	board, err := newBoard(e.expand(name), e.expand(content.Description), columns, labels)
+	if err != nil {
+		return nil, err
+	}
+	board.OwnerID = callerID(ctx)
+
+	seedTasks := make([]*repository.Task, len(content.Tasks))
+	for i, tt := range content.Tasks {
+		title, description := e.renderTask(tt)
+		seedTasks[i] = &repository.Task{
+			Title:       title,
+			Description: description,
+			CreatedBy:   createdBy,
+		}
+	}
+
+	if err := e.err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateBoard(ctx, board, seedTasks); err != nil {
+		log.Printf("Failed to create board from template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create board")
+	}
+
+	resp := &pb.CreateBoardFromTemplateResponse{Board: boardToProto(board)}
+	for _, task := range seedTasks {
+		s.publishEvent(ctx, "created", task)
+		resp.Tasks = append(resp.Tasks, domainToProto(task))
+	}
+
+	return resp, nil
+}
+
+func (s *TaskService) CreateTaskFromTemplate(ctx context.Context, req *pb.CreateTaskFromTemplateRequest) (*pb.CreateTaskFromTemplateResponse, error) {
+	createdBy, err := actingUser(ctx, req.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BoardId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "board_id is required")
+	}
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleMember); err != nil {
+		return nil, err
+	}
+
+	tmpl, err := s.getTemplate(ctx, req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	content := tmpl.GetTask()
+	if content == nil {
+		return nil, status.Error(codes.FailedPrecondition, "template is not a task template")
+	}
+
+	e := newExpander(time.Now().UTC(), req.Variables)
+	title, description := e.renderTask(content)
+	if err := e.err(); err != nil {
+		return nil, err
+	}
+
+	// Task templates carry no custom field values, so boards with
+	// required fields can't take them.
+	if _, err := s.resolveFieldValues(ctx, req.BoardId, nil, nil, true); err != nil {
+		return nil, err
+	}
+
+	task := &repository.Task{
+		BoardID:     req.BoardId,
+		Title:       title,
+		Description: description,
+		CreatedBy:   createdBy,
+	}
+	if err := s.repo.Create(ctx, task); err != nil {
+		log.Printf("Failed to create task from template: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create task")
+	}
+
+	event := s.newTaskEvent(ctx, "created", task)
+	s.publishTaskEvent(event)
+	s.notifyInbox(ctx, event, "", nil)
+
+	return &pb.CreateTaskFromTemplateResponse{Task: domainToProto(task)}, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+var templateNow = time.Date(2026, 3, 5, 14, 30, 0, 0, time.UTC)
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		vars map[string]string
+		want string
+	}{
+		{"no variables", "Plain title", nil, "Plain title"},
+		{"built-in date", "Standup {{date}}", nil, "Standup 2026-03-05"},
+		{"built-in year and month", "{{year}}-{{month}} report", nil, "2026-03 report"},
+		{"built-in datetime", "At {{datetime}}", nil, "At 2026-03-05T14:30:00Z"},
+		{"caller variable", "Release {{version}}", map[string]string{"version": "1.2"}, "Release 1.2"},
+		{"inner spaces", "Release {{ version }}", map[string]string{"version": "1.2"}, "Release 1.2"},
+		{"caller overrides built-in", "{{date}}", map[string]string{"date": "tomorrow"}, "tomorrow"},
+		{"repeated variable", "{{x}}/{{x}}", map[string]string{"x": "a"}, "a/a"},
+		{"not a variable", "{{1x}} and {single}", nil, "{{1x}} and {single}"},
+		{"values aren't expanded again", "{{x}}", map[string]string{"x": "{{date}}"}, "{{date}}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newExpander(templateNow, tt.vars)
+			if got := e.expand(tt.text); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+			if err := e.err(); err != nil {
+				t.Errorf("expected no error, got: %v", err)
+			}
+		})
+	}
+
+	t.Log("✅ Template variables expand")
+}
+
+func TestExpandUnknown(t *testing.T) {
+	e := newExpander(templateNow, map[string]string{"sprint": "12"})
+
+	got := e.expand("Sprint {{sprint}}: {{goal}} by {{owner}}, {{goal}}")
+	if want := "Sprint 12: {{goal}} by {{owner}}, {{goal}}"; got != want {
+		t.Errorf("expected unknown variables kept, got %q", got)
+	}
+
+	err := e.err()
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got: %v", err)
+	}
+	if want := "missing template variables: goal, owner"; status.Convert(err).Message() != want {
+		t.Errorf("expected %q, got %q", want, status.Convert(err).Message())
+	}
+
+	t.Log("✅ Unknown variables are reported once, sorted")
+}
+
+func TestRenderTask(t *testing.T) {
+	vars := map[string]string{"customer": "Acme"}
+
+	t.Run("checklist appended to description", func(t *testing.T) {
+		e := newExpander(templateNow, vars)
+		title, description := e.renderTask(&pb.TaskTemplate{
+			Title:       "Onboard {{customer}}",
+			Description: "Kick-off on {{date}}.",
+			Checklist:   []string{"Create {{customer}} workspace", "Send invites"},
+		})
+
+		if title != "Onboard Acme" {
+			t.Errorf("expected expanded title, got %q", title)
+		}
+		want := "Kick-off on 2026-03-05.\n\n- [ ] Create Acme workspace\n- [ ] Send invites"
+		if description != want {
+			t.Errorf("expected %q, got %q", want, description)
+		}
+	})
+
+	t.Run("checklist without description", func(t *testing.T) {
+		e := newExpander(templateNow, vars)
+		_, description := e.renderTask(&pb.TaskTemplate{Title: "x", Checklist: []string{"One"}})
+		if description != "- [ ] One" {
+			t.Errorf("expected only the checklist, got %q", description)
+		}
+	})
+
+	t.Run("no checklist", func(t *testing.T) {
+		e := newExpander(templateNow, vars)
+		_, description := e.renderTask(&pb.TaskTemplate{Title: "x", Description: "For {{customer}}"})
+		if description != "For Acme" {
+			t.Errorf("expected expanded description, got %q", description)
+		}
+	})
+
+	t.Log("✅ Task templates render")
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+func timeEntryToProto(entry *repository.TimeEntry) *pb.TimeEntry {
+	pbEntry := &pb.TimeEntry{
+		Id:              entry.ID,
+		TaskId:          entry.TaskID,
+		UserId:          entry.UserID,
+		Note:            entry.Note,
+		StartedAt:       timestamppb.New(entry.StartedAt),
+		DurationSeconds: int64(entry.Duration().Seconds()),
+		CreatedAt:       timestamppb.New(entry.CreatedAt),
+		UpdatedAt:       timestamppb.New(entry.UpdatedAt),
+	}
+	if entry.EndedAt != nil {
+		pbEntry.EndedAt = timestamppb.New(*entry.EndedAt)
+	}
+	return pbEntry
+}
+
+// requireTask checks that a task exists, returning a gRPC error if not.
+func (s *TaskService) requireTask(ctx context.Context, taskID int64) (*repository.Task, error) {
+	task, err := s.repo.GetByID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		log.Printf("Failed to get task: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get task")
+	}
+	return task, nil
+}
+
+// authorizeTimeEntry loads a time entry the caller may change: their own,
+// on a task they are at least a member for, or anyone's on a board they
+// are an admin of.
+func (s *TaskService) authorizeTimeEntry(ctx context.Context, id int64) (*repository.TimeEntry, error) {
+	entry, err := s.repo.GetTimeEntry(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrTimeEntryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to get time entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to get time entry")
+	}
+	task, err := s.authorizeTask(ctx, entry.TaskID, repository.RoleMember)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, repository.ErrTimeEntryNotFound.Error())
+		}
+		return nil, err
+	}
+	if entry.UserID != callerID(ctx) {
+		if err := s.authorizeBoard(ctx, task.BoardID, repository.RoleAdmin); err != nil {
+			if status.Code(err) == codes.PermissionDenied {
+				return nil, status.Error(codes.PermissionDenied, "only the entry's user and board admins can change it")
+			}
+			return nil, err
+		}
+	}
+	return entry, nil
+}
+
+func (s *TaskService) StartTimer(ctx context.Context, req *pb.StartTimerRequest) (*pb.StartTimerResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleMember); err != nil {
+		return nil, err
+	}
+
+	entry := &repository.TimeEntry{
+		TaskID: req.TaskId,
+		UserID: userID,
+		Note:   req.Note,
+	}
+	if err := s.repo.StartTimer(ctx, entry); err != nil {
+		if errors.Is(err, repository.ErrTimerRunning) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Printf("Failed to start timer: %v", err)
+		return nil, status.Error(codes.Internal, "failed to start timer")
+	}
+
+	return &pb.StartTimerResponse{Entry: timeEntryToProto(entry)}, nil
+}
+
+func (s *TaskService) StopTimer(ctx context.Context, req *pb.StopTimerRequest) (*pb.StopTimerResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.repo.StopTimer(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoRunningTimer) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		log.Printf("Failed to stop timer: %v", err)
+		return nil, status.Error(codes.Internal, "failed to stop timer")
+	}
+
+	return &pb.StopTimerResponse{Entry: timeEntryToProto(entry)}, nil
+}
+
+// validateRange checks a finished entry's start and end.
+func validateRange(start, end *timestamppb.Timestamp) error {
+	if start == nil || end == nil {
+		return status.Error(codes.InvalidArgument, "started_at and ended_at are required")
+	}
+	if end.AsTime().Before(start.AsTime()) {
+		return status.Error(codes.InvalidArgument, "ended_at must not be before started_at")
+	}
+	return nil
+}
+
+func (s *TaskService) CreateTimeEntry(ctx context.Context, req *pb.CreateTimeEntryRequest) (*pb.CreateTimeEntryResponse, error) {
+	if req.TaskId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateRange(req.StartedAt, req.EndedAt); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleMember); err != nil {
+		return nil, err
+	}
+
+	endedAt := req.EndedAt.AsTime()
+	entry := &repository.TimeEntry{
+		TaskID:    req.TaskId,
+		UserID:    userID,
+		Note:      req.Note,
+		StartedAt: req.StartedAt.AsTime(),
+		EndedAt:   &endedAt,
+	}
+	if err := s.repo.CreateTimeEntry(ctx, entry); err != nil {
+		log.Printf("Failed to create time entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create time entry")
+	}
+
+	return &pb.CreateTimeEntryResponse{Entry: timeEntryToProto(entry)}, nil
+}
+
+func (s *TaskService) UpdateTimeEntry(ctx context.Context, req *pb.UpdateTimeEntryRequest) (*pb.UpdateTimeEntryResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	entry, err := s.authorizeTimeEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update any of the optional fields.
+	if req.Note != nil {
+		entry.Note = *req.Note
+	}
+	if req.StartedAt != nil {
+		entry.StartedAt = req.StartedAt.AsTime()
+	}
+	if req.EndedAt != nil {
+		if entry.EndedAt == nil {
+			return nil, status.Error(codes.FailedPrecondition, "cannot set ended_at on a running timer, stop it instead")
+		}
+		endedAt := req.EndedAt.AsTime()
+		entry.EndedAt = &endedAt
+	}
+	if entry.EndedAt != nil && entry.EndedAt.Before(entry.StartedAt) {
+		return nil, status.Error(codes.InvalidArgument, "ended_at must not be before started_at")
+	}
+
+	if err := s.repo.UpdateTimeEntry(ctx, entry); err != nil {
+		log.Printf("Failed to update time entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to update time entry")
+	}
+
+	return &pb.UpdateTimeEntryResponse{Entry: timeEntryToProto(entry)}, nil
+}
+
+func (s *TaskService) DeleteTimeEntry(ctx context.Context, req *pb.DeleteTimeEntryRequest) (*pb.DeleteTimeEntryResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if _, err := s.authorizeTimeEntry(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteTimeEntry(ctx, req.Id); err != nil {
+		if errors.Is(err, repository.ErrTimeEntryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.Printf("Failed to delete time entry: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete time entry")
+	}
+
+	return &pb.DeleteTimeEntryResponse{Success: true}, nil
+}
+
+// timeEntryFilter converts the shared proto filter fields to the repository filter.
+func timeEntryFilter(taskID, boardID int64, userID string, from, to *timestamppb.Timestamp) repository.TimeEntryFilter {
+	filter := repository.TimeEntryFilter{
+		TaskID:  taskID,
+		BoardID: boardID,
+		UserID:  userID,
+	}
+	if from != nil {
+		filter.From = from.AsTime()
+	}
+	if to != nil {
+		filter.To = to.AsTime()
+	}
+	return filter
+}
+
+func (s *TaskService) ListTimeEntries(ctx context.Context, req *pb.ListTimeEntriesRequest) (*pb.ListTimeEntriesResponse, error) {
+	if req.TaskId == 0 && req.BoardId == 0 && req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "one of task_id, board_id or user_id is required")
+	}
+
+	if req.TaskId != 0 {
+		if _, err := s.authorizeTask(ctx, req.TaskId, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+	if req.BoardId != 0 {
+		if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+	}
+	// Across boards, users only list their own entries.
+	if req.TaskId == 0 && req.BoardId == 0 {
+		if _, err := actingUser(ctx, req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := timeEntryFilter(req.TaskId, req.BoardId, req.UserId, req.From, req.To)
+	entries, err := s.repo.ListTimeEntries(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list time entries: %v", err)
+		return nil, status.Error(codes.Internal, "failed to list time entries")
+	}
+
+	pbEntries := make([]*pb.TimeEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = timeEntryToProto(entry)
+	}
+
+	return &pb.ListTimeEntriesResponse{Entries: pbEntries}, nil
+}
+
+// reportPeriods maps the proto grouping onto postgres date_trunc fields.
+var reportPeriods = map[pb.TimeReportGrouping]string{
+	pb.TimeReportGrouping_TIME_REPORT_GROUPING_UNSPECIFIED: "day",
+	pb.TimeReportGrouping_TIME_REPORT_GROUPING_DAY:         "day",
+	pb.TimeReportGrouping_TIME_REPORT_GROUPING_WEEK:        "week",
+	pb.TimeReportGrouping_TIME_REPORT_GROUPING_MONTH:       "month",
+}
+
+func (s *TaskService) GetTimeReport(ctx context.Context, req *pb.GetTimeReportRequest) (*pb.GetTimeReportResponse, error) {
+	if req.BoardId == 0 && req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "board_id or user_id is required")
+	}
+	period, ok := reportPeriods[req.GroupBy]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid group_by")
+	}
+
+	// Across boards, users only report their own time.
+	if req.BoardId != 0 {
+		if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+	} else if _, err := actingUser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	filter := timeEntryFilter(0, req.BoardId, req.UserId, req.From, req.To)
+	rows, err := s.repo.TimeReport(ctx, filter, period)
+	if err != nil {
+		log.Printf("Failed to build time report: %v", err)
+		return nil, status.Error(codes.Internal, "failed to build time report")
+	}
+
+	resp := &pb.GetTimeReportResponse{Rows: make([]*pb.TimeReportRow, len(rows))}
+	for i, row := range rows {
+		resp.Rows[i] = &pb.TimeReportRow{
+			PeriodStart:  timestamppb.New(row.PeriodStart),
+			BoardId:      row.BoardID,
+			UserId:       row.UserID,
+			TotalSeconds: row.TotalSeconds,
+			EntryCount:   int32(row.EntryCount),
+		}
+		resp.TotalSeconds += row.TotalSeconds
+	}
+
+	return resp, nil
+}
+
+// taskTotalTime returns the rolled up time of a task, logging failures
+// rather than failing the surrounding request.
+func (s *TaskService) taskTotalTime(ctx context.Context, taskID int64) time.Duration {
+	total, err := s.repo.TotalTime(ctx, taskID)
+	if err != nil {
+		log.Printf("Failed to sum time for task %d: %v", taskID, err)
+		return 0
+	}
+	return total
+}
@@ -0,0 +1,605 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/core/boardio"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+const (
+	// exportChunkSize is the size of ExportBoard chunks.
+	exportChunkSize = 32 * 1024
+
+	// maxImportErrors bounds the row errors an import reports.
+	maxImportErrors = 1000
+)
+
+// errImportRejected rolls back an import that had row errors.
+var errImportRejected = errors.New("import has row errors")
+
+// chunkSender sends what is written to it as export chunks.
+type chunkSender struct {
+	stream grpc.ServerStreamingServer[pb.ExportBoardChunk]
+}
+
+func (c chunkSender) Write(p []byte) (int, error) {
+	// p is the bufio buffer, which is reused once Write returns.
+	if err := c.stream.Send(&pb.ExportBoardChunk{Data: bytes.Clone(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ExportBoard streams the board's tasks as a file. Tasks are read from the
+// database as they are sent, so exports of any size use little memory.
+func (s *TaskService) ExportBoard(req *pb.ExportBoardRequest, stream grpc.ServerStreamingServer[pb.ExportBoardChunk]) error {
+	ctx := stream.Context()
+
+	if req.BoardId == 0 {
+		return status.Error(codes.InvalidArgument, "board_id is required")
+	}
+
+	if err := s.authorizeBoard(ctx, req.BoardId, repository.RoleViewer); err != nil {
+		return err
+	}
+	board, err := s.getBoard(ctx, req.BoardId)
+	if err != nil {
+		return err
+	}
+	fields, err := s.repo.ListCustomFields(ctx, req.BoardId)
+	if err != nil {
+		return customFieldError("list", err)
+	}
+	fieldsByID := make(map[int64]*repository.CustomField, len(fields))
+	for _, f := range fields {
+		fieldsByID[f.ID] = f
+	}
+
+	out := bufio.NewWriterSize(chunkSender{stream: stream}, exportChunkSize)
+
+	var w boardio.Writer
+	switch req.Format {
+	case pb.DataFormat_DATA_FORMAT_CSV:
+		names := make([]string, len(fields))
+		for i, f := range fields {
+			names[i] = f.Name
+		}
+		w, err = boardio.NewCSVWriter(out, names)
+	case pb.DataFormat_DATA_FORMAT_JSON:
+		w, err = boardio.NewJSONWriter(out, exportBoard(board, fields))
+	default:
+		return status.Error(codes.InvalidArgument, "format must be CSV, JSON, Trello or GitHub")
+	}
+	if err != nil {
+		return exportError(err)
+	}
+
+	err = s.repo.ExportTasks(ctx, req.BoardId, func(task *repository.Task) error {
+		return w.WriteTask(exportTask(task, fieldsByID))
+	})
+	if err != nil {
+		return exportError(err)
+	}
+
+	if err := w.Close(); err != nil {
+		return exportError(err)
+	}
+	if err := out.Flush(); err != nil {
+		return exportError(err)
+	}
+
+	return nil
+}
+
+// exportError maps export failures. Errors sending to the client are
+// already gRPC errors.
+func exportError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	log.Printf("Failed to export board: %v", err)
+	return status.Error(codes.Internal, "failed to export board")
+}
+
+func exportBoard(board *repository.Board, fields []*repository.CustomField) *boardio.Board {
+	b := &boardio.Board{Name: board.Name, Description: board.Description}
+	for _, col := range board.Columns {
+		b.Columns = append(b.Columns, col.Name)
+	}
+	for _, label := range board.Labels {
+		b.Labels = append(b.Labels, boardio.Label{Name: label.Name, Color: label.Color})
+	}
+	for _, f := range fields {
+		b.CustomFields = append(b.CustomFields, boardio.CustomField{
+			Name:     f.Name,
+			Type:     f.Type,
+			Options:  f.Options,
+			Required: f.Required,
+			Position: f.Position,
+		})
+	}
+	return b
+}
+
+func exportTask(task *repository.Task, fields map[int64]*repository.CustomField) *boardio.Task {
+	createdAt, updatedAt := task.CreatedAt, task.UpdatedAt
+	t := &boardio.Task{
+		ID:          task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		Completed:   task.Completed,
+		CreatedBy:   task.CreatedBy,
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &updatedAt,
+		DueAt:       task.DueAt,
+		Labels:      task.Labels,
+		AssigneeIDs: task.AssigneeIDs,
+	}
+	if len(t.Labels) == 0 {
+		t.Labels = nil
+	}
+	if len(t.AssigneeIDs) == 0 {
+		t.AssigneeIDs = nil
+	}
+
+	for _, v := range task.CustomFields {
+		field, ok := fields[v.FieldID]
+		if !ok {
+			continue
+		}
+		var value any
+		switch field.Type {
+		case repository.FieldTypeNumber:
+			var n float64
+			if json.Unmarshal(v.Value, &n) == nil {
+				value = n
+			}
+		case repository.FieldTypeMultiSelect:
+			var opts []string
+			if json.Unmarshal(v.Value, &opts) == nil {
+				value = opts
+			}
+		default:
+			var text string
+			if json.Unmarshal(v.Value, &text) == nil {
+				value = text
+			}
+		}
+		if value == nil {
+			continue
+		}
+		if t.CustomFields == nil {
+			t.CustomFields = map[string]any{}
+		}
+		t.CustomFields[field.Name] = value
+	}
+
+	return t
+}
+
+// importReader reads the data messages of an import stream.
+type importReader struct {
+	stream grpc.ClientStreamingServer[pb.ImportBoardRequest, pb.ImportBoardResponse]
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetHeader() != nil {
+			return 0, status.Error(codes.InvalidArgument, "only the first message may be a header")
+		}
+		r.buf = msg.GetData()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// boardImport is the state of one ImportBoard call.
+type boardImport struct {
+	header *pb.ImportBoardHeader
+	fields map[string]*repository.CustomField
+	resp   *pb.ImportBoardResponse
+
+	// Which IDs the file names are users, looked up as they're met.
+	repo  repository.UserRepository
+	users map[string]bool
+}
+
+// isUser reports whether id is a user.
+func (imp *boardImport) isUser(ctx context.Context, id string) (bool, error) {
+	if known, ok := imp.users[id]; ok {
+		return known, nil
+	}
+	users, err := imp.repo.ListUserSummaries(ctx, []string{id})
+	if err != nil {
+		return false, err
+	}
+	imp.users[id] = len(users) > 0
+	return imp.users[id], nil
+}
+
+// fail records a row error.
+func (imp *boardImport) fail(row int, column, msg string) {
+	if len(imp.resp.Errors) >= maxImportErrors {
+		imp.resp.ErrorsTruncated = true
+		return
+	}
+	imp.resp.Errors = append(imp.resp.Errors, &pb.ImportRowError{Row: int64(row), Column: column, Message: msg})
+}
+
+func (imp *boardImport) failed() bool {
+	return len(imp.resp.Errors) > 0
+}
+
+// ImportBoard reads tasks from a file streamed after the header message.
+// Every row is validated as it arrives and written in one transaction,
+// which is rolled back if any row fails; a dry run only validates. Trello
+// and GitHub exports are imported by importExternal instead.
+// Imported tasks don't publish events, as a large import would flood
+// subscribers.
+func (s *TaskService) ImportBoard(stream grpc.ClientStreamingServer[pb.ImportBoardRequest, pb.ImportBoardResponse]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "the first message must be the header")
+	}
+	if header.CreatedBy, err = actingUser(ctx, header.CreatedBy); err != nil {
+		return err
+	}
+
+	imp := &boardImport{
+		header: header,
+		fields: map[string]*repository.CustomField{},
+		resp:   &pb.ImportBoardResponse{BoardId: header.BoardId, DryRun: header.DryRun},
+		repo:   s.repo,
+		users:  map[string]bool{},
+	}
+	in := &importReader{stream: stream}
+
+	switch header.Format {
+	case pb.DataFormat_DATA_FORMAT_TRELLO, pb.DataFormat_DATA_FORMAT_GITHUB:
+		return s.importExternal(stream, imp, in)
+	}
+	if len(header.UserMap) > 0 {
+		return status.Error(codes.InvalidArgument, "user_map only applies to Trello and GitHub imports")
+	}
+
+	var reader boardio.Reader
+	var fileFields []string
+	switch header.Format {
+	case pb.DataFormat_DATA_FORMAT_CSV:
+		if header.BoardId == 0 {
+			return status.Error(codes.InvalidArgument, "board_id is required for CSV imports")
+		}
+		r, err := boardio.NewCSVReader(in, header.ColumnMapping)
+		if err != nil {
+			return importError(err)
+		}
+		reader, fileFields = r, r.CustomFieldNames()
+	case pb.DataFormat_DATA_FORMAT_JSON:
+		if len(header.ColumnMapping) > 0 {
+			return status.Error(codes.InvalidArgument, "column_mapping only applies to CSV imports")
+		}
+		r, err := boardio.NewJSONReader(in)
+		if err != nil {
+			return importError(err)
+		}
+		reader = r
+	default:
+		return status.Error(codes.InvalidArgument, "format must be CSV, JSON, Trello or GitHub")
+	}
+
+	// The target board either exists, or is created from the file.
+	var newBoard *repository.Board
+	var newFields []*repository.CustomField
+	if header.BoardId != 0 {
+		if err := s.authorizeBoard(ctx, header.BoardId, repository.RoleAdmin); err != nil {
+			return err
+		}
+		if _, err := s.getBoard(ctx, header.BoardId); err != nil {
+			return err
+		}
+		fields, err := s.repo.ListCustomFields(ctx, header.BoardId)
+		if err != nil {
+			return customFieldError("list", err)
+		}
+		for _, f := range fields {
+			imp.fields[f.Name] = f
+		}
+	} else {
+		jr := reader.(*boardio.JSONReader)
+		if jr.Board() == nil {
+			return status.Error(codes.InvalidArgument, "board_id is required unless the file has a board")
+		}
+		newBoard, newFields, err = importBoard(jr.Board())
+		if err != nil {
+			return err
+		}
+		newBoard.OwnerID = callerID(ctx)
+		for _, f := range newFields {
+			imp.fields[f.Name] = f
+		}
+	}
+
+	for _, name := range fileFields {
+		if _, ok := imp.fields[name]; !ok {
+			return status.Errorf(codes.InvalidArgument, "column %s%s: the board has no such custom field",
+				boardio.CustomFieldPrefix, name)
+		}
+	}
+
+	run := func(insert func(*repository.Task) error) error {
+		for {
+			t, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			var rowErr *boardio.RowError
+			if errors.As(err, &rowErr) {
+				imp.resp.RowsTotal++
+				imp.fail(rowErr.Row, rowErr.Column, rowErr.Msg)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			imp.resp.RowsTotal++
+			task, ok, err := imp.task(ctx, reader.Row(), t)
+			if err != nil {
+				return err
+			}
+			if !ok || imp.failed() {
+				// Nothing will be committed; keep validating only.
+				continue
+			}
+			if err := insert(task); err != nil {
+				return err
+			}
+			imp.resp.RowsImported++
+		}
+
+		if imp.failed() {
+			return errImportRejected
+		}
+		return nil
+	}
+
+	if header.DryRun {
+		err = run(func(*repository.Task) error { return nil })
+	} else {
+		err = s.repo.ImportTasks(ctx, newBoard, newFields, run)
+	}
+	switch {
+	case errors.Is(err, errImportRejected):
+		imp.resp.RowsImported = 0
+	case err != nil:
+		return importError(err)
+	}
+
+	if newBoard != nil && !header.DryRun && !imp.failed() {
+		imp.resp.BoardId = newBoard.ID
+		imp.resp.BoardCreated = true
+	}
+
+	return stream.SendAndClose(imp.resp)
+}
+
+// importError maps import failures: unreadable files are the caller's
+// fault, and stream errors are already gRPC errors.
+func importError(err error) error {
+	if errors.Is(err, boardio.ErrInvalidFile) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	log.Printf("Failed to import board: %v", err)
+	return status.Error(codes.Internal, "failed to import board")
+}
+
+// importBoard validates a file's board and custom field definitions.
+func importBoard(b *boardio.Board) (*repository.Board, []*repository.CustomField, error) {
+	labels := make([]*pb.BoardLabel, len(b.Labels))
+	for i, label := range b.Labels {
+		labels[i] = &pb.BoardLabel{Name: label.Name, Color: label.Color}
+	}
+	board, err := newBoard(b.Name, b.Description, b.Columns, labels)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*repository.CustomField
+	seen := map[string]bool{}
+	for _, f := range b.CustomFields {
+		if strings.TrimSpace(f.Name) == "" {
+			return nil, nil, status.Error(codes.InvalidArgument, "custom field names cannot be empty")
+		}
+		if seen[f.Name] {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "duplicate custom field %q", f.Name)
+		}
+		seen[f.Name] = true
+		if _, ok := fieldTypesToProto[f.Type]; !ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "custom field %q has unknown type %q", f.Name, f.Type)
+		}
+		if err := validateOptions(f.Type, f.Options); err != nil {
+			return nil, nil, err
+		}
+		fields = append(fields, &repository.CustomField{
+			Name:     f.Name,
+			Type:     f.Type,
+			Options:  f.Options,
+			Required: f.Required,
+			Position: f.Position,
+		})
+	}
+
+	return board, fields, nil
+}
+
+// task validates a row and converts it, recording any errors. Creators who
+// aren't users are replaced by the header's; assignees must be users.
+func (imp *boardImport) task(ctx context.Context, row int, t *boardio.Task) (*repository.Task, bool, error) {
+	ok := true
+	fail := func(column string, err error) {
+		imp.fail(row, column, status.Convert(err).Message())
+		ok = false
+	}
+
+	if strings.TrimSpace(t.Title) == "" {
+		fail(boardio.ColumnTitle, status.Error(codes.InvalidArgument, "title is required"))
+	}
+	labels, err := cleanList("label", t.Labels)
+	if err != nil {
+		fail(boardio.ColumnLabels, err)
+	}
+	assignees, err := cleanList("assignee", t.AssigneeIDs)
+	if err != nil {
+		fail(boardio.ColumnAssigneeIDs, err)
+	}
+	for _, id := range assignees {
+		known, err := imp.isUser(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		if !known {
+			fail(boardio.ColumnAssigneeIDs, status.Errorf(codes.InvalidArgument, "assignee %q is not a user", id))
+		}
+	}
+
+	task := &repository.Task{
+		BoardID:     imp.header.BoardId,
+		Title:       t.Title,
+		Description: t.Description,
+		Completed:   t.Completed,
+		CreatedBy:   t.CreatedBy,
+		DueAt:       t.DueAt,
+		Labels:      labels,
+		AssigneeIDs: assignees,
+	}
+	if task.CreatedBy != "" {
+		known, err := imp.isUser(ctx, task.CreatedBy)
+		if err != nil {
+			return nil, false, err
+		}
+		if !known {
+			task.CreatedBy = ""
+		}
+	}
+	if task.CreatedBy == "" {
+		task.CreatedBy = imp.header.CreatedBy
+	}
+	if t.CreatedAt != nil {
+		task.CreatedAt = *t.CreatedAt
+	}
+	if t.UpdatedAt != nil {
+		task.UpdatedAt = *t.UpdatedAt
+	}
+
+	// Fields with invalid values, which are reported once.
+	invalid := map[string]bool{}
+	for name, value := range t.CustomFields {
+		column := boardio.CustomFieldPrefix + name
+		field, found := imp.fields[name]
+		if !found {
+			fail(column, status.Error(codes.InvalidArgument, "the board has no such custom field"))
+			continue
+		}
+		pbValue, err := importFieldValue(field, value)
+		if err == nil {
+			var raw json.RawMessage
+			raw, err = encodeFieldValue(field, pbValue)
+			if err == nil && raw != nil {
+				task.CustomFields = append(task.CustomFields, repository.CustomFieldValue{
+					FieldID: field.ID, Name: field.Name, Type: field.Type, Value: raw,
+				})
+			}
+		}
+		if err != nil {
+			fail(column, err)
+			invalid[name] = true
+		}
+	}
+
+	for _, field := range imp.fields {
+		if !field.Required {
+			continue
+		}
+		if !invalid[field.Name] && !hasFieldValue(task.CustomFields, field.Name) {
+			fail(boardio.CustomFieldPrefix+field.Name, status.Error(codes.InvalidArgument, "field is required"))
+		}
+	}
+
+	return task, ok, nil
+}
+
+func hasFieldValue(values []repository.CustomFieldValue, name string) bool {
+	for _, v := range values {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// importFieldValue converts a value read from a file for encodeFieldValue.
+// CSV values are text, so numbers and option lists are parsed here; values
+// that don't parse are passed on as text for encodeFieldValue to reject.
+func importFieldValue(field *repository.CustomField, value any) (*pb.CustomFieldValue, error) {
+	v := &pb.CustomFieldValue{FieldId: field.ID}
+
+	switch val := value.(type) {
+	case nil:
+	case float64:
+		v.Value = &pb.CustomFieldValue_Number{Number: val}
+	case []string:
+		v.Value = &pb.CustomFieldValue_Options{Options: &pb.StringList{Values: val}}
+	case string:
+		switch field.Type {
+		case repository.FieldTypeNumber:
+			if n, err := strconv.ParseFloat(strings.TrimSpace(val), 64); err == nil {
+				v.Value = &pb.CustomFieldValue_Number{Number: n}
+			} else {
+				v.Value = &pb.CustomFieldValue_Text{Text: val}
+			}
+		case repository.FieldTypeMultiSelect:
+			var opts []string
+			for _, opt := range strings.Split(val, boardio.ListSeparator) {
+				if opt = strings.TrimSpace(opt); opt != "" {
+					opts = append(opts, opt)
+				}
+			}
+			v.Value = &pb.CustomFieldValue_Options{Options: &pb.StringList{Values: opts}}
+		default:
+			v.Value = &pb.CustomFieldValue_Text{Text: val}
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "expected text, a number or a list of options")
+	}
+
+	return v, nil
+}
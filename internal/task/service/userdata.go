@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// workspaceUserDataToProto converts a workspace's data about a user, or
+// returns nil if there is none.
+func workspaceUserDataToProto(workspaceID int64, data *repository.UserData) *pb.WorkspaceUserData {
+	pbData := &pb.WorkspaceUserData{WorkspaceId: workspaceID, WatchedTaskIds: data.WatchedTaskIDs}
+	for _, task := range data.CreatedTasks {
+		pbData.CreatedTasks = append(pbData.CreatedTasks, domainToProto(task))
+	}
+	for _, task := range data.AssignedTasks {
+		pbData.AssignedTasks = append(pbData.AssignedTasks, domainToProto(task))
+	}
+	for _, entry := range data.TimeEntries {
+		pbData.TimeEntries = append(pbData.TimeEntries, timeEntryToProto(entry))
+	}
+	for _, m := range data.BoardMemberships {
+		pbData.BoardMemberships = append(pbData.BoardMemberships, boardMemberToProto(m))
+	}
+	for _, view := range data.SavedViews {
+		pbData.SavedViews = append(pbData.SavedViews, savedViewToProto(view))
+	}
+	for _, feed := range data.CalendarFeeds {
+		pbData.CalendarFeeds = append(pbData.CalendarFeeds, calendarFeedToProto(feed))
+	}
+	for _, n := range data.InboxNotifications {
+		pbData.InboxNotifications = append(pbData.InboxNotifications, inboxNotificationToProto(n))
+	}
+
+	empty := len(pbData.CreatedTasks)+len(pbData.AssignedTasks)+len(pbData.TimeEntries)+
+		len(pbData.WatchedTaskIds)+len(pbData.BoardMemberships)+len(pbData.SavedViews)+
+		len(pbData.CalendarFeeds)+len(pbData.InboxNotifications) == 0
+	if empty {
+		return nil
+	}
+	return pbData
+}
+
+// ExportUserData visits every workspace, including those the caller has
+// left, since the tasks they created there are still theirs.
+func (s *TaskService) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.repo.ListWorkspaceIDs(ctx)
+	if err != nil {
+		log.Printf("Failed to list workspaces: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	resp := &pb.ExportUserDataResponse{}
+	for _, id := range ids {
+		data, err := s.repo.ExportUserData(repository.WithWorkspace(ctx, id), userID)
+		if err != nil {
+			log.Printf("Failed to export user data in workspace %d: %v", id, err)
+			return nil, status.Error(codes.Internal, "failed to export user data")
+		}
+		if pbData := workspaceUserDataToProto(id, data); pbData != nil {
+			resp.Workspaces = append(resp.Workspaces, pbData)
+		}
+	}
+
+	memberships, err := s.repo.ListUserWorkspaceMemberships(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to export workspace memberships: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+	for _, m := range memberships {
+		resp.WorkspaceMemberships = append(resp.WorkspaceMemberships, workspaceMemberToProto(m))
+	}
+
+	prefs, err := s.repo.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to export notification preferences: %v", err)
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+	resp.NotificationPreferences = notificationPreferencesToProto(prefs)
+
+	return resp, nil
+}
+
+// DeleteUserData removes the caller from each workspace in turn. It is
+// idempotent, so a deletion that failed part way can be retried.
+func (s *TaskService) DeleteUserData(ctx context.Context, req *pb.DeleteUserDataRequest) (*pb.DeleteUserDataResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := s.repo.ListWorkspaceIDs(ctx)
+	if err != nil {
+		log.Printf("Failed to list workspaces: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+
+	for _, id := range ids {
+		if err := s.repo.DeleteUserData(repository.WithWorkspace(ctx, id), userID); err != nil {
+			log.Printf("Failed to delete user data in workspace %d: %v", id, err)
+			return nil, status.Error(codes.Internal, "failed to delete user data")
+		}
+	}
+
+	if err := s.repo.DeleteUserWorkspaceData(ctx, userID); err != nil {
+		log.Printf("Failed to delete user's workspace data: %v", err)
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+
+	log.Printf("Deleted data of user %s", userID)
+
+	return &pb.DeleteUserDataResponse{Success: true}, nil
+}
@@ -0,0 +1,621 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	mathrand "math/rand/v2"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/business/sys/webhook"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+const (
+	// webhookQueueGroup shares task events among task service instances,
+	// so each event is queued for delivery once.
+	webhookQueueGroup = "webhooks"
+
+	// Deliveries are sent webhookBatch at a time, at most
+	// webhookConcurrency at once, polling every webhookPollInterval for
+	// retries that are due.
+	webhookBatch        = 50
+	webhookConcurrency  = 8
+	webhookPollInterval = 5 * time.Second
+	webhookTimeout      = 10 * time.Second
+
+	// webhookLease is how long a claimed delivery is reserved for the
+	// worker sending it. It outlasts an attempt, so a delivery is only
+	// claimed again if its worker died.
+	webhookLease = time.Minute
+
+	// Webhooks are deactivated once their deliveries have failed for
+	// webhookDisableAfter, at least webhookDisableMinFailures times in a
+	// row.
+	webhookDisableAfter       = 24 * time.Hour
+	webhookDisableMinFailures = 10
+
+	minWebhookSecretLen = 16
+	maxWebhookURLLen    = 2048
+)
+
+// webhookEventTypes are the events webhooks can subscribe to.
+var webhookEventTypes = []string{"task.created", "task.updated", "task.deleted", "task.overdue"}
+
+// Webhook delivery statuses, as stored and in the API.
+var (
+	webhookDeliveryStatusesToRepo = map[pb.WebhookDeliveryStatus]string{
+		pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:   repository.DeliveryPending,
+		pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED: repository.DeliverySucceeded,
+		pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:      repository.DeliveryDead,
+	}
+	webhookDeliveryStatusesToProto = map[string]pb.WebhookDeliveryStatus{
+		repository.DeliveryPending:   pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+		repository.DeliverySucceeded: pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+		repository.DeliveryDead:      pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD,
+	}
+)
+
+// webhookPayload is the body of a webhook request. Data is the task event
+// as published on NATS.
+type webhookPayload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func webhookToProto(w *repository.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:             w.ID,
+		OwnerId:        w.OwnerID,
+		Url:            w.URL,
+		EventTypes:     w.EventTypes,
+		BoardIds:       w.BoardIDs,
+		Active:         w.Active,
+		DisabledReason: w.DisabledReason,
+		CreatedAt:      timestamppb.New(w.CreatedAt),
+		UpdatedAt:      timestamppb.New(w.UpdatedAt),
+	}
+}
+
+func webhookDeliveryToProto(d *repository.WebhookDelivery) *pb.WebhookDelivery {
+	pbDelivery := &pb.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.WebhookID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Status:         webhookDeliveryStatusesToProto[d.Status],
+		Attempts:       int32(d.Attempts),
+		Payload:        string(d.Payload),
+		ResponseStatus: int32(d.ResponseStatus),
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+		RedeliveryOf:   d.RedeliveryOf,
+	}
+	if d.LastAttemptAt != nil {
+		pbDelivery.LastAttemptAt = timestamppb.New(*d.LastAttemptAt)
+	}
+	if d.Status == repository.DeliveryPending {
+		pbDelivery.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	return pbDelivery
+}
+
+// webhookError maps repository errors to gRPC errors.
+func webhookError(action string, err error) error {
+	if errors.Is(err, repository.ErrWebhookNotFound) || errors.Is(err, repository.ErrWebhookDeliveryNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	log.Printf("Failed to %s webhook: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s webhook", action)
+}
+
+// newWebhookSecret returns a random signing secret.
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// validateWebhookURL checks that rawURL is an absolute http(s) URL, and
+// not one on an internal network. Names are checked when deliveries
+// connect, once they're resolved.
+func (s *TaskService) validateWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return status.Error(codes.InvalidArgument, "url is required")
+	}
+	if len(rawURL) > maxWebhookURLLen {
+		return status.Errorf(codes.InvalidArgument, "url is longer than %d characters", maxWebhookURLLen)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "url must be an absolute http or https URL, got %q", rawURL)
+	}
+	if ip, err := netip.ParseAddr(u.Hostname()); err == nil && webhook.Blocked(ip, s.webhookNetworks) {
+		return status.Errorf(codes.InvalidArgument, "url must not point to an internal address, got %q", rawURL)
+	}
+	return nil
+}
+
+// webhookEventFilter validates and deduplicates event types.
+func webhookEventFilter(eventTypes []string) ([]string, error) {
+	filter := []string{}
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown event type %q, want one of %v", eventType, webhookEventTypes)
+		}
+		if !slices.Contains(filter, eventType) {
+			filter = append(filter, eventType)
+		}
+	}
+	return filter, nil
+}
+
+// webhookBoardFilter checks that the boards exist and the caller can see
+// them, and deduplicates them.
+func (s *TaskService) webhookBoardFilter(ctx context.Context, boardIDs []int64) ([]int64, error) {
+	filter := []int64{}
+	for _, boardID := range boardIDs {
+		if slices.Contains(filter, boardID) {
+			continue
+		}
+		if err := s.authorizeBoard(ctx, boardID, repository.RoleViewer); err != nil {
+			return nil, err
+		}
+		if _, err := s.getBoard(ctx, boardID); err != nil {
+			return nil, err
+		}
+		filter = append(filter, boardID)
+	}
+	return filter, nil
+}
+
+// getOwnedWebhook returns a webhook owned by userID. Other users' webhooks
+// are reported as not found.
+func (s *TaskService) getOwnedWebhook(ctx context.Context, id int64, userID string) (*repository.Webhook, error) {
+	if id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	w, err := s.repo.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, webhookError("get", err)
+	}
+	if w.OwnerID != userID {
+		return nil, status.Error(codes.NotFound, repository.ErrWebhookNotFound.Error())
+	}
+	return w, nil
+}
+
+func (s *TaskService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	userID, err := actingUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateWebhookURL(req.Url); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = newWebhookSecret(); err != nil {
+			log.Printf("Failed to generate webhook secret: %v", err)
+			return nil, status.Error(codes.Internal, "failed to create webhook")
+		}
+	} else if len(secret) < minWebhookSecretLen {
+		return nil, status.Errorf(codes.InvalidArgument, "secret must be at least %d characters", minWebhookSecretLen)
+	}
+
+	eventTypes, err := webhookEventFilter(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	boardIDs, err := s.webhookBoardFilter(ctx, req.BoardIds)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &repository.Webhook{
+		OwnerID:    userID,
+		URL:        req.Url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		BoardIDs:   boardIDs,
+		Active:     true,
+	}
+	if err := s.repo.CreateWebhook(ctx, w); err != nil {
+		return nil, webhookError("create", err)
+	}
+
+	return &pb.CreateWebhookResponse{Webhook: webhookToProto(w), Secret: secret}, nil
+}
+
+func (s *TaskService) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.getOwnedWebhook(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWebhookResponse{Webhook: webhookToProto(w)}, nil
+}
+
+func (s *TaskService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := s.repo.ListWebhooks(ctx, userID)
+	if err != nil {
+		return nil, webhookError("list", err)
+	}
+
+	pbWebhooks := make([]*pb.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		pbWebhooks[i] = webhookToProto(w)
+	}
+
+	return &pb.ListWebhooksResponse{Webhooks: pbWebhooks}, nil
+}
+
+func (s *TaskService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.getOwnedWebhook(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Url != nil {
+		if err := s.validateWebhookURL(*req.Url); err != nil {
+			return nil, err
+		}
+		w.URL = *req.Url
+	}
+	if req.ReplaceEventTypes {
+		if w.EventTypes, err = webhookEventFilter(req.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	if req.ReplaceBoardIds {
+		if w.BoardIDs, err = s.webhookBoardFilter(ctx, req.BoardIds); err != nil {
+			return nil, err
+		}
+	}
+	if req.Active != nil {
+		if *req.Active != w.Active {
+			w.DisabledReason = ""
+		}
+		w.Active = *req.Active
+	}
+
+	var secret string
+	if req.RotateSecret {
+		if secret, err = newWebhookSecret(); err != nil {
+			log.Printf("Failed to generate webhook secret: %v", err)
+			return nil, status.Error(codes.Internal, "failed to update webhook")
+		}
+		w.Secret = secret
+	}
+
+	if err := s.repo.UpdateWebhook(ctx, w); err != nil {
+		return nil, webhookError("update", err)
+	}
+	if w.Active {
+		s.wakeWebhooks()
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: webhookToProto(w), Secret: secret}, nil
+}
+
+// DeleteWebhook removes a webhook and its delivery history.
+func (s *TaskService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedWebhook(ctx, req.Id, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.DeleteWebhook(ctx, req.Id); err != nil {
+		return nil, webhookError("delete", err)
+	}
+
+	return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (s *TaskService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOwnedWebhook(ctx, req.WebhookId, userID); err != nil {
+		return nil, err
+	}
+
+	filter := repository.WebhookDeliveryFilter{WebhookID: req.WebhookId}
+	if req.Status != pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED {
+		var ok bool
+		if filter.Status, ok = webhookDeliveryStatusesToRepo[req.Status]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown delivery status")
+		}
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 50 // Default page size.
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max page size.
+	}
+	pageNumber := req.PageNumber
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int((pageNumber - 1) * pageSize)
+
+	deliveries, totalCount, err := s.repo.ListWebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, webhookError("list deliveries of", err)
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		pbDeliveries[i] = webhookDeliveryToProto(d)
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: pbDeliveries, TotalCount: int32(totalCount)}, nil
+}
+
+// RedeliverWebhookDelivery queues a delivery's event to be sent again,
+// whatever became of it. The new delivery keeps the event ID.
+func (s *TaskService) RedeliverWebhookDelivery(ctx context.Context, req *pb.RedeliverWebhookDeliveryRequest) (*pb.RedeliverWebhookDeliveryResponse, error) {
+	userID, err := actingUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := s.getOwnedWebhook(ctx, req.WebhookId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.DeliveryId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "delivery_id is required")
+	}
+
+	original, err := s.repo.GetWebhookDelivery(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, webhookError("get delivery of", err)
+	}
+	if original.WebhookID != w.ID {
+		return nil, status.Error(codes.NotFound, repository.ErrWebhookDeliveryNotFound.Error())
+	}
+	if !w.Active {
+		return nil, status.Error(codes.FailedPrecondition, "webhook is inactive; activate it to redeliver")
+	}
+
+	delivery := &repository.WebhookDelivery{
+		WebhookID:    w.ID,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		RedeliveryOf: original.ID,
+	}
+	if err := s.repo.CreateWebhookDeliveries(ctx, []*repository.WebhookDelivery{delivery}); err != nil {
+		return nil, webhookError("redeliver to", err)
+	}
+	s.wakeWebhooks()
+
+	return &pb.RedeliverWebhookDeliveryResponse{Delivery: webhookDeliveryToProto(delivery)}, nil
+}
+
+// wakeWebhooks makes RunWebhooks send due deliveries now.
+func (s *TaskService) wakeWebhooks() {
+	select {
+	case s.webhookWake <- struct{}{}:
+	default:
+		// A wake-up is already pending.
+	}
+}
+
+// RunWebhooks queues task events for the webhooks subscribed to them and
+// sends due deliveries, until ctx is cancelled. Failed deliveries are
+// retried with exponential backoff, and are dead once the retries run out.
+func (s *TaskService) RunWebhooks(ctx context.Context) {
+	if s.nats == nil {
+		return
+	}
+
+	sub, err := s.nats.QueueSubscribe("workspaces.*.tasks.>", webhookQueueGroup, func(msg *nats.Msg) {
+		s.queueWebhookEvent(ctx, msg.Data)
+	})
+	if err != nil {
+		log.Printf("ERROR: Failed to subscribe to task events for webhooks: %v", err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	client := webhook.NewHTTPClient(webhookTimeout, s.webhookNetworks)
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.forEachWorkspace(ctx, func(ctx context.Context) {
+			s.sendWebhookDeliveries(ctx, client)
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.webhookWake:
+		}
+	}
+}
+
+// queueWebhookEvent creates a delivery of a task event to each webhook
+// subscribed to it.
+func (s *TaskService) queueWebhookEvent(ctx context.Context, data []byte) {
+	var event TaskEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		log.Printf("ERROR: Failed to unmarshal task event for webhooks: %v", err)
+		return
+	}
+	eventType := "task." + event.Type
+
+	ctx, cancel := context.WithTimeout(repository.WithWorkspace(ctx, event.WorkspaceID), webhookTimeout)
+	defer cancel()
+
+	webhooks, err := s.repo.MatchWebhooks(ctx, eventType, event.BoardId)
+	if err != nil {
+		log.Printf("ERROR: Failed to match webhooks to %s event: %v", eventType, err)
+		return
+	}
+	// Owners only hear about boards they can see.
+	webhooks = slices.DeleteFunc(webhooks, func(w *repository.Webhook) bool {
+		return !canSee(event.OpenBoard, event.MemberIDs, w.OwnerID)
+	})
+	if len(webhooks) == 0 {
+		return
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		log.Printf("ERROR: Failed to generate webhook event ID: %v", err)
+		return
+	}
+	payload, err := json.Marshal(webhookPayload{
+		ID:        hex.EncodeToString(id),
+		Type:      eventType,
+		CreatedAt: time.Unix(event.Timestamp, 0).UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		log.Printf("ERROR: Failed to marshal webhook payload: %v", err)
+		return
+	}
+
+	deliveries := make([]*repository.WebhookDelivery, len(webhooks))
+	for i, w := range webhooks {
+		deliveries[i] = &repository.WebhookDelivery{
+			WebhookID: w.ID,
+			EventID:   hex.EncodeToString(id),
+			EventType: eventType,
+			Payload:   payload,
+		}
+	}
+	if err := s.repo.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		log.Printf("ERROR: Failed to queue webhook deliveries of %s event: %v", eventType, err)
+		return
+	}
+	s.wakeWebhooks()
+}
+
+// sendWebhookDeliveries sends due deliveries until none are left.
+func (s *TaskService) sendWebhookDeliveries(ctx context.Context, client *http.Client) {
+	for ctx.Err() == nil {
+		deliveries, err := s.repo.ClaimWebhookDeliveries(ctx, webhookBatch, webhookLease)
+		if err != nil {
+			log.Printf("ERROR: Failed to claim webhook deliveries: %v", err)
+			return
+		}
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, webhookConcurrency)
+		for _, d := range deliveries {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				s.attemptWebhookDelivery(ctx, client, d)
+			}()
+		}
+		wg.Wait()
+
+		if len(deliveries) < webhookBatch {
+			return
+		}
+	}
+}
+
+// attemptWebhookDelivery sends a delivery and records the outcome.
+func (s *TaskService) attemptWebhookDelivery(ctx context.Context, client *http.Client, d *repository.WebhookDelivery) {
+	result := webhook.Send(ctx, client, webhook.Request{
+		URL:        d.URL,
+		Secret:     []byte(d.Secret),
+		EventType:  d.EventType,
+		DeliveryID: strconv.FormatInt(d.ID, 10),
+		Body:       d.Payload,
+	})
+
+	attempt := &repository.WebhookAttempt{
+		DeliveryID:         d.ID,
+		WebhookID:          d.WebhookID,
+		Succeeded:          result.OK(),
+		ResponseStatus:     result.StatusCode,
+		ResponseBody:       result.ResponseBody,
+		DisableAfter:       webhookDisableAfter,
+		DisableMinFailures: webhookDisableMinFailures,
+	}
+	if !result.OK() {
+		attempt.Error = result.Err.Error()
+		if delay, ok := webhook.DefaultRetryPolicy.Next(d.Attempts + 1); ok {
+			// Up to 10% jitter, so a receiver that was down isn't hit by
+			// all of its retries at once.
+			delay += mathrand.N(delay/10 + 1)
+			next := time.Now().Add(delay)
+			attempt.NextAttemptAt = &next
+		}
+	}
+
+	// Record the outcome even if ctx was cancelled mid-attempt; otherwise
+	// the delivery is retried once its lease runs out.
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), webhookTimeout)
+	defer cancel()
+	disabled, err := s.repo.RecordWebhookAttempt(recordCtx, attempt)
+	if err != nil {
+		log.Printf("ERROR: Failed to record webhook delivery %d: %v", d.ID, err)
+		return
+	}
+
+	switch {
+	case disabled:
+		log.Printf("⚠️ Webhook %d deactivated after repeated failures: %s", d.WebhookID, attempt.Error)
+	case !result.OK() && attempt.NextAttemptAt == nil:
+		log.Printf("Webhook delivery %d is dead after %d attempts: %s", d.ID, d.Attempts+1, attempt.Error)
+	}
+}
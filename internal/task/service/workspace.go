@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+	"github.com/zaouldyeck/taskboard/internal/task/repository"
+)
+
+// workspaceMetadataKey is the gRPC metadata key the gateway puts the
+// workspace a call is scoped to under.
+const workspaceMetadataKey = "x-workspace-id"
+
+// unscopedMethods take their workspace from the request rather than the
+// call's metadata.
+var unscopedMethods = map[string]bool{
+	pb.TaskService_CreateWorkspace_FullMethodName:       true,
+	pb.TaskService_ListWorkspaces_FullMethodName:        true,
+	pb.TaskService_UpdateWorkspace_FullMethodName:       true,
+	pb.TaskService_ListWorkspaceMembers_FullMethodName:  true,
+	pb.TaskService_AddWorkspaceMember_FullMethodName:    true,
+	pb.TaskService_RemoveWorkspaceMember_FullMethodName: true,
+	// Users' data spans every workspace.
+	pb.TaskService_ExportUserData_FullMethodName: true,
+	pb.TaskService_DeleteUserData_FullMethodName: true,
+	// Calendar tokens carry their workspace.
+	pb.TaskService_GetCalendar_FullMethodName: true,
+}
+
+var workspaceSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+var (
+	workspaceRolesToProto = map[string]pb.WorkspaceRole{
+		repository.WorkspaceRoleMember: pb.WorkspaceRole_WORKSPACE_ROLE_MEMBER,
+		repository.WorkspaceRoleOwner:  pb.WorkspaceRole_WORKSPACE_ROLE_OWNER,
+	}
+	workspaceRolesFromProto = map[pb.WorkspaceRole]string{
+		pb.WorkspaceRole_WORKSPACE_ROLE_MEMBER: repository.WorkspaceRoleMember,
+		pb.WorkspaceRole_WORKSPACE_ROLE_OWNER:  repository.WorkspaceRoleOwner,
+	}
+)
+
+func workspaceToProto(w *repository.Workspace) *pb.Workspace {
+	return &pb.Workspace{
+		Id:         w.ID,
+		Name:       w.Name,
+		Slug:       w.Slug,
+		CreatedAt:  timestamppb.New(w.CreatedAt),
+		RequireMfa: w.RequireMFA,
+	}
+}
+
+func workspaceMemberToProto(m *repository.WorkspaceMember) *pb.WorkspaceMember {
+	return &pb.WorkspaceMember{
+		WorkspaceId: m.WorkspaceID,
+		UserId:      m.UserID,
+		Role:        workspaceRolesToProto[m.Role],
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+}
+
+// UnaryInterceptor authenticates each call and scopes it to its
+// workspace; see prepareCall.
+func (s *TaskService) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := s.prepareCall(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authenticates each stream and scopes it to its
+// workspace; see prepareCall.
+func (s *TaskService) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := s.prepareCall(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &scopedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// prepareCall authenticates a TaskService call and scopes it to its
+// workspace. Calls to other services, like reflection, pass through.
+func (s *TaskService) prepareCall(ctx context.Context, method string) (context.Context, error) {
+	if !strings.HasPrefix(method, "/"+pb.TaskService_ServiceDesc.ServiceName+"/") {
+		return ctx, nil
+	}
+
+	ctx, err := s.authenticate(ctx, method)
+	if err != nil {
+		return nil, err
+	}
+	return s.scopeCall(ctx, method)
+}
+
+// scopedStream is a server stream with an authenticated, workspace-scoped
+// context.
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
+
+// scopeCall scopes ctx to the workspace in the call's metadata, or the
+// default workspace without one, once the caller is allowed to use it.
+func (s *TaskService) scopeCall(ctx context.Context, method string) (context.Context, error) {
+	if unscopedMethods[method] {
+		return ctx, nil
+	}
+
+	id := repository.DefaultWorkspaceID
+	if value := incomingMetadata(ctx, workspaceMetadataKey); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid workspace id")
+		}
+		id = parsed
+	}
+
+	if err := s.authorizeWorkspace(ctx, id, repository.WorkspaceRoleMember); err != nil {
+		return nil, err
+	}
+	return repository.WithWorkspace(ctx, id), nil
+}
+
+// workspaceAccess returns what the caller may do in a workspace.
+func (s *TaskService) workspaceAccess(ctx context.Context, id int64) (*repository.WorkspaceAccess, error) {
+	access, err := s.repo.GetWorkspaceAccess(ctx, id, callerID(ctx))
+	if errors.Is(err, repository.ErrWorkspaceNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		log.Printf("Failed to get workspace access: %v", err)
+		return nil, status.Error(codes.Internal, "failed to check workspace access")
+	}
+	return access, nil
+}
+
+// authorizeWorkspace checks that the caller holds at least minRole in a
+// workspace. Open workspaces allow everything. Workspaces the caller isn't
+// a member of are reported as not found, so their existence doesn't leak.
+// Either way, workspaces that require a second factor refuse callers
+// without one.
+func (s *TaskService) authorizeWorkspace(ctx context.Context, id int64, minRole string) error {
+	access, err := s.workspaceAccess(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case access.Open:
+		return checkMFA(access.RequireMFA, callerMFA(ctx), "workspace")
+	case callerID(ctx) == "":
+		return status.Error(codes.Unauthenticated, "workspace requires a user id")
+	case access.Role == "":
+		return status.Error(codes.NotFound, repository.ErrWorkspaceNotFound.Error())
+	case minRole == repository.WorkspaceRoleOwner && access.Role != repository.WorkspaceRoleOwner:
+		return status.Error(codes.PermissionDenied, "requires the owner role in this workspace")
+	}
+	return checkMFA(access.RequireMFA, callerMFA(ctx), "workspace")
+}
+
+// forEachWorkspace calls fn with ctx scoped to each workspace in turn, for
+// background jobs.
+func (s *TaskService) forEachWorkspace(ctx context.Context, fn func(ctx context.Context)) {
+	ids, err := s.repo.ListWorkspaceIDs(ctx)
+	if err != nil {
+		log.Printf("ERROR: Failed to list workspaces: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		fn(repository.WithWorkspace(ctx, id))
+	}
+}
+
+// eventWorkspace returns the workspace of the call that caused an event.
+func eventWorkspace(ctx context.Context) int64 {
+	id, _ := repository.WorkspaceFromContext(ctx)
+	return id
+}
+
+func workspaceError(err error, action string) error {
+	switch {
+	case errors.Is(err, repository.ErrWorkspaceNotFound), errors.Is(err, repository.ErrWorkspaceMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrWorkspaceSlugTaken), errors.Is(err, repository.ErrWorkspaceMemberExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, repository.ErrLastWorkspaceOwner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	log.Printf("Failed to %s: %v", action, err)
+	return status.Errorf(codes.Internal, "failed to %s", action)
+}
+
+func (s *TaskService) CreateWorkspace(ctx context.Context, req *pb.CreateWorkspaceRequest) (*pb.CreateWorkspaceResponse, error) {
+	userID := callerID(ctx)
+	if userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "creating a workspace requires a user id")
+	}
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if len(req.Slug) > 63 || !workspaceSlugPattern.MatchString(req.Slug) {
+		return nil, status.Error(codes.InvalidArgument,
+			"slug must be up to 63 lowercase letters, digits and single hyphens")
+	}
+
+	workspace := &repository.Workspace{Name: req.Name, Slug: req.Slug, OwnerID: userID}
+	if err := s.repo.CreateWorkspace(ctx, workspace); err != nil {
+		return nil, workspaceError(err, "create workspace")
+	}
+
+	return &pb.CreateWorkspaceResponse{Workspace: workspaceToProto(workspace)}, nil
+}
+
+func (s *TaskService) ListWorkspaces(ctx context.Context, req *pb.ListWorkspacesRequest) (*pb.ListWorkspacesResponse, error) {
+	workspaces, err := s.repo.ListWorkspaces(ctx, callerID(ctx))
+	if err != nil {
+		return nil, workspaceError(err, "list workspaces")
+	}
+
+	pbWorkspaces := make([]*pb.Workspace, len(workspaces))
+	for i, w := range workspaces {
+		pbWorkspaces[i] = workspaceToProto(w)
+	}
+
+	return &pb.ListWorkspacesResponse{Workspaces: pbWorkspaces}, nil
+}
+
+func (s *TaskService) UpdateWorkspace(ctx context.Context, req *pb.UpdateWorkspaceRequest) (*pb.UpdateWorkspaceResponse, error) {
+	if req.WorkspaceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace_id is required")
+	}
+	if err := s.authorizeWorkspace(ctx, req.WorkspaceId, repository.WorkspaceRoleOwner); err != nil {
+		return nil, err
+	}
+
+	if req.RequireMfa == nil {
+		workspace, err := s.repo.GetWorkspace(ctx, req.WorkspaceId)
+		if err != nil {
+			return nil, workspaceError(err, "get workspace")
+		}
+		return &pb.UpdateWorkspaceResponse{Workspace: workspaceToProto(workspace)}, nil
+	}
+
+	// Owners can't require what they couldn't satisfy themselves.
+	if *req.RequireMfa && !callerMFA(ctx) {
+		return nil, status.Error(codes.FailedPrecondition, "sign in with two-factor authentication before requiring it")
+	}
+	workspace, err := s.repo.SetWorkspaceRequireMFA(ctx, req.WorkspaceId, *req.RequireMfa)
+	if err != nil {
+		return nil, workspaceError(err, "update workspace")
+	}
+
+	return &pb.UpdateWorkspaceResponse{Workspace: workspaceToProto(workspace)}, nil
+}
+
+func (s *TaskService) ListWorkspaceMembers(ctx context.Context, req *pb.ListWorkspaceMembersRequest) (*pb.ListWorkspaceMembersResponse, error) {
+	if req.WorkspaceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace_id is required")
+	}
+	if err := s.authorizeWorkspace(ctx, req.WorkspaceId, repository.WorkspaceRoleMember); err != nil {
+		return nil, err
+	}
+
+	members, err := s.repo.ListWorkspaceMembers(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, workspaceError(err, "list workspace members")
+	}
+
+	pbMembers := make([]*pb.WorkspaceMember, len(members))
+	for i, m := range members {
+		pbMembers[i] = workspaceMemberToProto(m)
+	}
+
+	return &pb.ListWorkspaceMembersResponse{Members: pbMembers}, nil
+}
+
+func (s *TaskService) AddWorkspaceMember(ctx context.Context, req *pb.AddWorkspaceMemberRequest) (*pb.AddWorkspaceMemberResponse, error) {
+	if req.WorkspaceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	role, ok := workspaceRolesFromProto[req.Role]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "role is required")
+	}
+
+	access, err := s.workspaceAccess(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+	// Only admins may close an open workspace, and it must get an owner.
+	// Open workspaces include the default one, so anyone else could take
+	// it over.
+	if access.Open {
+		userID := callerID(ctx)
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "adding members requires a user id")
+		}
+		if err := s.requireAdmin(ctx, userID, "only admins can add an open workspace's first owner"); err != nil {
+			return nil, err
+		}
+		if role != repository.WorkspaceRoleOwner {
+			return nil, status.Error(codes.FailedPrecondition, "a workspace's first member must be an owner")
+		}
+		if err := checkMFA(access.RequireMFA, callerMFA(ctx), "workspace"); err != nil {
+			return nil, err
+		}
+	} else if err := s.authorizeWorkspace(ctx, req.WorkspaceId, repository.WorkspaceRoleOwner); err != nil {
+		return nil, err
+	}
+	if err := s.checkUsers(ctx, "user_id", []string{req.UserId}); err != nil {
+		return nil, err
+	}
+
+	member := &repository.WorkspaceMember{WorkspaceID: req.WorkspaceId, UserID: req.UserId, Role: role}
+	if err := s.repo.AddWorkspaceMember(ctx, member); err != nil {
+		return nil, workspaceError(err, "add workspace member")
+	}
+
+	return &pb.AddWorkspaceMemberResponse{Member: workspaceMemberToProto(member)}, nil
+}
+
+func (s *TaskService) RemoveWorkspaceMember(ctx context.Context, req *pb.RemoveWorkspaceMemberRequest) (*pb.RemoveWorkspaceMemberResponse, error) {
+	if req.WorkspaceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "workspace_id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Members can leave on their own.
+	if req.UserId != callerID(ctx) {
+		if err := s.authorizeWorkspace(ctx, req.WorkspaceId, repository.WorkspaceRoleOwner); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.RemoveWorkspaceMember(ctx, req.WorkspaceId, req.UserId); err != nil {
+		return nil, workspaceError(err, "remove workspace member")
+	}
+
+	return &pb.RemoveWorkspaceMemberResponse{Success: true}, nil
+}
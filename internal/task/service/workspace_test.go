@@ -0,0 +1,69 @@
+package service
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/zaouldyeck/taskboard/api/proto/task/v1"
+)
+
+func TestClaimOpenWorkspace(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller string
+		userID string
+		want   codes.Code
+	}{
+		{"admin", "root", "root", codes.OK},
+		{"admin for another user", "root", "member", codes.OK},
+		{"anyone else", "newcomer", "newcomer", codes.PermissionDenied},
+		{"unknown user", "root", "ghost", codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeRepo()
+			repo.workspace = map[string]string{}
+			s := &TaskService{repo: repo}
+
+			_, err := s.AddWorkspaceMember(asUser(tt.caller), &pb.AddWorkspaceMemberRequest{
+				WorkspaceId: 1, UserId: tt.userID, Role: pb.WorkspaceRole_WORKSPACE_ROLE_OWNER,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+			if claimed := len(repo.workspace) > 0; claimed != (tt.want == codes.OK) {
+				t.Errorf("expected workspace claimed %v, got %v", tt.want == codes.OK, claimed)
+			}
+		})
+	}
+
+	t.Log("✅ Only admins claim open workspaces")
+}
+
+func TestAddWorkspaceMember(t *testing.T) {
+	tests := []struct {
+		name   string
+		caller string
+		want   codes.Code
+	}{
+		{"owner", "boss", codes.OK},
+		{"not a member", "newcomer", codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &TaskService{repo: newFakeRepo()}
+			_, err := s.AddWorkspaceMember(asUser(tt.caller), &pb.AddWorkspaceMemberRequest{
+				WorkspaceId: 1, UserId: "member", Role: pb.WorkspaceRole_WORKSPACE_ROLE_MEMBER,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("expected %s, got: %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Log("✅ Only owners add members to closed workspaces")
+}
@@ -200,6 +200,14 @@ docker build -t taskboard-api-gateway:latest -f cmd/api-gateway/Dockerfile . ||
 docker tag taskboard-api-gateway:latest localhost:5010/taskboard-api-gateway:latest
 docker push localhost:5010/taskboard-api-gateway:latest
 
+echo "  - Building notifier..."
+docker build -t taskboard-notifier:latest -f cmd/notifier/Dockerfile . || {
+    echo -e "${RED}❌ Failed to build notifier${NC}"
+    exit 1
+}
+docker tag taskboard-notifier:latest localhost:5010/taskboard-notifier:latest
+docker push localhost:5010/taskboard-notifier:latest
+
 echo "✅ Images pushed to registry"
 
 # Step 7: Deploy with Helmfile